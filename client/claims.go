@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client // import "go.opentelemetry.io/collector/client"
+
+// SubjectProvider is an optional interface of AuthData, implemented by the
+// authenticators able to identify the authenticated client, e.g. from the
+// "sub" claim of a token or from a username.
+type SubjectProvider interface {
+	// Subject returns the identifier of the authenticated client.
+	Subject() string
+}
+
+// ScopesProvider is an optional interface of AuthData, implemented by the
+// authenticators able to tell the permissions granted to the authenticated
+// client, e.g. from the "scope" claim of a token.
+type ScopesProvider interface {
+	// Scopes returns the scopes granted to the authenticated client.
+	Scopes() []string
+}
+
+// TenantProvider is an optional interface of AuthData, implemented by the
+// authenticators able to tell the tenant the authenticated client belongs to.
+type TenantProvider interface {
+	// Tenant returns the tenant of the authenticated client.
+	Tenant() string
+}
+
+// Subject returns the subject of the authenticated client, and false if the
+// AuthData is nil or does not implement SubjectProvider.
+func (i Info) Subject() (string, bool) {
+	if p, ok := i.Auth.(SubjectProvider); ok {
+		return p.Subject(), true
+	}
+	return "", false
+}
+
+// Scopes returns the scopes granted to the authenticated client, and false if
+// the AuthData is nil or does not implement ScopesProvider.
+func (i Info) Scopes() ([]string, bool) {
+	if p, ok := i.Auth.(ScopesProvider); ok {
+		return p.Scopes(), true
+	}
+	return nil, false
+}
+
+// Tenant returns the tenant of the authenticated client, and false if the
+// AuthData is nil or does not implement TenantProvider.
+func (i Info) Tenant() (string, bool) {
+	if p, ok := i.Auth.(TenantProvider); ok {
+		return p.Tenant(), true
+	}
+	return "", false
+}
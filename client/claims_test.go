@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// basicAuthData only implements AuthData.
+type basicAuthData struct{}
+
+func (basicAuthData) GetAttribute(string) any     { return nil }
+func (basicAuthData) GetAttributeNames() []string { return nil }
+
+// claimsAuthData implements all the optional interfaces of AuthData.
+type claimsAuthData struct {
+	basicAuthData
+}
+
+func (claimsAuthData) Subject() string  { return "agent" }
+func (claimsAuthData) Scopes() []string { return []string{"traces:write", "metrics:write"} }
+func (claimsAuthData) Tenant() string   { return "acme" }
+
+func TestClaims(t *testing.T) {
+	for _, info := range []Info{{}, {Auth: basicAuthData{}}} {
+		subject, ok := info.Subject()
+		assert.False(t, ok)
+		assert.Empty(t, subject)
+		scopes, ok := info.Scopes()
+		assert.False(t, ok)
+		assert.Nil(t, scopes)
+		tenant, ok := info.Tenant()
+		assert.False(t, ok)
+		assert.Empty(t, tenant)
+	}
+
+	info := Info{Auth: claimsAuthData{}}
+	subject, ok := info.Subject()
+	assert.True(t, ok)
+	assert.Equal(t, "agent", subject)
+	scopes, ok := info.Scopes()
+	assert.True(t, ok)
+	assert.Equal(t, []string{"traces:write", "metrics:write"}, scopes)
+	tenant, ok := info.Tenant()
+	assert.True(t, ok)
+	assert.Equal(t, "acme", tenant)
+}
@@ -27,7 +27,11 @@
 // context, enhancing the client.Info with an implementation of client.AuthData,
 // and storing a new client.Info into the context that it passes down. The
 // attribute names should be documented with their return types and considered
-// part of the public API for the authenticator.
+// part of the public API for the authenticator. Authenticators should also
+// implement the SubjectProvider, ScopesProvider and TenantProvider interfaces
+// when they know the standard claims of the client, so consumers can read them
+// with Info.Subject, Info.Scopes and Info.Tenant without depending on a specific
+// authenticator.
 //
 // # Consumers
 //
@@ -79,7 +83,14 @@ package client // import "go.opentelemetry.io/collector/client"
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
 	"net"
+	"net/url"
+	"sort"
 	"strings"
 )
 
@@ -100,6 +111,33 @@ type Info struct {
 	// Metadata is the request metadata from the client connecting to this connector.
 	// Experimental: *NOTE* this structure is subject to change or removal in the future.
 	Metadata Metadata
+
+	// TLS contains the details of the certificate presented by the client, when
+	// connecting over TLS with a client certificate. It is nil otherwise.
+	// Experimental: *NOTE* this structure is subject to change or removal in the future.
+	TLS *TLS
+}
+
+// TLS contains the details of the certificate presented by a client over a TLS
+// connection, so components can enforce policies based on the client identity.
+type TLS struct {
+	// Subject is the subject of the client certificate.
+	Subject pkix.Name
+
+	// DNSNames, EmailAddresses, IPAddresses and URIs are the subject
+	// alternative names of the client certificate.
+	DNSNames       []string
+	EmailAddresses []string
+	IPAddresses    []net.IP
+	URIs           []*url.URL
+
+	// Fingerprint is the hex-encoded SHA-256 fingerprint of the client certificate.
+	Fingerprint string
+
+	// VerifiedChains are the certificate chains verified by the server, the
+	// first element of each chain being the client certificate. It is empty
+	// when the server does not verify the client certificates.
+	VerifiedChains [][]*x509.Certificate
 }
 
 // Metadata is an immutable map, meant to contain request metadata.
@@ -139,6 +177,25 @@ func FromContext(ctx context.Context) Info {
 	return c
 }
 
+// NewTLS returns the details of the client certificate of the given connection
+// state, or nil if the client did not present a certificate.
+func NewTLS(state *tls.ConnectionState) *TLS {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return nil
+	}
+	cert := state.PeerCertificates[0]
+	fingerprint := sha256.Sum256(cert.Raw)
+	return &TLS{
+		Subject:        cert.Subject,
+		DNSNames:       cert.DNSNames,
+		EmailAddresses: cert.EmailAddresses,
+		IPAddresses:    cert.IPAddresses,
+		URIs:           cert.URIs,
+		Fingerprint:    hex.EncodeToString(fingerprint[:]),
+		VerifiedChains: state.VerifiedChains,
+	}
+}
+
 // NewMetadata creates a new Metadata object to use in Info. md is used as-is.
 func NewMetadata(md map[string][]string) Metadata {
 	return Metadata{
@@ -146,6 +203,16 @@ func NewMetadata(md map[string][]string) Metadata {
 	}
 }
 
+// Keys returns the keys of the metadata, in lexicographical order.
+func (m Metadata) Keys() []string {
+	keys := make([]string, 0, len(m.data))
+	for key := range m.data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // Get gets the value of the key from metadata, returning a copy.
 func (m Metadata) Get(key string) []string {
 	vals := m.data[key]
@@ -7,10 +7,21 @@ package client
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"math/big"
 	"net"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewContext(t *testing.T) {
@@ -89,3 +100,44 @@ func TestMetadata(t *testing.T) {
 
 	assert.Empty(t, md.Get("non-existent-key"))
 }
+
+func TestNewTLS(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	spiffeID, err := url.Parse("spiffe://example.org/agent")
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(1),
+		Subject:        pkix.Name{CommonName: "agent", Organization: []string{"example"}},
+		DNSNames:       []string{"agent.example.org"},
+		EmailAddresses: []string{"agent@example.org"},
+		IPAddresses:    []net.IP{net.IPv4(10, 0, 0, 1)},
+		URIs:           []*url.URL{spiffeID},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	fingerprint := sha256.Sum256(der)
+
+	assert.Nil(t, NewTLS(nil))
+	assert.Nil(t, NewTLS(&tls.ConnectionState{}))
+
+	chains := [][]*x509.Certificate{{cert}}
+	info := NewTLS(&tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}, VerifiedChains: chains})
+	require.NotNil(t, info)
+	assert.Equal(t, "agent", info.Subject.CommonName)
+	assert.Equal(t, []string{"example"}, info.Subject.Organization)
+	assert.Equal(t, []string{"agent.example.org"}, info.DNSNames)
+	assert.Equal(t, []string{"agent@example.org"}, info.EmailAddresses)
+	assert.True(t, info.IPAddresses[0].Equal(net.IPv4(10, 0, 0, 1)))
+	assert.Equal(t, "spiffe://example.org/agent", info.URIs[0].String())
+	assert.Equal(t, hex.EncodeToString(fingerprint[:]), info.Fingerprint)
+	assert.Equal(t, chains, info.VerifiedChains)
+}
+
+func TestMetadataKeys(t *testing.T) {
+	assert.Empty(t, Metadata{}.Keys())
+	md := NewMetadata(map[string][]string{"b": {"1"}, "a": {"2"}})
+	assert.Equal(t, []string{"a", "b"}, md.Keys())
+}
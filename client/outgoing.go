@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client // import "go.opentelemetry.io/collector/client"
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// attributesAuthData is an AuthData holding a fixed set of attributes.
+type attributesAuthData map[string]any
+
+func (a attributesAuthData) GetAttribute(name string) any {
+	return a[name]
+}
+
+func (a attributesAuthData) GetAttributeNames() []string {
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewAuthData returns an AuthData holding the given attributes, e.g. to restore
+// the attributes of an Info which were stored with the data.
+func NewAuthData(attributes map[string]any) AuthData {
+	return attributesAuthData(attributes)
+}
+
+// Project returns an Info holding only the given metadata keys and auth
+// attributes of info, so that they can be propagated with the data after the
+// request of the client is done, e.g. while the data is queued by an exporter.
+// The auth attributes whose values are neither strings nor lists of strings are
+// not kept, nor are the address and the TLS details of the client.
+func Project(info Info, metadataKeys, authAttributes []string) Info {
+	var projected Info
+	if len(metadataKeys) > 0 {
+		md := map[string][]string{}
+		for _, key := range metadataKeys {
+			if vals := info.Metadata.Get(key); len(vals) > 0 {
+				md[key] = vals
+			}
+		}
+		projected.Metadata = NewMetadata(md)
+	}
+	if info.Auth != nil && len(authAttributes) > 0 {
+		attributes := map[string]any{}
+		for _, name := range authAttributes {
+			switch val := info.Auth.GetAttribute(name).(type) {
+			case string:
+				attributes[name] = val
+			case []string:
+				attributes[name] = append([]string(nil), val...)
+			}
+		}
+		projected.Auth = NewAuthData(attributes)
+	}
+	return projected
+}
+
+// ToOutgoingMetadata returns the metadata and the auth attributes of the Info
+// from the context as the metadata of an outgoing request, e.g. the gRPC
+// metadata or the HTTP headers sent by an exporter. The keys are lower-cased,
+// and only the auth attributes whose values are strings or lists of strings
+// are returned. The metadata take precedence over auth attributes with the same
+// name.
+func ToOutgoingMetadata(ctx context.Context) map[string][]string {
+	info := FromContext(ctx)
+	md := map[string][]string{}
+	if info.Auth != nil {
+		for _, name := range info.Auth.GetAttributeNames() {
+			switch val := info.Auth.GetAttribute(name).(type) {
+			case string:
+				md[strings.ToLower(name)] = []string{val}
+			case []string:
+				md[strings.ToLower(name)] = append([]string(nil), val...)
+			}
+		}
+	}
+	for _, key := range info.Metadata.Keys() {
+		md[strings.ToLower(key)] = info.Metadata.Get(key)
+	}
+	return md
+}
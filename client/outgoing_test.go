@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuthData(t *testing.T) {
+	auth := NewAuthData(map[string]any{"tenant": "acme", "groups": []string{"a", "b"}})
+	assert.Equal(t, []string{"groups", "tenant"}, auth.GetAttributeNames())
+	assert.Equal(t, "acme", auth.GetAttribute("tenant"))
+	assert.Nil(t, auth.GetAttribute("unknown"))
+}
+
+func TestProject(t *testing.T) {
+	info := Info{
+		Addr:     &net.IPAddr{IP: net.IPv4(1, 2, 3, 4)},
+		Metadata: NewMetadata(map[string][]string{"X-Tenant": {"acme"}, "Authorization": {"secret"}}),
+		Auth: NewAuthData(map[string]any{
+			"subject": "agent",
+			"groups":  []string{"a", "b"},
+			"expiry":  42,
+			"issuer":  "idp",
+		}),
+		TLS: &TLS{Fingerprint: "abc"},
+	}
+
+	projected := Project(info, []string{"x-tenant", "missing"}, []string{"subject", "groups", "expiry"})
+	assert.Nil(t, projected.Addr)
+	assert.Nil(t, projected.TLS)
+	assert.Equal(t, []string{"x-tenant"}, projected.Metadata.Keys())
+	assert.Equal(t, []string{"acme"}, projected.Metadata.Get("x-tenant"))
+	assert.Equal(t, []string{"groups", "subject"}, projected.Auth.GetAttributeNames())
+	assert.Equal(t, "agent", projected.Auth.GetAttribute("subject"))
+	assert.Equal(t, []string{"a", "b"}, projected.Auth.GetAttribute("groups"))
+
+	assert.Equal(t, Info{}, Project(info, nil, nil))
+	assert.Nil(t, Project(Info{}, nil, []string{"subject"}).Auth)
+}
+
+func TestToOutgoingMetadata(t *testing.T) {
+	assert.Empty(t, ToOutgoingMetadata(context.Background()))
+
+	ctx := NewContext(context.Background(), Info{
+		Metadata: NewMetadata(map[string][]string{"X-Tenant": {"acme"}, "Subject": {"from-metadata"}}),
+		Auth: NewAuthData(map[string]any{
+			"subject": "agent",
+			"Groups":  []string{"a", "b"},
+			"expiry":  42,
+		}),
+	})
+	assert.Equal(t, map[string][]string{
+		"x-tenant": {"acme"},
+		"subject":  {"from-metadata"},
+		"groups":   {"a", "b"},
+	}, ToOutgoingMetadata(ctx))
+}
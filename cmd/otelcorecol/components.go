@@ -3,8 +3,11 @@
 package main
 
 import (
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/connector"
+	countconnector "go.opentelemetry.io/collector/connector/countconnector"
 	forwardconnector "go.opentelemetry.io/collector/connector/forwardconnector"
+	spanmetricsconnector "go.opentelemetry.io/collector/connector/spanmetricsconnector"
 	"go.opentelemetry.io/collector/exporter"
 	debugexporter "go.opentelemetry.io/collector/exporter/debugexporter"
 	loggingexporter "go.opentelemetry.io/collector/exporter/loggingexporter"
@@ -12,7 +15,13 @@ import (
 	otlphttpexporter "go.opentelemetry.io/collector/exporter/otlphttpexporter"
 	"go.opentelemetry.io/collector/extension"
 	ballastextension "go.opentelemetry.io/collector/extension/ballastextension"
+	filestorageextension "go.opentelemetry.io/collector/extension/filestorageextension"
+	gomemlimitextension "go.opentelemetry.io/collector/extension/gomemlimitextension"
+	healthcheckextension "go.opentelemetry.io/collector/extension/healthcheckextension"
+	leaderelectionextension "go.opentelemetry.io/collector/extension/leaderelectionextension"
 	memorylimiterextension "go.opentelemetry.io/collector/extension/memorylimiterextension"
+	opampextension "go.opentelemetry.io/collector/extension/opampextension"
+	profilingextension "go.opentelemetry.io/collector/extension/profilingextension"
 	zpagesextension "go.opentelemetry.io/collector/extension/zpagesextension"
 	"go.opentelemetry.io/collector/otelcol"
 	"go.opentelemetry.io/collector/processor"
@@ -20,6 +29,7 @@ import (
 	memorylimiterprocessor "go.opentelemetry.io/collector/processor/memorylimiterprocessor"
 	"go.opentelemetry.io/collector/receiver"
 	otlpreceiver "go.opentelemetry.io/collector/receiver/otlpreceiver"
+	profilingreceiver "go.opentelemetry.io/collector/receiver/profilingreceiver"
 )
 
 func components() (otelcol.Factories, error) {
@@ -28,19 +38,39 @@ func components() (otelcol.Factories, error) {
 
 	factories.Extensions, err = extension.MakeFactoryMap(
 		ballastextension.NewFactory(),
+		filestorageextension.NewFactory(),
+		gomemlimitextension.NewFactory(),
+		healthcheckextension.NewFactory(),
+		leaderelectionextension.NewFactory(),
 		memorylimiterextension.NewFactory(),
+		opampextension.NewFactory(),
+		profilingextension.NewFactory(),
 		zpagesextension.NewFactory(),
 	)
 	if err != nil {
 		return otelcol.Factories{}, err
 	}
+	factories.ExtensionModules = make(map[component.Type]string, len(factories.Extensions))
+	factories.ExtensionModules[ballastextension.NewFactory().Type()] = "go.opentelemetry.io/collector/extension/ballastextension v0.93.0"
+	factories.ExtensionModules[filestorageextension.NewFactory().Type()] = "go.opentelemetry.io/collector/extension/filestorageextension v0.93.0"
+	factories.ExtensionModules[gomemlimitextension.NewFactory().Type()] = "go.opentelemetry.io/collector/extension/gomemlimitextension v0.93.0"
+	factories.ExtensionModules[healthcheckextension.NewFactory().Type()] = "go.opentelemetry.io/collector/extension/healthcheckextension v0.93.0"
+	factories.ExtensionModules[leaderelectionextension.NewFactory().Type()] = "go.opentelemetry.io/collector/extension/leaderelectionextension v0.93.0"
+	factories.ExtensionModules[memorylimiterextension.NewFactory().Type()] = "go.opentelemetry.io/collector/extension/memorylimiterextension v0.93.0"
+	factories.ExtensionModules[opampextension.NewFactory().Type()] = "go.opentelemetry.io/collector/extension/opampextension v0.93.0"
+	factories.ExtensionModules[profilingextension.NewFactory().Type()] = "go.opentelemetry.io/collector/extension/profilingextension v0.93.0"
+	factories.ExtensionModules[zpagesextension.NewFactory().Type()] = "go.opentelemetry.io/collector/extension/zpagesextension v0.93.0"
 
 	factories.Receivers, err = receiver.MakeFactoryMap(
 		otlpreceiver.NewFactory(),
+		profilingreceiver.NewFactory(),
 	)
 	if err != nil {
 		return otelcol.Factories{}, err
 	}
+	factories.ReceiverModules = make(map[component.Type]string, len(factories.Receivers))
+	factories.ReceiverModules[otlpreceiver.NewFactory().Type()] = "go.opentelemetry.io/collector/receiver/otlpreceiver v0.93.0"
+	factories.ReceiverModules[profilingreceiver.NewFactory().Type()] = "go.opentelemetry.io/collector/receiver/profilingreceiver v0.93.0"
 
 	factories.Exporters, err = exporter.MakeFactoryMap(
 		debugexporter.NewFactory(),
@@ -51,6 +81,11 @@ func components() (otelcol.Factories, error) {
 	if err != nil {
 		return otelcol.Factories{}, err
 	}
+	factories.ExporterModules = make(map[component.Type]string, len(factories.Exporters))
+	factories.ExporterModules[debugexporter.NewFactory().Type()] = "go.opentelemetry.io/collector/exporter/debugexporter v0.93.0"
+	factories.ExporterModules[loggingexporter.NewFactory().Type()] = "go.opentelemetry.io/collector/exporter/loggingexporter v0.93.0"
+	factories.ExporterModules[otlpexporter.NewFactory().Type()] = "go.opentelemetry.io/collector/exporter/otlpexporter v0.93.0"
+	factories.ExporterModules[otlphttpexporter.NewFactory().Type()] = "go.opentelemetry.io/collector/exporter/otlphttpexporter v0.93.0"
 
 	factories.Processors, err = processor.MakeFactoryMap(
 		batchprocessor.NewFactory(),
@@ -59,13 +94,22 @@ func components() (otelcol.Factories, error) {
 	if err != nil {
 		return otelcol.Factories{}, err
 	}
+	factories.ProcessorModules = make(map[component.Type]string, len(factories.Processors))
+	factories.ProcessorModules[batchprocessor.NewFactory().Type()] = "go.opentelemetry.io/collector/processor/batchprocessor v0.93.0"
+	factories.ProcessorModules[memorylimiterprocessor.NewFactory().Type()] = "go.opentelemetry.io/collector/processor/memorylimiterprocessor v0.93.0"
 
 	factories.Connectors, err = connector.MakeFactoryMap(
+		countconnector.NewFactory(),
 		forwardconnector.NewFactory(),
+		spanmetricsconnector.NewFactory(),
 	)
 	if err != nil {
 		return otelcol.Factories{}, err
 	}
+	factories.ConnectorModules = make(map[component.Type]string, len(factories.Connectors))
+	factories.ConnectorModules[countconnector.NewFactory().Type()] = "go.opentelemetry.io/collector/connector/countconnector v0.93.0"
+	factories.ConnectorModules[forwardconnector.NewFactory().Type()] = "go.opentelemetry.io/collector/connector/forwardconnector v0.93.0"
+	factories.ConnectorModules[spanmetricsconnector.NewFactory().Type()] = "go.opentelemetry.io/collector/connector/spanmetricsconnector v0.93.0"
 
 	return factories, nil
 }
@@ -8,7 +8,9 @@ require (
 	github.com/stretchr/testify v1.8.4
 	go.opentelemetry.io/collector/component v0.93.0
 	go.opentelemetry.io/collector/connector v0.93.0
+	go.opentelemetry.io/collector/connector/countconnector v0.93.0
 	go.opentelemetry.io/collector/connector/forwardconnector v0.93.0
+	go.opentelemetry.io/collector/connector/spanmetricsconnector v0.93.0
 	go.opentelemetry.io/collector/exporter v0.93.0
 	go.opentelemetry.io/collector/exporter/debugexporter v0.93.0
 	go.opentelemetry.io/collector/exporter/loggingexporter v0.93.0
@@ -16,7 +18,13 @@ require (
 	go.opentelemetry.io/collector/exporter/otlphttpexporter v0.93.0
 	go.opentelemetry.io/collector/extension v0.93.0
 	go.opentelemetry.io/collector/extension/ballastextension v0.93.0
+	go.opentelemetry.io/collector/extension/filestorageextension v0.93.0
+	go.opentelemetry.io/collector/extension/gomemlimitextension v0.93.0
+	go.opentelemetry.io/collector/extension/healthcheckextension v0.93.0
+	go.opentelemetry.io/collector/extension/leaderelectionextension v0.93.0
 	go.opentelemetry.io/collector/extension/memorylimiterextension v0.93.0
+	go.opentelemetry.io/collector/extension/opampextension v0.93.0
+	go.opentelemetry.io/collector/extension/profilingextension v0.93.0
 	go.opentelemetry.io/collector/extension/zpagesextension v0.93.0
 	go.opentelemetry.io/collector/otelcol v0.93.0
 	go.opentelemetry.io/collector/processor v0.93.0
@@ -24,6 +32,7 @@ require (
 	go.opentelemetry.io/collector/processor/memorylimiterprocessor v0.93.0
 	go.opentelemetry.io/collector/receiver v0.93.0
 	go.opentelemetry.io/collector/receiver/otlpreceiver v0.93.0
+	go.opentelemetry.io/collector/receiver/profilingreceiver v0.93.0
 	go.uber.org/goleak v1.3.0
 	golang.org/x/sys v0.16.0
 )
@@ -73,6 +82,7 @@ require (
 	github.com/tklauser/go-sysconf v0.3.12 // indirect
 	github.com/tklauser/numcpus v0.6.1 // indirect
 	github.com/yusufpapurcu/wmi v1.2.3 // indirect
+	go.etcd.io/bbolt v1.3.8 // indirect
 	go.opencensus.io v0.24.0 // indirect
 	go.opentelemetry.io/collector v0.93.0 // indirect
 	go.opentelemetry.io/collector/config/configauth v0.93.0 // indirect
@@ -156,8 +166,12 @@ replace go.opentelemetry.io/collector/consumer => ../../consumer
 
 replace go.opentelemetry.io/collector/connector => ../../connector
 
+replace go.opentelemetry.io/collector/connector/countconnector => ../../connector/countconnector
+
 replace go.opentelemetry.io/collector/connector/forwardconnector => ../../connector/forwardconnector
 
+replace go.opentelemetry.io/collector/connector/spanmetricsconnector => ../../connector/spanmetricsconnector
+
 replace go.opentelemetry.io/collector/exporter => ../../exporter
 
 replace go.opentelemetry.io/collector/exporter/debugexporter => ../../exporter/debugexporter
@@ -174,8 +188,20 @@ replace go.opentelemetry.io/collector/extension/auth => ../../extension/auth
 
 replace go.opentelemetry.io/collector/extension/ballastextension => ../../extension/ballastextension
 
+replace go.opentelemetry.io/collector/extension/filestorageextension => ../../extension/filestorageextension
+
+replace go.opentelemetry.io/collector/extension/gomemlimitextension => ../../extension/gomemlimitextension
+
+replace go.opentelemetry.io/collector/extension/healthcheckextension => ../../extension/healthcheckextension
+
+replace go.opentelemetry.io/collector/extension/leaderelectionextension => ../../extension/leaderelectionextension
+
 replace go.opentelemetry.io/collector/extension/memorylimiterextension => ../../extension/memorylimiterextension
 
+replace go.opentelemetry.io/collector/extension/opampextension => ../../extension/opampextension
+
+replace go.opentelemetry.io/collector/extension/profilingextension => ../../extension/profilingextension
+
 replace go.opentelemetry.io/collector/extension/zpagesextension => ../../extension/zpagesextension
 
 replace go.opentelemetry.io/collector/featuregate => ../../featuregate
@@ -188,6 +214,8 @@ replace go.opentelemetry.io/collector/receiver => ../../receiver
 
 replace go.opentelemetry.io/collector/receiver/otlpreceiver => ../../receiver/otlpreceiver
 
+replace go.opentelemetry.io/collector/receiver/profilingreceiver => ../../receiver/profilingreceiver
+
 replace go.opentelemetry.io/collector/processor/batchprocessor => ../../processor/batchprocessor
 
 replace go.opentelemetry.io/collector/processor/memorylimiterprocessor => ../../processor/memorylimiterprocessor
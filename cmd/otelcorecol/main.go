@@ -5,6 +5,7 @@ package main
 
 import (
 	"log"
+	"os"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/otelcol"
@@ -25,7 +26,8 @@ func main() {
 func runInteractive(params otelcol.CollectorSettings) error {
 	cmd := otelcol.NewCommand(params)
 	if err := cmd.Execute(); err != nil {
-		log.Fatalf("collector server run finished with error: %v", err)
+		log.Printf("collector server run finished with error: %v", err)
+		os.Exit(otelcol.ExitCode(err))
 	}
 
 	return nil
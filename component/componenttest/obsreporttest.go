@@ -26,11 +26,13 @@ const (
 	// Changes to metric names or labels can break alerting, dashboards, etc
 	// that are used to monitor the Collector in production deployments.
 	// DO NOT SWITCH THE VARIABLES BELOW TO SIMILAR ONES DEFINED ON THE PACKAGE.
-	receiverTag  = "receiver"
-	scraperTag   = "scraper"
-	transportTag = "transport"
-	exporterTag  = "exporter"
-	processorTag = "processor"
+	receiverTag      = "receiver"
+	scraperTag       = "scraper"
+	errorCategoryTag = "error_category"
+	reasonTag        = "reason"
+	transportTag     = "transport"
+	exporterTag      = "exporter"
+	processorTag     = "processor"
 )
 
 type TestTelemetry struct {
@@ -112,12 +114,26 @@ func (tts *TestTelemetry) CheckReceiverMetrics(protocol string, acceptedMetricPo
 	return tts.prometheusChecker.checkReceiverMetrics(tts.id, protocol, acceptedMetricPoints, droppedMetricPoints)
 }
 
+// CheckReceiverAdmission checks that for the current exported values for the admission metrics of the
+// receiver, the in-flight bytes and the numbers of rejected requests by reason match given values.
+// When this function is called it is required to also call SetupTelemetry as first thing.
+func (tts *TestTelemetry) CheckReceiverAdmission(protocol string, inFlightBytes, tooLarge, overloaded int64) error {
+	return tts.prometheusChecker.checkReceiverAdmission(tts.id, protocol, inFlightBytes, tooLarge, overloaded)
+}
+
 // CheckScraperMetrics checks that for the current exported values for metrics scraper metrics match given values.
 // When this function is called it is required to also call SetupTelemetry as first thing.
 func (tts *TestTelemetry) CheckScraperMetrics(receiver component.ID, scraper component.ID, scrapedMetricPoints, erroredMetricPoints int64) error {
 	return tts.prometheusChecker.checkScraperMetrics(receiver, scraper, scrapedMetricPoints, erroredMetricPoints)
 }
 
+// CheckScraperErrors checks that for the current exported values for the scraper_scrape_errors metric,
+// the numbers of failed scrapes by category of error match given values.
+// When this function is called it is required to also call SetupTelemetry as first thing.
+func (tts *TestTelemetry) CheckScraperErrors(receiver component.ID, scraper component.ID, timeout, partial, permanent int64) error {
+	return tts.prometheusChecker.checkScraperErrors(receiver, scraper, timeout, partial, permanent)
+}
+
 // Shutdown unregisters any views and shuts down the SpanRecorder
 func (tts *TestTelemetry) Shutdown(ctx context.Context) error {
 	var errs error
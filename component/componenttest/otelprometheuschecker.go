@@ -29,6 +29,24 @@ func (pc *prometheusChecker) checkScraperMetrics(receiver component.ID, scraper
 		pc.checkCounter("scraper_errored_metric_points", erroredMetricPoints, scraperAttrs))
 }
 
+func (pc *prometheusChecker) checkScraperErrors(receiver component.ID, scraper component.ID, timeout, partial, permanent int64) error {
+	var errs error
+	for _, category := range []struct {
+		name     string
+		expected int64
+	}{{"timeout", timeout}, {"partial", partial}, {"permanent", permanent}} {
+		attrs := append(attributesForScraperMetrics(receiver, scraper), attribute.String(errorCategoryTag, category.name))
+		if category.expected == 0 {
+			// The counter is not recorded until a scrape fails with the category.
+			if _, err := pc.getMetric("scraper_scrape_errors", io_prometheus_client.MetricType_COUNTER, attrs); err != nil {
+				continue
+			}
+		}
+		errs = multierr.Append(errs, pc.checkCounter("scraper_scrape_errors", category.expected, attrs))
+	}
+	return errs
+}
+
 func (pc *prometheusChecker) checkReceiverTraces(receiver component.ID, protocol string, accepted, dropped int64) error {
 	return pc.checkReceiver(receiver, "spans", protocol, accepted, dropped)
 }
@@ -48,6 +66,32 @@ func (pc *prometheusChecker) checkReceiver(receiver component.ID, datatype, prot
 		pc.checkCounter(fmt.Sprintf("receiver_refused_%s", datatype), droppedMetricPoints, receiverAttrs))
 }
 
+func (pc *prometheusChecker) checkReceiverAdmission(receiver component.ID, protocol string, inFlightBytes, tooLarge, overloaded int64) error {
+	receiverAttrs := attributesForReceiverMetrics(receiver, protocol)
+	var errs error
+	ts, err := pc.getMetric("receiver_inflight_bytes", io_prometheus_client.MetricType_GAUGE, receiverAttrs)
+	switch {
+	case err != nil && inFlightBytes != 0:
+		errs = multierr.Append(errs, err)
+	case err == nil && math.Abs(ts.GetGauge().GetValue()-float64(inFlightBytes)) > 0.0001:
+		errs = multierr.Append(errs, fmt.Errorf("values for metric 'receiver_inflight_bytes' did not match, expected '%f' got '%f'", float64(inFlightBytes), ts.GetGauge().GetValue()))
+	}
+	for _, reason := range []struct {
+		name     string
+		expected int64
+	}{{"too_large", tooLarge}, {"overloaded", overloaded}} {
+		attrs := append(attributesForReceiverMetrics(receiver, protocol), attribute.String(reasonTag, reason.name))
+		if reason.expected == 0 {
+			// The counter is not recorded until a request is rejected for the reason.
+			if _, err := pc.getMetric("receiver_rejected_requests", io_prometheus_client.MetricType_COUNTER, attrs); err != nil {
+				continue
+			}
+		}
+		errs = multierr.Append(errs, pc.checkCounter("receiver_rejected_requests", reason.expected, attrs))
+	}
+	return errs
+}
+
 func (pc *prometheusChecker) checkProcessorTraces(processor component.ID, accepted, refused, dropped int64) error {
 	return pc.checkProcessor(processor, "spans", accepted, refused, dropped)
 }
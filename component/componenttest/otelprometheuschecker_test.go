@@ -72,6 +72,26 @@ func TestPromChecker(t *testing.T) {
 		"metrics from Scraper Metrics should be valid",
 	)
 
+	assert.NoError(t,
+		pc.checkScraperErrors(receiver, scraper, 3, 0, 2),
+		"errors from Scraper Metrics should be valid",
+	)
+
+	assert.Error(t,
+		pc.checkScraperErrors(receiver, scraper, 3, 1, 2),
+		"missing errors from Scraper Metrics should return error",
+	)
+
+	assert.NoError(t,
+		pc.checkReceiverAdmission(receiver, transport, 1024, 0, 5),
+		"admission metrics from Receiver should be valid",
+	)
+
+	assert.Error(t,
+		pc.checkReceiverAdmission(receiver, transport, 0, 1, 5),
+		"missing admission metrics from Receiver should return error",
+	)
+
 	assert.NoError(t,
 		pc.checkReceiverTraces(receiver, transport, 42, 13),
 		"metrics from Receiver Traces should be valid",
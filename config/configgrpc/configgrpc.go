@@ -18,12 +18,15 @@ import (
 	"go.opentelemetry.io/otel"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/tap"
 
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
@@ -38,6 +41,10 @@ import (
 
 var errMetadataNotFound = errors.New("no request metadata found")
 
+// errMemoryLimitExceeded is returned to the clients while the memory limiter refuses data,
+// the Unavailable code tells them to retry later.
+var errMemoryLimitExceeded = status.Error(codes.Unavailable, "data refused due to high memory usage")
+
 // KeepaliveClientConfig exposes the keepalive.ClientParameters to be used by the exporter.
 // Refer to the original data-structure for the meaning of each parameter:
 // https://godoc.org/google.golang.org/grpc/keepalive#ClientParameters
@@ -146,6 +153,10 @@ type GRPCServerSettings struct {
 	// Auth for this receiver
 	Auth *configauth.Authentication `mapstructure:"auth"`
 
+	// MemoryLimiter is the ID of the memory_limiter extension consulted before reading
+	// the requests, they are rejected while the memory usage is above the limits.
+	MemoryLimiter *component.ID `mapstructure:"memory_limiter"`
+
 	// Include propagates the incoming connection's metadata to downstream consumers.
 	// Experimental: *NOTE* this option is subject to change or removal in the future.
 	IncludeMetadata bool `mapstructure:"include_metadata"`
@@ -348,6 +359,15 @@ func (gss *GRPCServerSettings) toServerOption(host component.Host, settings comp
 		}
 	}
 
+	if gss.MemoryLimiter != nil {
+		ml, err := getMemoryLimiter(host.GetExtensions(), *gss.MemoryLimiter)
+		if err != nil {
+			return nil, err
+		}
+		// The tap handle runs before the request message is read and decoded.
+		opts = append(opts, grpc.InTapHandle(memoryLimiterTapHandle(ml)))
+	}
+
 	var uInterceptors []grpc.UnaryServerInterceptor
 	var sInterceptors []grpc.StreamServerInterceptor
 
@@ -381,6 +401,33 @@ func (gss *GRPCServerSettings) toServerOption(host component.Host, settings comp
 	return opts, nil
 }
 
+// memoryLimiter is implemented by the memory_limiter extension.
+type memoryLimiter interface {
+	// MustRefuse returns whether the data must be refused because the memory usage is above the limits.
+	MustRefuse() bool
+}
+
+func getMemoryLimiter(extensions map[component.ID]component.Component, id component.ID) (memoryLimiter, error) {
+	ext, ok := extensions[id]
+	if !ok {
+		return nil, fmt.Errorf("failed to resolve memory limiter %q: extension not found", id)
+	}
+	ml, ok := ext.(memoryLimiter)
+	if !ok {
+		return nil, fmt.Errorf("extension %q is not a memory limiter", id)
+	}
+	return ml, nil
+}
+
+func memoryLimiterTapHandle(ml memoryLimiter) tap.ServerInHandle {
+	return func(ctx context.Context, _ *tap.Info) (context.Context, error) {
+		if ml.MustRefuse() {
+			return ctx, errMemoryLimitExceeded
+		}
+		return ctx, nil
+	}
+}
+
 // getGRPCCompressionName returns compression name registered in grpc.
 func getGRPCCompressionName(compressionType configcompression.CompressionType) (string, error) {
 	switch compressionType {
@@ -409,12 +456,17 @@ func enhanceStreamWithClientInformation(includeMetadata bool) func(srv any, ss g
 	}
 }
 
-// contextWithClient attempts to add the peer address to the client.Info from the context. When no
-// client.Info exists in the context, one is created.
+// contextWithClient attempts to add the peer address and TLS certificate to the client.Info from the
+// context. When no client.Info exists in the context, one is created.
 func contextWithClient(ctx context.Context, includeMetadata bool) context.Context {
 	cl := client.FromContext(ctx)
 	if p, ok := peer.FromContext(ctx); ok {
 		cl.Addr = p.Addr
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			if clientTLS := client.NewTLS(&tlsInfo.State); clientTLS != nil {
+				cl.TLS = clientTLS
+			}
+		}
 	}
 	if includeMetadata {
 		if md, ok := metadata.FromIncomingContext(ctx); ok {
@@ -5,11 +5,14 @@ package configgrpc
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"net"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -19,8 +22,11 @@ import (
 	"go.uber.org/zap/zaptest/observer"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
@@ -688,6 +694,63 @@ func TestReceiveOnUnixDomainSocket(t *testing.T) {
 	srv.Stop()
 }
 
+func TestGRPCServerMemoryLimiter(t *testing.T) {
+	ml := &mockMemoryLimiter{}
+	host := &mockHost{
+		ext: map[component.ID]component.Component{
+			component.NewID("memory_limiter"): ml,
+		},
+	}
+	id := component.NewID("memory_limiter")
+	gss := &GRPCServerSettings{
+		NetAddr: confignet.NetAddr{
+			Endpoint:  "localhost:0",
+			Transport: "tcp",
+		},
+		MemoryLimiter: &id,
+	}
+	ln, err := gss.ToListenerContext(context.Background())
+	require.NoError(t, err)
+	srv, err := gss.ToServer(host, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	ptraceotlp.RegisterGRPCServer(srv, &grpcTraceServer{})
+	go func() {
+		_ = srv.Serve(ln)
+	}()
+	defer srv.Stop()
+
+	gcs := &GRPCClientSettings{
+		Endpoint: ln.Addr().String(),
+		TLSSetting: configtls.TLSClientSetting{
+			Insecure: true,
+		},
+	}
+	grpcClientConn, err := gcs.ToClientConn(context.Background(), componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	defer grpcClientConn.Close()
+	c := ptraceotlp.NewGRPCClient(grpcClientConn)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancelFunc()
+
+	_, err = c.Export(ctx, ptraceotlp.NewExportRequest(), grpc.WaitForReady(true))
+	assert.NoError(t, err)
+
+	ml.mustRefuse.Store(true)
+	_, err = c.Export(ctx, ptraceotlp.NewExportRequest(), grpc.WaitForReady(true))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestGRPCServerMemoryLimiterErrors(t *testing.T) {
+	id := component.NewID("memory_limiter")
+	gss := &GRPCServerSettings{MemoryLimiter: &id}
+
+	_, err := gss.ToServer(&mockHost{}, componenttest.NewNopTelemetrySettings())
+	assert.EqualError(t, err, `failed to resolve memory limiter "memory_limiter": extension not found`)
+
+	_, err = gss.ToServer(&mockHost{ext: map[component.ID]component.Component{id: auth.NewServer()}}, componenttest.NewNopTelemetrySettings())
+	assert.EqualError(t, err, `extension "memory_limiter" is not a memory limiter`)
+}
+
 func TestContextWithClient(t *testing.T) {
 	testCases := []struct {
 		desc       string
@@ -792,6 +855,27 @@ func TestContextWithClient(t *testing.T) {
 	}
 }
 
+func TestContextWithClientTLS(t *testing.T) {
+	pair, err := tls.LoadX509KeyPair(filepath.Join("testdata", "client.crt"), filepath.Join("testdata", "client.key"))
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	require.NoError(t, err)
+	state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr:     &net.IPAddr{IP: net.IPv4(1, 2, 3, 4)},
+		AuthInfo: credentials.TLSInfo{State: state},
+	})
+	cl := client.FromContext(contextWithClient(ctx, false))
+	assert.Equal(t, &net.IPAddr{IP: net.IPv4(1, 2, 3, 4)}, cl.Addr)
+	assert.Equal(t, client.NewTLS(&state), cl.TLS)
+	assert.Equal(t, cert.Subject, cl.TLS.Subject)
+
+	// Connections without a client certificate have no TLS information.
+	ctx = peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{}})
+	assert.Nil(t, client.FromContext(contextWithClient(ctx, false)).TLS)
+}
+
 func TestStreamInterceptorEnhancesClient(t *testing.T) {
 	// prepare
 	inCtx := peer.NewContext(context.Background(), &peer.Peer{
@@ -1092,3 +1176,13 @@ type mockHost struct {
 func (nh *mockHost) GetExtensions() map[component.ID]component.Component {
 	return nh.ext
 }
+
+type mockMemoryLimiter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	mustRefuse atomic.Bool
+}
+
+func (ml *mockMemoryLimiter) MustRefuse() bool {
+	return ml.mustRefuse.Load()
+}
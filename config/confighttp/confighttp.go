@@ -4,6 +4,7 @@
 package confighttp // import "go.opentelemetry.io/collector/config/confighttp"
 
 import (
+	"context"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -21,13 +22,19 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configauth"
 	"go.opentelemetry.io/collector/config/configcompression"
+	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/config/internal"
 	"go.opentelemetry.io/collector/extension/auth"
 )
 
-const headerContentEncoding = "Content-Encoding"
+const (
+	headerContentEncoding = "Content-Encoding"
+
+	// memoryLimitExceededMsg is the message of the requests rejected by the memory limiter.
+	memoryLimitExceededMsg = "data refused due to high memory usage"
+)
 
 // HTTPClientSettings defines settings for creating an HTTP client.
 // Deprecated: [v0.94.0] Use HTTPClientConfig instead
@@ -274,6 +281,10 @@ type HTTPServerConfig struct {
 	// Auth for this receiver
 	Auth *configauth.Authentication `mapstructure:"auth"`
 
+	// MemoryLimiter is the ID of the memory_limiter extension consulted before reading
+	// the requests, they are rejected while the memory usage is above the limits.
+	MemoryLimiter *component.ID `mapstructure:"memory_limiter"`
+
 	// MaxRequestBodySize sets the maximum request body size in bytes
 	MaxRequestBodySize int64 `mapstructure:"max_request_body_size"`
 
@@ -286,9 +297,9 @@ type HTTPServerConfig struct {
 	ResponseHeaders map[string]configopaque.String `mapstructure:"response_headers"`
 }
 
-// ToListener creates a net.Listener.
+// ToListener creates a net.Listener, see confignet.Listen.
 func (hss *HTTPServerConfig) ToListener() (net.Listener, error) {
-	listener, err := net.Listen("tcp", hss.Endpoint)
+	listener, err := confignet.Listen(context.Background(), "tcp", hss.Endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -359,6 +370,15 @@ func (hss *HTTPServerConfig) ToServer(host component.Host, settings component.Te
 		handler = authInterceptor(handler, server)
 	}
 
+	if hss.MemoryLimiter != nil {
+		ml, err := getMemoryLimiter(host.GetExtensions(), *hss.MemoryLimiter)
+		if err != nil {
+			return nil, err
+		}
+
+		handler = memoryLimiterInterceptor(handler, ml, serverOpts.errHandler)
+	}
+
 	if hss.CORS != nil && len(hss.CORS.AllowedOrigins) > 0 {
 		co := cors.Options{
 			AllowedOrigins:   hss.CORS.AllowedOrigins,
@@ -451,6 +471,39 @@ func authInterceptor(next http.Handler, server auth.Server) http.Handler {
 	})
 }
 
+// memoryLimiter is implemented by the memory_limiter extension.
+type memoryLimiter interface {
+	// MustRefuse returns whether the data must be refused because the memory usage is above the limits.
+	MustRefuse() bool
+}
+
+func getMemoryLimiter(extensions map[component.ID]component.Component, id component.ID) (memoryLimiter, error) {
+	ext, ok := extensions[id]
+	if !ok {
+		return nil, fmt.Errorf("failed to resolve memory limiter %q: extension not found", id)
+	}
+	ml, ok := ext.(memoryLimiter)
+	if !ok {
+		return nil, fmt.Errorf("extension %q is not a memory limiter", id)
+	}
+	return ml, nil
+}
+
+func memoryLimiterInterceptor(next http.Handler, ml memoryLimiter, eh func(w http.ResponseWriter, r *http.Request, errorMsg string, statusCode int)) http.Handler {
+	errHandler := defaultErrorHandler
+	if eh != nil {
+		errHandler = eh
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ml.MustRefuse() {
+			errHandler(w, r, memoryLimitExceededMsg, http.StatusServiceUnavailable)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 func maxRequestBodySizeInterceptor(next http.Handler, maxRecvSize int64) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.Body = http.MaxBytesReader(w, r.Body, maxRecvSize)
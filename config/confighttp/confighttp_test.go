@@ -5,6 +5,8 @@ package confighttp
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
@@ -1122,6 +1124,23 @@ func TestContextWithClient(t *testing.T) {
 	}
 }
 
+func TestContextWithClientTLS(t *testing.T) {
+	pair, err := tls.LoadX509KeyPair(filepath.Join("testdata", "client.crt"), filepath.Join("testdata", "client.key"))
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	require.NoError(t, err)
+	state := &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+
+	cl := client.FromContext(contextWithClient(&http.Request{RemoteAddr: "1.2.3.4:55443", TLS: state}, false))
+	assert.Equal(t, &net.IPAddr{IP: net.IPv4(1, 2, 3, 4)}, cl.Addr)
+	assert.Equal(t, client.NewTLS(state), cl.TLS)
+	assert.Equal(t, cert.Subject, cl.TLS.Subject)
+
+	// Connections without a client certificate have no TLS information.
+	cl = client.FromContext(contextWithClient(&http.Request{TLS: &tls.ConnectionState{}}, false))
+	assert.Nil(t, cl.TLS)
+}
+
 func TestServerAuth(t *testing.T) {
 	// prepare
 	authCalled := false
@@ -1159,6 +1178,48 @@ func TestServerAuth(t *testing.T) {
 	assert.True(t, authCalled)
 }
 
+func TestServerMemoryLimiter(t *testing.T) {
+	ml := &mockMemoryLimiter{}
+	id := component.NewID("memory_limiter")
+	hss := HTTPServerConfig{
+		Endpoint:      "localhost:0",
+		MemoryLimiter: &id,
+	}
+	host := &mockHost{
+		ext: map[component.ID]component.Component{id: ml},
+	}
+
+	handlerCalled := false
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+	})
+	srv, err := hss.ToServer(host, componenttest.NewNopTelemetrySettings(), handler)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	srv.Handler.ServeHTTP(rec, httptest.NewRequest("POST", "/", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, handlerCalled)
+
+	handlerCalled = false
+	ml.mustRefuse = true
+	rec = httptest.NewRecorder()
+	srv.Handler.ServeHTTP(rec, httptest.NewRequest("POST", "/", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.False(t, handlerCalled)
+}
+
+func TestInvalidServerMemoryLimiter(t *testing.T) {
+	id := component.NewID("memory_limiter")
+	hss := HTTPServerConfig{MemoryLimiter: &id}
+
+	_, err := hss.ToServer(&mockHost{}, componenttest.NewNopTelemetrySettings(), http.NewServeMux())
+	assert.EqualError(t, err, `failed to resolve memory limiter "memory_limiter": extension not found`)
+
+	_, err = hss.ToServer(&mockHost{ext: map[component.ID]component.Component{id: auth.NewServer()}}, componenttest.NewNopTelemetrySettings(), http.NewServeMux())
+	assert.EqualError(t, err, `extension "memory_limiter" is not a memory limiter`)
+}
+
 func TestInvalidServerAuth(t *testing.T) {
 	hss := HTTPServerConfig{
 		Auth: &configauth.Authentication{
@@ -1266,6 +1327,16 @@ type mockHost struct {
 	ext map[component.ID]component.Component
 }
 
+type mockMemoryLimiter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	mustRefuse bool
+}
+
+func (ml *mockMemoryLimiter) MustRefuse() bool {
+	return ml.mustRefuse
+}
+
 func (nh *mockHost) GetExtensions() map[component.ID]component.Component {
 	return nh.ext
 }
@@ -11,6 +11,7 @@ require (
 	go.opentelemetry.io/collector/component v0.93.0
 	go.opentelemetry.io/collector/config/configauth v0.93.0
 	go.opentelemetry.io/collector/config/configcompression v0.93.0
+	go.opentelemetry.io/collector/config/confignet v0.93.0
 	go.opentelemetry.io/collector/config/configopaque v0.93.0
 	go.opentelemetry.io/collector/config/configtelemetry v0.93.0
 	go.opentelemetry.io/collector/config/configtls v0.93.0
@@ -69,6 +70,8 @@ replace go.opentelemetry.io/collector/config/configauth => ../configauth
 
 replace go.opentelemetry.io/collector/config/configcompression => ../configcompression
 
+replace go.opentelemetry.io/collector/config/confignet => ../confignet
+
 replace go.opentelemetry.io/collector/config/configopaque => ../configopaque
 
 replace go.opentelemetry.io/collector/config/configtls => ../configtls
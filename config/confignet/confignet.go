@@ -39,10 +39,9 @@ func (na *NetAddr) Dial(ctx context.Context) (net.Conn, error) {
 	return d.DialContext(ctx, na.Transport, na.Endpoint)
 }
 
-// Listen equivalent with net.ListenConfig's Listen for this address.
+// Listen equivalent with net.ListenConfig's Listen for this address, see Listen.
 func (na *NetAddr) Listen(ctx context.Context) (net.Listener, error) {
-	lc := net.ListenConfig{}
-	return lc.Listen(ctx, na.Transport, na.Endpoint)
+	return Listen(ctx, na.Transport, na.Endpoint)
 }
 
 // TCPAddr represents a TCP endpoint address.
@@ -64,8 +63,7 @@ func (na *TCPAddr) Dial(ctx context.Context) (net.Conn, error) {
 	return d.DialContext(ctx, "tcp", na.Endpoint)
 }
 
-// Listen equivalent with net.ListenConfig's Listen for this address.
+// Listen equivalent with net.ListenConfig's Listen for this address, see Listen.
 func (na *TCPAddr) Listen(ctx context.Context) (net.Listener, error) {
-	lc := net.ListenConfig{}
-	return lc.Listen(ctx, "tcp", na.Endpoint)
+	return Listen(ctx, "tcp", na.Endpoint)
 }
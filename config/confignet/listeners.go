@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package confignet // import "go.opentelemetry.io/collector/config/confignet"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+)
+
+// InheritedListenersEnv is the environment variable listing the listeners inherited from the
+// parent process, as comma-separated "network:address" entries, e.g. "tcp:0.0.0.0:4317".
+// The file descriptor of the n-th entry (starting from 0) is 3+n, as set by exec.Cmd.ExtraFiles.
+const InheritedListenersEnv = "OTELCOL_INHERITED_LISTENERS"
+
+// listeners tracks the listeners of the process, so that they can be handed off to a new process.
+var listeners = &listenerRegistry{}
+
+// Listen listens on the network address as net.ListenConfig's Listen does, unless a listener on
+// the same network and address was inherited from the parent process, in which case it is used.
+// The listener can be handed off to a child process with ListenerFiles until it is closed.
+func Listen(ctx context.Context, network, address string) (net.Listener, error) {
+	return listeners.listen(ctx, network, address)
+}
+
+// ListenerFiles returns duplicates of the file descriptors of the listeners which are open,
+// and the value of InheritedListenersEnv describing them, so that the listeners are inherited
+// by a child process started with the files as exec.Cmd.ExtraFiles and with the environment
+// variable set. The caller must close the files once the child process is started.
+func ListenerFiles() ([]*os.File, string, error) {
+	return listeners.files()
+}
+
+// CloseUnusedInheritedListeners closes the listeners inherited from the parent process which
+// were not used by Listen, e.g. the ones of a component which was removed from the configuration.
+func CloseUnusedInheritedListeners() error {
+	return listeners.closeUnusedInherited()
+}
+
+type listenerRegistry struct {
+	once sync.Once
+
+	mu sync.Mutex
+	// inherited are the listeners inherited from the parent process and not yet used, by key.
+	inherited map[string][]net.Listener
+	// inheritErr is the error of the parsing of the inherited listeners, returned by Listen.
+	inheritErr error
+	// active are the listeners returned by Listen and not closed.
+	active map[*trackedListener]struct{}
+}
+
+func listenerKey(network, address string) string {
+	return network + ":" + address
+}
+
+// loadInherited loads the listeners inherited from the parent process, once.
+func (r *listenerRegistry) loadInherited() {
+	r.once.Do(func() {
+		r.inherited = map[string][]net.Listener{}
+		r.active = map[*trackedListener]struct{}{}
+		env := os.Getenv(InheritedListenersEnv)
+		if env == "" {
+			return
+		}
+		// Unset the variable, so that it is not inherited by the processes started by the components.
+		_ = os.Unsetenv(InheritedListenersEnv)
+		for i, key := range strings.Split(env, ",") {
+			f := os.NewFile(uintptr(3+i), key)
+			ln, err := net.FileListener(f)
+			// The listener holds its own duplicate of the file descriptor.
+			_ = f.Close()
+			if err != nil {
+				r.inheritErr = errors.Join(r.inheritErr, fmt.Errorf("failed to inherit listener %q: %w", key, err))
+				continue
+			}
+			r.inherited[key] = append(r.inherited[key], ln)
+		}
+	})
+}
+
+func (r *listenerRegistry) listen(ctx context.Context, network, address string) (net.Listener, error) {
+	r.loadInherited()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.inheritErr != nil {
+		return nil, r.inheritErr
+	}
+
+	key := listenerKey(network, address)
+	var ln net.Listener
+	if inherited := r.inherited[key]; len(inherited) > 0 {
+		ln = inherited[0]
+		r.inherited[key] = inherited[1:]
+	} else {
+		lc := net.ListenConfig{}
+		var err error
+		if ln, err = lc.Listen(ctx, network, address); err != nil {
+			return nil, err
+		}
+	}
+	tl := &trackedListener{Listener: ln, key: key, registry: r}
+	r.active[tl] = struct{}{}
+	return tl, nil
+}
+
+func (r *listenerRegistry) files() ([]*os.File, string, error) {
+	r.loadInherited()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var files []*os.File
+	var keys []string
+	for tl := range r.active {
+		fl, ok := tl.Listener.(interface{ File() (*os.File, error) })
+		if !ok {
+			continue
+		}
+		if ul, ok := tl.Listener.(*net.UnixListener); ok {
+			// The socket file must be kept for the child process when the listener is closed.
+			ul.SetUnlinkOnClose(false)
+		}
+		f, err := fl.File()
+		if err != nil {
+			for _, f := range files {
+				_ = f.Close()
+			}
+			return nil, "", fmt.Errorf("failed to get the file of listener %q: %w", tl.key, err)
+		}
+		files = append(files, f)
+		keys = append(keys, tl.key)
+	}
+	return files, strings.Join(keys, ","), nil
+}
+
+func (r *listenerRegistry) closeUnusedInherited() error {
+	r.loadInherited()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var errs error
+	for key, lns := range r.inherited {
+		for _, ln := range lns {
+			errs = errors.Join(errs, ln.Close())
+		}
+		delete(r.inherited, key)
+	}
+	return errs
+}
+
+// trackedListener is a listener returned by Listen, tracked until it is closed.
+type trackedListener struct {
+	net.Listener
+	key      string
+	registry *listenerRegistry
+}
+
+func (tl *trackedListener) Close() error {
+	tl.registry.mu.Lock()
+	delete(tl.registry.active, tl)
+	tl.registry.mu.Unlock()
+	return tl.Listener.Close()
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package confignet
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const handoffTestAddrEnv = "CONFIGNET_TEST_HANDOFF_ADDR"
+
+func TestListenerFiles(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("listeners can not be handed off on windows")
+	}
+	r := &listenerRegistry{}
+	ln, err := r.listen(context.Background(), "tcp", "localhost:0")
+	require.NoError(t, err)
+
+	files, env, err := r.files()
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "tcp:localhost:0", env)
+	require.NoError(t, files[0].Close())
+
+	require.NoError(t, ln.Close())
+	files, env, err = r.files()
+	require.NoError(t, err)
+	assert.Empty(t, files)
+	assert.Empty(t, env)
+}
+
+func TestCloseUnusedInheritedListeners(t *testing.T) {
+	r := &listenerRegistry{}
+	r.loadInherited()
+	ln, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	r.inherited["tcp:localhost:0"] = []net.Listener{ln}
+
+	require.NoError(t, r.closeUnusedInherited())
+	assert.Empty(t, r.inherited)
+	_, err = ln.Accept()
+	assert.ErrorIs(t, err, net.ErrClosed)
+}
+
+func TestListenerHandoff(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("listeners can not be handed off on windows")
+	}
+	ln, err := Listen(context.Background(), "tcp", "localhost:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+
+	files, env, err := ListenerFiles()
+	require.NoError(t, err)
+	// Run TestListenerHandoffChild in a child process inheriting the listener.
+	cmd := exec.Command(os.Args[0], "-test.run=^TestListenerHandoffChild$")
+	cmd.Env = append(os.Environ(), InheritedListenersEnv+"="+env, handoffTestAddrEnv+"="+addr)
+	cmd.ExtraFiles = files
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	require.NoError(t, cmd.Start())
+	for _, f := range files {
+		require.NoError(t, f.Close())
+	}
+
+	// The listener of this process is closed, the connection is accepted by the child process.
+	require.NoError(t, ln.Close())
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	response, err := io.ReadAll(conn)
+	require.NoError(t, err)
+	assert.Equal(t, "child", string(response))
+	require.NoError(t, conn.Close())
+	require.NoError(t, cmd.Wait())
+}
+
+func TestListenerHandoffChild(t *testing.T) {
+	addr := os.Getenv(handoffTestAddrEnv)
+	if addr == "" {
+		t.Skip("only run as the child process of TestListenerHandoff")
+	}
+	ln, err := Listen(context.Background(), "tcp", "localhost:0")
+	require.NoError(t, err)
+	assert.Equal(t, addr, ln.Addr().String())
+	require.NoError(t, CloseUnusedInheritedListeners())
+
+	conn, err := ln.Accept()
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("child"))
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+	require.NoError(t, ln.Close())
+}
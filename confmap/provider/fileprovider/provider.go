@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/confmap/provider/internal"
@@ -33,22 +34,41 @@ type provider struct{}
 // `file:/path/to/file` - absolute path (unix, windows)
 // `file:c:/path/to/file` - absolute path including drive-letter (windows)
 // `file:c:\path\to\file` - absolute path including drive-letter (windows)
+//
+// The provider does not watch the files itself: the changes of a file are notified with NotifyChange.
 func New() confmap.Provider {
 	return &provider{}
 }
 
-func (fmp *provider) Retrieve(_ context.Context, uri string, _ confmap.WatcherFunc) (*confmap.Retrieved, error) {
+func (fmp *provider) Retrieve(_ context.Context, uri string, watcher confmap.WatcherFunc) (*confmap.Retrieved, error) {
 	if !strings.HasPrefix(uri, schemeName+":") {
 		return nil, fmt.Errorf("%q uri is not supported by %q provider", uri, schemeName)
 	}
 
 	// Clean the path before using it.
-	content, err := os.ReadFile(filepath.Clean(uri[len(schemeName)+1:]))
+	path := filepath.Clean(uri[len(schemeName)+1:])
+	content, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("unable to read the file %v: %w", uri, err)
 	}
+	if watcher == nil {
+		return internal.NewRetrievedFromYAML(content)
+	}
 
-	return internal.NewRetrievedFromYAML(content)
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve the path of the file %v: %w", uri, err)
+	}
+	fw := &fileWatch{watcher: watcher}
+	ret, err := internal.NewRetrievedFromYAML(content, confmap.WithRetrievedClose(func(context.Context) error {
+		unwatch(abs, fw)
+		return nil
+	}))
+	if err != nil {
+		return nil, err
+	}
+	watch(abs, fw)
+	return ret, nil
 }
 
 func (*provider) Scheme() string {
@@ -58,3 +78,62 @@ func (*provider) Scheme() string {
 func (*provider) Shutdown(context.Context) error {
 	return nil
 }
+
+// fileWatch is the watcher of a configuration retrieved from a file.
+type fileWatch struct {
+	watcher confmap.WatcherFunc
+	// notified is set once the watcher is notified, it is notified at most once per retrieval.
+	notified bool
+}
+
+// watches are the watches of the configurations retrieved from each file and not closed yet, by absolute path.
+var watches = struct {
+	sync.Mutex
+	byPath map[string]map[*fileWatch]struct{}
+}{byPath: map[string]map[*fileWatch]struct{}{}}
+
+func watch(path string, fw *fileWatch) {
+	watches.Lock()
+	defer watches.Unlock()
+	if watches.byPath[path] == nil {
+		watches.byPath[path] = map[*fileWatch]struct{}{}
+	}
+	watches.byPath[path][fw] = struct{}{}
+}
+
+func unwatch(path string, fw *fileWatch) {
+	watches.Lock()
+	defer watches.Unlock()
+	delete(watches.byPath[path], fw)
+	if len(watches.byPath[path]) == 0 {
+		delete(watches.byPath, path)
+	}
+}
+
+// NotifyChange notifies the watchers of the configurations retrieved from the file at path that the file changed,
+// so that the configuration is retrieved again, e.g. by the collector which reloads its configuration. It returns
+// an error if no configuration retrieved from the file with a watcher is in use.
+func NotifyChange(path string) error {
+	abs, err := filepath.Abs(filepath.Clean(path))
+	if err != nil {
+		return fmt.Errorf("unable to resolve the path of the file %v: %w", path, err)
+	}
+	var notify []confmap.WatcherFunc
+	watches.Lock()
+	found := len(watches.byPath[abs]) > 0
+	for fw := range watches.byPath[abs] {
+		if !fw.notified {
+			fw.notified = true
+			notify = append(notify, fw.watcher)
+		}
+	}
+	watches.Unlock()
+	if !found {
+		return fmt.Errorf("the configuration of the file %v is not watched", path)
+	}
+	// The watchers are called without holding the lock, so that they do not block the retrievals and closes.
+	for _, watcher := range notify {
+		watcher(&confmap.ChangeEvent{})
+	}
+	return nil
+}
@@ -82,6 +82,29 @@ func TestAbsolutePath(t *testing.T) {
 	assert.NoError(t, fp.Shutdown(context.Background()))
 }
 
+func TestNotifyChange(t *testing.T) {
+	fp := New()
+	path := filepath.Join("testdata", "default-config.yaml")
+	assert.Error(t, NotifyChange(path))
+
+	changes := 0
+	ret, err := fp.Retrieve(context.Background(), fileSchemePrefix+path, func(event *confmap.ChangeEvent) {
+		assert.NoError(t, event.Error)
+		changes++
+	})
+	require.NoError(t, err)
+	// The file is identified by its absolute path, and the watcher is notified once per retrieval.
+	require.NoError(t, NotifyChange(absolutePath(t, path)))
+	require.NoError(t, NotifyChange(path))
+	assert.Equal(t, 1, changes)
+
+	// Once the configuration is closed, the file is not watched anymore.
+	require.NoError(t, ret.Close(context.Background()))
+	assert.Error(t, NotifyChange(path))
+	assert.Equal(t, 1, changes)
+	assert.NoError(t, fp.Shutdown(context.Background()))
+}
+
 func absolutePath(t *testing.T, relativePath string) string {
 	dir, err := os.Getwd()
 	require.NoError(t, err)
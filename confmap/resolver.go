@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 
 	"go.uber.org/multierr"
 )
@@ -25,6 +26,12 @@ type Resolver struct {
 
 	closers []CloseFunc
 	watcher chan error
+	// watcherMu guards the watcher channel against the changes notified after Shutdown.
+	watcherMu sync.Mutex
+	shutdown  bool
+
+	// origins maps the keys of the last resolved configuration to the URI they were retrieved from.
+	origins map[string]string
 }
 
 // ResolverSettings are the settings to configure the behavior of the Resolver.
@@ -114,6 +121,7 @@ func (mr *Resolver) Resolve(ctx context.Context) (*Conf, error) {
 
 	// Retrieves individual configurations from all URIs in the given order, and merge them in retMap.
 	retMap := New()
+	origins := make(map[string]string)
 	for _, uri := range mr.uris {
 		ret, err := mr.retrieveValue(ctx, uri)
 		if err != nil {
@@ -127,6 +135,9 @@ func (mr *Resolver) Resolve(ctx context.Context) (*Conf, error) {
 		if err = retMap.Merge(retCfgMap); err != nil {
 			return nil, err
 		}
+		for _, k := range retCfgMap.AllKeys() {
+			origins[k] = uri.asString()
+		}
 	}
 
 	cfgMap := make(map[string]any)
@@ -146,9 +157,29 @@ func (mr *Resolver) Resolve(ctx context.Context) (*Conf, error) {
 		}
 	}
 
+	// Keep only the origins of the keys that were not overridden by a later URI as a whole, or by a converter.
+	mr.origins = make(map[string]string, len(origins))
+	for _, k := range retMap.AllKeys() {
+		if origin, ok := origins[k]; ok {
+			mr.origins[k] = origin
+		}
+	}
+
 	return retMap, nil
 }
 
+// Origins returns the URI from which each key of the last resolved configuration was retrieved.
+// When a key is set by several URIs, the last one is returned. The keys added by a Converter are not included.
+//
+// Should never be called concurrently with Resolve.
+func (mr *Resolver) Origins() map[string]string {
+	origins := make(map[string]string, len(mr.origins))
+	for k, v := range mr.origins {
+		origins[k] = v
+	}
+	return origins
+}
+
 // Watch blocks until any configuration change was detected or an unrecoverable error
 // happened during monitoring the configuration changes.
 //
@@ -165,7 +196,10 @@ func (mr *Resolver) Watch() <-chan error {
 //
 // Should never be called concurrently with itself or Get.
 func (mr *Resolver) Shutdown(ctx context.Context) error {
+	mr.watcherMu.Lock()
+	mr.shutdown = true
 	close(mr.watcher)
+	mr.watcherMu.Unlock()
 
 	var errs error
 	errs = multierr.Append(errs, mr.closeIfNeeded(ctx))
@@ -176,8 +210,18 @@ func (mr *Resolver) Shutdown(ctx context.Context) error {
 	return errs
 }
 
+// onChange notifies the change to Watch. The providers may notify changes concurrently with Shutdown, or while
+// a change is still pending, in which case the configuration is retrieved again anyway and the change is dropped.
 func (mr *Resolver) onChange(event *ChangeEvent) {
-	mr.watcher <- event.Error
+	mr.watcherMu.Lock()
+	defer mr.watcherMu.Unlock()
+	if mr.shutdown {
+		return
+	}
+	select {
+	case mr.watcher <- event.Error:
+	default:
+	}
 }
 
 func (mr *Resolver) closeIfNeeded(ctx context.Context) error {
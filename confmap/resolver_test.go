@@ -301,6 +301,38 @@ func TestResolver(t *testing.T) {
 	assert.Equal(t, int32(3), numCalls.Load())
 }
 
+func TestResolverOrigins(t *testing.T) {
+	provider := newFakeProvider("mock", func(_ context.Context, uri string, _ WatcherFunc) (*Retrieved, error) {
+		switch uri {
+		case "mock:base":
+			return NewRetrieved(map[string]any{
+				"receivers": map[string]any{"nop": map[string]any{"endpoint": "localhost:4317", "timeout": "1s"}},
+				"exporters": map[string]any{"nop": map[string]any{"headers": map[string]any{"key": "value"}}},
+			})
+		case "mock:override":
+			return NewRetrieved(map[string]any{
+				"receivers": map[string]any{"nop": map[string]any{"endpoint": "localhost:4318"}},
+				"exporters": map[string]any{"nop": map[string]any{"headers": "none"}},
+			})
+		}
+		return nil, errors.New("unknown uri")
+	})
+	resolver, err := NewResolver(ResolverSettings{
+		URIs:      []string{"mock:base", "mock:override"},
+		Providers: makeMapProvidersMap(provider),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resolver.Origins())
+
+	_, err = resolver.Resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"receivers::nop::endpoint": "mock:override",
+		"receivers::nop::timeout":  "mock:base",
+		"exporters::nop::headers":  "mock:override",
+	}, resolver.Origins())
+}
+
 func TestResolverNewLinesInOpaqueValue(t *testing.T) {
 	_, err := NewResolver(ResolverSettings{
 		URIs:       []string{"mock:receivers:\n nop:\n"},
@@ -347,3 +379,28 @@ func TestResolverShutdownClosesWatch(t *testing.T) {
 	assert.NoError(t, resolver.Shutdown(context.Background()))
 	watcherWG.Wait()
 }
+
+func TestResolverWatchAfterShutdown(t *testing.T) {
+	var watcher WatcherFunc
+	resolver, err := NewResolver(ResolverSettings{
+		URIs: []string{"mock:"},
+		Providers: makeMapProvidersMap(newFakeProvider("mock", func(_ context.Context, _ string, w WatcherFunc) (*Retrieved, error) {
+			watcher = w
+			return NewRetrieved(map[string]any{})
+		})),
+	})
+	require.NoError(t, err)
+	_, err = resolver.Resolve(context.Background())
+	require.NoError(t, err)
+
+	// A change notified while another one is pending does not block.
+	watcher(&ChangeEvent{})
+	watcher(&ChangeEvent{})
+	assert.NoError(t, <-resolver.Watch())
+
+	// A change notified after Shutdown is dropped.
+	require.NoError(t, resolver.Shutdown(context.Background()))
+	watcher(&ChangeEvent{})
+	_, ok := <-resolver.Watch()
+	assert.False(t, ok)
+}
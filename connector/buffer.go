@@ -0,0 +1,381 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connector // import "go.opentelemetry.io/collector/connector"
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/identity"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var errBufferShutdown = errors.New("connector buffer is shut down")
+
+// BufferSettings configures the buffering of the data consumed by the connectors created by a Builder.
+//
+// Without buffering, a connector consumes data synchronously: the call returns once the downstream
+// pipelines consumed the data, and their errors are returned to the upstream pipeline.
+// With buffering, the data is queued and the call returns as soon as it is queued. When a queue is full,
+// the call blocks until there is room in the queue or its context is done, so that a slow downstream
+// pipeline slows down the upstream pipelines instead of dropping data. The data of a call is either
+// entirely queued, or not queued at all if the context is done first. The errors of the downstream
+// pipelines are logged.
+type BufferSettings struct {
+	// QueueSize is the number of batches each shard can hold. Buffering is disabled when it is 0.
+	QueueSize int `mapstructure:"queue_size"`
+
+	// NumShards is the number of queues, each delivered by its own goroutine. The data of a resource
+	// is always queued in the same shard, so that it is delivered in the order it was consumed,
+	// even when the connector is fed by multiple pipelines. Defaults to 1.
+	NumShards int `mapstructure:"num_shards"`
+}
+
+// Validate checks if the BufferSettings configuration is valid.
+func (set *BufferSettings) Validate() error {
+	if set.QueueSize < 0 {
+		return errors.New("queue_size must not be negative")
+	}
+	if set.NumShards < 0 {
+		return errors.New("num_shards must not be negative")
+	}
+	return nil
+}
+
+// BuilderOption applies changes to Builder.
+type BuilderOption interface {
+	// apply applies the option.
+	apply(b *Builder)
+}
+
+// builderOptionFunc is a BuilderOption created through a function.
+type builderOptionFunc func(*Builder)
+
+func (f builderOptionFunc) apply(b *Builder) {
+	f(b)
+}
+
+// WithBuffering buffers the data consumed by the connectors created by the Builder.
+func WithBuffering(set BufferSettings) BuilderOption {
+	return builderOptionFunc(func(b *Builder) {
+		if set.NumShards <= 0 {
+			set.NumShards = 1
+		}
+		b.buffer = set
+	})
+}
+
+// shardData is the part of the consumed data queued in a shard.
+type shardData[T any] struct {
+	shard int
+	data  T
+}
+
+type bufferItem[T any] struct {
+	ctx  context.Context
+	data T
+}
+
+// bufferShard is the queue of a shard.
+type bufferShard[T any] struct {
+	// slots holds a token for each batch queued, or about to be queued, in items. The room for all the
+	// parts of the consumed data is reserved before any of them is queued, so that the sends to items
+	// never block.
+	slots chan struct{}
+	items chan bufferItem[T]
+}
+
+// buffer queues the data consumed by a connector and delivers it to the connector from a goroutine per shard.
+type buffer[T any] struct {
+	component.Component
+	logger  *zap.Logger
+	consume func(context.Context, T) error
+	split   func(T, int) []shardData[T]
+
+	// mu protects the shards from being closed while data is queued.
+	mu      sync.RWMutex
+	stopped bool
+	shards  []bufferShard[T]
+	wg      sync.WaitGroup
+}
+
+func newBuffer[T any](comp component.Component, logger *zap.Logger, set BufferSettings, consume func(context.Context, T) error, split func(T, int) []shardData[T]) *buffer[T] {
+	b := &buffer[T]{
+		Component: comp,
+		logger:    logger,
+		consume:   consume,
+		split:     split,
+		shards:    make([]bufferShard[T], set.NumShards),
+	}
+	for i := range b.shards {
+		b.shards[i] = bufferShard[T]{
+			slots: make(chan struct{}, set.QueueSize),
+			items: make(chan bufferItem[T], set.QueueSize),
+		}
+	}
+	return b
+}
+
+// Start starts the connector, then the delivery of the queued data.
+func (b *buffer[T]) Start(ctx context.Context, host component.Host) error {
+	if err := b.Component.Start(ctx, host); err != nil {
+		return err
+	}
+	for _, shard := range b.shards {
+		b.wg.Add(1)
+		go func(shard bufferShard[T]) {
+			defer b.wg.Done()
+			for item := range shard.items {
+				<-shard.slots
+				if err := b.consume(item.ctx, item.data); err != nil {
+					b.logger.Error("Failed to deliver buffered data", zap.Error(err))
+				}
+			}
+		}(shard)
+	}
+	return nil
+}
+
+// Shutdown delivers the queued data, then shuts down the connector.
+func (b *buffer[T]) Shutdown(ctx context.Context) error {
+	b.mu.Lock()
+	if !b.stopped {
+		b.stopped = true
+		for _, shard := range b.shards {
+			close(shard.items)
+		}
+	}
+	b.mu.Unlock()
+	b.wg.Wait()
+	return b.Component.Shutdown(ctx)
+}
+
+// enqueue queues the data, blocking while the queue of a shard is full. Either all the parts of the
+// data are queued, or none of them if ctx is done first.
+func (b *buffer[T]) enqueue(ctx context.Context, data T) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.stopped {
+		return errBufferShutdown
+	}
+	parts := b.split(data, len(b.shards))
+	// The slots are reserved in the order of the shards, so that concurrent calls waiting for the
+	// room of several shards do not hold a slot of each other's shards.
+	sort.Slice(parts, func(i, j int) bool { return parts[i].shard < parts[j].shard })
+	for i, sd := range parts {
+		select {
+		case b.shards[sd.shard].slots <- struct{}{}:
+		case <-ctx.Done():
+			for _, reserved := range parts[:i] {
+				<-b.shards[reserved.shard].slots
+			}
+			return ctx.Err()
+		}
+	}
+	// The data is delivered after the call returns, the context must not cancel it.
+	item := bufferItem[T]{ctx: noCancellationContext{Context: ctx}}
+	for _, sd := range parts {
+		item.data = sd.data
+		b.shards[sd.shard].items <- item
+	}
+	return nil
+}
+
+// resourceShards returns the shard of each of the n resources, and whether they are all in the same shard.
+func resourceShards(n, numShards int, resourceAt func(int) identity.Hash) ([]int, bool) {
+	shards := make([]int, n)
+	single := true
+	for i := range shards {
+		h := resourceAt(i)
+		shards[i] = int(binary.LittleEndian.Uint64(h[:8]) % uint64(numShards))
+		single = single && shards[i] == shards[0]
+	}
+	return shards, single
+}
+
+func splitTraces(td ptrace.Traces, numShards int) []shardData[ptrace.Traces] {
+	rss := td.ResourceSpans()
+	if numShards == 1 || rss.Len() == 0 {
+		return []shardData[ptrace.Traces]{{data: td}}
+	}
+	shards, single := resourceShards(rss.Len(), numShards, func(i int) identity.Hash {
+		return identity.Resource(rss.At(i).Resource())
+	})
+	if single {
+		return []shardData[ptrace.Traces]{{shard: shards[0], data: td}}
+	}
+	parts := map[int]ptrace.Traces{}
+	var res []shardData[ptrace.Traces]
+	for i, shard := range shards {
+		part, ok := parts[shard]
+		if !ok {
+			part = ptrace.NewTraces()
+			parts[shard] = part
+			res = append(res, shardData[ptrace.Traces]{shard: shard, data: part})
+		}
+		rss.At(i).CopyTo(part.ResourceSpans().AppendEmpty())
+	}
+	return res
+}
+
+func splitMetrics(md pmetric.Metrics, numShards int) []shardData[pmetric.Metrics] {
+	rms := md.ResourceMetrics()
+	if numShards == 1 || rms.Len() == 0 {
+		return []shardData[pmetric.Metrics]{{data: md}}
+	}
+	shards, single := resourceShards(rms.Len(), numShards, func(i int) identity.Hash {
+		return identity.Resource(rms.At(i).Resource())
+	})
+	if single {
+		return []shardData[pmetric.Metrics]{{shard: shards[0], data: md}}
+	}
+	parts := map[int]pmetric.Metrics{}
+	var res []shardData[pmetric.Metrics]
+	for i, shard := range shards {
+		part, ok := parts[shard]
+		if !ok {
+			part = pmetric.NewMetrics()
+			parts[shard] = part
+			res = append(res, shardData[pmetric.Metrics]{shard: shard, data: part})
+		}
+		rms.At(i).CopyTo(part.ResourceMetrics().AppendEmpty())
+	}
+	return res
+}
+
+func splitLogs(ld plog.Logs, numShards int) []shardData[plog.Logs] {
+	rls := ld.ResourceLogs()
+	if numShards == 1 || rls.Len() == 0 {
+		return []shardData[plog.Logs]{{data: ld}}
+	}
+	shards, single := resourceShards(rls.Len(), numShards, func(i int) identity.Hash {
+		return identity.Resource(rls.At(i).Resource())
+	})
+	if single {
+		return []shardData[plog.Logs]{{shard: shards[0], data: ld}}
+	}
+	parts := map[int]plog.Logs{}
+	var res []shardData[plog.Logs]
+	for i, shard := range shards {
+		part, ok := parts[shard]
+		if !ok {
+			part = plog.NewLogs()
+			parts[shard] = part
+			res = append(res, shardData[plog.Logs]{shard: shard, data: part})
+		}
+		rls.At(i).CopyTo(part.ResourceLogs().AppendEmpty())
+	}
+	return res
+}
+
+type bufferedTraces struct {
+	*buffer[ptrace.Traces]
+	Traces
+}
+
+func newBufferedTraces(conn Traces, logger *zap.Logger, set BufferSettings) Traces {
+	return &bufferedTraces{
+		buffer: newBuffer[ptrace.Traces](conn, logger, set, conn.ConsumeTraces, splitTraces),
+		Traces: conn,
+	}
+}
+
+func (bt *bufferedTraces) Start(ctx context.Context, host component.Host) error {
+	return bt.buffer.Start(ctx, host)
+}
+
+func (bt *bufferedTraces) Shutdown(ctx context.Context) error {
+	return bt.buffer.Shutdown(ctx)
+}
+
+func (bt *bufferedTraces) Capabilities() consumer.Capabilities {
+	return bt.Traces.Capabilities()
+}
+
+func (bt *bufferedTraces) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	return bt.buffer.enqueue(ctx, td)
+}
+
+type bufferedMetrics struct {
+	*buffer[pmetric.Metrics]
+	Metrics
+}
+
+func newBufferedMetrics(conn Metrics, logger *zap.Logger, set BufferSettings) Metrics {
+	return &bufferedMetrics{
+		buffer:  newBuffer[pmetric.Metrics](conn, logger, set, conn.ConsumeMetrics, splitMetrics),
+		Metrics: conn,
+	}
+}
+
+func (bm *bufferedMetrics) Start(ctx context.Context, host component.Host) error {
+	return bm.buffer.Start(ctx, host)
+}
+
+func (bm *bufferedMetrics) Shutdown(ctx context.Context) error {
+	return bm.buffer.Shutdown(ctx)
+}
+
+func (bm *bufferedMetrics) Capabilities() consumer.Capabilities {
+	return bm.Metrics.Capabilities()
+}
+
+func (bm *bufferedMetrics) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return bm.buffer.enqueue(ctx, md)
+}
+
+type bufferedLogs struct {
+	*buffer[plog.Logs]
+	Logs
+}
+
+func newBufferedLogs(conn Logs, logger *zap.Logger, set BufferSettings) Logs {
+	return &bufferedLogs{
+		buffer: newBuffer[plog.Logs](conn, logger, set, conn.ConsumeLogs, splitLogs),
+		Logs:   conn,
+	}
+}
+
+func (bl *bufferedLogs) Start(ctx context.Context, host component.Host) error {
+	return bl.buffer.Start(ctx, host)
+}
+
+func (bl *bufferedLogs) Shutdown(ctx context.Context) error {
+	return bl.buffer.Shutdown(ctx)
+}
+
+func (bl *bufferedLogs) Capabilities() consumer.Capabilities {
+	return bl.Logs.Capabilities()
+}
+
+func (bl *bufferedLogs) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	return bl.buffer.enqueue(ctx, ld)
+}
+
+// noCancellationContext keeps the values of a context, without its deadline and cancellation.
+type noCancellationContext struct {
+	context.Context
+}
+
+func (noCancellationContext) Deadline() (deadline time.Time, ok bool) {
+	return
+}
+
+func (noCancellationContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (noCancellationContext) Err() error {
+	return nil
+}
@@ -0,0 +1,251 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connector
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/identity"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// forwardConnector passes the consumed data to the next consumer.
+type forwardConnector struct {
+	component.StartFunc
+	component.ShutdownFunc
+	consumer.Traces
+	consumer.Metrics
+	consumer.Logs
+}
+
+func (forwardConnector) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func newForwardBuilder(options ...BuilderOption) *Builder {
+	factory := NewFactory("forward", func() component.Config { return &struct{}{} },
+		WithTracesToTraces(func(_ context.Context, _ CreateSettings, _ component.Config, next consumer.Traces) (Traces, error) {
+			return &forwardConnector{Traces: next}, nil
+		}, component.StabilityLevelDevelopment),
+		WithMetricsToMetrics(func(_ context.Context, _ CreateSettings, _ component.Config, next consumer.Metrics) (Metrics, error) {
+			return &forwardConnector{Metrics: next}, nil
+		}, component.StabilityLevelDevelopment),
+		WithLogsToLogs(func(_ context.Context, _ CreateSettings, _ component.Config, next consumer.Logs) (Logs, error) {
+			return &forwardConnector{Logs: next}, nil
+		}, component.StabilityLevelDevelopment),
+	)
+	cfgs := map[component.ID]component.Config{component.NewID("forward"): factory.CreateDefaultConfig()}
+	return NewBuilder(cfgs, map[component.Type]Factory{factory.Type(): factory}, options...)
+}
+
+// newResourceTraces returns traces with a span named after seq for each of the resources.
+func newResourceTraces(seq int, resources ...string) ptrace.Traces {
+	td := ptrace.NewTraces()
+	for _, res := range resources {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", res)
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(strconv.Itoa(seq))
+	}
+	return td
+}
+
+func TestBuilderWithoutBuffering(t *testing.T) {
+	b := newForwardBuilder()
+	conn, err := b.CreateTracesToTraces(context.Background(), createSettings(component.NewID("forward")), consumertest.NewNop())
+	require.NoError(t, err)
+	assert.IsType(t, &forwardConnector{}, conn)
+}
+
+func TestBufferedTracesOrdering(t *testing.T) {
+	b := newForwardBuilder(WithBuffering(BufferSettings{QueueSize: 2, NumShards: 4}))
+	sink := new(consumertest.TracesSink)
+	conn, err := b.CreateTracesToTraces(context.Background(), createSettings(component.NewID("forward")), sink)
+	require.NoError(t, err)
+	assert.False(t, conn.Capabilities().MutatesData)
+	require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+
+	resources := []string{"a", "b", "c", "d", "e", "f"}
+	for seq := 0; seq < 50; seq++ {
+		require.NoError(t, conn.ConsumeTraces(context.Background(), newResourceTraces(seq, resources...)))
+	}
+	require.NoError(t, conn.Shutdown(context.Background()))
+
+	assert.Equal(t, 50*len(resources), sink.SpanCount())
+	next := map[string]int{}
+	for _, td := range sink.AllTraces() {
+		for i := 0; i < td.ResourceSpans().Len(); i++ {
+			rs := td.ResourceSpans().At(i)
+			res, _ := rs.Resource().Attributes().Get("service.name")
+			seq := rs.ScopeSpans().At(0).Spans().At(0).Name()
+			assert.Equal(t, strconv.Itoa(next[res.Str()]), seq, "resource %s", res.Str())
+			next[res.Str()]++
+		}
+	}
+}
+
+func TestBufferedMetricsAndLogs(t *testing.T) {
+	b := newForwardBuilder(WithBuffering(BufferSettings{QueueSize: 10, NumShards: 2}))
+
+	metricsSink := new(consumertest.MetricsSink)
+	metricsConn, err := b.CreateMetricsToMetrics(context.Background(), createSettings(component.NewID("forward")), metricsSink)
+	require.NoError(t, err)
+	require.NoError(t, metricsConn.Start(context.Background(), componenttest.NewNopHost()))
+	md := pmetric.NewMetrics()
+	for _, res := range []string{"a", "b", "c"} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("service.name", res)
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	}
+	require.NoError(t, metricsConn.ConsumeMetrics(context.Background(), md))
+	require.NoError(t, metricsConn.Shutdown(context.Background()))
+	assert.Equal(t, 3, metricsSink.DataPointCount())
+
+	logsSink := new(consumertest.LogsSink)
+	logsConn, err := b.CreateLogsToLogs(context.Background(), createSettings(component.NewID("forward")), logsSink)
+	require.NoError(t, err)
+	require.NoError(t, logsConn.Start(context.Background(), componenttest.NewNopHost()))
+	ld := plog.NewLogs()
+	for _, res := range []string{"a", "b", "c"} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", res)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	}
+	require.NoError(t, logsConn.ConsumeLogs(context.Background(), ld))
+	require.NoError(t, logsConn.Shutdown(context.Background()))
+	assert.Equal(t, 3, logsSink.LogRecordCount())
+}
+
+// blockingTraces blocks the consumption of traces until unblock is closed.
+type blockingTraces struct {
+	consumertest.TracesSink
+	started chan struct{}
+	unblock chan struct{}
+}
+
+func (bt *blockingTraces) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	select {
+	case bt.started <- struct{}{}:
+	default:
+	}
+	<-bt.unblock
+	return bt.TracesSink.ConsumeTraces(ctx, td)
+}
+
+func TestBufferedBackpressure(t *testing.T) {
+	b := newForwardBuilder(WithBuffering(BufferSettings{QueueSize: 1}))
+	next := &blockingTraces{started: make(chan struct{}, 1), unblock: make(chan struct{})}
+	conn, err := b.CreateTracesToTraces(context.Background(), createSettings(component.NewID("forward")), next)
+	require.NoError(t, err)
+	require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+
+	// The first traces are being delivered and the second ones fill the queue.
+	require.NoError(t, conn.ConsumeTraces(context.Background(), newResourceTraces(0, "a")))
+	<-next.started
+	require.NoError(t, conn.ConsumeTraces(context.Background(), newResourceTraces(1, "a")))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, conn.ConsumeTraces(ctx, newResourceTraces(2, "a")), context.DeadlineExceeded)
+
+	close(next.unblock)
+	require.NoError(t, conn.Shutdown(context.Background()))
+	assert.Equal(t, 2, next.SpanCount())
+	assert.ErrorIs(t, conn.ConsumeTraces(context.Background(), newResourceTraces(3, "a")), errBufferShutdown)
+}
+
+func TestBufferedAllOrNothing(t *testing.T) {
+	// Find a resource in each of the 2 shards.
+	shardOf := func(res string) int {
+		shards, _ := resourceShards(1, 2, func(int) identity.Hash {
+			return identity.Resource(newResourceTraces(0, res).ResourceSpans().At(0).Resource())
+		})
+		return shards[0]
+	}
+	full, other := "a", ""
+	for i := 0; other == ""; i++ {
+		if res := strconv.Itoa(i); shardOf(res) != shardOf(full) {
+			other = res
+		}
+	}
+
+	b := newForwardBuilder(WithBuffering(BufferSettings{QueueSize: 1, NumShards: 2}))
+	next := &blockingTraces{started: make(chan struct{}, 1), unblock: make(chan struct{})}
+	conn, err := b.CreateTracesToTraces(context.Background(), createSettings(component.NewID("forward")), next)
+	require.NoError(t, err)
+	require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+
+	// The queue of the shard of the first resource is full.
+	require.NoError(t, conn.ConsumeTraces(context.Background(), newResourceTraces(0, full)))
+	<-next.started
+	require.NoError(t, conn.ConsumeTraces(context.Background(), newResourceTraces(1, full)))
+
+	// The part of the other resource is not queued, since the part of the first resource can not be.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, conn.ConsumeTraces(ctx, newResourceTraces(2, other, full)), context.DeadlineExceeded)
+
+	close(next.unblock)
+	require.NoError(t, conn.Shutdown(context.Background()))
+	assert.Equal(t, 2, next.SpanCount())
+	for _, td := range next.AllTraces() {
+		assert.Equal(t, full, td.ResourceSpans().At(0).Resource().Attributes().AsRaw()["service.name"])
+	}
+}
+
+func TestBufferSettingsValidate(t *testing.T) {
+	assert.NoError(t, (&BufferSettings{}).Validate())
+	assert.EqualError(t, (&BufferSettings{QueueSize: -1}).Validate(), "queue_size must not be negative")
+	assert.EqualError(t, (&BufferSettings{NumShards: -1}).Validate(), "num_shards must not be negative")
+}
+
+func TestBufferedErrorsAreLogged(t *testing.T) {
+	core, logs := observer.New(zap.ErrorLevel)
+	set := createSettings(component.NewID("forward"))
+	set.Logger = zap.New(core)
+	b := newForwardBuilder(WithBuffering(BufferSettings{QueueSize: 1}))
+	conn, err := b.CreateTracesToTraces(context.Background(), set, consumertest.NewErr(errors.New("downstream failed")))
+	require.NoError(t, err)
+	require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, conn.ConsumeTraces(context.Background(), newResourceTraces(0, "a")))
+	require.NoError(t, conn.Shutdown(context.Background()))
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, "downstream failed", logs.All()[0].ContextMap()["error"])
+}
+
+func TestBufferedContextValues(t *testing.T) {
+	type key struct{}
+	b := newForwardBuilder(WithBuffering(BufferSettings{QueueSize: 1}))
+	var got context.Context
+	next, err := consumer.NewTraces(func(ctx context.Context, _ ptrace.Traces) error {
+		got = ctx
+		return nil
+	})
+	require.NoError(t, err)
+	conn, err := b.CreateTracesToTraces(context.Background(), createSettings(component.NewID("forward")), next)
+	require.NoError(t, err)
+	require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "value"))
+	require.NoError(t, conn.ConsumeTraces(ctx, newResourceTraces(0, "a")))
+	cancel()
+	require.NoError(t, conn.Shutdown(context.Background()))
+	require.NotNil(t, got)
+	assert.Equal(t, "value", got.Value(key{}))
+	assert.NoError(t, got.Err())
+}
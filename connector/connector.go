@@ -447,11 +447,16 @@ func MakeFactoryMap(factories ...Factory) (map[component.Type]Factory, error) {
 type Builder struct {
 	cfgs      map[component.ID]component.Config
 	factories map[component.Type]Factory
+	buffer    BufferSettings
 }
 
 // NewBuilder creates a new connector.Builder to help with creating components form a set of configs and factories.
-func NewBuilder(cfgs map[component.ID]component.Config, factories map[component.Type]Factory) *Builder {
-	return &Builder{cfgs: cfgs, factories: factories}
+func NewBuilder(cfgs map[component.ID]component.Config, factories map[component.Type]Factory, options ...BuilderOption) *Builder {
+	b := &Builder{cfgs: cfgs, factories: factories}
+	for _, opt := range options {
+		opt.apply(b)
+	}
+	return b
 }
 
 // CreateTracesToTraces creates a Traces connector based on the settings and config.
@@ -467,7 +472,11 @@ func (b *Builder) CreateTracesToTraces(ctx context.Context, set CreateSettings,
 	}
 
 	logStabilityLevel(set.Logger, f.TracesToTracesStability())
-	return f.CreateTracesToTraces(ctx, set, cfg, next)
+	conn, err := f.CreateTracesToTraces(ctx, set, cfg, next)
+	if err != nil || b.buffer.QueueSize == 0 {
+		return conn, err
+	}
+	return newBufferedTraces(conn, set.Logger, b.buffer), nil
 }
 
 // CreateTracesToMetrics creates a Traces connector based on the settings and config.
@@ -483,7 +492,11 @@ func (b *Builder) CreateTracesToMetrics(ctx context.Context, set CreateSettings,
 	}
 
 	logStabilityLevel(set.Logger, f.TracesToMetricsStability())
-	return f.CreateTracesToMetrics(ctx, set, cfg, next)
+	conn, err := f.CreateTracesToMetrics(ctx, set, cfg, next)
+	if err != nil || b.buffer.QueueSize == 0 {
+		return conn, err
+	}
+	return newBufferedTraces(conn, set.Logger, b.buffer), nil
 }
 
 // CreateTracesToLogs creates a Traces connector based on the settings and config.
@@ -499,7 +512,11 @@ func (b *Builder) CreateTracesToLogs(ctx context.Context, set CreateSettings, ne
 	}
 
 	logStabilityLevel(set.Logger, f.TracesToLogsStability())
-	return f.CreateTracesToLogs(ctx, set, cfg, next)
+	conn, err := f.CreateTracesToLogs(ctx, set, cfg, next)
+	if err != nil || b.buffer.QueueSize == 0 {
+		return conn, err
+	}
+	return newBufferedTraces(conn, set.Logger, b.buffer), nil
 }
 
 // CreateMetricsToTraces creates a Metrics connector based on the settings and config.
@@ -515,7 +532,11 @@ func (b *Builder) CreateMetricsToTraces(ctx context.Context, set CreateSettings,
 	}
 
 	logStabilityLevel(set.Logger, f.MetricsToTracesStability())
-	return f.CreateMetricsToTraces(ctx, set, cfg, next)
+	conn, err := f.CreateMetricsToTraces(ctx, set, cfg, next)
+	if err != nil || b.buffer.QueueSize == 0 {
+		return conn, err
+	}
+	return newBufferedMetrics(conn, set.Logger, b.buffer), nil
 }
 
 // CreateMetricsToMetrics creates a Metrics connector based on the settings and config.
@@ -531,7 +552,11 @@ func (b *Builder) CreateMetricsToMetrics(ctx context.Context, set CreateSettings
 	}
 
 	logStabilityLevel(set.Logger, f.MetricsToMetricsStability())
-	return f.CreateMetricsToMetrics(ctx, set, cfg, next)
+	conn, err := f.CreateMetricsToMetrics(ctx, set, cfg, next)
+	if err != nil || b.buffer.QueueSize == 0 {
+		return conn, err
+	}
+	return newBufferedMetrics(conn, set.Logger, b.buffer), nil
 }
 
 // CreateMetricsToLogs creates a Metrics connector based on the settings and config.
@@ -547,7 +572,11 @@ func (b *Builder) CreateMetricsToLogs(ctx context.Context, set CreateSettings, n
 	}
 
 	logStabilityLevel(set.Logger, f.MetricsToLogsStability())
-	return f.CreateMetricsToLogs(ctx, set, cfg, next)
+	conn, err := f.CreateMetricsToLogs(ctx, set, cfg, next)
+	if err != nil || b.buffer.QueueSize == 0 {
+		return conn, err
+	}
+	return newBufferedMetrics(conn, set.Logger, b.buffer), nil
 }
 
 // CreateLogsToTraces creates a Logs connector based on the settings and config.
@@ -563,7 +592,11 @@ func (b *Builder) CreateLogsToTraces(ctx context.Context, set CreateSettings, ne
 	}
 
 	logStabilityLevel(set.Logger, f.LogsToTracesStability())
-	return f.CreateLogsToTraces(ctx, set, cfg, next)
+	conn, err := f.CreateLogsToTraces(ctx, set, cfg, next)
+	if err != nil || b.buffer.QueueSize == 0 {
+		return conn, err
+	}
+	return newBufferedLogs(conn, set.Logger, b.buffer), nil
 }
 
 // CreateLogsToMetrics creates a Logs connector based on the settings and config.
@@ -579,7 +612,11 @@ func (b *Builder) CreateLogsToMetrics(ctx context.Context, set CreateSettings, n
 	}
 
 	logStabilityLevel(set.Logger, f.LogsToMetricsStability())
-	return f.CreateLogsToMetrics(ctx, set, cfg, next)
+	conn, err := f.CreateLogsToMetrics(ctx, set, cfg, next)
+	if err != nil || b.buffer.QueueSize == 0 {
+		return conn, err
+	}
+	return newBufferedLogs(conn, set.Logger, b.buffer), nil
 }
 
 // CreateLogsToLogs creates a Logs connector based on the settings and config.
@@ -595,7 +632,11 @@ func (b *Builder) CreateLogsToLogs(ctx context.Context, set CreateSettings, next
 	}
 
 	logStabilityLevel(set.Logger, f.LogsToLogsStability())
-	return f.CreateLogsToLogs(ctx, set, cfg, next)
+	conn, err := f.CreateLogsToLogs(ctx, set, cfg, next)
+	if err != nil || b.buffer.QueueSize == 0 {
+		return conn, err
+	}
+	return newBufferedLogs(conn, set.Logger, b.buffer), nil
 }
 
 func (b *Builder) IsConfigured(componentID component.ID) bool {
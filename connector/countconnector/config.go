@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector // import "go.opentelemetry.io/collector/connector/countconnector"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	defaultMetricNameSpans = "trace.span.count"
+	defaultMetricDescSpans = "The number of spans observed."
+
+	defaultMetricNameDataPoints = "metric.datapoint.count"
+	defaultMetricDescDataPoints = "The number of data points observed."
+
+	defaultMetricNameLogs = "log.record.count"
+	defaultMetricDescLogs = "The number of log records observed."
+)
+
+// Config defines the configuration of the count connector.
+// When no metric is configured for a signal, a single metric counting all of its items is emitted.
+type Config struct {
+	// Spans are the metrics counting spans, by metric name.
+	Spans map[string]MetricInfo `mapstructure:"spans"`
+
+	// DataPoints are the metrics counting data points, by metric name.
+	DataPoints map[string]MetricInfo `mapstructure:"datapoints"`
+
+	// Logs are the metrics counting log records, by metric name.
+	Logs map[string]MetricInfo `mapstructure:"logs"`
+}
+
+// MetricInfo defines a metric counting the items matching conditions.
+type MetricInfo struct {
+	// Description is the description of the metric.
+	Description string `mapstructure:"description"`
+
+	// Conditions select the counted items. An item is counted if it matches any of the conditions.
+	// All the items are counted when there is no condition.
+	Conditions []Condition `mapstructure:"conditions"`
+
+	// Attributes are the attributes of the counted items the count is broken down by.
+	Attributes []AttributeConfig `mapstructure:"attributes"`
+}
+
+// Condition matches the items satisfying all of its constraints.
+// The attributes of an item are looked up in the item, then in its instrumentation scope, then in its resource.
+type Condition struct {
+	// Attributes are the values the attributes of the item must have, by attribute key.
+	Attributes map[string]string `mapstructure:"attributes"`
+
+	// AttributeReferences are back-references between attributes: the attribute with the key must be set to
+	// the same value as the referenced attribute, e.g. `peer.service: service.name` matches the items calling
+	// their own service.
+	AttributeReferences map[string]string `mapstructure:"attribute_references"`
+
+	// SeverityText is the severity text the log records must have, compared case-insensitively.
+	// It can only be set for logs.
+	SeverityText string `mapstructure:"severity_text"`
+
+	// StatusCode is the status code the spans must have, either "Unset", "Ok" or "Error".
+	// It can only be set for spans.
+	StatusCode string `mapstructure:"status_code"`
+}
+
+// AttributeConfig defines an attribute of the counted items.
+type AttributeConfig struct {
+	// Key is the key of the attribute.
+	Key string `mapstructure:"key"`
+
+	// DefaultValue is the value used for the items without the attribute.
+	// When it is not set, the items without the attribute are not counted.
+	DefaultValue any `mapstructure:"default_value"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the connector configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs error
+	for name, info := range cfg.Spans {
+		errs = multierr.Append(errs, info.validate(name, func(cond Condition) error {
+			if cond.SeverityText != "" {
+				return errors.New("severity_text can only be set for logs")
+			}
+			switch cond.StatusCode {
+			case "", ptrace.StatusCodeUnset.String(), ptrace.StatusCodeOk.String(), ptrace.StatusCodeError.String():
+				return nil
+			}
+			return fmt.Errorf("invalid status_code %q", cond.StatusCode)
+		}))
+	}
+	for name, info := range cfg.DataPoints {
+		errs = multierr.Append(errs, info.validate(name, func(cond Condition) error {
+			if cond.SeverityText != "" {
+				return errors.New("severity_text can only be set for logs")
+			}
+			if cond.StatusCode != "" {
+				return errors.New("status_code can only be set for spans")
+			}
+			return nil
+		}))
+	}
+	for name, info := range cfg.Logs {
+		errs = multierr.Append(errs, info.validate(name, func(cond Condition) error {
+			if cond.StatusCode != "" {
+				return errors.New("status_code can only be set for spans")
+			}
+			return nil
+		}))
+	}
+	return errs
+}
+
+func (info MetricInfo) validate(name string, validateCondition func(Condition) error) error {
+	if name == "" {
+		return errors.New("metric name must not be empty")
+	}
+	for _, cond := range info.Conditions {
+		for key, ref := range cond.AttributeReferences {
+			if key == "" || ref == "" {
+				return fmt.Errorf("metric %q: attribute_references must not have empty keys", name)
+			}
+		}
+		if err := validateCondition(cond); err != nil {
+			return fmt.Errorf("metric %q: %w", name, err)
+		}
+	}
+	uniq := map[string]bool{}
+	for _, attr := range info.Attributes {
+		if attr.Key == "" {
+			return fmt.Errorf("metric %q: attribute key must not be empty", name)
+		}
+		if uniq[attr.Key] {
+			return fmt.Errorf("metric %q: duplicate attribute %q", name, attr.Key)
+		}
+		if attr.DefaultValue != nil {
+			if err := pcommon.NewValueEmpty().FromRaw(attr.DefaultValue); err != nil {
+				return fmt.Errorf("metric %q: invalid default_value of attribute %q: %w", name, attr.Key, err)
+			}
+		}
+		uniq[attr.Key] = true
+	}
+	return nil
+}
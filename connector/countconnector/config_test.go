@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	assert.NoError(t, component.UnmarshalConfig(confmap.New(), cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig()
+	require.NoError(t, component.UnmarshalConfig(cm, cfg))
+	assert.NoError(t, component.ValidateConfig(cfg))
+	assert.Equal(t,
+		&Config{
+			Spans: map[string]MetricInfo{
+				"span.error.count": {
+					Description: "The number of failed spans.",
+					Conditions:  []Condition{{StatusCode: "Error"}},
+					Attributes:  []AttributeConfig{{Key: "service.name"}},
+				},
+			},
+			Logs: map[string]MetricInfo{
+				"log.error.count": {
+					Description: "The number of error logs.",
+					Conditions: []Condition{
+						{SeverityText: "ERROR"},
+						{Attributes: map[string]string{"level": "error"}},
+					},
+					Attributes: []AttributeConfig{{Key: "env", DefaultValue: "prod"}},
+				},
+			},
+			DataPoints: map[string]MetricInfo{
+				"datapoint.self.count": {
+					Conditions: []Condition{{AttributeReferences: map[string]string{"peer.service": "service.name"}}},
+				},
+			},
+		}, cfg)
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      *Config
+		expected string
+	}{
+		{
+			name:     "empty metric name",
+			cfg:      &Config{Logs: map[string]MetricInfo{"": {}}},
+			expected: "metric name must not be empty",
+		},
+		{
+			name:     "invalid status code",
+			cfg:      &Config{Spans: map[string]MetricInfo{"count": {Conditions: []Condition{{StatusCode: "Failed"}}}}},
+			expected: `metric "count": invalid status_code "Failed"`,
+		},
+		{
+			name:     "severity text for spans",
+			cfg:      &Config{Spans: map[string]MetricInfo{"count": {Conditions: []Condition{{SeverityText: "ERROR"}}}}},
+			expected: `metric "count": severity_text can only be set for logs`,
+		},
+		{
+			name:     "severity text for data points",
+			cfg:      &Config{DataPoints: map[string]MetricInfo{"count": {Conditions: []Condition{{SeverityText: "ERROR"}}}}},
+			expected: `metric "count": severity_text can only be set for logs`,
+		},
+		{
+			name:     "status code for data points",
+			cfg:      &Config{DataPoints: map[string]MetricInfo{"count": {Conditions: []Condition{{StatusCode: "Error"}}}}},
+			expected: `metric "count": status_code can only be set for spans`,
+		},
+		{
+			name:     "status code for logs",
+			cfg:      &Config{Logs: map[string]MetricInfo{"count": {Conditions: []Condition{{StatusCode: "Error"}}}}},
+			expected: `metric "count": status_code can only be set for spans`,
+		},
+		{
+			name:     "empty attribute reference",
+			cfg:      &Config{Logs: map[string]MetricInfo{"count": {Conditions: []Condition{{AttributeReferences: map[string]string{"key": ""}}}}}},
+			expected: `metric "count": attribute_references must not have empty keys`,
+		},
+		{
+			name:     "empty attribute key",
+			cfg:      &Config{Logs: map[string]MetricInfo{"count": {Attributes: []AttributeConfig{{}}}}},
+			expected: `metric "count": attribute key must not be empty`,
+		},
+		{
+			name:     "duplicate attribute",
+			cfg:      &Config{Logs: map[string]MetricInfo{"count": {Attributes: []AttributeConfig{{Key: "key"}, {Key: "key"}}}}},
+			expected: `metric "count": duplicate attribute "key"`,
+		},
+		{
+			name:     "invalid default value",
+			cfg:      &Config{Logs: map[string]MetricInfo{"count": {Attributes: []AttributeConfig{{Key: "key", DefaultValue: struct{}{}}}}}},
+			expected: `metric "count": invalid default_value of attribute "key": <Invalid value type struct {}>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.cfg.Validate(), tt.expected)
+		})
+	}
+}
@@ -0,0 +1,318 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector // import "go.opentelemetry.io/collector/connector/countconnector"
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const scopeName = "go.opentelemetry.io/collector/connector/countconnector"
+
+// count counts the items of each batch it consumes and sends the counts to the next consumer as delta sums.
+type count struct {
+	component.StartFunc
+	component.ShutdownFunc
+
+	next consumer.Metrics
+	defs []metricDef
+
+	mu sync.Mutex
+	// lastTimestamp is the end of the interval of the last counts sent, the start of the next one.
+	lastTimestamp pcommon.Timestamp
+}
+
+func newCount(next consumer.Metrics, defs []metricDef) *count {
+	return &count{
+		next:          next,
+		defs:          defs,
+		lastTimestamp: pcommon.NewTimestampFromTime(time.Now()),
+	}
+}
+
+// metricDef is a metric configured for the connector.
+type metricDef struct {
+	name string
+	MetricInfo
+}
+
+// newMetricDefs returns the definitions of the metrics, sorted by name, or of the default metric if there is none.
+func newMetricDefs(infos map[string]MetricInfo, defaultName, defaultDesc string) []metricDef {
+	if len(infos) == 0 {
+		return []metricDef{{name: defaultName, MetricInfo: MetricInfo{Description: defaultDesc}}}
+	}
+	defs := make([]metricDef, 0, len(infos))
+	for name, info := range infos {
+		defs = append(defs, metricDef{name: name, MetricInfo: info})
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].name < defs[j].name })
+	return defs
+}
+
+func (c *count) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (c *count) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	md := pmetric.NewMetrics()
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		cnt := newCounter(c.defs)
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			ss := sss.At(j)
+			spans := ss.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				cnt.update(item{
+					attrs:      attributeSources{span.Attributes(), ss.Scope().Attributes(), rs.Resource().Attributes()},
+					statusCode: span.Status().Code().String(),
+				})
+			}
+		}
+		cnt.appendTo(md, rs.Resource())
+	}
+	return c.export(ctx, md)
+}
+
+func (c *count) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	countMd := pmetric.NewMetrics()
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		cnt := newCounter(c.defs)
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sm := sms.At(j)
+			metrics := sm.Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				metrics.At(k).ForEachDataPointAttributes(func(attrs pcommon.Map) {
+					cnt.update(item{attrs: attributeSources{attrs, sm.Scope().Attributes(), rm.Resource().Attributes()}})
+				})
+			}
+		}
+		cnt.appendTo(countMd, rm.Resource())
+	}
+	return c.export(ctx, countMd)
+}
+
+func (c *count) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	md := pmetric.NewMetrics()
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		cnt := newCounter(c.defs)
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			records := sl.LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				lr := records.At(k)
+				cnt.update(item{
+					attrs:        attributeSources{lr.Attributes(), sl.Scope().Attributes(), rl.Resource().Attributes()},
+					severityText: lr.SeverityText(),
+				})
+			}
+		}
+		cnt.appendTo(md, rl.Resource())
+	}
+	return c.export(ctx, md)
+}
+
+// export sends the counts to the next consumer. Their data points cover the interval since the
+// previous counts were sent, or since the connector was created.
+func (c *count) export(ctx context.Context, md pmetric.Metrics) error {
+	if md.ResourceMetrics().Len() == 0 {
+		return nil
+	}
+	start, end := c.interval()
+	md.ForEachMetric(func(_ pmetric.ResourceMetrics, _ pmetric.ScopeMetrics, m pmetric.Metric) bool {
+		dps := m.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dps.At(i).SetStartTimestamp(start)
+			dps.At(i).SetTimestamp(end)
+		}
+		return true
+	})
+	return c.next.ConsumeMetrics(ctx, md)
+}
+
+// interval returns the start and end timestamps of the counts being sent, and starts the next interval.
+func (c *count) interval() (pcommon.Timestamp, pcommon.Timestamp) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	start := c.lastTimestamp
+	end := pcommon.NewTimestampFromTime(time.Now())
+	if end <= start {
+		// The intervals never overlap, even if the clock goes backwards.
+		end = start + 1
+	}
+	c.lastTimestamp = end
+	return start, end
+}
+
+// attributeSources are the attributes of an item, looked up in order.
+type attributeSources []pcommon.Map
+
+func (as attributeSources) get(key string) (pcommon.Value, bool) {
+	for _, attrs := range as {
+		if v, ok := attrs.Get(key); ok {
+			return v, true
+		}
+	}
+	return pcommon.Value{}, false
+}
+
+// item is a span, a data point or a log record to count.
+type item struct {
+	attrs        attributeSources
+	severityText string
+	statusCode   string
+}
+
+func (cond Condition) matches(it item) bool {
+	if cond.SeverityText != "" && !strings.EqualFold(cond.SeverityText, it.severityText) {
+		return false
+	}
+	if cond.StatusCode != "" && cond.StatusCode != it.statusCode {
+		return false
+	}
+	for key, expected := range cond.Attributes {
+		if v, ok := it.attrs.get(key); !ok || v.AsString() != expected {
+			return false
+		}
+	}
+	for key, ref := range cond.AttributeReferences {
+		v, ok := it.attrs.get(key)
+		if !ok {
+			return false
+		}
+		if refV, ok := it.attrs.get(ref); !ok || v.AsString() != refV.AsString() {
+			return false
+		}
+	}
+	return true
+}
+
+func (def metricDef) matches(it item) bool {
+	if len(def.Conditions) == 0 {
+		return true
+	}
+	for _, cond := range def.Conditions {
+		if cond.matches(it) {
+			return true
+		}
+	}
+	return false
+}
+
+// counter counts the items of a resource for each metric definition.
+type counter struct {
+	defs   []metricDef
+	counts []*attributeCounts
+}
+
+// attributeCounts holds the counts of a metric by attributes, in the order they were first counted.
+type attributeCounts struct {
+	index  map[string]int
+	attrs  []pcommon.Map
+	counts []int64
+}
+
+func newCounter(defs []metricDef) *counter {
+	return &counter{defs: defs, counts: make([]*attributeCounts, len(defs))}
+}
+
+func (c *counter) update(it item) {
+	var key strings.Builder
+	for i, def := range c.defs {
+		if !def.matches(it) {
+			continue
+		}
+		key.Reset()
+		values := make([]pcommon.Value, len(def.Attributes))
+		missing := false
+		for j, attr := range def.Attributes {
+			v, ok := it.attrs.get(attr.Key)
+			if !ok {
+				if attr.DefaultValue == nil {
+					missing = true
+					break
+				}
+				// The default values are validated with the configuration.
+				v = pcommon.NewValueEmpty()
+				_ = v.FromRaw(attr.DefaultValue)
+			}
+			values[j] = v
+			// The type is part of the key, so that e.g. the int 1 and the string "1" are counted apart.
+			key.WriteString(v.Type().String())
+			key.WriteByte(0)
+			key.WriteString(v.AsString())
+			key.WriteByte(0)
+		}
+		if missing {
+			continue
+		}
+
+		ac := c.counts[i]
+		if ac == nil {
+			ac = &attributeCounts{index: map[string]int{}}
+			c.counts[i] = ac
+		}
+		idx, ok := ac.index[key.String()]
+		if !ok {
+			attrs := pcommon.NewMap()
+			attrs.EnsureCapacity(len(values))
+			for j, v := range values {
+				v.CopyTo(attrs.PutEmpty(def.Attributes[j].Key))
+			}
+			idx = len(ac.counts)
+			ac.index[key.String()] = idx
+			ac.attrs = append(ac.attrs, attrs)
+			ac.counts = append(ac.counts, 0)
+		}
+		ac.counts[idx]++
+	}
+}
+
+// appendTo appends the counts to the metrics, under a copy of the resource. Nothing is appended if nothing was counted.
+func (c *counter) appendTo(md pmetric.Metrics, res pcommon.Resource) {
+	var metrics pmetric.MetricSlice
+	hasCounts := false
+	for i, ac := range c.counts {
+		if ac == nil {
+			continue
+		}
+		if !hasCounts {
+			rm := md.ResourceMetrics().AppendEmpty()
+			res.CopyTo(rm.Resource())
+			sm := rm.ScopeMetrics().AppendEmpty()
+			sm.Scope().SetName(scopeName)
+			metrics = sm.Metrics()
+			hasCounts = true
+		}
+		m := metrics.AppendEmpty()
+		m.SetName(c.defs[i].name)
+		m.SetDescription(c.defs[i].Description)
+		sum := m.SetEmptySum()
+		sum.SetIsMonotonic(true)
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		for j, attrs := range ac.attrs {
+			dp := sum.DataPoints().AppendEmpty()
+			attrs.CopyTo(dp.Attributes())
+			dp.SetIntValue(ac.counts[j])
+		}
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// countsByAttributes returns the counts of the metric with the given name, keyed by the service name of their
+// resource followed by their attributes.
+func countsByAttributes(t *testing.T, md pmetric.Metrics, name string) map[string]int64 {
+	counts := map[string]int64{}
+	md.ForEachMetric(func(rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, m pmetric.Metric) bool {
+		if m.Name() != name {
+			return true
+		}
+		assert.Equal(t, scopeName, sm.Scope().Name())
+		assert.True(t, m.Sum().IsMonotonic())
+		assert.Equal(t, pmetric.AggregationTemporalityDelta, m.Sum().AggregationTemporality())
+		for i := 0; i < m.Sum().DataPoints().Len(); i++ {
+			dp := m.Sum().DataPoints().At(i)
+			assert.NotZero(t, dp.StartTimestamp())
+			assert.Less(t, dp.StartTimestamp(), dp.Timestamp())
+			service, _ := rm.Resource().Attributes().Get("service.name")
+			key := service.AsString()
+			dp.Attributes().Range(func(k string, v pcommon.Value) bool {
+				key += "," + k + "=" + v.AsString()
+				return true
+			})
+			counts[key] += dp.IntValue()
+		}
+		return true
+	})
+	return counts
+}
+
+func TestCountSpans(t *testing.T) {
+	cfg := &Config{Spans: map[string]MetricInfo{
+		"span.error.count": {
+			Description: "The number of failed spans.",
+			Conditions:  []Condition{{StatusCode: "Error"}},
+			Attributes:  []AttributeConfig{{Key: "http.route", DefaultValue: "unknown"}},
+		},
+		"span.self.count": {
+			Conditions: []Condition{{AttributeReferences: map[string]string{"peer.service": "service.name"}}},
+		},
+	}}
+	require.NoError(t, cfg.Validate())
+	sink := new(consumertest.MetricsSink)
+	conn, err := NewFactory().CreateTracesToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	assert.False(t, conn.Capabilities().MutatesData)
+	require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "frontend")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	failed := spans.AppendEmpty()
+	failed.Status().SetCode(ptrace.StatusCodeError)
+	failed.Attributes().PutStr("http.route", "/users")
+	failed.CopyTo(spans.AppendEmpty())
+	spans.AppendEmpty().Status().SetCode(ptrace.StatusCodeError)
+	spans.AppendEmpty().Attributes().PutStr("peer.service", "frontend")
+	spans.AppendEmpty().Attributes().PutStr("peer.service", "backend")
+
+	rs = td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "backend")
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+
+	require.NoError(t, conn.ConsumeTraces(context.Background(), td))
+	require.NoError(t, conn.Shutdown(context.Background()))
+
+	require.Len(t, sink.AllMetrics(), 1)
+	md := sink.AllMetrics()[0]
+	// Nothing was counted for the backend.
+	assert.Equal(t, 1, md.ResourceMetrics().Len())
+	assert.Equal(t, map[string]int64{
+		"frontend,http.route=/users":  2,
+		"frontend,http.route=unknown": 1,
+	}, countsByAttributes(t, md, "span.error.count"))
+	assert.Equal(t, map[string]int64{"frontend": 1}, countsByAttributes(t, md, "span.self.count"))
+	assert.Equal(t, "The number of failed spans.", md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Description())
+}
+
+func TestCountDataPoints(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	conn, err := NewFactory().CreateMetricsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), createDefaultConfig(), sink)
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "svc")
+	ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+	ms.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	ms.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	ms.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
+	ms.AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+	ms.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty()
+	ms.AppendEmpty()
+
+	require.NoError(t, conn.ConsumeMetrics(context.Background(), md))
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.Equal(t, map[string]int64{"svc": 5}, countsByAttributes(t, sink.AllMetrics()[0], defaultMetricNameDataPoints))
+}
+
+func TestCountAttributeTypes(t *testing.T) {
+	cfg := &Config{Logs: map[string]MetricInfo{
+		"log.count": {Attributes: []AttributeConfig{{Key: "code"}}},
+	}}
+	require.NoError(t, cfg.Validate())
+	sink := new(consumertest.MetricsSink)
+	conn, err := NewFactory().CreateLogsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().Attributes().PutInt("code", 1)
+	records.AppendEmpty().Attributes().PutStr("code", "1")
+	records.AppendEmpty().Attributes().PutStr("code", "1")
+
+	require.NoError(t, conn.ConsumeLogs(context.Background(), ld))
+	require.Len(t, sink.AllMetrics(), 1)
+	// The int 1 and the string "1" are counted apart.
+	dps := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	code, _ := dps.At(0).Attributes().Get("code")
+	assert.Equal(t, pcommon.ValueTypeInt, code.Type())
+	assert.Equal(t, int64(1), dps.At(0).IntValue())
+	code, _ = dps.At(1).Attributes().Get("code")
+	assert.Equal(t, pcommon.ValueTypeStr, code.Type())
+	assert.Equal(t, int64(2), dps.At(1).IntValue())
+}
+
+func TestCountIntervals(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	conn, err := NewFactory().CreateLogsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), createDefaultConfig(), sink)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	require.NoError(t, conn.ConsumeLogs(context.Background(), ld))
+	require.NoError(t, conn.ConsumeLogs(context.Background(), ld))
+
+	require.Len(t, sink.AllMetrics(), 2)
+	first := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	second := sink.AllMetrics()[1].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	// The delta counts follow each other without gaps nor overlaps.
+	assert.Equal(t, first.Timestamp(), second.StartTimestamp())
+	assert.Less(t, second.StartTimestamp(), second.Timestamp())
+}
+
+func TestCountLogs(t *testing.T) {
+	cfg := &Config{Logs: map[string]MetricInfo{
+		"log.error.count": {
+			Conditions: []Condition{
+				{SeverityText: "error"},
+				{Attributes: map[string]string{"level": "error", "code": "500"}},
+			},
+			Attributes: []AttributeConfig{{Key: "env"}},
+		},
+	}}
+	require.NoError(t, cfg.Validate())
+	sink := new(consumertest.MetricsSink)
+	conn, err := NewFactory().CreateLogsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "svc")
+	rl.Resource().Attributes().PutStr("env", "prod")
+	sl := rl.ScopeLogs().AppendEmpty()
+	records := sl.LogRecords()
+	records.AppendEmpty().SetSeverityText("ERROR")
+	records.AppendEmpty().SetSeverityText("INFO")
+	lr := records.AppendEmpty()
+	lr.Attributes().PutStr("level", "error")
+	lr.Attributes().PutInt("code", 500)
+	lr.Attributes().PutStr("env", "dev")
+	records.AppendEmpty().Attributes().PutStr("level", "error")
+
+	require.NoError(t, conn.ConsumeLogs(context.Background(), ld))
+
+	// Records without the env attribute are not counted.
+	noEnv := plog.NewLogs()
+	noEnv.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().SetSeverityText("ERROR")
+	require.NoError(t, conn.ConsumeLogs(context.Background(), noEnv))
+
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.Equal(t, map[string]int64{
+		"svc,env=prod": 1,
+		"svc,env=dev":  1,
+	}, countsByAttributes(t, sink.AllMetrics()[0], "log.error.count"))
+}
+
+func TestCountDefaultMetrics(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	conn, err := NewFactory().CreateLogsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), createDefaultConfig(), sink)
+	require.NoError(t, err)
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	require.NoError(t, conn.ConsumeLogs(context.Background(), ld))
+	require.Len(t, sink.AllMetrics(), 1)
+	m := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, defaultMetricNameLogs, m.Name())
+	assert.Equal(t, defaultMetricDescLogs, m.Description())
+	assert.Equal(t, int64(1), m.Sum().DataPoints().At(0).IntValue())
+
+	tracesConn, err := NewFactory().CreateTracesToMetrics(context.Background(), connectortest.NewNopCreateSettings(), createDefaultConfig(), sink)
+	require.NoError(t, err)
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	require.NoError(t, tracesConn.ConsumeTraces(context.Background(), td))
+	require.Len(t, sink.AllMetrics(), 2)
+	assert.Equal(t, defaultMetricNameSpans, sink.AllMetrics()[1].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+}
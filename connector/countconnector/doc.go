@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package countconnector counts the spans, data points and log records matching conditions, and emits the counts as metrics.
+package countconnector // import "go.opentelemetry.io/collector/connector/countconnector"
@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package forwardconnector // import "go.opentelemetry.io/collector/connector/forwardconnector"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines the configuration of the forward connector.
+type Config struct {
+	// SamplingPercentage is the percentage of the data passed to the next pipelines.
+	// The spans are sampled by trace ID, so that the spans of a trace are either all passed or all dropped.
+	// The log records are sampled by trace ID when they have one, otherwise by timestamp and body.
+	// The data points are sampled by metric stream, so that a stream is either fully passed or dropped.
+	// Default value is 100, that means all the data is passed.
+	SamplingPercentage float64 `mapstructure:"sampling_percentage"`
+
+	// HashSeed is mixed in the hash deciding whether the data is sampled. Connectors and collectors
+	// configured with the same seed and percentage sample the same traces, log records and metric streams.
+	HashSeed uint32 `mapstructure:"hash_seed"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the connector configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.SamplingPercentage < 0 || cfg.SamplingPercentage > 100 {
+		return errors.New("sampling_percentage must be between 0 and 100")
+	}
+	return nil
+}
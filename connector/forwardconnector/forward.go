@@ -23,20 +23,21 @@ func NewFactory() connector.Factory {
 	)
 }
 
-type Config struct{}
-
 // createDefaultConfig creates the default configuration.
 func createDefaultConfig() component.Config {
-	return &Config{}
+	return &Config{SamplingPercentage: 100}
 }
 
 // createTracesToTraces creates a trace receiver based on provided config.
 func createTracesToTraces(
 	_ context.Context,
 	_ connector.CreateSettings,
-	_ component.Config,
+	cfg component.Config,
 	nextConsumer consumer.Traces,
 ) (connector.Traces, error) {
+	if s := newSampler(cfg.(*Config)); s != nil {
+		return &forward{Traces: &sampledTraces{sampler: s, next: nextConsumer}}, nil
+	}
 	return &forward{Traces: nextConsumer}, nil
 }
 
@@ -44,9 +45,12 @@ func createTracesToTraces(
 func createMetricsToMetrics(
 	_ context.Context,
 	_ connector.CreateSettings,
-	_ component.Config,
+	cfg component.Config,
 	nextConsumer consumer.Metrics,
 ) (connector.Metrics, error) {
+	if s := newSampler(cfg.(*Config)); s != nil {
+		return &forward{Metrics: &sampledMetrics{sampler: s, next: nextConsumer}}, nil
+	}
 	return &forward{Metrics: nextConsumer}, nil
 }
 
@@ -54,9 +58,12 @@ func createMetricsToMetrics(
 func createLogsToLogs(
 	_ context.Context,
 	_ connector.CreateSettings,
-	_ component.Config,
+	cfg component.Config,
 	nextConsumer consumer.Logs,
 ) (connector.Logs, error) {
+	if s := newSampler(cfg.(*Config)); s != nil {
+		return &forward{Logs: &sampledLogs{sampler: s, next: nextConsumer}}, nil
+	}
 	return &forward{Logs: nextConsumer}, nil
 }
 
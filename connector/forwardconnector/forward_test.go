@@ -19,7 +19,7 @@ import (
 func TestForward(t *testing.T) {
 	f := NewFactory()
 	cfg := f.CreateDefaultConfig()
-	assert.Equal(t, &Config{}, cfg)
+	assert.Equal(t, &Config{SamplingPercentage: 100}, cfg)
 
 	ctx := context.Background()
 	set := connectortest.NewNopCreateSettings()
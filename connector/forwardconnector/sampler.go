@@ -0,0 +1,284 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package forwardconnector // import "go.opentelemetry.io/collector/connector/forwardconnector"
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/identity"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// sampler decides whether data is passed from the hash of its identity.
+type sampler struct {
+	seed [4]byte
+	// threshold is the number of 32-bit hash values, from 0, that are sampled.
+	threshold uint64
+}
+
+// newSampler returns the sampler of the configuration, or nil if all the data is passed.
+func newSampler(cfg *Config) *sampler {
+	if cfg.SamplingPercentage >= 100 {
+		return nil
+	}
+	s := &sampler{threshold: uint64(math.Round(cfg.SamplingPercentage / 100 * (1 << 32)))}
+	binary.LittleEndian.PutUint32(s.seed[:], cfg.HashSeed)
+	return s
+}
+
+// sample returns whether the data identified by the given bytes is passed.
+func (s *sampler) sample(id ...[]byte) bool {
+	h := fnv.New32a()
+	_, _ = h.Write(s.seed[:])
+	for _, b := range id {
+		_, _ = h.Write(b)
+	}
+	return uint64(h.Sum32()) < s.threshold
+}
+
+func (s *sampler) sampleLogRecord(lr plog.LogRecord) bool {
+	if traceID := lr.TraceID(); !traceID.IsEmpty() {
+		return s.sample(traceID[:])
+	}
+	var ts [16]byte
+	binary.LittleEndian.PutUint64(ts[:8], uint64(lr.Timestamp()))
+	binary.LittleEndian.PutUint64(ts[8:], uint64(lr.ObservedTimestamp()))
+	return s.sample(ts[:], []byte(lr.Body().AsString()))
+}
+
+// sampledTraces passes the spans of the sampled traces to the next consumer.
+type sampledTraces struct {
+	sampler *sampler
+	next    consumer.Traces
+}
+
+func (st *sampledTraces) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeTraces copies the sampled spans, along with their resource and scope, to a new batch,
+// so that the spans which are not sampled are never copied.
+func (st *sampledTraces) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	sampled := ptrace.NewTraces()
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		var sampledRS ptrace.ResourceSpans
+		hasRS := false
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			var sampledSS ptrace.ScopeSpans
+			hasSS := false
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				traceID := span.TraceID()
+				if !st.sampler.sample(traceID[:]) {
+					continue
+				}
+				if !hasRS {
+					sampledRS = sampled.ResourceSpans().AppendEmpty()
+					rs.Resource().CopyTo(sampledRS.Resource())
+					sampledRS.SetSchemaUrl(rs.SchemaUrl())
+					hasRS = true
+				}
+				if !hasSS {
+					sampledSS = sampledRS.ScopeSpans().AppendEmpty()
+					ss.Scope().CopyTo(sampledSS.Scope())
+					sampledSS.SetSchemaUrl(ss.SchemaUrl())
+					hasSS = true
+				}
+				span.CopyTo(sampledSS.Spans().AppendEmpty())
+			}
+		}
+	}
+	if sampled.ResourceSpans().Len() == 0 {
+		return nil
+	}
+	return st.next.ConsumeTraces(ctx, sampled)
+}
+
+// sampledMetrics passes the data points of the sampled metric streams to the next consumer.
+type sampledMetrics struct {
+	sampler *sampler
+	next    consumer.Metrics
+}
+
+func (sm *sampledMetrics) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeMetrics copies the sampled data points, along with their metric, resource and scope,
+// to a new batch, so that the data points which are not sampled are never copied.
+func (sm *sampledMetrics) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	sampled := pmetric.NewMetrics()
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		var sampledRM pmetric.ResourceMetrics
+		hasRM := false
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			scm := rm.ScopeMetrics().At(j)
+			var sampledSM pmetric.ScopeMetrics
+			hasSM := false
+			for k := 0; k < scm.Metrics().Len(); k++ {
+				m := scm.Metrics().At(k)
+				keep := func(attrs pcommon.Map) bool {
+					h := identity.Stream(rm.Resource(), scm.Scope(), m, attrs)
+					return sm.sampler.sample(h[:])
+				}
+				var sampledMetric pmetric.Metric
+				hasMetric := false
+				metric := func() pmetric.Metric {
+					if !hasRM {
+						sampledRM = sampled.ResourceMetrics().AppendEmpty()
+						rm.Resource().CopyTo(sampledRM.Resource())
+						sampledRM.SetSchemaUrl(rm.SchemaUrl())
+						hasRM = true
+					}
+					if !hasSM {
+						sampledSM = sampledRM.ScopeMetrics().AppendEmpty()
+						scm.Scope().CopyTo(sampledSM.Scope())
+						sampledSM.SetSchemaUrl(scm.SchemaUrl())
+						hasSM = true
+					}
+					if !hasMetric {
+						sampledMetric = sampledSM.Metrics().AppendEmpty()
+						copyMetricDescriptor(m, sampledMetric)
+						hasMetric = true
+					}
+					return sampledMetric
+				}
+				sampleDataPoints(m, keep, metric)
+			}
+		}
+	}
+	if sampled.ResourceMetrics().Len() == 0 {
+		return nil
+	}
+	return sm.next.ConsumeMetrics(ctx, sampled)
+}
+
+// sampleDataPoints copies the data points of m whose attributes are kept to the metric returned
+// by dest, which is only called when a data point is kept.
+func sampleDataPoints(m pmetric.Metric, keep func(pcommon.Map) bool, dest func() pmetric.Metric) {
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := m.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if keep(dps.At(i).Attributes()) {
+				dps.At(i).CopyTo(dest().Gauge().DataPoints().AppendEmpty())
+			}
+		}
+	case pmetric.MetricTypeSum:
+		dps := m.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if keep(dps.At(i).Attributes()) {
+				dps.At(i).CopyTo(dest().Sum().DataPoints().AppendEmpty())
+			}
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := m.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if keep(dps.At(i).Attributes()) {
+				dps.At(i).CopyTo(dest().Histogram().DataPoints().AppendEmpty())
+			}
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := m.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if keep(dps.At(i).Attributes()) {
+				dps.At(i).CopyTo(dest().ExponentialHistogram().DataPoints().AppendEmpty())
+			}
+		}
+	case pmetric.MetricTypeSummary:
+		dps := m.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if keep(dps.At(i).Attributes()) {
+				dps.At(i).CopyTo(dest().Summary().DataPoints().AppendEmpty())
+			}
+		}
+	default:
+		if keep(pcommon.NewMap()) {
+			dest()
+		}
+	}
+}
+
+// copyMetricDescriptor copies the name, description, unit and type of src to dest, without its data points.
+func copyMetricDescriptor(src, dest pmetric.Metric) {
+	dest.SetName(src.Name())
+	dest.SetDescription(src.Description())
+	dest.SetUnit(src.Unit())
+	switch src.Type() {
+	case pmetric.MetricTypeGauge:
+		dest.SetEmptyGauge()
+	case pmetric.MetricTypeSum:
+		sum := dest.SetEmptySum()
+		sum.SetAggregationTemporality(src.Sum().AggregationTemporality())
+		sum.SetIsMonotonic(src.Sum().IsMonotonic())
+	case pmetric.MetricTypeHistogram:
+		dest.SetEmptyHistogram().SetAggregationTemporality(src.Histogram().AggregationTemporality())
+	case pmetric.MetricTypeExponentialHistogram:
+		dest.SetEmptyExponentialHistogram().SetAggregationTemporality(src.ExponentialHistogram().AggregationTemporality())
+	case pmetric.MetricTypeSummary:
+		dest.SetEmptySummary()
+	}
+}
+
+// sampledLogs passes the sampled log records to the next consumer.
+type sampledLogs struct {
+	sampler *sampler
+	next    consumer.Logs
+}
+
+func (sl *sampledLogs) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeLogs copies the sampled log records, along with their resource and scope, to a new batch,
+// so that the log records which are not sampled are never copied.
+func (sl *sampledLogs) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	sampled := plog.NewLogs()
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		var sampledRL plog.ResourceLogs
+		hasRL := false
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			scopeLogs := rl.ScopeLogs().At(j)
+			var sampledSL plog.ScopeLogs
+			hasSL := false
+			for k := 0; k < scopeLogs.LogRecords().Len(); k++ {
+				lr := scopeLogs.LogRecords().At(k)
+				if !sl.sampler.sampleLogRecord(lr) {
+					continue
+				}
+				if !hasRL {
+					sampledRL = sampled.ResourceLogs().AppendEmpty()
+					rl.Resource().CopyTo(sampledRL.Resource())
+					sampledRL.SetSchemaUrl(rl.SchemaUrl())
+					hasRL = true
+				}
+				if !hasSL {
+					sampledSL = sampledRL.ScopeLogs().AppendEmpty()
+					scopeLogs.Scope().CopyTo(sampledSL.Scope())
+					sampledSL.SetSchemaUrl(scopeLogs.SchemaUrl())
+					hasSL = true
+				}
+				lr.CopyTo(sampledSL.LogRecords().AppendEmpty())
+			}
+		}
+	}
+	if sampled.ResourceLogs().Len() == 0 {
+		return nil
+	}
+	return sl.next.ConsumeLogs(ctx, sampled)
+}
@@ -0,0 +1,241 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package forwardconnector
+
+import (
+	"context"
+	"encoding/binary"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func traceID(i int) pcommon.TraceID {
+	var id pcommon.TraceID
+	binary.BigEndian.PutUint64(id[8:], uint64(i))
+	return id
+}
+
+// newTraces returns traces with 2 spans for each of the n traces.
+func newTraces(n int) ptrace.Traces {
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	for i := 0; i < n; i++ {
+		for j := 0; j < 2; j++ {
+			span := spans.AppendEmpty()
+			span.SetTraceID(traceID(i))
+			span.SetName(strconv.Itoa(i))
+		}
+	}
+	return td
+}
+
+func TestSampledTraces(t *testing.T) {
+	cfg := &Config{SamplingPercentage: 25}
+	sink := new(consumertest.TracesSink)
+	conn, err := NewFactory().CreateTracesToTraces(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	assert.False(t, conn.Capabilities().MutatesData)
+
+	td := newTraces(1000)
+	require.NoError(t, conn.ConsumeTraces(context.Background(), td))
+	// The consumed traces are not modified.
+	assert.Equal(t, 2000, td.SpanCount())
+	require.Len(t, sink.AllTraces(), 1)
+	sampled := sink.AllTraces()[0]
+	assert.InDelta(t, 500, sampled.SpanCount(), 100)
+
+	// The spans of a trace are all passed or all dropped.
+	counts := map[pcommon.TraceID]int{}
+	sampled.ForEachSpan(func(_ ptrace.ResourceSpans, _ ptrace.ScopeSpans, span ptrace.Span) bool {
+		counts[span.TraceID()]++
+		return true
+	})
+	for id, count := range counts {
+		assert.Equal(t, 2, count, "trace %v", id)
+	}
+
+	// The same traces are sampled again, and other ones with another seed.
+	require.NoError(t, conn.ConsumeTraces(context.Background(), newTraces(1000)))
+	assert.Equal(t, sampled, sink.AllTraces()[1])
+	cfg.HashSeed = 1
+	seeded, err := NewFactory().CreateTracesToTraces(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, seeded.ConsumeTraces(context.Background(), newTraces(1000)))
+	assert.NotEqual(t, sampled, sink.AllTraces()[2])
+}
+
+func TestSampledTracesNone(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	conn, err := NewFactory().CreateTracesToTraces(context.Background(), connectortest.NewNopCreateSettings(), &Config{}, sink)
+	require.NoError(t, err)
+	require.NoError(t, conn.ConsumeTraces(context.Background(), newTraces(100)))
+	assert.Empty(t, sink.AllTraces())
+}
+
+func newMetrics(n int) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "svc")
+	ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+	gauge := ms.AppendEmpty().SetEmptyGauge()
+	sum := ms.AppendEmpty().SetEmptySum()
+	histogram := ms.AppendEmpty().SetEmptyHistogram()
+	expHistogram := ms.AppendEmpty().SetEmptyExponentialHistogram()
+	summary := ms.AppendEmpty().SetEmptySummary()
+	for i := 0; i < ms.Len(); i++ {
+		ms.At(i).SetName(ms.At(i).Type().String())
+	}
+	for i := 0; i < n; i++ {
+		key := strconv.Itoa(i)
+		gauge.DataPoints().AppendEmpty().Attributes().PutStr("key", key)
+		sum.DataPoints().AppendEmpty().Attributes().PutStr("key", key)
+		histogram.DataPoints().AppendEmpty().Attributes().PutStr("key", key)
+		expHistogram.DataPoints().AppendEmpty().Attributes().PutStr("key", key)
+		summary.DataPoints().AppendEmpty().Attributes().PutStr("key", key)
+	}
+	return md
+}
+
+func TestSampledMetrics(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	conn, err := NewFactory().CreateMetricsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), &Config{SamplingPercentage: 50}, sink)
+	require.NoError(t, err)
+	assert.False(t, conn.Capabilities().MutatesData)
+
+	md := newMetrics(200)
+	require.NoError(t, conn.ConsumeMetrics(context.Background(), md))
+	assert.Equal(t, 1000, md.DataPointCount())
+	require.Len(t, sink.AllMetrics(), 1)
+	sampled := sink.AllMetrics()[0]
+	assert.InDelta(t, 500, sampled.DataPointCount(), 100)
+
+	// The streams are sampled independently of the other data points of their metric.
+	require.NoError(t, conn.ConsumeMetrics(context.Background(), newMetrics(1)))
+	require.NoError(t, conn.ConsumeMetrics(context.Background(), newMetrics(2)))
+	var first, second []string
+	sink.AllMetrics()[1].ForEachMetric(func(_ pmetric.ResourceMetrics, _ pmetric.ScopeMetrics, m pmetric.Metric) bool {
+		first = append(first, m.Name())
+		return true
+	})
+	sink.AllMetrics()[2].ForEachMetric(func(_ pmetric.ResourceMetrics, _ pmetric.ScopeMetrics, m pmetric.Metric) bool {
+		second = append(second, m.Name())
+		return true
+	})
+	assert.Subset(t, second, first)
+}
+
+func TestSampledLogs(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	conn, err := NewFactory().CreateLogsToLogs(context.Background(), connectortest.NewNopCreateSettings(), &Config{SamplingPercentage: 50}, sink)
+	require.NoError(t, err)
+	assert.False(t, conn.Capabilities().MutatesData)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for i := 0; i < 500; i++ {
+		// Records of the same trace are sampled together.
+		for j := 0; j < 2; j++ {
+			lr := records.AppendEmpty()
+			lr.SetTraceID(traceID(i))
+			lr.Body().SetStr(strconv.Itoa(j))
+		}
+		records.AppendEmpty().Body().SetStr(strconv.Itoa(i))
+	}
+	require.NoError(t, conn.ConsumeLogs(context.Background(), ld))
+	assert.Equal(t, 1500, ld.LogRecordCount())
+	require.Len(t, sink.AllLogs(), 1)
+	sampled := sink.AllLogs()[0]
+	assert.InDelta(t, 750, sampled.LogRecordCount(), 150)
+
+	withTrace, withoutTrace := 0, 0
+	sampled.ForEachLogRecord(func(_ plog.ResourceLogs, _ plog.ScopeLogs, lr plog.LogRecord) bool {
+		if lr.TraceID().IsEmpty() {
+			withoutTrace++
+		} else {
+			withTrace++
+		}
+		return true
+	})
+	assert.Equal(t, 0, withTrace%2)
+	assert.InDelta(t, 250, withoutTrace, 75)
+}
+
+// TestSampledMatchesFilter checks that the sampled batches are the consumed ones without the data
+// which is not sampled, with the same resources, scopes and metric descriptors.
+func TestSampledMatchesFilter(t *testing.T) {
+	s := newSampler(&Config{SamplingPercentage: 50})
+
+	td := ptrace.NewTraces()
+	for i := 0; i < 3; i++ {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.SetSchemaUrl("https://opentelemetry.io/schemas/1.0.0")
+		rs.Resource().Attributes().PutInt("resource", int64(i))
+		ss := rs.ScopeSpans().AppendEmpty()
+		ss.Scope().SetName("scope")
+		newTraces(300).ResourceSpans().At(0).ScopeSpans().At(0).Spans().MoveAndAppendTo(ss.Spans())
+	}
+	tracesSink := new(consumertest.TracesSink)
+	require.NoError(t, (&sampledTraces{sampler: s, next: tracesSink}).ConsumeTraces(context.Background(), td))
+	expectedTraces := ptrace.NewTraces()
+	td.CopyTo(expectedTraces)
+	expectedTraces.RemoveSpansIf(func(_ ptrace.ResourceSpans, _ ptrace.ScopeSpans, span ptrace.Span) bool {
+		traceID := span.TraceID()
+		return !s.sample(traceID[:])
+	})
+	require.Len(t, tracesSink.AllTraces(), 1)
+	assert.Equal(t, expectedTraces, tracesSink.AllTraces()[0])
+
+	md := newMetrics(20)
+	md.ResourceMetrics().At(0).SetSchemaUrl("https://opentelemetry.io/schemas/1.0.0")
+	md.ResourceMetrics().At(0).ScopeMetrics().At(0).Scope().SetVersion("v1")
+	md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().AppendEmpty().SetName("empty")
+	md.ForEachMetric(func(_ pmetric.ResourceMetrics, _ pmetric.ScopeMetrics, m pmetric.Metric) bool {
+		m.SetUnit("1")
+		m.SetDescription(m.Name())
+		switch m.Type() {
+		case pmetric.MetricTypeSum:
+			m.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+			m.Sum().SetIsMonotonic(true)
+		case pmetric.MetricTypeHistogram:
+			m.Histogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		case pmetric.MetricTypeExponentialHistogram:
+			m.ExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		}
+		return true
+	})
+	metricsSink := new(consumertest.MetricsSink)
+	require.NoError(t, (&sampledMetrics{sampler: s, next: metricsSink}).ConsumeMetrics(context.Background(), md))
+	require.Len(t, metricsSink.AllMetrics(), 1)
+	sampled := metricsSink.AllMetrics()[0]
+	assert.Less(t, sampled.DataPointCount(), md.DataPointCount())
+	sampled.ForEachMetric(func(_ pmetric.ResourceMetrics, _ pmetric.ScopeMetrics, m pmetric.Metric) bool {
+		var orig pmetric.Metric
+		md.ForEachMetric(func(_ pmetric.ResourceMetrics, _ pmetric.ScopeMetrics, candidate pmetric.Metric) bool {
+			if candidate.Name() == m.Name() {
+				orig = candidate
+				return false
+			}
+			return true
+		})
+		descriptor, origDescriptor := pmetric.NewMetric(), pmetric.NewMetric()
+		copyMetricDescriptor(m, descriptor)
+		copyMetricDescriptor(orig, origDescriptor)
+		assert.Equal(t, origDescriptor, descriptor)
+		assert.Equal(t, orig.Description(), m.Description())
+		return true
+	})
+	assert.Equal(t, md.ResourceMetrics().At(0).SchemaUrl(), sampled.ResourceMetrics().At(0).SchemaUrl())
+	assert.Equal(t, "v1", sampled.ResourceMetrics().At(0).ScopeMetrics().At(0).Scope().Version())
+	assert.Equal(t, "svc", sampled.ResourceMetrics().At(0).Resource().Attributes().AsRaw()["service.name"])
+}
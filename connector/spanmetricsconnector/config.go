@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanmetricsconnector // import "go.opentelemetry.io/collector/connector/spanmetricsconnector"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+const (
+	// maxHistogramMaxSize is the largest number of buckets an exponential histogram can be configured with.
+	maxHistogramMaxSize = 1024
+)
+
+// Config defines the configuration of the spanmetrics connector.
+type Config struct {
+	// Dimensions are the span or resource attributes added as attributes of the generated metrics, in addition
+	// to the span name, the span kind and the status code. The span attributes take precedence over the
+	// resource attributes of the same name.
+	Dimensions []Dimension `mapstructure:"dimensions"`
+
+	// Histogram configures the histogram of the span durations.
+	Histogram HistogramConfig `mapstructure:"histogram"`
+
+	// Namespace is the prefix of the names of the generated metrics.
+	Namespace string `mapstructure:"namespace"`
+
+	// MetricsFlushInterval is the interval at which the metrics are sent to the next consumer.
+	MetricsFlushInterval time.Duration `mapstructure:"metrics_flush_interval"`
+
+	// MetricsExpiration is the duration after which a series which did not receive any span is removed,
+	// so that the series of the combinations which are not seen anymore are not tracked forever. The
+	// series is started again if a span of its combination is received later. 0 means that the series
+	// never expire.
+	MetricsExpiration time.Duration `mapstructure:"metrics_expiration"`
+
+	// MaxSeries is the maximum number of distinct combinations of resource and attributes tracked by the
+	// connector. The spans of new combinations past the limit are recorded in a single overflow series
+	// with the otel.metric.overflow attribute set to true. 0 means no limit.
+	MaxSeries int `mapstructure:"max_series"`
+}
+
+// Dimension defines a span or resource attribute added to the generated metrics.
+type Dimension struct {
+	// Name is the name of the attribute.
+	Name string `mapstructure:"name"`
+
+	// Default is the value used when neither the span nor its resource has the attribute.
+	// When it is not set, the attribute is omitted for these spans.
+	Default *string `mapstructure:"default"`
+}
+
+// HistogramConfig defines the configuration of the exponential histogram of the span durations.
+type HistogramConfig struct {
+	// Unit is the unit of the recorded durations, either "ms" or "s".
+	Unit string `mapstructure:"unit"`
+
+	// MaxSize is the maximum number of buckets of the histogram. The scale of the histogram is lowered
+	// as much as required for the recorded durations to fit in this number of buckets.
+	MaxSize int32 `mapstructure:"max_size"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the connector configuration is valid.
+func (cfg *Config) Validate() error {
+	uniq := map[string]bool{}
+	for _, d := range cfg.Dimensions {
+		if d.Name == "" {
+			return errors.New("dimension name must not be empty")
+		}
+		if reservedAttributes[d.Name] {
+			return fmt.Errorf("dimension %q is always added and must not be configured", d.Name)
+		}
+		if uniq[d.Name] {
+			return fmt.Errorf("duplicate dimension %q", d.Name)
+		}
+		uniq[d.Name] = true
+	}
+	if cfg.Histogram.Unit != unitMilliseconds && cfg.Histogram.Unit != unitSeconds {
+		return fmt.Errorf("histogram::unit must be %q or %q", unitMilliseconds, unitSeconds)
+	}
+	if cfg.Histogram.MaxSize < 2 || cfg.Histogram.MaxSize > maxHistogramMaxSize {
+		return fmt.Errorf("histogram::max_size must be between 2 and %d", maxHistogramMaxSize)
+	}
+	if cfg.MetricsFlushInterval <= 0 {
+		return errors.New("metrics_flush_interval must be greater than 0")
+	}
+	if cfg.MetricsExpiration < 0 {
+		return errors.New("metrics_expiration must be greater or equal to 0")
+	}
+	// Every series is sent at least once after it receives a span before it expires.
+	if cfg.MetricsExpiration > 0 && cfg.MetricsExpiration < cfg.MetricsFlushInterval {
+		return errors.New("metrics_expiration must be 0 or greater or equal to metrics_flush_interval")
+	}
+	if cfg.MaxSeries < 0 {
+		return errors.New("max_series must be greater or equal to 0")
+	}
+	return nil
+}
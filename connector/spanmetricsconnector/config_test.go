@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanmetricsconnector
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	assert.NoError(t, component.UnmarshalConfig(confmap.New(), cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	assert.NoError(t, component.UnmarshalConfig(cm, cfg))
+	defaultMethod := "GET"
+	assert.Equal(t,
+		&Config{
+			Dimensions: []Dimension{
+				{Name: "http.method", Default: &defaultMethod},
+				{Name: "http.status_code"},
+			},
+			Histogram: HistogramConfig{
+				Unit:    "s",
+				MaxSize: 80,
+			},
+			Namespace:            "spans",
+			MetricsFlushInterval: 30 * time.Second,
+			MetricsExpiration:    10 * time.Minute,
+			MaxSeries:            1000,
+		}, cfg)
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(*Config)
+		expected string
+	}{
+		{
+			name:     "empty dimension",
+			modify:   func(cfg *Config) { cfg.Dimensions = []Dimension{{}} },
+			expected: "dimension name must not be empty",
+		},
+		{
+			name:     "reserved dimension",
+			modify:   func(cfg *Config) { cfg.Dimensions = []Dimension{{Name: "span.name"}} },
+			expected: `dimension "span.name" is always added and must not be configured`,
+		},
+		{
+			name:     "duplicate dimension",
+			modify:   func(cfg *Config) { cfg.Dimensions = []Dimension{{Name: "key"}, {Name: "key"}} },
+			expected: `duplicate dimension "key"`,
+		},
+		{
+			name:     "invalid unit",
+			modify:   func(cfg *Config) { cfg.Histogram.Unit = "us" },
+			expected: `histogram::unit must be "ms" or "s"`,
+		},
+		{
+			name:     "invalid max size",
+			modify:   func(cfg *Config) { cfg.Histogram.MaxSize = 1 },
+			expected: "histogram::max_size must be between 2 and 1024",
+		},
+		{
+			name:     "invalid flush interval",
+			modify:   func(cfg *Config) { cfg.MetricsFlushInterval = 0 },
+			expected: "metrics_flush_interval must be greater than 0",
+		},
+		{
+			name:     "invalid metrics expiration",
+			modify:   func(cfg *Config) { cfg.MetricsExpiration = -1 },
+			expected: "metrics_expiration must be greater or equal to 0",
+		},
+		{
+			name:     "metrics expiration shorter than flush interval",
+			modify:   func(cfg *Config) { cfg.MetricsExpiration = time.Second },
+			expected: "metrics_expiration must be 0 or greater or equal to metrics_flush_interval",
+		},
+		{
+			name:     "invalid max series",
+			modify:   func(cfg *Config) { cfg.MaxSeries = -1 },
+			expected: "max_series must be greater or equal to 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.expected)
+		})
+	}
+}
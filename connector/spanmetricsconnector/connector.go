@@ -0,0 +1,368 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanmetricsconnector // import "go.opentelemetry.io/collector/connector/spanmetricsconnector"
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/identity"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	scopeName = "go.opentelemetry.io/collector/connector/spanmetricsconnector"
+
+	unitMilliseconds = "ms"
+	unitSeconds      = "s"
+
+	spanNameKey   = "span.name"
+	spanKindKey   = "span.kind"
+	statusCodeKey = "status.code"
+	overflowKey   = "otel.metric.overflow"
+
+	callsMetricName    = "calls"
+	errorsMetricName   = "errors"
+	durationMetricName = "duration"
+)
+
+// reservedAttributes are the attributes set by the connector on every data point.
+var reservedAttributes = map[string]bool{
+	spanNameKey:   true,
+	spanKindKey:   true,
+	statusCodeKey: true,
+	overflowKey:   true,
+}
+
+// connectorImp aggregates the spans it consumes into cumulative call counts, error counts and
+// duration histograms, which are sent to the next consumer every MetricsFlushInterval. The series
+// which did not receive any span during MetricsExpiration are removed before the metrics are sent.
+type connectorImp struct {
+	config *Config
+	logger *zap.Logger
+	next   consumer.Metrics
+	now    func() time.Time
+
+	mu          sync.Mutex
+	resources   []*resourceSeries
+	resourceIdx map[identity.Hash]*resourceSeries
+	seriesCount int
+	overflow    *resourceSeries
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// resourceSeries holds the series of the spans of a resource, in the order they were created.
+type resourceSeries struct {
+	hash      identity.Hash
+	resource  pcommon.Resource
+	series    []*series
+	seriesIdx map[string]*series
+}
+
+// series holds the aggregated values of the spans sharing the same resource and attributes.
+type series struct {
+	attrs    pcommon.Map
+	start    pcommon.Timestamp
+	lastSeen time.Time
+	calls    uint64
+	errors   uint64
+	duration *exponentialHistogram
+}
+
+func newConnector(logger *zap.Logger, cfg *Config, next consumer.Metrics) *connectorImp {
+	return &connectorImp{
+		config:      cfg,
+		logger:      logger,
+		next:        next,
+		now:         time.Now,
+		resourceIdx: map[identity.Hash]*resourceSeries{},
+		done:        make(chan struct{}),
+	}
+}
+
+// Start starts the periodic flush of the metrics.
+func (c *connectorImp) Start(context.Context, component.Host) error {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		ticker := time.NewTicker(c.config.MetricsFlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.exportMetrics(context.Background())
+			case <-c.done:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops the periodic flush and sends the metrics one last time.
+func (c *connectorImp) Shutdown(ctx context.Context) error {
+	close(c.done)
+	c.wg.Wait()
+	c.exportMetrics(ctx)
+	return nil
+}
+
+func (c *connectorImp) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeTraces aggregates the spans into the series matching their resource and attributes.
+func (c *connectorImp) ConsumeTraces(_ context.Context, td ptrace.Traces) error {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var key strings.Builder
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		res := rs.Resource()
+		resHash := identity.Resource(res)
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			spans := sss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				key.Reset()
+				c.writeKey(&key, res, span)
+				var s *series
+				if rser := c.resourceIdx[resHash]; rser != nil {
+					s = rser.seriesIdx[key.String()]
+				}
+				if s == nil {
+					s = c.newSeries(resHash, key.String(), res, span, pcommon.NewTimestampFromTime(now))
+				}
+				s.lastSeen = now
+				c.record(s, span)
+			}
+		}
+	}
+	return nil
+}
+
+// writeKey writes the values of the attributes of the series of the span to the key.
+func (c *connectorImp) writeKey(key *strings.Builder, res pcommon.Resource, span ptrace.Span) {
+	key.WriteString(span.Name())
+	key.WriteByte(0)
+	key.WriteString(span.Kind().String())
+	key.WriteByte(0)
+	key.WriteString(span.Status().Code().String())
+	for _, d := range c.config.Dimensions {
+		key.WriteByte(0)
+		if v, ok := c.dimensionValue(d, res, span); ok {
+			// The type prefix distinguishes a missing attribute from any value.
+			key.WriteString(v.Type().String())
+			key.WriteByte(':')
+			key.WriteString(v.AsString())
+		}
+	}
+}
+
+// dimensionValue returns the value of the dimension for the span, or false if the attribute is omitted.
+func (c *connectorImp) dimensionValue(d Dimension, res pcommon.Resource, span ptrace.Span) (pcommon.Value, bool) {
+	if v, ok := span.Attributes().Get(d.Name); ok {
+		return v, true
+	}
+	if v, ok := res.Attributes().Get(d.Name); ok {
+		return v, true
+	}
+	if d.Default != nil {
+		return pcommon.NewValueStr(*d.Default), true
+	}
+	return pcommon.Value{}, false
+}
+
+// newSeries creates the series of the span, or returns the overflow series if MaxSeries is reached.
+func (c *connectorImp) newSeries(resHash identity.Hash, key string, res pcommon.Resource, span ptrace.Span, now pcommon.Timestamp) *series {
+	if c.config.MaxSeries > 0 && c.seriesCount >= c.config.MaxSeries {
+		if c.overflow == nil {
+			s := &series{attrs: pcommon.NewMap(), start: now, duration: newExponentialHistogram(c.config.Histogram.MaxSize)}
+			s.attrs.PutBool(overflowKey, true)
+			c.overflow = &resourceSeries{resource: pcommon.NewResource(), series: []*series{s}}
+		}
+		return c.overflow.series[0]
+	}
+	s := &series{attrs: pcommon.NewMap(), start: now, duration: newExponentialHistogram(c.config.Histogram.MaxSize)}
+	s.attrs.EnsureCapacity(3 + len(c.config.Dimensions))
+	s.attrs.PutStr(spanNameKey, span.Name())
+	s.attrs.PutStr(spanKindKey, "SPAN_KIND_"+strings.ToUpper(span.Kind().String()))
+	s.attrs.PutStr(statusCodeKey, "STATUS_CODE_"+strings.ToUpper(span.Status().Code().String()))
+	for _, d := range c.config.Dimensions {
+		if v, ok := c.dimensionValue(d, res, span); ok {
+			v.CopyTo(s.attrs.PutEmpty(d.Name))
+		}
+	}
+	rser := c.resourceIdx[resHash]
+	if rser == nil {
+		rser = &resourceSeries{hash: resHash, resource: pcommon.NewResource(), seriesIdx: map[string]*series{}}
+		res.Attributes().CopyTo(rser.resource.Attributes())
+		c.resourceIdx[resHash] = rser
+		c.resources = append(c.resources, rser)
+	}
+	rser.seriesIdx[key] = s
+	rser.series = append(rser.series, s)
+	c.seriesCount++
+	return s
+}
+
+func (c *connectorImp) record(s *series, span ptrace.Span) {
+	s.calls++
+	if span.Status().Code() == ptrace.StatusCodeError {
+		s.errors++
+	}
+	var duration time.Duration
+	if span.EndTimestamp() > span.StartTimestamp() {
+		duration = span.EndTimestamp().AsTime().Sub(span.StartTimestamp().AsTime())
+	}
+	if c.config.Histogram.Unit == unitSeconds {
+		s.duration.record(duration.Seconds())
+	} else {
+		s.duration.record(float64(duration) / float64(time.Millisecond))
+	}
+}
+
+// exportMetrics sends the current state of the series to the next consumer.
+func (c *connectorImp) exportMetrics(ctx context.Context) {
+	md := c.buildMetrics()
+	if md.ResourceMetrics().Len() == 0 {
+		return
+	}
+	if err := c.next.ConsumeMetrics(ctx, md); err != nil {
+		c.logger.Error("Failed to send span metrics", zap.Error(err))
+	}
+}
+
+// buildMetrics removes the expired series and returns the cumulative metrics of the remaining ones.
+func (c *connectorImp) buildMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	nowTime := c.now()
+	if c.config.MetricsExpiration > 0 {
+		c.removeExpired(nowTime.Add(-c.config.MetricsExpiration))
+	}
+	now := pcommon.NewTimestampFromTime(nowTime)
+	resources := c.resources
+	if c.overflow != nil {
+		resources = append(resources[:len(resources):len(resources)], c.overflow)
+	}
+	for _, rser := range resources {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rser.resource.CopyTo(rm.Resource())
+		sm := rm.ScopeMetrics().AppendEmpty()
+		sm.Scope().SetName(scopeName)
+		c.buildResourceMetrics(sm.Metrics(), rser, now)
+	}
+	return md
+}
+
+// removeExpired removes the series which did not receive any span since the deadline, and the
+// resources left without series. It must be called with mu held.
+func (c *connectorImp) removeExpired(deadline time.Time) {
+	if c.overflow != nil && c.overflow.series[0].lastSeen.Before(deadline) {
+		c.overflow = nil
+	}
+	resources := c.resources[:0]
+	for _, rser := range c.resources {
+		kept := rser.series[:0]
+		for _, s := range rser.series {
+			if s.lastSeen.Before(deadline) {
+				continue
+			}
+			kept = append(kept, s)
+		}
+		if len(kept) < len(rser.series) {
+			c.seriesCount -= len(rser.series) - len(kept)
+			// Clear the removed series, so that they are garbage collected.
+			for i := len(kept); i < len(rser.series); i++ {
+				rser.series[i] = nil
+			}
+			rser.series = kept
+			for key, s := range rser.seriesIdx {
+				if s.lastSeen.Before(deadline) {
+					delete(rser.seriesIdx, key)
+				}
+			}
+		}
+		if len(rser.series) == 0 {
+			delete(c.resourceIdx, rser.hash)
+			continue
+		}
+		resources = append(resources, rser)
+	}
+	for i := len(resources); i < len(c.resources); i++ {
+		c.resources[i] = nil
+	}
+	c.resources = resources
+}
+
+func (c *connectorImp) buildResourceMetrics(metrics pmetric.MetricSlice, rser *resourceSeries, now pcommon.Timestamp) {
+	calls := c.newSum(metrics, callsMetricName, "{call}")
+	var errs pmetric.NumberDataPointSlice
+	hasErrors := false
+	for _, s := range rser.series {
+		hasErrors = hasErrors || s.errors > 0
+	}
+	if hasErrors {
+		errs = c.newSum(metrics, errorsMetricName, "{error}")
+	}
+	duration := metrics.AppendEmpty()
+	duration.SetName(c.metricName(durationMetricName))
+	duration.SetUnit(c.config.Histogram.Unit)
+	duration.SetEmptyExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+
+	for _, s := range rser.series {
+		dp := calls.AppendEmpty()
+		s.attrs.CopyTo(dp.Attributes())
+		dp.SetStartTimestamp(s.start)
+		dp.SetTimestamp(now)
+		dp.SetIntValue(int64(s.calls))
+
+		if s.errors > 0 {
+			dp = errs.AppendEmpty()
+			s.attrs.CopyTo(dp.Attributes())
+			dp.SetStartTimestamp(s.start)
+			dp.SetTimestamp(now)
+			dp.SetIntValue(int64(s.errors))
+		}
+
+		hdp := duration.ExponentialHistogram().DataPoints().AppendEmpty()
+		s.attrs.CopyTo(hdp.Attributes())
+		hdp.SetStartTimestamp(s.start)
+		hdp.SetTimestamp(now)
+		s.duration.copyTo(hdp)
+	}
+}
+
+// newSum appends a cumulative monotonic sum to the metrics and returns its data points.
+func (c *connectorImp) newSum(metrics pmetric.MetricSlice, name, unit string) pmetric.NumberDataPointSlice {
+	m := metrics.AppendEmpty()
+	m.SetName(c.metricName(name))
+	m.SetUnit(unit)
+	sum := m.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	return sum.DataPoints()
+}
+
+func (c *connectorImp) metricName(name string) string {
+	if c.config.Namespace == "" {
+		return name
+	}
+	return c.config.Namespace + "." + name
+}
@@ -0,0 +1,252 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanmetricsconnector
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func appendSpan(ss ptrace.ScopeSpans, name string, code ptrace.StatusCode, duration time.Duration) ptrace.Span {
+	span := ss.Spans().AppendEmpty()
+	span.SetName(name)
+	span.SetKind(ptrace.SpanKindServer)
+	span.Status().SetCode(code)
+	start := time.Unix(1000, 0)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(start.Add(duration)))
+	return span
+}
+
+func newTestTraces() ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "frontend")
+	rs.Resource().Attributes().PutStr("http.method", "POST")
+	ss := rs.ScopeSpans().AppendEmpty()
+	appendSpan(ss, "GET /", ptrace.StatusCodeUnset, 2*time.Millisecond)
+	appendSpan(ss, "GET /", ptrace.StatusCodeUnset, 4*time.Millisecond)
+	appendSpan(ss, "GET /", ptrace.StatusCodeError, time.Millisecond).Attributes().PutStr("http.method", "GET")
+	appendSpan(ss, "GET /", ptrace.StatusCodeError, time.Millisecond).Attributes().PutStr("http.method", "GET")
+
+	rs = td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "backend")
+	ss = rs.ScopeSpans().AppendEmpty()
+	appendSpan(ss, "query", ptrace.StatusCodeOk, time.Second)
+	return td
+}
+
+func newTestConnector(t *testing.T, cfg *Config) *connectorImp {
+	require.NoError(t, cfg.Validate())
+	return newConnector(zap.NewNop(), cfg, consumertest.NewNop())
+}
+
+func TestConnector(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	defaultMethod := "UNKNOWN"
+	cfg.Dimensions = []Dimension{{Name: "http.method", Default: &defaultMethod}, {Name: "http.route"}}
+	c := newTestConnector(t, cfg)
+	require.NoError(t, c.ConsumeTraces(context.Background(), newTestTraces()))
+	require.NoError(t, c.ConsumeTraces(context.Background(), newTestTraces()))
+
+	md := c.buildMetrics()
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+
+	frontend := md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]any{"service.name": "frontend", "http.method": "POST"}, frontend.Resource().Attributes().AsRaw())
+	require.Equal(t, 1, frontend.ScopeMetrics().Len())
+	assert.Equal(t, scopeName, frontend.ScopeMetrics().At(0).Scope().Name())
+	metrics := frontend.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, metrics.Len())
+
+	calls := metrics.At(0)
+	assert.Equal(t, "traces.span.metrics.calls", calls.Name())
+	assert.Equal(t, "{call}", calls.Unit())
+	assert.True(t, calls.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, calls.Sum().AggregationTemporality())
+	require.Equal(t, 2, calls.Sum().DataPoints().Len())
+	dp := calls.Sum().DataPoints().At(0)
+	assert.Equal(t, map[string]any{
+		"span.name":   "GET /",
+		"span.kind":   "SPAN_KIND_SERVER",
+		"status.code": "STATUS_CODE_UNSET",
+		"http.method": "POST",
+	}, dp.Attributes().AsRaw())
+	assert.Equal(t, int64(4), dp.IntValue())
+	assert.NotZero(t, dp.StartTimestamp())
+	assert.GreaterOrEqual(t, dp.Timestamp(), dp.StartTimestamp())
+	dp = calls.Sum().DataPoints().At(1)
+	assert.Equal(t, map[string]any{
+		"span.name":   "GET /",
+		"span.kind":   "SPAN_KIND_SERVER",
+		"status.code": "STATUS_CODE_ERROR",
+		"http.method": "GET",
+	}, dp.Attributes().AsRaw())
+	assert.Equal(t, int64(4), dp.IntValue())
+
+	errs := metrics.At(1)
+	assert.Equal(t, "traces.span.metrics.errors", errs.Name())
+	require.Equal(t, 1, errs.Sum().DataPoints().Len())
+	assert.Equal(t, "STATUS_CODE_ERROR", errs.Sum().DataPoints().At(0).Attributes().AsRaw()["status.code"])
+	assert.Equal(t, int64(4), errs.Sum().DataPoints().At(0).IntValue())
+
+	duration := metrics.At(2)
+	assert.Equal(t, "traces.span.metrics.duration", duration.Name())
+	assert.Equal(t, "ms", duration.Unit())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, duration.ExponentialHistogram().AggregationTemporality())
+	require.Equal(t, 2, duration.ExponentialHistogram().DataPoints().Len())
+	hdp := duration.ExponentialHistogram().DataPoints().At(0)
+	assert.Equal(t, uint64(4), hdp.Count())
+	assert.Equal(t, 12.0, hdp.Sum())
+	assert.Equal(t, 2.0, hdp.Min())
+	assert.Equal(t, 4.0, hdp.Max())
+
+	backend := md.ResourceMetrics().At(1)
+	metrics = backend.ScopeMetrics().At(0).Metrics()
+	// No span of the backend failed, so there is no errors metric.
+	require.Equal(t, 2, metrics.Len())
+	dp = metrics.At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, "UNKNOWN", dp.Attributes().AsRaw()["http.method"])
+	assert.Equal(t, "STATUS_CODE_OK", dp.Attributes().AsRaw()["status.code"])
+	assert.Equal(t, int64(2), dp.IntValue())
+	assert.Equal(t, 1000.0, metrics.At(1).ExponentialHistogram().DataPoints().At(0).Max())
+}
+
+func TestConnectorUnitSeconds(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Histogram.Unit = unitSeconds
+	cfg.Namespace = ""
+	c := newTestConnector(t, cfg)
+	require.NoError(t, c.ConsumeTraces(context.Background(), newTestTraces()))
+
+	md := c.buildMetrics()
+	duration := md.ResourceMetrics().At(1).ScopeMetrics().At(0).Metrics().At(1)
+	assert.Equal(t, "duration", duration.Name())
+	assert.Equal(t, "s", duration.Unit())
+	assert.Equal(t, 1.0, duration.ExponentialHistogram().DataPoints().At(0).Sum())
+}
+
+func TestConnectorMaxSeries(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MaxSeries = 1
+	c := newTestConnector(t, cfg)
+	require.NoError(t, c.ConsumeTraces(context.Background(), newTestTraces()))
+
+	md := c.buildMetrics()
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	calls := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, 1, calls.Sum().DataPoints().Len())
+	assert.Equal(t, int64(2), calls.Sum().DataPoints().At(0).IntValue())
+
+	overflow := md.ResourceMetrics().At(1)
+	assert.Equal(t, 0, overflow.Resource().Attributes().Len())
+	metrics := overflow.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, metrics.Len())
+	dp := metrics.At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, map[string]any{"otel.metric.overflow": true}, dp.Attributes().AsRaw())
+	assert.Equal(t, int64(3), dp.IntValue())
+	assert.Equal(t, int64(2), metrics.At(1).Sum().DataPoints().At(0).IntValue())
+}
+
+func TestConnectorMetricsExpiration(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	c := newTestConnector(t, cfg)
+	now := time.Unix(1000, 0)
+	c.now = func() time.Time { return now }
+	require.NoError(t, c.ConsumeTraces(context.Background(), newTestTraces()))
+	assert.Equal(t, 2, c.buildMetrics().ResourceMetrics().Len())
+	assert.Equal(t, 3, c.seriesCount)
+
+	// Only the spans of the backend are received afterwards, the other series expire.
+	backend := ptrace.NewTraces()
+	newTestTraces().ResourceSpans().At(1).CopyTo(backend.ResourceSpans().AppendEmpty())
+	now = now.Add(cfg.MetricsExpiration)
+	require.NoError(t, c.ConsumeTraces(context.Background(), backend))
+	now = now.Add(time.Second)
+	md := c.buildMetrics()
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	assert.Equal(t, "backend", md.ResourceMetrics().At(0).Resource().Attributes().AsRaw()["service.name"])
+	assert.Equal(t, int64(2), md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, 1, c.seriesCount)
+	assert.Len(t, c.resourceIdx, 1)
+
+	// The expired series are started again.
+	start := pcommon.NewTimestampFromTime(now)
+	require.NoError(t, c.ConsumeTraces(context.Background(), newTestTraces()))
+	md = c.buildMetrics()
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	frontend := md.ResourceMetrics().At(1)
+	assert.Equal(t, "frontend", frontend.Resource().Attributes().AsRaw()["service.name"])
+	dp := frontend.ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, int64(2), dp.IntValue())
+	assert.Equal(t, start, dp.StartTimestamp())
+	assert.Equal(t, 3, c.seriesCount)
+
+	// The overflow series expires as well.
+	cfg.MaxSeries = 1
+	c = newTestConnector(t, cfg)
+	c.now = func() time.Time { return now }
+	require.NoError(t, c.ConsumeTraces(context.Background(), newTestTraces()))
+	require.NotNil(t, c.overflow)
+	now = now.Add(cfg.MetricsExpiration + time.Second)
+	assert.Equal(t, 0, c.buildMetrics().ResourceMetrics().Len())
+	assert.Nil(t, c.overflow)
+	assert.Equal(t, 0, c.seriesCount)
+}
+
+func TestConnectorFlush(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.MetricsFlushInterval = time.Millisecond
+	sink := new(consumertest.MetricsSink)
+	c, err := factory.CreateTracesToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	assert.False(t, c.Capabilities().MutatesData)
+	require.NoError(t, c.Start(context.Background(), componenttest.NewNopHost()))
+
+	// Nothing is sent before spans are consumed.
+	time.Sleep(10 * time.Millisecond)
+	assert.Empty(t, sink.AllMetrics())
+
+	require.NoError(t, c.ConsumeTraces(context.Background(), newTestTraces()))
+	assert.Eventually(t, func() bool {
+		return len(sink.AllMetrics()) > 0
+	}, time.Second, time.Millisecond)
+	require.NoError(t, c.Shutdown(context.Background()))
+	count := len(sink.AllMetrics())
+	assert.Equal(t, 7, sink.AllMetrics()[count-1].DataPointCount())
+}
+
+func TestConnectorShutdownFlushes(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	sink := new(consumertest.MetricsSink)
+	c := newConnector(zap.NewNop(), cfg, sink)
+	require.NoError(t, c.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, c.ConsumeTraces(context.Background(), newTestTraces()))
+	require.NoError(t, c.Shutdown(context.Background()))
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.Equal(t, 7, sink.AllMetrics()[0].DataPointCount())
+}
+
+func TestConnectorNextConsumerError(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	c := newConnector(zap.NewNop(), cfg, consumertest.NewErr(errors.New("failed")))
+	require.NoError(t, c.ConsumeTraces(context.Background(), newTestTraces()))
+	// The error is logged, and the metrics are sent again at the next flush.
+	c.exportMetrics(context.Background())
+	assert.Equal(t, 2, c.buildMetrics().ResourceMetrics().Len())
+}
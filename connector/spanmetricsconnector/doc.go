@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package spanmetricsconnector aggregates spans into request, error and duration (RED) metrics.
+package spanmetricsconnector // import "go.opentelemetry.io/collector/connector/spanmetricsconnector"
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanmetricsconnector // import "go.opentelemetry.io/collector/connector/spanmetricsconnector"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/connector/spanmetricsconnector/internal/metadata"
+	"go.opentelemetry.io/collector/consumer"
+)
+
+const (
+	defaultNamespace            = "traces.span.metrics"
+	defaultMetricsFlushInterval = 15 * time.Second
+	defaultHistogramMaxSize     = 160
+	defaultMetricsExpiration    = 5 * time.Minute
+	defaultMaxSeries            = 10000
+)
+
+// NewFactory returns a connector.Factory.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		connector.WithTracesToMetrics(createTracesToMetrics, metadata.TracesToMetricsStability),
+	)
+}
+
+// createDefaultConfig creates the default configuration.
+func createDefaultConfig() component.Config {
+	return &Config{
+		Histogram: HistogramConfig{
+			Unit:    unitMilliseconds,
+			MaxSize: defaultHistogramMaxSize,
+		},
+		Namespace:            defaultNamespace,
+		MetricsFlushInterval: defaultMetricsFlushInterval,
+		MetricsExpiration:    defaultMetricsExpiration,
+		MaxSeries:            defaultMaxSeries,
+	}
+}
+
+// createTracesToMetrics creates a traces to metrics connector based on provided config.
+func createTracesToMetrics(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Traces, error) {
+	return newConnector(set.Logger, cfg.(*Config), nextConsumer), nil
+}
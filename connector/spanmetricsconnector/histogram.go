@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanmetricsconnector // import "go.opentelemetry.io/collector/connector/spanmetricsconnector"
+
+import (
+	"math"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// maxScale is the scale exponential histograms start with, the precision being lowered as values are recorded.
+const maxScale = 20
+
+// exponentialHistogram aggregates positive values in base-2 exponential buckets.
+// The bucket of index i at scale s holds the values in (2^(i/2^s), 2^((i+1)/2^s)].
+type exponentialHistogram struct {
+	maxSize   int32
+	scale     int32
+	offset    int32
+	counts    []uint64
+	zeroCount uint64
+	count     uint64
+	sum       float64
+	min       float64
+	max       float64
+}
+
+func newExponentialHistogram(maxSize int32) *exponentialHistogram {
+	return &exponentialHistogram{maxSize: maxSize, scale: maxScale}
+}
+
+// record adds the value to the histogram. Negative values are recorded as zeros.
+func (h *exponentialHistogram) record(v float64) {
+	if v < 0 {
+		v = 0
+	}
+	if h.count == 0 || v < h.min {
+		h.min = v
+	}
+	if h.count == 0 || v > h.max {
+		h.max = v
+	}
+	h.count++
+	h.sum += v
+	if v == 0 {
+		h.zeroCount++
+		return
+	}
+
+	index := bucketIndex(v, h.scale)
+	if len(h.counts) == 0 {
+		h.offset = index
+		h.counts = append(h.counts, 1)
+		return
+	}
+	low, high := h.offset, h.offset+int32(len(h.counts))-1
+	if index < low {
+		low = index
+	}
+	if index > high {
+		high = index
+	}
+	// Each lower scale halves the number of buckets required to cover the range.
+	var delta int32
+	for (high>>delta)-(low>>delta)+1 > h.maxSize {
+		delta++
+	}
+	if delta > 0 {
+		h.downscale(delta)
+		index >>= delta
+	}
+
+	switch {
+	case index < h.offset:
+		counts := make([]uint64, int(h.offset-index)+len(h.counts))
+		copy(counts[h.offset-index:], h.counts)
+		h.counts, h.offset = counts, index
+	case index >= h.offset+int32(len(h.counts)):
+		h.counts = append(h.counts, make([]uint64, int(index-h.offset)-len(h.counts)+1)...)
+	}
+	h.counts[index-h.offset]++
+}
+
+// downscale lowers the scale by delta, merging each 2^delta consecutive buckets into one.
+func (h *exponentialHistogram) downscale(delta int32) {
+	offset := h.offset >> delta
+	counts := make([]uint64, ((h.offset+int32(len(h.counts))-1)>>delta)-offset+1)
+	for i, c := range h.counts {
+		counts[((h.offset+int32(i))>>delta)-offset] += c
+	}
+	h.scale -= delta
+	h.offset, h.counts = offset, counts
+}
+
+// copyTo sets the fields of the data point to the state of the histogram.
+func (h *exponentialHistogram) copyTo(dp pmetric.ExponentialHistogramDataPoint) {
+	dp.SetScale(h.scale)
+	dp.SetCount(h.count)
+	dp.SetSum(h.sum)
+	dp.SetZeroCount(h.zeroCount)
+	if h.count > 0 {
+		dp.SetMin(h.min)
+		dp.SetMax(h.max)
+	}
+	dp.Positive().SetOffset(h.offset)
+	dp.Positive().BucketCounts().FromRaw(h.counts)
+}
+
+// bucketIndex returns the index of the bucket holding the positive value v at the given scale.
+func bucketIndex(v float64, scale int32) int32 {
+	frac, exp := math.Frexp(v)
+	if scale <= 0 {
+		index := int32(exp) - 1
+		if frac == 0.5 {
+			index--
+		}
+		return index >> -scale
+	}
+	if frac == 0.5 {
+		// Exact powers of two are the upper bound of their bucket.
+		return ((int32(exp) - 1) << scale) - 1
+	}
+	return int32(math.Ceil(math.Log2(v)*math.Exp2(float64(scale)))) - 1
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanmetricsconnector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestBucketIndex(t *testing.T) {
+	tests := []struct {
+		value    float64
+		scale    int32
+		expected int32
+	}{
+		{value: 1, scale: 0, expected: -1},
+		{value: 1.5, scale: 0, expected: 0},
+		{value: 2, scale: 0, expected: 0},
+		{value: 3, scale: 0, expected: 1},
+		{value: 0.5, scale: 0, expected: -2},
+		{value: 4, scale: 1, expected: 3},
+		{value: 5, scale: 1, expected: 4},
+		{value: 1024, scale: -1, expected: 4},
+		{value: 1025, scale: -1, expected: 5},
+		{value: 1.5, scale: 1, expected: 1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, bucketIndex(tt.value, tt.scale), "value %v at scale %d", tt.value, tt.scale)
+	}
+}
+
+func TestExponentialHistogram(t *testing.T) {
+	h := newExponentialHistogram(4)
+	for _, v := range []float64{0, 1.5, 3, 6, 12, -1} {
+		h.record(v)
+	}
+
+	dp := pmetric.NewExponentialHistogramDataPoint()
+	h.copyTo(dp)
+	assert.Equal(t, uint64(6), dp.Count())
+	assert.Equal(t, 22.5, dp.Sum())
+	assert.Equal(t, 0.0, dp.Min())
+	assert.Equal(t, 12.0, dp.Max())
+	assert.Equal(t, uint64(2), dp.ZeroCount())
+	// The values are spread over 4 powers of two, which requires the scale 0 to fit in 4 buckets.
+	assert.Equal(t, int32(0), dp.Scale())
+	assert.Equal(t, int32(0), dp.Positive().Offset())
+	assert.Equal(t, []uint64{1, 1, 1, 1}, dp.Positive().BucketCounts().AsRaw())
+
+	// A value below the current range is added in front of the buckets.
+	h.record(0.75)
+	h.copyTo(dp)
+	assert.Equal(t, int32(-1), dp.Scale())
+	assert.Equal(t, int32(-1), dp.Positive().Offset())
+	assert.Equal(t, []uint64{1, 2, 2}, dp.Positive().BucketCounts().AsRaw())
+	assert.Equal(t, 0.0, dp.Min())
+}
+
+func TestExponentialHistogramMaxScale(t *testing.T) {
+	h := newExponentialHistogram(160)
+	h.record(1.5)
+	h.record(1.5)
+
+	dp := pmetric.NewExponentialHistogramDataPoint()
+	h.copyTo(dp)
+	assert.Equal(t, int32(maxScale), dp.Scale())
+	assert.Equal(t, []uint64{2}, dp.Positive().BucketCounts().AsRaw())
+	assert.Equal(t, bucketIndex(1.5, maxScale), dp.Positive().Offset())
+}
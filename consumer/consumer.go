@@ -15,6 +15,12 @@ type Capabilities struct {
 	// does not modify the data it MUST set this flag to false. If the processor creates
 	// a copy of the data before modifying then this flag can be safely set to false.
 	MutatesData bool
+
+	// CopiesReadOnlyData is set to true if the processor only modifies the input data once
+	// it copied the data marked as read-only, e.g. with ptrace.Traces.Mutable. The data
+	// shared with other consumers can then be passed to the processor as read-only instead
+	// of being copied for it, even if MutatesData is set to true.
+	CopiesReadOnlyData bool
 }
 
 type baseConsumer interface {
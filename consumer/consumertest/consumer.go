@@ -9,6 +9,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 )
 
@@ -29,12 +30,16 @@ type Consumer interface {
 	// ConsumeLogs to implement the consumer.Logs.
 	ConsumeLogs(context.Context, plog.Logs) error
 
+	// ConsumeProfiles to implement the consumer.Profiles.
+	ConsumeProfiles(context.Context, pprofile.Profiles) error
+
 	unexported()
 }
 
 var _ consumer.Logs = (Consumer)(nil)
 var _ consumer.Metrics = (Consumer)(nil)
 var _ consumer.Traces = (Consumer)(nil)
+var _ consumer.Profiles = (Consumer)(nil)
 
 type nonMutatingConsumer struct{}
 
@@ -48,6 +53,7 @@ type baseConsumer struct {
 	consumer.ConsumeTracesFunc
 	consumer.ConsumeMetricsFunc
 	consumer.ConsumeLogsFunc
+	consumer.ConsumeProfilesFunc
 }
 
 func (bc baseConsumer) unexported() {}
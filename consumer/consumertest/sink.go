@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 )
 
@@ -156,3 +157,51 @@ func (sle *LogsSink) Reset() {
 	sle.logs = nil
 	sle.logRecordCount = 0
 }
+
+// ProfilesSink is a consumer.Profiles that acts like a sink that
+// stores all profiles and allows querying them for testing.
+type ProfilesSink struct {
+	nonMutatingConsumer
+	mu          sync.Mutex
+	profiles    []pprofile.Profiles
+	sampleCount int
+}
+
+var _ consumer.Profiles = (*ProfilesSink)(nil)
+
+// ConsumeProfiles stores profiles to this sink.
+func (spe *ProfilesSink) ConsumeProfiles(_ context.Context, pd pprofile.Profiles) error {
+	spe.mu.Lock()
+	defer spe.mu.Unlock()
+
+	spe.profiles = append(spe.profiles, pd)
+	spe.sampleCount += pd.SampleCount()
+
+	return nil
+}
+
+// AllProfiles returns the profiles stored by this sink since last Reset.
+func (spe *ProfilesSink) AllProfiles() []pprofile.Profiles {
+	spe.mu.Lock()
+	defer spe.mu.Unlock()
+
+	copyProfiles := make([]pprofile.Profiles, len(spe.profiles))
+	copy(copyProfiles, spe.profiles)
+	return copyProfiles
+}
+
+// SampleCount returns the number of profile samples stored by this sink since last Reset.
+func (spe *ProfilesSink) SampleCount() int {
+	spe.mu.Lock()
+	defer spe.mu.Unlock()
+	return spe.sampleCount
+}
+
+// Reset deletes any stored data.
+func (spe *ProfilesSink) Reset() {
+	spe.mu.Lock()
+	defer spe.mu.Unlock()
+
+	spe.profiles = nil
+	spe.sampleCount = 0
+}
@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper // import "go.opentelemetry.io/collector/exporter/exporterhelper"
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/client"
+)
+
+// clientInfoMagic is the first byte of the requests marshaled with their client.Info in the persistent queue.
+// A marshaled pdata request can not start with it, as it is a protobuf tag with the invalid wire type 7.
+const clientInfoMagic byte = 0x6f
+
+// PropagationSettings selects the client.Info of the incoming requests propagated with the data, see
+// WithClientInfoPropagation.
+type PropagationSettings struct {
+	// MetadataKeys are the metadata keys of the incoming requests propagated with the data.
+	MetadataKeys []string `mapstructure:"metadata_keys"`
+
+	// AuthAttributes are the auth attributes of the incoming requests propagated with the data. They are
+	// written in plaintext to the persistent queue, along with the metadata.
+	AuthAttributes []string `mapstructure:"auth_attributes"`
+}
+
+// WithClientInfoPropagation propagates the given metadata keys and auth attributes of the client.Info of the
+// incoming requests with the data, and sets them in the client.Info of the context of the export, so they are
+// available when the data is finally sent, even after being queued. The propagated values are stored in plaintext in the
+// persistent queue: a persistent queue storing requests without client.Info can still be read when the option
+// is enabled, but not the other way around. See client.Project for the propagated auth attributes.
+func WithClientInfoPropagation(metadataKeys, authAttributes []string) Option {
+	return func(o *baseExporter) {
+		o.clientMetadataKeys = metadataKeys
+		o.clientAuthAttributes = authAttributes
+	}
+}
+
+// clientInfoRequest is a Request carrying the client.Info of the request the data was received with.
+type clientInfoRequest struct {
+	Request
+	info client.Info
+}
+
+var _ RequestErrorHandler = (*clientInfoRequest)(nil)
+
+func (r *clientInfoRequest) Export(ctx context.Context) error {
+	return r.Request.Export(client.NewContext(ctx, r.info))
+}
+
+func (r *clientInfoRequest) OnError(err error) Request {
+	return &clientInfoRequest{Request: extractPartialRequest(r.Request, err), info: r.info}
+}
+
+// withClientInfo returns the request carrying the propagated client.Info from the context, if the propagation is enabled.
+func (be *baseExporter) withClientInfo(ctx context.Context, req Request) Request {
+	if len(be.clientMetadataKeys) == 0 && len(be.clientAuthAttributes) == 0 {
+		return req
+	}
+	return &clientInfoRequest{Request: req, info: client.Project(client.FromContext(ctx), be.clientMetadataKeys, be.clientAuthAttributes)}
+}
+
+// encodedClientInfo is the representation of a propagated client.Info in the persistent queue.
+type encodedClientInfo struct {
+	Metadata map[string][]string `json:"metadata,omitempty"`
+	Auth     map[string]any      `json:"auth,omitempty"`
+}
+
+// marshalRequest marshals the request for the persistent queue. The client.Info carried by the request, if any,
+// is marshaled as JSON before the request: clientInfoMagic, the length of the JSON as an uvarint, the JSON and the request.
+func (be *baseExporter) marshalRequest(req Request) ([]byte, error) {
+	cir, ok := req.(*clientInfoRequest)
+	if !ok {
+		return be.marshaler(req)
+	}
+	payload, err := be.marshaler(cir.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	var enc encodedClientInfo
+	if keys := cir.info.Metadata.Keys(); len(keys) > 0 {
+		enc.Metadata = make(map[string][]string, len(keys))
+		for _, key := range keys {
+			enc.Metadata[key] = cir.info.Metadata.Get(key)
+		}
+	}
+	if cir.info.Auth != nil {
+		enc.Auth = map[string]any{}
+		for _, name := range cir.info.Auth.GetAttributeNames() {
+			enc.Auth[name] = cir.info.Auth.GetAttribute(name)
+		}
+	}
+	info, err := json.Marshal(enc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the client info: %w", err)
+	}
+
+	buf := make([]byte, 0, 1+binary.MaxVarintLen64+len(info)+len(payload))
+	buf = append(buf, clientInfoMagic)
+	buf = binary.AppendUvarint(buf, uint64(len(info)))
+	buf = append(buf, info...)
+	return append(buf, payload...), nil
+}
+
+// unmarshalRequest unmarshals a request marshaled by marshalRequest.
+func (be *baseExporter) unmarshalRequest(data []byte) (Request, error) {
+	if len(data) == 0 || data[0] != clientInfoMagic {
+		return be.unmarshaler(data)
+	}
+	size, n := binary.Uvarint(data[1:])
+	if n <= 0 || uint64(len(data)-1-n) < size {
+		return nil, errors.New("invalid client info length")
+	}
+	start := 1 + n
+	end := start + int(size)
+	var enc encodedClientInfo
+	if err := json.Unmarshal(data[start:end], &enc); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the client info: %w", err)
+	}
+	req, err := be.unmarshaler(data[end:])
+	if err != nil {
+		return nil, err
+	}
+
+	var info client.Info
+	if enc.Metadata != nil {
+		info.Metadata = client.NewMetadata(enc.Metadata)
+	}
+	if enc.Auth != nil {
+		// The lists of strings are decoded as lists of any.
+		for name, val := range enc.Auth {
+			if list, ok := val.([]any); ok {
+				strs := make([]string, len(list))
+				for i, v := range list {
+					strs[i], _ = v.(string)
+				}
+				enc.Auth[name] = strs
+			}
+		}
+		info.Auth = client.NewAuthData(enc.Auth)
+	}
+	return &clientInfoRequest{Request: req, info: info}, nil
+}
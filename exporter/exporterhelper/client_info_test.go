@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter/exporterhelper/internal"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/internal/testdata"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// clientInfoRecorder records the client.Info of the contexts of the exports.
+type clientInfoRecorder struct {
+	mu    sync.Mutex
+	infos []client.Info
+}
+
+func (r *clientInfoRecorder) push(ctx context.Context, _ ptrace.Traces) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.infos = append(r.infos, client.FromContext(ctx))
+	return nil
+}
+
+func (r *clientInfoRecorder) recorded() []client.Info {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]client.Info(nil), r.infos...)
+}
+
+func incomingContext() context.Context {
+	return client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"x-tenant": {"acme"}, "authorization": {"secret"}}),
+		Auth:     client.NewAuthData(map[string]any{"subject": "agent", "groups": []string{"a", "b"}, "token": "secret"}),
+	})
+}
+
+func assertPropagatedInfo(t *testing.T, info client.Info) {
+	assert.Equal(t, []string{"x-tenant"}, info.Metadata.Keys())
+	assert.Equal(t, []string{"acme"}, info.Metadata.Get("x-tenant"))
+	require.NotNil(t, info.Auth)
+	assert.Equal(t, []string{"groups", "subject"}, info.Auth.GetAttributeNames())
+	assert.Equal(t, "agent", info.Auth.GetAttribute("subject"))
+	assert.Equal(t, []string{"a", "b"}, info.Auth.GetAttribute("groups"))
+}
+
+func TestClientInfoPropagation(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{name: "no queue"},
+		{name: "memory queue", opts: []Option{WithQueue(NewDefaultQueueSettings())}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &clientInfoRecorder{}
+			opts := append([]Option{WithClientInfoPropagation([]string{"x-tenant"}, []string{"subject", "groups"})}, tt.opts...)
+			te, err := NewTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), &fakeTracesExporterConfig, rec.push, opts...)
+			require.NoError(t, err)
+			require.NoError(t, te.Start(context.Background(), &mockHost{}))
+			require.NoError(t, te.ConsumeTraces(incomingContext(), testdata.GenerateTraces(2)))
+			require.NoError(t, te.Shutdown(context.Background()))
+
+			require.Len(t, rec.recorded(), 1)
+			assertPropagatedInfo(t, rec.recorded()[0])
+		})
+	}
+}
+
+func TestClientInfoPropagationPersistentQueue(t *testing.T) {
+	qCfg := NewDefaultQueueSettings()
+	storageID := component.NewIDWithName("file_storage", "storage")
+	qCfg.StorageID = &storageID
+	host := &mockHost{ext: map[component.ID]component.Component{storageID: internal.NewMockStorageExtension(nil)}}
+
+	rec := &clientInfoRecorder{}
+	te, err := NewTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), &fakeTracesExporterConfig, rec.push,
+		WithQueue(qCfg), WithClientInfoPropagation([]string{"x-tenant"}, []string{"subject", "groups"}))
+	require.NoError(t, err)
+	require.NoError(t, te.Start(context.Background(), host))
+	t.Cleanup(func() { require.NoError(t, te.Shutdown(context.Background())) })
+
+	require.NoError(t, te.ConsumeTraces(incomingContext(), testdata.GenerateTraces(2)))
+	assert.Eventually(t, func() bool { return len(rec.recorded()) == 1 }, time.Second, 10*time.Millisecond)
+	assertPropagatedInfo(t, rec.recorded()[0])
+}
+
+func TestClientInfoRequestMarshaling(t *testing.T) {
+	be, err := newBaseExporter(exportertest.NewNopCreateSettings(), component.DataTypeTraces, false, tracesRequestMarshaler,
+		newTraceRequestUnmarshalerFunc(nil), newNoopObsrepSender, WithClientInfoPropagation([]string{"x-tenant"}, []string{"subject", "groups"}))
+	require.NoError(t, err)
+
+	td := testdata.GenerateTraces(2)
+	req := be.withClientInfo(incomingContext(), newTracesRequest(td, nil))
+	data, err := be.marshalRequest(req)
+	require.NoError(t, err)
+	assert.Equal(t, clientInfoMagic, data[0])
+	unmarshaled, err := be.unmarshalRequest(data)
+	require.NoError(t, err)
+	cir, ok := unmarshaled.(*clientInfoRequest)
+	require.True(t, ok)
+	assertPropagatedInfo(t, cir.info)
+	assert.Equal(t, td, cir.Request.(*tracesRequest).td)
+
+	// The requests marshaled without client info can be read.
+	data, err = tracesRequestMarshaler(newTracesRequest(td, nil))
+	require.NoError(t, err)
+	unmarshaled, err = be.unmarshalRequest(data)
+	require.NoError(t, err)
+	assert.Equal(t, td, unmarshaled.(*tracesRequest).td)
+
+	_, err = be.unmarshalRequest([]byte{clientInfoMagic, 10, '{'})
+	assert.EqualError(t, err, "invalid client info length")
+	_, err = be.unmarshalRequest([]byte{clientInfoMagic, 1, '{'})
+	assert.ErrorContains(t, err, "failed to unmarshal the client info")
+}
+
+func TestClientInfoRequestOnError(t *testing.T) {
+	td := testdata.GenerateTraces(2)
+	info := client.Info{Metadata: client.NewMetadata(map[string][]string{"x-tenant": {"acme"}})}
+	req := &clientInfoRequest{Request: newTracesRequest(td, nil), info: info}
+
+	partial := testdata.GenerateTraces(1)
+	retried := req.OnError(consumererror.NewTraces(errors.New("partial failure"), partial))
+	cir, ok := retried.(*clientInfoRequest)
+	require.True(t, ok)
+	assert.Equal(t, info, cir.info)
+	assert.Equal(t, 1, cir.ItemsCount())
+}
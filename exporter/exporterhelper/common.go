@@ -81,7 +81,7 @@ func WithRetry(config configretry.BackOffConfig) Option {
 
 // WithQueue overrides the default QueueSettings for an exporter.
 // The default QueueSettings is to disable queueing.
-// This option cannot be used with the new exporter helpers New[Traces|Metrics|Logs]RequestExporter.
+// This option cannot be used with the new exporter helpers New[Traces|Metrics|Logs|Profiles]RequestExporter.
 func WithQueue(config QueueSettings) Option {
 	return func(o *baseExporter) {
 		if o.requestExporter {
@@ -95,7 +95,7 @@ func WithQueue(config QueueSettings) Option {
 			o.set.Logger.Error("Exporting failed. Dropping data."+o.exportFailureMessage,
 				zap.Error(err), zap.Int("dropped_items", req.ItemsCount()))
 		}
-		o.queueSender = newQueueSender(config, o.set, o.signal, o.marshaler, o.unmarshaler, consumeErrHandler)
+		o.queueSender = newQueueSender(config, o.set, o.signal, o.marshalRequest, o.unmarshalRequest, consumeErrHandler)
 	}
 }
 
@@ -118,6 +118,10 @@ type baseExporter struct {
 	unmarshaler     RequestUnmarshaler
 	signal          component.DataType
 
+	// The metadata keys and auth attributes of the client.Info propagated with the requests.
+	clientMetadataKeys   []string
+	clientAuthAttributes []string
+
 	set    exporter.CreateSettings
 	obsrep *ObsReport
 
@@ -169,6 +173,7 @@ func newBaseExporter(set exporter.CreateSettings, signal component.DataType, req
 
 // send sends the request using the first sender in the chain.
 func (be *baseExporter) send(ctx context.Context, req Request) error {
+	req = be.withClientInfo(ctx, req)
 	err := be.queueSender.send(ctx, req)
 	if err != nil {
 		be.set.Logger.Error("Exporting failed. Rejecting data."+be.exportFailureMessage,
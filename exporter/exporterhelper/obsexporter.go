@@ -40,6 +40,9 @@ type ObsReport struct {
 	sentLogRecords              metric.Int64Counter
 	failedToSendLogRecords      metric.Int64Counter
 	failedToEnqueueLogRecords   metric.Int64Counter
+	sentSamples                 metric.Int64Counter
+	failedToSendSamples         metric.Int64Counter
+	failedToEnqueueSamples      metric.Int64Counter
 }
 
 // ObsReportSettings are settings for creating an ObsReport.
@@ -131,6 +134,24 @@ func (or *ObsReport) createOtelMetrics(cfg ObsReportSettings) error {
 		metric.WithUnit("1"))
 	errors = multierr.Append(errors, err)
 
+	or.sentSamples, err = meter.Int64Counter(
+		obsmetrics.ExporterPrefix+obsmetrics.SentSamplesKey,
+		metric.WithDescription("Number of profile samples successfully sent to destination."),
+		metric.WithUnit("1"))
+	errors = multierr.Append(errors, err)
+
+	or.failedToSendSamples, err = meter.Int64Counter(
+		obsmetrics.ExporterPrefix+obsmetrics.FailedToSendSamplesKey,
+		metric.WithDescription("Number of profile samples in failed attempts to send to destination."),
+		metric.WithUnit("1"))
+	errors = multierr.Append(errors, err)
+
+	or.failedToEnqueueSamples, err = meter.Int64Counter(
+		obsmetrics.ExporterPrefix+obsmetrics.FailedToEnqueueSamplesKey,
+		metric.WithDescription("Number of profile samples failed to be added to the sending queue."),
+		metric.WithUnit("1"))
+	errors = multierr.Append(errors, err)
+
 	return errors
 }
 
@@ -177,6 +198,20 @@ func (or *ObsReport) EndLogsOp(ctx context.Context, numLogRecords int, err error
 	endSpan(ctx, err, numSent, numFailedToSend, obsmetrics.SentLogRecordsKey, obsmetrics.FailedToSendLogRecordsKey)
 }
 
+// StartProfilesOp is called at the start of an Export operation.
+// The returned context should be used in other calls to the Exporter functions
+// dealing with the same export operation.
+func (or *ObsReport) StartProfilesOp(ctx context.Context) context.Context {
+	return or.startOp(ctx, obsmetrics.ExportProfilesOperationSuffix)
+}
+
+// EndProfilesOp completes the export operation that was started with StartProfilesOp.
+func (or *ObsReport) EndProfilesOp(ctx context.Context, numSamples int, err error) {
+	numSent, numFailedToSend := toNumItems(numSamples, err)
+	or.recordMetrics(noCancellationContext{Context: ctx}, component.DataTypeProfiles, numSent, numFailedToSend)
+	endSpan(ctx, err, numSent, numFailedToSend, obsmetrics.SentSamplesKey, obsmetrics.FailedToSendSamplesKey)
+}
+
 // startOp creates the span used to trace the operation. Returning
 // the updated context and the created span.
 func (or *ObsReport) startOp(ctx context.Context, operationSuffix string) context.Context {
@@ -200,6 +235,9 @@ func (or *ObsReport) recordMetrics(ctx context.Context, dataType component.DataT
 	case component.DataTypeLogs:
 		sentMeasure = or.sentLogRecords
 		failedMeasure = or.failedToSendLogRecords
+	case component.DataTypeProfiles:
+		sentMeasure = or.sentSamples
+		failedMeasure = or.failedToSendSamples
 	}
 
 	sentMeasure.Add(ctx, sent, metric.WithAttributes(or.otelAttrs...))
@@ -237,6 +275,8 @@ func (or *ObsReport) recordEnqueueFailure(ctx context.Context, dataType componen
 		enqueueFailedMeasure = or.failedToEnqueueMetricPoints
 	case component.DataTypeLogs:
 		enqueueFailedMeasure = or.failedToEnqueueLogRecords
+	case component.DataTypeProfiles:
+		enqueueFailedMeasure = or.failedToEnqueueSamples
 	}
 
 	enqueueFailedMeasure.Add(ctx, failed, metric.WithAttributes(or.otelAttrs...))
@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper // import "go.opentelemetry.io/collector/exporter/exporterhelper"
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper/internal"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+var profilesMarshaler = &pprofile.ProtoMarshaler{}
+var profilesUnmarshaler = &pprofile.ProtoUnmarshaler{}
+
+type profilesRequest struct {
+	pd     pprofile.Profiles
+	pusher consumer.ConsumeProfilesFunc
+}
+
+func newProfilesRequest(pd pprofile.Profiles, pusher consumer.ConsumeProfilesFunc) Request {
+	return &profilesRequest{
+		pd:     pd,
+		pusher: pusher,
+	}
+}
+
+func newProfilesRequestUnmarshalerFunc(pusher consumer.ConsumeProfilesFunc) RequestUnmarshaler {
+	return func(bytes []byte) (Request, error) {
+		profiles, err := profilesUnmarshaler.UnmarshalProfiles(bytes)
+		if err != nil {
+			return nil, err
+		}
+		return newProfilesRequest(profiles, pusher), nil
+	}
+}
+
+func profilesRequestMarshaler(req Request) ([]byte, error) {
+	return profilesMarshaler.MarshalProfiles(req.(*profilesRequest).pd)
+}
+
+func (req *profilesRequest) OnError(err error) Request {
+	var profileError consumererror.Profiles
+	if errors.As(err, &profileError) {
+		return newProfilesRequest(profileError.Data(), req.pusher)
+	}
+	return req
+}
+
+func (req *profilesRequest) Export(ctx context.Context) error {
+	return req.pusher(ctx, req.pd)
+}
+
+func (req *profilesRequest) ItemsCount() int {
+	return req.pd.SampleCount()
+}
+
+type profilesExporter struct {
+	*baseExporter
+	consumer.Profiles
+}
+
+// NewProfilesExporter creates an exporter.Profiles that records observability metrics and wraps every request with a Span.
+func NewProfilesExporter(
+	_ context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config,
+	pusher consumer.ConsumeProfilesFunc,
+	options ...Option,
+) (exporter.Profiles, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+
+	if set.Logger == nil {
+		return nil, errNilLogger
+	}
+
+	if pusher == nil {
+		return nil, errNilPushProfilesData
+	}
+
+	be, err := newBaseExporter(set, component.DataTypeProfiles, false, profilesRequestMarshaler,
+		newProfilesRequestUnmarshalerFunc(pusher), newProfilesExporterWithObservability, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	pc, err := consumer.NewProfiles(func(ctx context.Context, pd pprofile.Profiles) error {
+		req := newProfilesRequest(pd, pusher)
+		serr := be.send(ctx, req)
+		if errors.Is(serr, internal.ErrQueueIsFull) {
+			be.obsrep.recordEnqueueFailure(ctx, component.DataTypeProfiles, int64(req.ItemsCount()))
+		}
+		return serr
+	}, be.consumerOptions...)
+
+	return &profilesExporter{
+		baseExporter: be,
+		Profiles:     pc,
+	}, err
+}
+
+// RequestFromProfilesFunc converts pprofile.Profiles data into a user-defined request.
+// This API is at the early stage of development and may change without backward compatibility
+// until https://github.com/open-telemetry/opentelemetry-collector/issues/8122 is resolved.
+type RequestFromProfilesFunc func(context.Context, pprofile.Profiles) (Request, error)
+
+// NewProfilesRequestExporter creates new profiles exporter based on custom ProfilesConverter and RequestSender.
+// This API is at the early stage of development and may change without backward compatibility
+// until https://github.com/open-telemetry/opentelemetry-collector/issues/8122 is resolved.
+func NewProfilesRequestExporter(
+	_ context.Context,
+	set exporter.CreateSettings,
+	converter RequestFromProfilesFunc,
+	options ...Option,
+) (exporter.Profiles, error) {
+	if set.Logger == nil {
+		return nil, errNilLogger
+	}
+
+	if converter == nil {
+		return nil, errNilProfilesConverter
+	}
+
+	be, err := newBaseExporter(set, component.DataTypeProfiles, true, nil, nil, newProfilesExporterWithObservability, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	pc, err := consumer.NewProfiles(func(ctx context.Context, pd pprofile.Profiles) error {
+		req, cErr := converter(ctx, pd)
+		if cErr != nil {
+			set.Logger.Error("Failed to convert profiles. Dropping data.",
+				zap.Int("dropped_samples", pd.SampleCount()),
+				zap.Error(err))
+			return consumererror.NewPermanent(cErr)
+		}
+		sErr := be.send(ctx, req)
+		if errors.Is(sErr, internal.ErrQueueIsFull) {
+			be.obsrep.recordEnqueueFailure(ctx, component.DataTypeProfiles, int64(req.ItemsCount()))
+		}
+		return sErr
+	}, be.consumerOptions...)
+
+	return &profilesExporter{
+		baseExporter: be,
+		Profiles:     pc,
+	}, err
+}
+
+type profilesExporterWithObservability struct {
+	baseRequestSender
+	obsrep *ObsReport
+}
+
+func newProfilesExporterWithObservability(obsrep *ObsReport) requestSender {
+	return &profilesExporterWithObservability{obsrep: obsrep}
+}
+
+func (pewo *profilesExporterWithObservability) send(ctx context.Context, req Request) error {
+	c := pewo.obsrep.StartProfilesOp(ctx)
+	err := pewo.nextSender.send(c, req)
+	pewo.obsrep.EndProfilesOp(c, req.ItemsCount(), err)
+	return err
+}
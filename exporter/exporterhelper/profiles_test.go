@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	nooptrace "go.opentelemetry.io/otel/trace/noop"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/internal/obsreportconfig/obsmetrics"
+	"go.opentelemetry.io/collector/internal/testdata"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+const (
+	fakeProfilesParentSpanName = "fake_profiles_parent_span_name"
+)
+
+var (
+	fakeProfilesExporterConfig = struct{}{}
+)
+
+func TestProfilesRequest(t *testing.T) {
+	pr := newProfilesRequest(testdata.GenerateProfiles(1), nil)
+
+	profileErr := consumererror.NewProfiles(errors.New("some error"), pprofile.NewProfiles())
+	assert.EqualValues(
+		t,
+		newProfilesRequest(pprofile.NewProfiles(), nil),
+		pr.(RequestErrorHandler).OnError(profileErr),
+	)
+}
+
+func TestProfilesExporter_InvalidName(t *testing.T) {
+	pe, err := NewProfilesExporter(context.Background(), exportertest.NewNopCreateSettings(), nil, newPushProfilesData(nil))
+	require.Nil(t, pe)
+	require.Equal(t, errNilConfig, err)
+}
+
+func TestProfilesExporter_NilLogger(t *testing.T) {
+	pe, err := NewProfilesExporter(context.Background(), exporter.CreateSettings{}, &fakeProfilesExporterConfig, newPushProfilesData(nil))
+	require.Nil(t, pe)
+	require.Equal(t, errNilLogger, err)
+}
+
+func TestProfilesExporter_NilPushProfilesData(t *testing.T) {
+	pe, err := NewProfilesExporter(context.Background(), exportertest.NewNopCreateSettings(), &fakeProfilesExporterConfig, nil)
+	require.Nil(t, pe)
+	require.Equal(t, errNilPushProfilesData, err)
+}
+
+func TestProfilesRequestExporter_NilProfilesConverter(t *testing.T) {
+	pe, err := NewProfilesRequestExporter(context.Background(), exportertest.NewNopCreateSettings(), nil)
+	require.Nil(t, pe)
+	require.Equal(t, errNilProfilesConverter, err)
+}
+
+func TestProfilesExporter_Default(t *testing.T) {
+	pd := pprofile.NewProfiles()
+	pe, err := NewProfilesExporter(context.Background(), exportertest.NewNopCreateSettings(), &fakeProfilesExporterConfig, newPushProfilesData(nil))
+	assert.NotNil(t, pe)
+	assert.NoError(t, err)
+
+	assert.Equal(t, consumer.Capabilities{MutatesData: false}, pe.Capabilities())
+	assert.NoError(t, pe.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, pe.ConsumeProfiles(context.Background(), pd))
+	assert.NoError(t, pe.Shutdown(context.Background()))
+}
+
+func TestProfilesExporter_Default_ReturnError(t *testing.T) {
+	pd := testdata.GenerateProfiles(1)
+	want := errors.New("my_error")
+	pe, err := NewProfilesExporter(context.Background(), exportertest.NewNopCreateSettings(), &fakeProfilesExporterConfig, newPushProfilesData(want))
+	require.NoError(t, err)
+	require.NotNil(t, pe)
+	require.Equal(t, want, pe.ConsumeProfiles(context.Background(), pd))
+}
+
+func TestProfilesExporter_WithSpan(t *testing.T) {
+	set := exportertest.NewNopCreateSettings()
+	set.ID = component.NewIDWithName("fake_profiles_exporter", "with_name")
+	sr := new(tracetest.SpanRecorder)
+	set.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	otel.SetTracerProvider(set.TracerProvider)
+	defer otel.SetTracerProvider(nooptrace.NewTracerProvider())
+
+	pe, err := NewProfilesExporter(context.Background(), set, &fakeProfilesExporterConfig, newPushProfilesData(nil))
+	require.NoError(t, err)
+	require.NotNil(t, pe)
+
+	pd := testdata.GenerateProfiles(1)
+	ctx, span := set.TracerProvider.Tracer("test").Start(context.Background(), fakeProfilesParentSpanName)
+	require.NoError(t, pe.ConsumeProfiles(ctx, pd))
+	span.End()
+
+	gotSpanData := sr.Ended()
+	require.Len(t, gotSpanData, 2)
+	sd := gotSpanData[0]
+	require.Equal(t, "exporter/"+set.ID.String()+"/profiles", sd.Name())
+	require.Equal(t, gotSpanData[1].SpanContext(), sd.Parent())
+	require.Contains(t, sd.Attributes(), attribute.KeyValue{Key: obsmetrics.SentSamplesKey, Value: attribute.Int64Value(int64(pd.SampleCount()))})
+	require.Contains(t, sd.Attributes(), attribute.KeyValue{Key: obsmetrics.FailedToSendSamplesKey, Value: attribute.Int64Value(0)})
+}
+
+func TestProfilesExporter_WithShutdown(t *testing.T) {
+	shutdownCalled := false
+	shutdown := func(context.Context) error { shutdownCalled = true; return nil }
+
+	pe, err := NewProfilesExporter(context.Background(), exportertest.NewNopCreateSettings(), &fakeProfilesExporterConfig, newPushProfilesData(nil), WithShutdown(shutdown))
+	assert.NotNil(t, pe)
+	assert.NoError(t, err)
+
+	assert.NoError(t, pe.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, pe.Shutdown(context.Background()))
+	assert.True(t, shutdownCalled)
+}
+
+func newPushProfilesData(retError error) consumer.ConsumeProfilesFunc {
+	return func(ctx context.Context, pd pprofile.Profiles) error {
+		return retError
+	}
+}
@@ -6,6 +6,7 @@ package exporterhelper // import "go.opentelemetry.io/collector/exporter/exporte
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -24,6 +25,8 @@ const defaultQueueSize = 1000
 
 var (
 	scopeName = "go.opentelemetry.io/collector/exporterhelper"
+
+	errQueueFullStatus = errors.New("sending queue is full")
 )
 
 // QueueSettings defines configuration for queueing batches before sending to the consumerSender.
@@ -76,6 +79,9 @@ type queueSender struct {
 	logger         *zap.Logger
 	meter          otelmetric.Meter
 	consumers      *internal.QueueConsumers[Request]
+	reportStatus   func(*component.StatusEvent)
+	// full is set while the requests are rejected because the queue is full.
+	full atomic.Bool
 
 	metricCapacity otelmetric.Int64ObservableGauge
 	metricSize     otelmetric.Int64ObservableGauge
@@ -109,6 +115,10 @@ func newQueueSender(config QueueSettings, set exporter.CreateSettings, signal co
 		traceAttribute: attribute.String(obsmetrics.ExporterKey, set.ID.String()),
 		logger:         set.TelemetrySettings.Logger,
 		meter:          set.TelemetrySettings.MeterProvider.Meter(scopeName),
+		reportStatus:   set.TelemetrySettings.ReportStatus,
+	}
+	if qs.reportStatus == nil {
+		qs.reportStatus = func(*component.StatusEvent) {}
 	}
 	consumeFunc := func(ctx context.Context, req Request) error {
 		err := qs.nextSender.send(ctx, req)
@@ -163,6 +173,7 @@ func (qs *queueSender) Shutdown(ctx context.Context) error {
 }
 
 // send implements the requestSender interface. It puts the request in the queue.
+// A recoverable error status is reported while the queue is full, so that health checks can detect it.
 func (qs *queueSender) send(ctx context.Context, req Request) error {
 	// Prevent cancellation and deadline to propagate to the context stored in the queue.
 	// The grpc/http based receivers will cancel the request context after this function returns.
@@ -171,8 +182,14 @@ func (qs *queueSender) send(ctx context.Context, req Request) error {
 	span := trace.SpanFromContext(c)
 	if err := qs.queue.Offer(c, req); err != nil {
 		span.AddEvent("Failed to enqueue item.", trace.WithAttributes(qs.traceAttribute))
+		if errors.Is(err, internal.ErrQueueIsFull) && qs.full.CompareAndSwap(false, true) {
+			qs.reportStatus(component.NewRecoverableErrorEvent(errQueueFullStatus))
+		}
 		return err
 	}
+	if qs.full.CompareAndSwap(true, false) {
+		qs.reportStatus(component.NewStatusEvent(component.StatusOK))
+	}
 
 	span.AddEvent("Enqueued item.", trace.WithAttributes(qs.traceAttribute))
 	return nil
@@ -6,6 +6,7 @@ package exporterhelper
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -322,6 +323,58 @@ func TestQueuedRetryPersistentEnabled_NoDataLossOnShutdown(t *testing.T) {
 	replacedReq.checkNumRequests(t, 1)
 }
 
+// blockingSender blocks the requests until it is released.
+type blockingSender struct {
+	baseRequestSender
+	release chan struct{}
+}
+
+func (bs *blockingSender) send(context.Context, Request) error {
+	<-bs.release
+	return nil
+}
+
+func TestQueueSenderReportsFullQueue(t *testing.T) {
+	var mu sync.Mutex
+	var events []*component.StatusEvent
+	set := exportertest.NewNopCreateSettings()
+	set.ReportStatus = func(ev *component.StatusEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, ev)
+	}
+	reported := func() []*component.StatusEvent {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]*component.StatusEvent{}, events...)
+	}
+
+	qCfg := NewDefaultQueueSettings()
+	qCfg.QueueSize = 1
+	qCfg.NumConsumers = 1
+	qs := newQueueSender(qCfg, set, "", nil, nil, nil)
+	next := &blockingSender{release: make(chan struct{})}
+	qs.setNextSender(next)
+	require.NoError(t, qs.Start(context.Background(), componenttest.NewNopHost()))
+
+	// The first request is blocked in the consumer, and the second one fills the queue.
+	require.NoError(t, qs.send(context.Background(), newMockRequest(1, nil)))
+	assert.Eventually(t, func() bool { return qs.queue.Size() == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, qs.send(context.Background(), newMockRequest(1, nil)))
+	require.ErrorIs(t, qs.send(context.Background(), newMockRequest(1, nil)), internal.ErrQueueIsFull)
+	require.ErrorIs(t, qs.send(context.Background(), newMockRequest(1, nil)), internal.ErrQueueIsFull)
+	require.Len(t, reported(), 1)
+	assert.Equal(t, component.StatusRecoverableError, reported()[0].Status())
+	assert.EqualError(t, reported()[0].Err(), "sending queue is full")
+
+	close(next.release)
+	assert.Eventually(t, func() bool { return qs.queue.Size() == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, qs.send(context.Background(), newMockRequest(1, nil)))
+	require.Len(t, reported(), 2)
+	assert.Equal(t, component.StatusOK, reported()[1].Status())
+	require.NoError(t, qs.Shutdown(context.Background()))
+}
+
 func TestQueueSenderNoStartShutdown(t *testing.T) {
 	qs := newQueueSender(NewDefaultQueueSettings(), exportertest.NewNopCreateSettings(), "", nil, nil, nil)
 	assert.NoError(t, qs.Shutdown(context.Background()))
@@ -30,6 +30,7 @@ func NewNopFactory() exporter.Factory {
 		exporter.WithTraces(createTracesExporter, component.StabilityLevelStable),
 		exporter.WithMetrics(createMetricsExporter, component.StabilityLevelStable),
 		exporter.WithLogs(createLogsExporter, component.StabilityLevelStable),
+		exporter.WithProfiles(createProfilesExporter, component.StabilityLevelStable),
 	)
 }
 
@@ -45,6 +46,10 @@ func createLogsExporter(context.Context, exporter.CreateSettings, component.Conf
 	return nopInstance, nil
 }
 
+func createProfilesExporter(context.Context, exporter.CreateSettings, component.Config) (exporter.Profiles, error) {
+	return nopInstance, nil
+}
+
 type nopConfig struct{}
 
 var nopInstance = &nopExporter{
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mirror // import "go.opentelemetry.io/collector/exporter/internal/mirror"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+// Config defines a secondary endpoint receiving a copy of the data.
+type Config struct {
+	// Endpoint of the mirror, all the other client settings are shared with the primary endpoint.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// QueueConfig is the sending queue of the mirror, independent from the primary one, through
+	// which the mirror is sent asynchronously. If omitted the default queue settings are used, the
+	// settings which are set override the default ones.
+	QueueConfig *exporterhelper.QueueSettings `mapstructure:"sending_queue"`
+}
+
+// Unmarshal a confmap.Conf into the mirror config, starting from the default queue settings.
+func (cfg *Config) Unmarshal(conf *confmap.Conf) error {
+	if conf.IsSet("sending_queue") {
+		queueConfig := exporterhelper.NewDefaultQueueSettings()
+		cfg.QueueConfig = &queueConfig
+	}
+	return conf.Unmarshal(cfg)
+}
+
+// QueueSettings returns the settings of the sending queue of the mirror.
+func (cfg *Config) QueueSettings() exporterhelper.QueueSettings {
+	if cfg.QueueConfig != nil {
+		return *cfg.QueueConfig
+	}
+	return exporterhelper.NewDefaultQueueSettings()
+}
+
+// Validate checks if the mirrors configuration is valid. The settings of the queues are
+// validated with the queues.
+func Validate(cfgs []Config) error {
+	for i, cfg := range cfgs {
+		if cfg.Endpoint == "" {
+			return fmt.Errorf("mirrors::%d::endpoint must not be empty", i)
+		}
+		if cfg.QueueConfig != nil && !cfg.QueueConfig.Enabled {
+			return fmt.Errorf("mirrors::%d::sending_queue must be enabled, the mirrors are sent asynchronously", i)
+		}
+	}
+	return nil
+}
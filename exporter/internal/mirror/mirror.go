@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mirror implements exporters that send a copy of every request to secondary
+// exporters on a best-effort basis.
+package mirror // import "go.opentelemetry.io/collector/exporter/internal/mirror"
+
+import (
+	"context"
+	"strconv"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// CreateSettings returns the settings used to create the secondary exporter at the given index.
+// Secondaries get their own ID, so that their telemetry and persistent queues are kept
+// apart from the primary ones.
+func CreateSettings(set exporter.CreateSettings, index int, endpoint string) exporter.CreateSettings {
+	name := "mirror_" + strconv.Itoa(index)
+	if set.ID.Name() != "" {
+		name = set.ID.Name() + "_" + name
+	}
+	set.ID = component.NewIDWithName(set.ID.Type(), name)
+	set.Logger = set.Logger.With(zap.String("mirror", endpoint))
+	return set
+}
+
+// Create creates the primary exporter with cfg and, when mirrors are configured, a secondary exporter per
+// mirror with the configuration returned by mirrorConfig, and returns them wrapped with wrap.
+func Create[C any, E component.Component](
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg C,
+	mirrors []Config,
+	mirrorConfig func(C, Config) C,
+	create func(context.Context, exporter.CreateSettings, C) (E, error),
+	wrap func(*zap.Logger, E, []E) E,
+) (E, error) {
+	primary, err := create(ctx, set, cfg)
+	if err != nil || len(mirrors) == 0 {
+		return primary, err
+	}
+	secondaries := make([]E, 0, len(mirrors))
+	for i, m := range mirrors {
+		secondary, err := create(ctx, CreateSettings(set, i, m.Endpoint), mirrorConfig(cfg, m))
+		if err != nil {
+			var none E
+			return none, err
+		}
+		secondaries = append(secondaries, secondary)
+	}
+	return wrap(set.Logger, primary, secondaries), nil
+}
+
+type base[E component.Component] struct {
+	logger      *zap.Logger
+	primary     E
+	secondaries []E
+}
+
+// Start starts the primary and all the secondaries.
+func (b *base[E]) Start(ctx context.Context, host component.Host) error {
+	if err := b.primary.Start(ctx, host); err != nil {
+		return err
+	}
+	for _, s := range b.secondaries {
+		if err := s.Start(ctx, host); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Shutdown shuts down the primary and all the secondaries.
+func (b *base[E]) Shutdown(ctx context.Context) error {
+	errs := b.primary.Shutdown(ctx)
+	for _, s := range b.secondaries {
+		errs = multierr.Append(errs, s.Shutdown(ctx))
+	}
+	return errs
+}
+
+// consume sends the data to every secondary, logging their errors, then to the primary.
+func (b *base[E]) consume(consume func(E) error) error {
+	for i, s := range b.secondaries {
+		if err := consume(s); err != nil {
+			b.logger.Warn("Failed to mirror data", zap.Int("mirror", i), zap.Error(err))
+		}
+	}
+	return consume(b.primary)
+}
+
+type traces struct {
+	base[exporter.Traces]
+}
+
+// NewTraces returns an exporter.Traces that sends the data to the primary and a copy to every secondary.
+// Only the primary error is returned, the secondaries errors are logged. The secondaries must have a
+// sending queue: the data is enqueued to the secondaries first, without waiting for their export, so
+// that the secondaries are sent asynchronously and never delay the primary.
+func NewTraces(logger *zap.Logger, primary exporter.Traces, secondaries []exporter.Traces) exporter.Traces {
+	return &traces{base[exporter.Traces]{logger: logger, primary: primary, secondaries: secondaries}}
+}
+
+func (t *traces) Capabilities() consumer.Capabilities {
+	return t.primary.Capabilities()
+}
+
+func (t *traces) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	return t.consume(func(e exporter.Traces) error { return e.ConsumeTraces(ctx, td) })
+}
+
+type metrics struct {
+	base[exporter.Metrics]
+}
+
+// NewMetrics returns an exporter.Metrics that sends the data to the primary and a copy to every secondary.
+// Only the primary error is returned, the secondaries errors are logged. See NewTraces for the order.
+func NewMetrics(logger *zap.Logger, primary exporter.Metrics, secondaries []exporter.Metrics) exporter.Metrics {
+	return &metrics{base[exporter.Metrics]{logger: logger, primary: primary, secondaries: secondaries}}
+}
+
+func (m *metrics) Capabilities() consumer.Capabilities {
+	return m.primary.Capabilities()
+}
+
+func (m *metrics) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return m.consume(func(e exporter.Metrics) error { return e.ConsumeMetrics(ctx, md) })
+}
+
+type logs struct {
+	base[exporter.Logs]
+}
+
+// NewLogs returns an exporter.Logs that sends the data to the primary and a copy to every secondary.
+// Only the primary error is returned, the secondaries errors are logged. See NewTraces for the order.
+func NewLogs(logger *zap.Logger, primary exporter.Logs, secondaries []exporter.Logs) exporter.Logs {
+	return &logs{base[exporter.Logs]{logger: logger, primary: primary, secondaries: secondaries}}
+}
+
+func (l *logs) Capabilities() consumer.Capabilities {
+	return l.primary.Capabilities()
+}
+
+func (l *logs) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	return l.consume(func(e exporter.Logs) error { return e.ConsumeLogs(ctx, ld) })
+}
+
+type profiles struct {
+	base[exporter.Profiles]
+}
+
+// NewProfiles returns an exporter.Profiles that sends the data to the primary and a copy to every secondary.
+// Only the primary error is returned, the secondaries errors are logged. See NewTraces for the order.
+func NewProfiles(logger *zap.Logger, primary exporter.Profiles, secondaries []exporter.Profiles) exporter.Profiles {
+	return &profiles{base[exporter.Profiles]{logger: logger, primary: primary, secondaries: secondaries}}
+}
+
+func (p *profiles) Capabilities() consumer.Capabilities {
+	return p.primary.Capabilities()
+}
+
+func (p *profiles) ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	return p.consume(func(e exporter.Profiles) error { return e.ConsumeProfiles(ctx, pd) })
+}
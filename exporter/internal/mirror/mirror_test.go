@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mirror
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestCreateSettings(t *testing.T) {
+	set := exportertest.NewNopCreateSettings()
+	set.ID = component.NewID("otlp")
+	assert.Equal(t, component.NewIDWithName("otlp", "mirror_0"), CreateSettings(set, 0, "localhost:4317").ID)
+
+	set.ID = component.NewIDWithName("otlp", "backend")
+	assert.Equal(t, component.NewIDWithName("otlp", "backend_mirror_1"), CreateSettings(set, 1, "localhost:4317").ID)
+}
+
+func TestCreate(t *testing.T) {
+	sinks := map[string]*consumertest.TracesSink{}
+	create := func(_ context.Context, set exporter.CreateSettings, endpoint string) (exporter.Traces, error) {
+		if endpoint == "" {
+			return nil, errors.New("empty endpoint")
+		}
+		sinks[set.ID.String()+" "+endpoint] = new(consumertest.TracesSink)
+		return newTraces(t, sinks[set.ID.String()+" "+endpoint]), nil
+	}
+	mirrorConfig := func(_ string, m Config) string { return m.Endpoint }
+	set := exportertest.NewNopCreateSettings()
+	set.ID = component.NewID("otlp")
+
+	exp, err := Create(context.Background(), set, "primary", nil, mirrorConfig, create, NewTraces)
+	require.NoError(t, err)
+	require.NoError(t, exp.ConsumeTraces(context.Background(), ptrace.NewTraces()))
+	assert.Len(t, sinks, 1)
+	assert.Len(t, sinks["otlp primary"].AllTraces(), 1)
+
+	exp, err = Create(context.Background(), set, "primary", []Config{{Endpoint: "shadow"}}, mirrorConfig, create, NewTraces)
+	require.NoError(t, err)
+	require.NoError(t, exp.ConsumeTraces(context.Background(), ptrace.NewTraces()))
+	assert.Len(t, sinks["otlp primary"].AllTraces(), 1)
+	assert.Len(t, sinks["otlp/mirror_0 shadow"].AllTraces(), 1)
+
+	_, err = Create(context.Background(), set, "primary", []Config{{}}, mirrorConfig, create, NewTraces)
+	assert.EqualError(t, err, "empty endpoint")
+}
+
+func TestTraces(t *testing.T) {
+	primary, secondary := new(consumertest.TracesSink), new(consumertest.TracesSink)
+	failing := consumertest.NewErr(errors.New("unavailable"))
+	core, logs := observer.New(zap.WarnLevel)
+
+	exp := NewTraces(zap.New(core), newTraces(t, primary), []exporter.Traces{newTraces(t, failing), newTraces(t, secondary)})
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	assert.False(t, exp.Capabilities().MutatesData)
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	assert.NoError(t, exp.ConsumeTraces(context.Background(), td))
+	assert.Equal(t, 1, primary.SpanCount())
+	assert.Equal(t, 1, secondary.SpanCount())
+	assert.Equal(t, 1, logs.FilterMessage("Failed to mirror data").Len())
+	assert.NoError(t, exp.Shutdown(context.Background()))
+}
+
+func TestTracesPrimaryError(t *testing.T) {
+	secondary := new(consumertest.TracesSink)
+	exp := NewTraces(zap.NewNop(), newTraces(t, consumertest.NewErr(errors.New("unavailable"))), []exporter.Traces{newTraces(t, secondary)})
+
+	assert.EqualError(t, exp.ConsumeTraces(context.Background(), ptrace.NewTraces()), "unavailable")
+	// The data is still mirrored.
+	assert.Len(t, secondary.AllTraces(), 1)
+}
+
+func TestTracesSlowSecondary(t *testing.T) {
+	primary := new(consumertest.TracesSink)
+	release := make(chan struct{})
+	mirrored := make(chan struct{})
+	slow, err := exporterhelper.NewTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), &struct{}{},
+		func(context.Context, ptrace.Traces) error {
+			<-release
+			close(mirrored)
+			return nil
+		},
+		exporterhelper.WithQueue(exporterhelper.NewDefaultQueueSettings()))
+	require.NoError(t, err)
+
+	exp := NewTraces(zap.NewNop(), newTraces(t, primary), []exporter.Traces{slow})
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	// The secondary is sent through its queue, the primary does not wait for its export.
+	require.NoError(t, exp.ConsumeTraces(context.Background(), ptrace.NewTraces()))
+	assert.Len(t, primary.AllTraces(), 1)
+	select {
+	case <-mirrored:
+		t.Fatal("the secondary must still be exporting")
+	default:
+	}
+
+	close(release)
+	<-mirrored
+	assert.NoError(t, exp.Shutdown(context.Background()))
+}
+
+func TestMetrics(t *testing.T) {
+	primary, secondary := new(consumertest.MetricsSink), new(consumertest.MetricsSink)
+	exp := NewMetrics(zap.NewNop(), newMetrics(t, primary), []exporter.Metrics{newMetrics(t, secondary)})
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	assert.NoError(t, exp.ConsumeMetrics(context.Background(), md))
+	assert.Equal(t, 1, primary.DataPointCount())
+	assert.Equal(t, 1, secondary.DataPointCount())
+	assert.NoError(t, exp.Shutdown(context.Background()))
+}
+
+func TestLogs(t *testing.T) {
+	primary, secondary := new(consumertest.LogsSink), new(consumertest.LogsSink)
+	exp := NewLogs(zap.NewNop(), newLogs(t, primary), []exporter.Logs{newLogs(t, secondary)})
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	assert.NoError(t, exp.ConsumeLogs(context.Background(), ld))
+	assert.Equal(t, 1, primary.LogRecordCount())
+	assert.Equal(t, 1, secondary.LogRecordCount())
+	assert.NoError(t, exp.Shutdown(context.Background()))
+}
+
+func TestProfiles(t *testing.T) {
+	primary, secondary := new(consumertest.ProfilesSink), new(consumertest.ProfilesSink)
+	exp := NewProfiles(zap.NewNop(), newProfiles(t, primary), []exporter.Profiles{newProfiles(t, secondary)})
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	pd := pprofile.NewProfiles()
+	pd.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty().Profile().Sample().AppendEmpty()
+	assert.NoError(t, exp.ConsumeProfiles(context.Background(), pd))
+	assert.Equal(t, 1, primary.SampleCount())
+	assert.Equal(t, 1, secondary.SampleCount())
+	assert.NoError(t, exp.Shutdown(context.Background()))
+}
+
+func newTraces(t *testing.T, next consumer.Traces) exporter.Traces {
+	exp, err := exporterhelper.NewTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), &struct{}{}, next.ConsumeTraces)
+	require.NoError(t, err)
+	return exp
+}
+
+func newMetrics(t *testing.T, next consumer.Metrics) exporter.Metrics {
+	exp, err := exporterhelper.NewMetricsExporter(context.Background(), exportertest.NewNopCreateSettings(), &struct{}{}, next.ConsumeMetrics)
+	require.NoError(t, err)
+	return exp
+}
+
+func newLogs(t *testing.T, next consumer.Logs) exporter.Logs {
+	exp, err := exporterhelper.NewLogsExporter(context.Background(), exportertest.NewNopCreateSettings(), &struct{}{}, next.ConsumeLogs)
+	require.NoError(t, err)
+	return exp
+}
+
+func newProfiles(t *testing.T, next consumer.Profiles) exporter.Profiles {
+	exp, err := exporterhelper.NewProfilesExporter(context.Background(), exportertest.NewNopCreateSettings(), &struct{}{}, next.ConsumeProfiles)
+	require.NoError(t, err)
+	return exp
+}
@@ -0,0 +1,201 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package payloadsummary logs structured summaries of the payloads sent by the OTLP exporters,
+// to help debugging data loss without capturing the traffic.
+package payloadsummary // import "go.opentelemetry.io/collector/exporter/internal/payloadsummary"
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// maxIDs is the maximum number of trace IDs or metric names included in a summary.
+const maxIDs = 5
+
+// LogPayloadSummaryFeatureGate controls whether the OTLP exporters log a summary of every payload they send.
+var LogPayloadSummaryFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"exporter.otlp.logPayloadSummary",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("When enabled, the OTLP exporters log a summary of every payload they send."))
+
+// DryRunFeatureGate controls whether the OTLP exporters log a summary of every payload instead of sending it.
+var DryRunFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"exporter.otlp.dryRun",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("When enabled, the OTLP exporters log a summary of every payload instead of sending it."))
+
+// LogTraces logs a summary of td if enabled, and reports whether the payload must be
+// dropped instead of sent because the dry-run mode is enabled.
+func LogTraces(logger *zap.Logger, td ptrace.Traces) bool {
+	if !LogPayloadSummaryFeatureGate.IsEnabled() && !DryRunFeatureGate.IsEnabled() {
+		return false
+	}
+	rss := td.ResourceSpans()
+	counts := make([]resourceCount, rss.Len())
+	var traceIDs []string
+	seen := make(map[pcommon.TraceID]struct{})
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		counts[i].resource = rs.Resource()
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			counts[i].count += spans.Len()
+			for k := 0; k < spans.Len() && len(traceIDs) < maxIDs; k++ {
+				traceID := spans.At(k).TraceID()
+				if _, ok := seen[traceID]; !ok {
+					seen[traceID] = struct{}{}
+					traceIDs = append(traceIDs, traceID.String())
+				}
+			}
+		}
+	}
+	logSummary(logger, "traces", td.SpanCount(), (&ptrace.ProtoMarshaler{}).TracesSize(td), counts,
+		zap.Strings("trace_ids", traceIDs))
+	return DryRunFeatureGate.IsEnabled()
+}
+
+// LogMetrics logs a summary of md if enabled, and reports whether the payload must be
+// dropped instead of sent because the dry-run mode is enabled.
+func LogMetrics(logger *zap.Logger, md pmetric.Metrics) bool {
+	if !LogPayloadSummaryFeatureGate.IsEnabled() && !DryRunFeatureGate.IsEnabled() {
+		return false
+	}
+	rms := md.ResourceMetrics()
+	counts := make([]resourceCount, rms.Len())
+	var names []string
+	seen := make(map[string]struct{})
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		counts[i].resource = rm.Resource()
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				counts[i].count += dataPointCount(metrics.At(k))
+				if name := metrics.At(k).Name(); len(names) < maxIDs {
+					if _, ok := seen[name]; !ok {
+						seen[name] = struct{}{}
+						names = append(names, name)
+					}
+				}
+			}
+		}
+	}
+	logSummary(logger, "metrics", md.DataPointCount(), (&pmetric.ProtoMarshaler{}).MetricsSize(md), counts,
+		zap.Strings("metric_names", names))
+	return DryRunFeatureGate.IsEnabled()
+}
+
+// LogLogs logs a summary of ld if enabled, and reports whether the payload must be
+// dropped instead of sent because the dry-run mode is enabled.
+func LogLogs(logger *zap.Logger, ld plog.Logs) bool {
+	if !LogPayloadSummaryFeatureGate.IsEnabled() && !DryRunFeatureGate.IsEnabled() {
+		return false
+	}
+	rls := ld.ResourceLogs()
+	counts := make([]resourceCount, rls.Len())
+	var traceIDs []string
+	seen := make(map[pcommon.TraceID]struct{})
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		counts[i].resource = rl.Resource()
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			records := rl.ScopeLogs().At(j).LogRecords()
+			counts[i].count += records.Len()
+			for k := 0; k < records.Len() && len(traceIDs) < maxIDs; k++ {
+				traceID := records.At(k).TraceID()
+				if _, ok := seen[traceID]; !ok && !traceID.IsEmpty() {
+					seen[traceID] = struct{}{}
+					traceIDs = append(traceIDs, traceID.String())
+				}
+			}
+		}
+	}
+	logSummary(logger, "logs", ld.LogRecordCount(), (&plog.ProtoMarshaler{}).LogsSize(ld), counts,
+		zap.Strings("trace_ids", traceIDs))
+	return DryRunFeatureGate.IsEnabled()
+}
+
+// LogProfiles logs a summary of pd if enabled, and reports whether the payload must be
+// dropped instead of sent because the dry-run mode is enabled.
+func LogProfiles(logger *zap.Logger, pd pprofile.Profiles) bool {
+	if !LogPayloadSummaryFeatureGate.IsEnabled() && !DryRunFeatureGate.IsEnabled() {
+		return false
+	}
+	rps := pd.ResourceProfiles()
+	counts := make([]resourceCount, rps.Len())
+	var profileIDs []string
+	for i := 0; i < rps.Len(); i++ {
+		rp := rps.At(i)
+		counts[i].resource = rp.Resource()
+		for j := 0; j < rp.ScopeProfiles().Len(); j++ {
+			pcs := rp.ScopeProfiles().At(j).Profiles()
+			for k := 0; k < pcs.Len(); k++ {
+				counts[i].count += pcs.At(k).Profile().Sample().Len()
+				if profileID := pcs.At(k).ProfileID(); !profileID.IsEmpty() && len(profileIDs) < maxIDs {
+					profileIDs = append(profileIDs, profileID.String())
+				}
+			}
+		}
+	}
+	logSummary(logger, "profiles", pd.SampleCount(), (&pprofile.ProtoMarshaler{}).ProfilesSize(pd), counts,
+		zap.Strings("profile_ids", profileIDs))
+	return DryRunFeatureGate.IsEnabled()
+}
+
+type resourceCount struct {
+	resource pcommon.Resource
+	count    int
+}
+
+func logSummary(logger *zap.Logger, signal string, items int, size int, counts []resourceCount, ids zap.Field) {
+	msg := "Payload summary"
+	if DryRunFeatureGate.IsEnabled() {
+		msg = "Payload summary, dry-run mode enabled, payload not sent"
+	}
+	logger.Info(msg,
+		zap.String("signal", signal),
+		zap.Int("resources", len(counts)),
+		zap.Int("items", items),
+		zap.Int("bytes", size),
+		zap.Array("items_per_resource", zapcore.ArrayMarshalerFunc(func(enc zapcore.ArrayEncoder) error {
+			for _, c := range counts {
+				c := c
+				err := enc.AppendObject(zapcore.ObjectMarshalerFunc(func(oe zapcore.ObjectEncoder) error {
+					if serviceName, ok := c.resource.Attributes().Get("service.name"); ok {
+						oe.AddString("service.name", serviceName.AsString())
+					}
+					oe.AddInt("items", c.count)
+					return nil
+				}))
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})),
+		ids)
+}
+
+func dataPointCount(m pmetric.Metric) int {
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		return m.Gauge().DataPoints().Len()
+	case pmetric.MetricTypeSum:
+		return m.Sum().DataPoints().Len()
+	case pmetric.MetricTypeHistogram:
+		return m.Histogram().DataPoints().Len()
+	case pmetric.MetricTypeExponentialHistogram:
+		return m.ExponentialHistogram().DataPoints().Len()
+	case pmetric.MetricTypeSummary:
+		return m.Summary().DataPoints().Len()
+	}
+	return 0
+}
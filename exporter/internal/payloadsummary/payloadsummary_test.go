@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package payloadsummary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func setFeatureGateForTest(t testing.TB, gate *featuregate.Gate, enabled bool) func() {
+	originalValue := gate.IsEnabled()
+	require.NoError(t, featuregate.GlobalRegistry().Set(gate.ID(), enabled))
+	return func() {
+		require.NoError(t, featuregate.GlobalRegistry().Set(gate.ID(), originalValue))
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	logger := zap.New(core)
+
+	assert.False(t, LogTraces(logger, ptrace.NewTraces()))
+	assert.False(t, LogMetrics(logger, pmetric.NewMetrics()))
+	assert.False(t, LogLogs(logger, plog.NewLogs()))
+	assert.False(t, LogProfiles(logger, pprofile.NewProfiles()))
+	assert.Equal(t, 0, logs.Len())
+}
+
+func TestLogTraces(t *testing.T) {
+	defer setFeatureGateForTest(t, LogPayloadSummaryFeatureGate, true)()
+	core, logs := observer.New(zap.InfoLevel)
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	for i := 0; i < 10; i++ {
+		spans.AppendEmpty().SetTraceID(pcommon.TraceID([16]byte{byte(i / 2)}))
+	}
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+
+	assert.False(t, LogTraces(zap.New(core), td))
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, "Payload summary", entry.Message)
+	fields := entry.ContextMap()
+	assert.Equal(t, "traces", fields["signal"])
+	assert.EqualValues(t, 2, fields["resources"])
+	assert.EqualValues(t, 11, fields["items"])
+	assert.EqualValues(t, (&ptrace.ProtoMarshaler{}).TracesSize(td), fields["bytes"])
+	assert.Equal(t, []any{
+		map[string]any{"service.name": "checkout", "items": 10},
+		map[string]any{"items": 1},
+	}, fields["items_per_resource"])
+	assert.Len(t, fields["trace_ids"], maxIDs)
+}
+
+func TestLogMetricsDryRun(t *testing.T) {
+	defer setFeatureGateForTest(t, DryRunFeatureGate, true)()
+	core, logs := observer.New(zap.InfoLevel)
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("cpu")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+	sum := metrics.AppendEmpty()
+	sum.SetName("requests")
+	sum.SetEmptySum().DataPoints().AppendEmpty()
+	sum.Sum().DataPoints().AppendEmpty()
+
+	assert.True(t, LogMetrics(zap.New(core), md))
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, "Payload summary, dry-run mode enabled, payload not sent", entry.Message)
+	fields := entry.ContextMap()
+	assert.EqualValues(t, 3, fields["items"])
+	assert.Equal(t, []any{map[string]any{"items": 3}}, fields["items_per_resource"])
+	assert.Equal(t, []any{"cpu", "requests"}, fields["metric_names"])
+}
+
+func TestLogLogs(t *testing.T) {
+	defer setFeatureGateForTest(t, LogPayloadSummaryFeatureGate, true)()
+	core, logs := observer.New(zap.InfoLevel)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty()
+	records.AppendEmpty().SetTraceID(pcommon.TraceID([16]byte{1}))
+
+	assert.False(t, LogLogs(zap.New(core), ld))
+	require.Equal(t, 1, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, "logs", fields["signal"])
+	assert.EqualValues(t, 2, fields["items"])
+	assert.Equal(t, []any{pcommon.TraceID([16]byte{1}).String()}, fields["trace_ids"])
+}
+
+func TestLogProfiles(t *testing.T) {
+	defer setFeatureGateForTest(t, LogPayloadSummaryFeatureGate, true)()
+	core, logs := observer.New(zap.InfoLevel)
+
+	pd := pprofile.NewProfiles()
+	pcs := pd.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles()
+	pcs.AppendEmpty().Profile().Sample().AppendEmpty()
+	pc := pcs.AppendEmpty()
+	pc.SetProfileID(pprofile.ProfileID([16]byte{1}))
+	pc.Profile().Sample().AppendEmpty()
+
+	assert.False(t, LogProfiles(zap.New(core), pd))
+	require.Equal(t, 1, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, "profiles", fields["signal"])
+	assert.EqualValues(t, 2, fields["items"])
+	assert.Equal(t, []any{pprofile.ProfileID([16]byte{1}).String()}, fields["profile_ids"])
+}
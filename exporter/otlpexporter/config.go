@@ -4,10 +4,25 @@
 package otlpexporter // import "go.opentelemetry.io/collector/exporter/otlpexporter"
 
 import (
+	"errors"
+	"fmt"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/configretry"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/exporter/internal/mirror"
+)
+
+// RoutingKey selects the value that is hashed to pick an endpoint when load balancing.
+type RoutingKey string
+
+const (
+	// RoutingKeyTraceID routes spans and log records by their trace ID, so that all the
+	// data of a trace reaches the same endpoint. Metrics are routed by resource attribute.
+	RoutingKeyTraceID RoutingKey = "trace_id"
+	// RoutingKeyResourceAttribute routes every resource by the value of a resource attribute.
+	RoutingKeyResourceAttribute RoutingKey = "resource_attribute"
 )
 
 // Config defines configuration for OTLP exporter.
@@ -17,6 +32,63 @@ type Config struct {
 	RetryConfig                    configretry.BackOffConfig    `mapstructure:"retry_on_failure"`
 
 	configgrpc.GRPCClientSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+
+	// LoadBalancing spreads the data across a list of endpoints using consistent hashing.
+	LoadBalancing LoadBalancingConfig `mapstructure:"load_balancing"`
+
+	// Mirrors are secondary endpoints receiving a copy of every request on a best-effort basis.
+	Mirrors []MirrorConfig `mapstructure:"mirrors"`
+
+	// Propagate selects the metadata and the auth attributes of the incoming requests sent as gRPC metadata.
+	Propagate exporterhelper.PropagationSettings `mapstructure:"propagate"`
+}
+
+// LoadBalancingConfig defines the consistent-hash endpoint selection.
+type LoadBalancingConfig struct {
+	// Endpoints is the list of endpoints to balance across. When set, it replaces the
+	// top-level endpoint, and all the other client settings apply to every endpoint.
+	Endpoints []string `mapstructure:"endpoints"`
+
+	// RoutingKey selects what is hashed to pick the endpoint, one of "trace_id" or "resource_attribute".
+	RoutingKey RoutingKey `mapstructure:"routing_key"`
+
+	// ResourceAttribute is the resource attribute hashed when routing by resource attribute,
+	// and for metrics when routing by trace ID.
+	ResourceAttribute string `mapstructure:"resource_attribute"`
 }
 
+// MirrorConfig defines a secondary endpoint receiving a copy of the data.
+type MirrorConfig = mirror.Config
+
 var _ component.Config = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint != "" && len(cfg.LoadBalancing.Endpoints) > 0 {
+		return errors.New("endpoint and load_balancing::endpoints cannot be set at the same time")
+	}
+	return mirror.Validate(cfg.Mirrors)
+}
+
+// Validate checks if the load balancing configuration is valid.
+func (cfg *LoadBalancingConfig) Validate() error {
+	switch cfg.RoutingKey {
+	case RoutingKeyTraceID, RoutingKeyResourceAttribute:
+	default:
+		return fmt.Errorf("unsupported routing_key %q", cfg.RoutingKey)
+	}
+	if cfg.ResourceAttribute == "" {
+		return errors.New("resource_attribute must not be empty")
+	}
+	seen := make(map[string]struct{}, len(cfg.Endpoints))
+	for _, endpoint := range cfg.Endpoints {
+		if endpoint == "" {
+			return errors.New("load balancing endpoints must not be empty")
+		}
+		if _, ok := seen[endpoint]; ok {
+			return fmt.Errorf("duplicate load balancing endpoint %q", endpoint)
+		}
+		seen[endpoint] = struct{}{}
+	}
+	return nil
+}
@@ -76,5 +76,108 @@ func TestUnmarshalConfig(t *testing.T) {
 				BalancerName:    "round_robin",
 				Auth:            &configauth.Authentication{AuthenticatorID: component.NewID("nop")},
 			},
+			LoadBalancing: LoadBalancingConfig{
+				RoutingKey:        RoutingKeyTraceID,
+				ResourceAttribute: "service.name",
+			},
 		}, cfg)
 }
+
+func TestUnmarshalLoadBalancingConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "loadbalancing.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	require.NoError(t, component.UnmarshalConfig(cm, cfg))
+	assert.NoError(t, component.ValidateConfig(cfg))
+	assert.Equal(t,
+		LoadBalancingConfig{
+			Endpoints:         []string{"backend-1:4317", "backend-2:4317"},
+			RoutingKey:        RoutingKeyResourceAttribute,
+			ResourceAttribute: "tenant",
+		}, cfg.(*Config).LoadBalancing)
+}
+
+func TestUnmarshalMirrorsConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "mirrors.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	require.NoError(t, component.UnmarshalConfig(cm, cfg))
+	assert.NoError(t, component.ValidateConfig(cfg))
+	assert.Equal(t,
+		[]MirrorConfig{
+			{Endpoint: "shadow:4317"},
+			{
+				Endpoint: "migration:4317",
+				QueueConfig: &exporterhelper.QueueSettings{
+					Enabled:      true,
+					NumConsumers: 1,
+					QueueSize:    1000,
+				},
+			},
+			{
+				Endpoint: "backup:4317",
+				QueueConfig: &exporterhelper.QueueSettings{
+					Enabled:      true,
+					NumConsumers: 10,
+					QueueSize:    100,
+				},
+			},
+		}, cfg.(*Config).Mirrors)
+
+	mirrors := cfg.(*Config).Mirrors
+	cfg.(*Config).Mirrors = append(mirrors, MirrorConfig{})
+	assert.ErrorContains(t, component.ValidateConfig(cfg), "mirrors::3::endpoint must not be empty")
+
+	cfg.(*Config).Mirrors = append(mirrors, MirrorConfig{Endpoint: "backup:4317", QueueConfig: &exporterhelper.QueueSettings{}})
+	assert.ErrorContains(t, component.ValidateConfig(cfg), "mirrors::3::sending_queue must be enabled, the mirrors are sent asynchronously")
+
+	cfg.(*Config).Mirrors = append(mirrors, MirrorConfig{Endpoint: "backup:4317", QueueConfig: &exporterhelper.QueueSettings{Enabled: true}})
+	assert.ErrorContains(t, component.ValidateConfig(cfg), "queue size must be positive")
+}
+
+func TestValidateLoadBalancingConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		errMsg string
+	}{
+		{
+			name: "endpoint and endpoints",
+			modify: func(cfg *Config) {
+				cfg.Endpoint = "backend:4317"
+			},
+			errMsg: "endpoint and load_balancing::endpoints cannot be set at the same time",
+		},
+		{
+			name: "unsupported routing key",
+			modify: func(cfg *Config) {
+				cfg.LoadBalancing.RoutingKey = "span_id"
+			},
+			errMsg: `unsupported routing_key "span_id"`,
+		},
+		{
+			name: "empty resource attribute",
+			modify: func(cfg *Config) {
+				cfg.LoadBalancing.ResourceAttribute = ""
+			},
+			errMsg: "resource_attribute must not be empty",
+		},
+		{
+			name: "duplicate endpoint",
+			modify: func(cfg *Config) {
+				cfg.LoadBalancing.Endpoints = append(cfg.LoadBalancing.Endpoints, "backend-1:4317")
+			},
+			errMsg: `duplicate load balancing endpoint "backend-1:4317"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			cfg.LoadBalancing.Endpoints = []string{"backend-1:4317", "backend-2:4317"}
+			tt.modify(cfg)
+			assert.ErrorContains(t, component.ValidateConfig(cfg), tt.errMsg)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/exporter/internal/mirror"
 	"go.opentelemetry.io/collector/exporter/otlpexporter/internal/metadata"
 )
 
@@ -25,6 +26,7 @@ func NewFactory() exporter.Factory {
 		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
 		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
 		exporter.WithLogs(createLogsExporter, metadata.LogsStability),
+		exporter.WithProfiles(createProfilesExporter, metadata.ProfilesStability),
 	)
 }
 
@@ -40,6 +42,10 @@ func createDefaultConfig() component.Config {
 			// We almost read 0 bytes, so no need to tune ReadBufferSize.
 			WriteBufferSize: 512 * 1024,
 		},
+		LoadBalancing: LoadBalancingConfig{
+			RoutingKey:        RoutingKeyTraceID,
+			ResourceAttribute: "service.name",
+		},
 	}
 }
 
@@ -48,17 +54,26 @@ func createTracesExporter(
 	set exporter.CreateSettings,
 	cfg component.Config,
 ) (exporter.Traces, error) {
-	oce, err := newExporter(cfg, set)
+	oCfg := cfg.(*Config)
+	return mirror.Create(ctx, set, oCfg, oCfg.Mirrors, mirrorConfig, newTracesExporter, mirror.NewTraces)
+}
+
+func newTracesExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	oCfg *Config,
+) (exporter.Traces, error) {
+	oce, err := newExporter(oCfg, set)
 	if err != nil {
 		return nil, err
 	}
-	oCfg := cfg.(*Config)
-	return exporterhelper.NewTracesExporter(ctx, set, cfg,
+	return exporterhelper.NewTracesExporter(ctx, set, oCfg,
 		oce.pushTraces,
 		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
 		exporterhelper.WithTimeout(oCfg.TimeoutSettings),
 		exporterhelper.WithRetry(oCfg.RetryConfig),
 		exporterhelper.WithQueue(oCfg.QueueConfig),
+		exporterhelper.WithClientInfoPropagation(oCfg.Propagate.MetadataKeys, oCfg.Propagate.AuthAttributes),
 		exporterhelper.WithStart(oce.start),
 		exporterhelper.WithShutdown(oce.shutdown))
 }
@@ -68,17 +83,26 @@ func createMetricsExporter(
 	set exporter.CreateSettings,
 	cfg component.Config,
 ) (exporter.Metrics, error) {
-	oce, err := newExporter(cfg, set)
+	oCfg := cfg.(*Config)
+	return mirror.Create(ctx, set, oCfg, oCfg.Mirrors, mirrorConfig, newMetricsExporter, mirror.NewMetrics)
+}
+
+func newMetricsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	oCfg *Config,
+) (exporter.Metrics, error) {
+	oce, err := newExporter(oCfg, set)
 	if err != nil {
 		return nil, err
 	}
-	oCfg := cfg.(*Config)
-	return exporterhelper.NewMetricsExporter(ctx, set, cfg,
+	return exporterhelper.NewMetricsExporter(ctx, set, oCfg,
 		oce.pushMetrics,
 		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
 		exporterhelper.WithTimeout(oCfg.TimeoutSettings),
 		exporterhelper.WithRetry(oCfg.RetryConfig),
 		exporterhelper.WithQueue(oCfg.QueueConfig),
+		exporterhelper.WithClientInfoPropagation(oCfg.Propagate.MetadataKeys, oCfg.Propagate.AuthAttributes),
 		exporterhelper.WithStart(oce.start),
 		exporterhelper.WithShutdown(oce.shutdown),
 	)
@@ -89,18 +113,68 @@ func createLogsExporter(
 	set exporter.CreateSettings,
 	cfg component.Config,
 ) (exporter.Logs, error) {
-	oce, err := newExporter(cfg, set)
+	oCfg := cfg.(*Config)
+	return mirror.Create(ctx, set, oCfg, oCfg.Mirrors, mirrorConfig, newLogsExporter, mirror.NewLogs)
+}
+
+func newLogsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	oCfg *Config,
+) (exporter.Logs, error) {
+	oce, err := newExporter(oCfg, set)
 	if err != nil {
 		return nil, err
 	}
-	oCfg := cfg.(*Config)
-	return exporterhelper.NewLogsExporter(ctx, set, cfg,
+	return exporterhelper.NewLogsExporter(ctx, set, oCfg,
 		oce.pushLogs,
 		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
 		exporterhelper.WithTimeout(oCfg.TimeoutSettings),
 		exporterhelper.WithRetry(oCfg.RetryConfig),
 		exporterhelper.WithQueue(oCfg.QueueConfig),
+		exporterhelper.WithClientInfoPropagation(oCfg.Propagate.MetadataKeys, oCfg.Propagate.AuthAttributes),
 		exporterhelper.WithStart(oce.start),
 		exporterhelper.WithShutdown(oce.shutdown),
 	)
 }
+
+func createProfilesExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config,
+) (exporter.Profiles, error) {
+	oCfg := cfg.(*Config)
+	return mirror.Create(ctx, set, oCfg, oCfg.Mirrors, mirrorConfig, newProfilesExporter, mirror.NewProfiles)
+}
+
+func newProfilesExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	oCfg *Config,
+) (exporter.Profiles, error) {
+	oce, err := newExporter(oCfg, set)
+	if err != nil {
+		return nil, err
+	}
+	return exporterhelper.NewProfilesExporter(ctx, set, oCfg,
+		oce.pushProfiles,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(oCfg.TimeoutSettings),
+		exporterhelper.WithRetry(oCfg.RetryConfig),
+		exporterhelper.WithQueue(oCfg.QueueConfig),
+		exporterhelper.WithClientInfoPropagation(oCfg.Propagate.MetadataKeys, oCfg.Propagate.AuthAttributes),
+		exporterhelper.WithStart(oce.start),
+		exporterhelper.WithShutdown(oce.shutdown),
+	)
+}
+
+// mirrorConfig returns the configuration of a mirror exporter, sharing all the settings
+// of the primary one but the endpoint and the sending queue.
+func mirrorConfig(oCfg *Config, m MirrorConfig) *Config {
+	mCfg := *oCfg
+	mCfg.Endpoint = m.Endpoint
+	mCfg.LoadBalancing.Endpoints = nil
+	mCfg.Mirrors = nil
+	mCfg.QueueConfig = m.QueueSettings()
+	return &mCfg
+}
@@ -0,0 +1,199 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpexporter // import "go.opentelemetry.io/collector/exporter/otlpexporter"
+
+import (
+	"hash/fnv"
+	"sort"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// ringReplicas is the number of virtual nodes placed on the ring for every endpoint,
+// which keeps the distribution even with a small number of endpoints.
+const ringReplicas = 100
+
+type ringPoint struct {
+	hash  uint32
+	index int
+}
+
+// hashRing maps keys to endpoint indexes using consistent hashing, so that adding or
+// removing an endpoint only moves the keys owned by that endpoint.
+type hashRing struct {
+	points []ringPoint
+}
+
+func newHashRing(endpoints []string) *hashRing {
+	points := make([]ringPoint, 0, len(endpoints)*ringReplicas)
+	for i, endpoint := range endpoints {
+		for r := 0; r < ringReplicas; r++ {
+			points = append(points, ringPoint{hash: hashKey([]byte(endpoint + "-" + strconv.Itoa(r))), index: i})
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].hash == points[j].hash {
+			return points[i].index < points[j].index
+		}
+		return points[i].hash < points[j].hash
+	})
+	return &hashRing{points: points}
+}
+
+// endpointFor returns the index of the endpoint owning the given key.
+func (r *hashRing) endpointFor(key []byte) int {
+	h := hashKey(key)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i].hash >= h })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.points[i].index
+}
+
+func hashKey(key []byte) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write(key)
+	return h.Sum32()
+}
+
+// router splits the incoming data in one batch per endpoint.
+type router struct {
+	ring              *hashRing
+	size              int
+	routingKey        RoutingKey
+	resourceAttribute string
+}
+
+func newRouter(cfg LoadBalancingConfig) *router {
+	return &router{
+		ring:              newHashRing(cfg.Endpoints),
+		size:              len(cfg.Endpoints),
+		routingKey:        cfg.RoutingKey,
+		resourceAttribute: cfg.ResourceAttribute,
+	}
+}
+
+func (r *router) forResource(res pcommon.Resource) int {
+	val, _ := res.Attributes().Get(r.resourceAttribute)
+	return r.ring.endpointFor([]byte(val.AsString()))
+}
+
+func (r *router) forTraceID(id pcommon.TraceID) int {
+	return r.ring.endpointFor(id[:])
+}
+
+// splitTraces returns one batch per endpoint, batches with no spans are empty.
+func (r *router) splitTraces(td ptrace.Traces) []ptrace.Traces {
+	batches := make([]ptrace.Traces, r.size)
+	for i := range batches {
+		batches[i] = ptrace.NewTraces()
+	}
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		if r.routingKey == RoutingKeyResourceAttribute {
+			rs.CopyTo(batches[r.forResource(rs.Resource())].ResourceSpans().AppendEmpty())
+			continue
+		}
+		dstRSs := make(map[int]ptrace.ResourceSpans)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			dstSSs := make(map[int]ptrace.ScopeSpans)
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				idx := r.forTraceID(span.TraceID())
+				dstSS, ok := dstSSs[idx]
+				if !ok {
+					dstRS, found := dstRSs[idx]
+					if !found {
+						dstRS = batches[idx].ResourceSpans().AppendEmpty()
+						rs.Resource().CopyTo(dstRS.Resource())
+						dstRS.SetSchemaUrl(rs.SchemaUrl())
+						dstRSs[idx] = dstRS
+					}
+					dstSS = dstRS.ScopeSpans().AppendEmpty()
+					ss.Scope().CopyTo(dstSS.Scope())
+					dstSS.SetSchemaUrl(ss.SchemaUrl())
+					dstSSs[idx] = dstSS
+				}
+				span.CopyTo(dstSS.Spans().AppendEmpty())
+			}
+		}
+	}
+	return batches
+}
+
+// splitMetrics returns one batch per endpoint, metrics are always routed by resource attribute.
+func (r *router) splitMetrics(md pmetric.Metrics) []pmetric.Metrics {
+	batches := make([]pmetric.Metrics, r.size)
+	for i := range batches {
+		batches[i] = pmetric.NewMetrics()
+	}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		rm.CopyTo(batches[r.forResource(rm.Resource())].ResourceMetrics().AppendEmpty())
+	}
+	return batches
+}
+
+// splitLogs returns one batch per endpoint, batches with no log records are empty.
+func (r *router) splitLogs(ld plog.Logs) []plog.Logs {
+	batches := make([]plog.Logs, r.size)
+	for i := range batches {
+		batches[i] = plog.NewLogs()
+	}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		if r.routingKey == RoutingKeyResourceAttribute {
+			rl.CopyTo(batches[r.forResource(rl.Resource())].ResourceLogs().AppendEmpty())
+			continue
+		}
+		dstRLs := make(map[int]plog.ResourceLogs)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			dstSLs := make(map[int]plog.ScopeLogs)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				idx := r.forTraceID(lr.TraceID())
+				dstSL, ok := dstSLs[idx]
+				if !ok {
+					dstRL, found := dstRLs[idx]
+					if !found {
+						dstRL = batches[idx].ResourceLogs().AppendEmpty()
+						rl.Resource().CopyTo(dstRL.Resource())
+						dstRL.SetSchemaUrl(rl.SchemaUrl())
+						dstRLs[idx] = dstRL
+					}
+					dstSL = dstRL.ScopeLogs().AppendEmpty()
+					sl.Scope().CopyTo(dstSL.Scope())
+					dstSL.SetSchemaUrl(sl.SchemaUrl())
+					dstSLs[idx] = dstSL
+				}
+				lr.CopyTo(dstSL.LogRecords().AppendEmpty())
+			}
+		}
+	}
+	return batches
+}
+
+// splitProfiles returns one batch per endpoint, profiles are always routed by resource attribute.
+func (r *router) splitProfiles(pd pprofile.Profiles) []pprofile.Profiles {
+	batches := make([]pprofile.Profiles, r.size)
+	for i := range batches {
+		batches[i] = pprofile.NewProfiles()
+	}
+	rps := pd.ResourceProfiles()
+	for i := 0; i < rps.Len(); i++ {
+		rp := rps.At(i)
+		rp.CopyTo(batches[r.forResource(rp.Resource())].ResourceProfiles().AppendEmpty())
+	}
+	return batches
+}
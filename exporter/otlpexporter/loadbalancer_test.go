@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpexporter
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestHashRingIsConsistent(t *testing.T) {
+	ring := newHashRing([]string{"backend-1:4317", "backend-2:4317", "backend-3:4317"})
+	grown := newHashRing([]string{"backend-1:4317", "backend-2:4317", "backend-3:4317", "backend-4:4317"})
+
+	counts := make([]int, 3)
+	moved := 0
+	for i := 0; i < 1000; i++ {
+		key := []byte("key-" + strconv.Itoa(i))
+		idx := ring.endpointFor(key)
+		assert.Equal(t, idx, ring.endpointFor(key))
+		counts[idx]++
+		if grownIdx := grown.endpointFor(key); grownIdx != idx {
+			// Keys only move to the new endpoint.
+			assert.Equal(t, 3, grownIdx)
+			moved++
+		}
+	}
+	for _, count := range counts {
+		assert.Greater(t, count, 0)
+	}
+	assert.Greater(t, moved, 0)
+	assert.Less(t, moved, 500)
+}
+
+func TestRouterSplitTracesByTraceID(t *testing.T) {
+	r := newRouter(LoadBalancingConfig{
+		Endpoints:         []string{"backend-1:4317", "backend-2:4317"},
+		RoutingKey:        RoutingKeyTraceID,
+		ResourceAttribute: "service.name",
+	})
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "svc")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("scope")
+	for i := 0; i < 20; i++ {
+		span := ss.Spans().AppendEmpty()
+		// Two spans per trace.
+		span.SetTraceID(pcommon.TraceID([16]byte{byte(i / 2)}))
+		span.SetName(strconv.Itoa(i))
+	}
+
+	batches := r.splitTraces(td)
+	require.Len(t, batches, 2)
+	total := 0
+	owners := map[pcommon.TraceID]int{}
+	for i, batch := range batches {
+		total += batch.SpanCount()
+		for j := 0; j < batch.ResourceSpans().Len(); j++ {
+			dstRS := batch.ResourceSpans().At(j)
+			assert.Equal(t, rs.Resource().Attributes().AsRaw(), dstRS.Resource().Attributes().AsRaw())
+			dstSS := dstRS.ScopeSpans().At(0)
+			assert.Equal(t, "scope", dstSS.Scope().Name())
+			for k := 0; k < dstSS.Spans().Len(); k++ {
+				traceID := dstSS.Spans().At(k).TraceID()
+				if owner, ok := owners[traceID]; ok {
+					assert.Equal(t, owner, i, "spans of a trace must be sent to the same endpoint")
+				}
+				owners[traceID] = i
+			}
+		}
+	}
+	assert.Equal(t, 20, total)
+	// The input must not be modified.
+	assert.Equal(t, 20, td.SpanCount())
+}
+
+func TestRouterSplitByResourceAttribute(t *testing.T) {
+	r := newRouter(LoadBalancingConfig{
+		Endpoints:         []string{"backend-1:4317", "backend-2:4317", "backend-3:4317"},
+		RoutingKey:        RoutingKeyResourceAttribute,
+		ResourceAttribute: "tenant",
+	})
+
+	td := ptrace.NewTraces()
+	md := pmetric.NewMetrics()
+	ld := plog.NewLogs()
+	pd := pprofile.NewProfiles()
+	for i := 0; i < 10; i++ {
+		tenant := "tenant-" + strconv.Itoa(i%5)
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("tenant", tenant)
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("tenant", tenant)
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("tenant", tenant)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		rp := pd.ResourceProfiles().AppendEmpty()
+		rp.Resource().Attributes().PutStr("tenant", tenant)
+		rp.ScopeProfiles().AppendEmpty().Profiles().AppendEmpty().Profile().Sample().AppendEmpty()
+	}
+
+	traceBatches := r.splitTraces(td)
+	metricBatches := r.splitMetrics(md)
+	logBatches := r.splitLogs(ld)
+	profileBatches := r.splitProfiles(pd)
+	spans, dataPoints, logRecords, samples := 0, 0, 0, 0
+	for i := 0; i < 3; i++ {
+		spans += traceBatches[i].SpanCount()
+		dataPoints += metricBatches[i].DataPointCount()
+		logRecords += logBatches[i].LogRecordCount()
+		samples += profileBatches[i].SampleCount()
+		for j := 0; j < traceBatches[i].ResourceSpans().Len(); j++ {
+			tenant, _ := traceBatches[i].ResourceSpans().At(j).Resource().Attributes().Get("tenant")
+			assert.Equal(t, i, r.ring.endpointFor([]byte(tenant.Str())))
+		}
+	}
+	assert.Equal(t, 10, spans)
+	assert.Equal(t, 10, dataPoints)
+	assert.Equal(t, 10, logRecords)
+	assert.Equal(t, 10, samples)
+}
@@ -10,20 +10,26 @@ import (
 	"runtime"
 	"time"
 
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/exporter/internal/payloadsummary"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 )
@@ -32,13 +38,13 @@ type baseExporter struct {
 	// Input configuration.
 	config *Config
 
-	// gRPC clients and connection.
-	traceExporter  ptraceotlp.GRPCClient
-	metricExporter pmetricotlp.GRPCClient
-	logExporter    plogotlp.GRPCClient
-	clientConn     *grpc.ClientConn
-	metadata       metadata.MD
-	callOptions    []grpc.CallOption
+	// gRPC clients and connections, one per endpoint.
+	clients     []*grpcClient
+	metadata    metadata.MD
+	callOptions []grpc.CallOption
+
+	// router splits the data across the clients when load balancing, nil otherwise.
+	router *router
 
 	settings component.TelemetrySettings
 
@@ -46,30 +52,55 @@ type baseExporter struct {
 	userAgent string
 }
 
+type grpcClient struct {
+	traceExporter   ptraceotlp.GRPCClient
+	metricExporter  pmetricotlp.GRPCClient
+	logExporter     plogotlp.GRPCClient
+	profileExporter pprofileotlp.GRPCClient
+	clientConn      *grpc.ClientConn
+}
+
 // Crete new exporter and start it. The exporter will begin connecting but
 // this function may return before the connection is established.
 func newExporter(cfg component.Config, set exporter.CreateSettings) (*baseExporter, error) {
 	oCfg := cfg.(*Config)
 
-	if oCfg.Endpoint == "" {
+	if oCfg.Endpoint == "" && len(oCfg.LoadBalancing.Endpoints) == 0 {
 		return nil, errors.New("OTLP exporter config requires an Endpoint")
 	}
 
 	userAgent := fmt.Sprintf("%s/%s (%s/%s)",
 		set.BuildInfo.Description, set.BuildInfo.Version, runtime.GOOS, runtime.GOARCH)
 
-	return &baseExporter{config: oCfg, settings: set.TelemetrySettings, userAgent: userAgent}, nil
+	be := &baseExporter{config: oCfg, settings: set.TelemetrySettings, userAgent: userAgent}
+	if len(oCfg.LoadBalancing.Endpoints) > 0 {
+		be.router = newRouter(oCfg.LoadBalancing)
+	}
+	return be, nil
 }
 
-// start actually creates the gRPC connection. The client construction is deferred till this point as this
+// start actually creates the gRPC connections. The client construction is deferred till this point as this
 // is the only place we get hold of Extensions which are required to construct auth round tripper.
-func (e *baseExporter) start(ctx context.Context, host component.Host) (err error) {
-	if e.clientConn, err = e.config.GRPCClientSettings.ToClientConn(ctx, host, e.settings, grpc.WithUserAgent(e.userAgent)); err != nil {
-		return err
+func (e *baseExporter) start(ctx context.Context, host component.Host) error {
+	endpoints := e.config.LoadBalancing.Endpoints
+	if len(endpoints) == 0 {
+		endpoints = []string{e.config.Endpoint}
+	}
+	for _, endpoint := range endpoints {
+		clientSettings := e.config.GRPCClientSettings
+		clientSettings.Endpoint = endpoint
+		clientConn, err := clientSettings.ToClientConn(ctx, host, e.settings, grpc.WithUserAgent(e.userAgent))
+		if err != nil {
+			return err
+		}
+		e.clients = append(e.clients, &grpcClient{
+			traceExporter:   ptraceotlp.NewGRPCClient(clientConn),
+			metricExporter:  pmetricotlp.NewGRPCClient(clientConn),
+			logExporter:     plogotlp.NewGRPCClient(clientConn),
+			profileExporter: pprofileotlp.NewGRPCClient(clientConn),
+			clientConn:      clientConn,
+		})
 	}
-	e.traceExporter = ptraceotlp.NewGRPCClient(e.clientConn)
-	e.metricExporter = pmetricotlp.NewGRPCClient(e.clientConn)
-	e.logExporter = plogotlp.NewGRPCClient(e.clientConn)
 	headers := map[string]string{}
 	for k, v := range e.config.GRPCClientSettings.Headers {
 		headers[k] = string(v)
@@ -79,19 +110,143 @@ func (e *baseExporter) start(ctx context.Context, host component.Host) (err erro
 		grpc.WaitForReady(e.config.GRPCClientSettings.WaitForReady),
 	}
 
-	return
+	return nil
 }
 
 func (e *baseExporter) shutdown(context.Context) error {
-	if e.clientConn != nil {
-		return e.clientConn.Close()
+	var errs error
+	for _, client := range e.clients {
+		errs = multierr.Append(errs, client.clientConn.Close())
 	}
-	return nil
+	return errs
 }
 
 func (e *baseExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
+	if payloadsummary.LogTraces(e.settings.Logger, td) {
+		return nil
+	}
+	if e.router == nil {
+		return e.exportTraces(ctx, e.clients[0], td)
+	}
+	var errs error
+	failed := ptrace.NewTraces()
+	for i, batch := range e.router.splitTraces(td) {
+		if batch.ResourceSpans().Len() == 0 {
+			continue
+		}
+		if err := e.exportTraces(ctx, e.clients[i], batch); err != nil {
+			errs = e.appendError(errs, err)
+			if !consumererror.IsPermanent(err) {
+				batch.ResourceSpans().MoveAndAppendTo(failed.ResourceSpans())
+			}
+		}
+	}
+	if failed.ResourceSpans().Len() > 0 {
+		// Only retry the batches that failed, the other endpoints already accepted their data.
+		return consumererror.NewTraces(errs, failed)
+	}
+	return errs
+}
+
+func (e *baseExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	if payloadsummary.LogMetrics(e.settings.Logger, md) {
+		return nil
+	}
+	if e.router == nil {
+		return e.exportMetrics(ctx, e.clients[0], md)
+	}
+	var errs error
+	failed := pmetric.NewMetrics()
+	for i, batch := range e.router.splitMetrics(md) {
+		if batch.ResourceMetrics().Len() == 0 {
+			continue
+		}
+		if err := e.exportMetrics(ctx, e.clients[i], batch); err != nil {
+			errs = e.appendError(errs, err)
+			if !consumererror.IsPermanent(err) {
+				batch.ResourceMetrics().MoveAndAppendTo(failed.ResourceMetrics())
+			}
+		}
+	}
+	if failed.ResourceMetrics().Len() > 0 {
+		return consumererror.NewMetrics(errs, failed)
+	}
+	return errs
+}
+
+func (e *baseExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	if payloadsummary.LogLogs(e.settings.Logger, ld) {
+		return nil
+	}
+	if e.router == nil {
+		return e.exportLogs(ctx, e.clients[0], ld)
+	}
+	var errs error
+	failed := plog.NewLogs()
+	for i, batch := range e.router.splitLogs(ld) {
+		if batch.ResourceLogs().Len() == 0 {
+			continue
+		}
+		if err := e.exportLogs(ctx, e.clients[i], batch); err != nil {
+			errs = e.appendError(errs, err)
+			if !consumererror.IsPermanent(err) {
+				batch.ResourceLogs().MoveAndAppendTo(failed.ResourceLogs())
+			}
+		}
+	}
+	if failed.ResourceLogs().Len() > 0 {
+		return consumererror.NewLogs(errs, failed)
+	}
+	return errs
+}
+
+func (e *baseExporter) pushProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	if payloadsummary.LogProfiles(e.settings.Logger, pd) {
+		return nil
+	}
+	if e.router == nil {
+		return e.exportProfiles(ctx, e.clients[0], pd)
+	}
+	var errs error
+	failed := pprofile.NewProfiles()
+	for i, batch := range e.router.splitProfiles(pd) {
+		if batch.ResourceProfiles().Len() == 0 {
+			continue
+		}
+		if err := e.exportProfiles(ctx, e.clients[i], batch); err != nil {
+			errs = e.appendError(errs, err)
+			if !consumererror.IsPermanent(err) {
+				batch.ResourceProfiles().MoveAndAppendTo(failed.ResourceProfiles())
+			}
+		}
+	}
+	if failed.ResourceProfiles().Len() > 0 {
+		return consumererror.NewProfiles(errs, failed)
+	}
+	return errs
+}
+
+// appendError combines the errors returned by the endpoints. Once an endpoint failed with
+// a retryable error, permanent errors from other endpoints are logged instead of returned,
+// otherwise they would prevent the retry of the failed data.
+func (e *baseExporter) appendError(errs error, err error) error {
+	switch {
+	case errs == nil:
+		return err
+	case consumererror.IsPermanent(err) == consumererror.IsPermanent(errs):
+		return multierr.Append(errs, err)
+	case consumererror.IsPermanent(err):
+		e.settings.Logger.Error("Dropping data rejected by endpoint", zap.Error(err))
+		return errs
+	default:
+		e.settings.Logger.Error("Dropping data rejected by endpoint", zap.Error(errs))
+		return err
+	}
+}
+
+func (e *baseExporter) exportTraces(ctx context.Context, client *grpcClient, td ptrace.Traces) error {
 	req := ptraceotlp.NewExportRequestFromTraces(td)
-	resp, respErr := e.traceExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
+	resp, respErr := client.traceExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
 	if err := processError(respErr); err != nil {
 		return err
 	}
@@ -102,9 +257,9 @@ func (e *baseExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
 	return nil
 }
 
-func (e *baseExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+func (e *baseExporter) exportMetrics(ctx context.Context, client *grpcClient, md pmetric.Metrics) error {
 	req := pmetricotlp.NewExportRequestFromMetrics(md)
-	resp, respErr := e.metricExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
+	resp, respErr := client.metricExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
 	if err := processError(respErr); err != nil {
 		return err
 	}
@@ -115,9 +270,9 @@ func (e *baseExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) erro
 	return nil
 }
 
-func (e *baseExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+func (e *baseExporter) exportLogs(ctx context.Context, client *grpcClient, ld plog.Logs) error {
 	req := plogotlp.NewExportRequestFromLogs(ld)
-	resp, respErr := e.logExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
+	resp, respErr := client.logExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
 	if err := processError(respErr); err != nil {
 		return err
 	}
@@ -128,9 +283,30 @@ func (e *baseExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
 	return nil
 }
 
+func (e *baseExporter) exportProfiles(ctx context.Context, client *grpcClient, pd pprofile.Profiles) error {
+	req := pprofileotlp.NewExportRequestFromProfiles(pd)
+	resp, respErr := client.profileExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
+	if err := processError(respErr); err != nil {
+		return err
+	}
+	partialSuccess := resp.PartialSuccess()
+	if !(partialSuccess.ErrorMessage() == "" && partialSuccess.RejectedProfiles() == 0) {
+		return consumererror.NewPermanent(fmt.Errorf("OTLP partial success: \"%s\" (%d rejected)", resp.PartialSuccess().ErrorMessage(), resp.PartialSuccess().RejectedProfiles()))
+	}
+	return nil
+}
+
 func (e *baseExporter) enhanceContext(ctx context.Context) context.Context {
-	if e.metadata.Len() > 0 {
-		return metadata.NewOutgoingContext(ctx, e.metadata)
+	md := e.metadata
+	if len(e.config.Propagate.MetadataKeys) > 0 || len(e.config.Propagate.AuthAttributes) > 0 {
+		// The configured headers take precedence over the propagated ones.
+		md = metadata.MD(client.ToOutgoingMetadata(ctx))
+		for k, v := range e.metadata {
+			md[k] = v
+		}
+	}
+	if md.Len() > 0 {
+		return metadata.NewOutgoingContext(ctx, md)
 	}
 	return ctx
 }
@@ -23,13 +23,17 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/internal/testdata"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -772,3 +776,124 @@ func TestSendLogData(t *testing.T) {
 	err = exp.ConsumeLogs(context.Background(), ld)
 	assert.Error(t, err)
 }
+
+func TestSendTracesLoadBalancing(t *testing.T) {
+	var rcvs []*mockTracesReceiver
+	var endpoints []string
+	for i := 0; i < 2; i++ {
+		ln, err := net.Listen("tcp", "localhost:")
+		require.NoError(t, err)
+		rcv, _ := otlpTracesReceiverOnGRPCServer(ln, false)
+		defer rcv.srv.GracefulStop()
+		rcvs = append(rcvs, rcv)
+		endpoints = append(endpoints, ln.Addr().String())
+	}
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.QueueConfig.Enabled = false
+	cfg.RetryConfig.Enabled = false
+	cfg.TLSSetting.Insecure = true
+	cfg.LoadBalancing.Endpoints = endpoints
+	require.NoError(t, component.ValidateConfig(cfg))
+	exp, err := factory.CreateTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, exp.Shutdown(context.Background()))
+	}()
+
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	for i := 0; i < 50; i++ {
+		spans.AppendEmpty().SetTraceID(pcommon.TraceID([16]byte{byte(i)}))
+	}
+	require.NoError(t, exp.ConsumeTraces(context.Background(), td))
+	assert.EqualValues(t, 50, rcvs[0].totalItems.Load()+rcvs[1].totalItems.Load())
+	assert.Greater(t, rcvs[0].totalItems.Load(), int32(0))
+	assert.Greater(t, rcvs[1].totalItems.Load(), int32(0))
+
+	// Only the data of the failing endpoint is returned for retry.
+	rcvs[1].setExportError(status.Error(codes.Unavailable, "unavailable"))
+	err = exp.ConsumeTraces(context.Background(), td)
+	var tracesErr consumererror.Traces
+	require.ErrorAs(t, err, &tracesErr)
+	assert.Equal(t, rcvs[1].getLastRequest().SpanCount(), tracesErr.Data().SpanCount())
+	assert.Less(t, tracesErr.Data().SpanCount(), 50)
+}
+
+func TestSendTracesPropagation(t *testing.T) {
+	ln, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err)
+	rcv, _ := otlpTracesReceiverOnGRPCServer(ln, false)
+	defer rcv.srv.GracefulStop()
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Endpoint = ln.Addr().String()
+	cfg.TLSSetting.Insecure = true
+	cfg.Headers = map[string]configopaque.String{"header": "header-value"}
+	cfg.Propagate.MetadataKeys = []string{"X-Tenant", "header"}
+	cfg.Propagate.AuthAttributes = []string{"subject"}
+	exp, err := factory.CreateTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, exp.Shutdown(context.Background()))
+	}()
+
+	// The selected client info is sent once the data is dequeued.
+	ctx := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"X-Tenant": {"tenant"}, "header": {"other"}, "Other": {"other"}}),
+		Auth:     client.NewAuthData(map[string]any{"subject": "user", "token": "secret"}),
+	})
+	require.NoError(t, exp.ConsumeTraces(ctx, testdata.GenerateTraces(1)))
+	assert.Eventually(t, func() bool {
+		return rcv.requestCount.Load() > 0
+	}, 10*time.Second, 5*time.Millisecond)
+
+	md := rcv.getMetadata()
+	assert.Equal(t, []string{"tenant"}, md.Get("x-tenant"))
+	assert.Equal(t, []string{"user"}, md.Get("subject"))
+	assert.Equal(t, []string{"header-value"}, md.Get("header"))
+	assert.Empty(t, md.Get("other"))
+	assert.Empty(t, md.Get("token"))
+}
+
+func TestSendTracesMirrors(t *testing.T) {
+	ln, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err)
+	rcv, _ := otlpTracesReceiverOnGRPCServer(ln, false)
+	defer rcv.srv.GracefulStop()
+
+	mirrorLn, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err)
+	mirrorRcv, _ := otlpTracesReceiverOnGRPCServer(mirrorLn, false)
+	defer mirrorRcv.srv.GracefulStop()
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.QueueConfig.Enabled = false
+	cfg.RetryConfig.Enabled = false
+	cfg.TLSSetting.Insecure = true
+	cfg.Endpoint = ln.Addr().String()
+	cfg.Mirrors = []MirrorConfig{
+		{Endpoint: mirrorLn.Addr().String()},
+		{Endpoint: "localhost:1"},
+	}
+	require.NoError(t, component.ValidateConfig(cfg))
+	exp, err := factory.CreateTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, exp.Shutdown(context.Background()))
+	}()
+
+	// The unreachable mirror does not fail the request.
+	require.NoError(t, exp.ConsumeTraces(context.Background(), testdata.GenerateTraces(2)))
+	assert.EqualValues(t, 2, rcv.totalItems.Load())
+	// The mirrors are sent asynchronously through their sending queue.
+	assert.Eventually(t, func() bool {
+		return mirrorRcv.totalItems.Load() == 2
+	}, 10*time.Second, 5*time.Millisecond)
+}
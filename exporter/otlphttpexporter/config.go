@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configretry"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/exporter/internal/mirror"
 )
 
 // Config defines configuration for OTLP/HTTP exporter.
@@ -26,14 +27,30 @@ type Config struct {
 
 	// The URL to send logs to. If omitted the Endpoint + "/v1/logs" will be used.
 	LogsEndpoint string `mapstructure:"logs_endpoint"`
+
+	// The URL to send profiles to. If omitted the Endpoint + "/v1experimental/profiles" will be used.
+	ProfilesEndpoint string `mapstructure:"profiles_endpoint"`
+
+	// Signer is the ID of an extension implementing auth.RequestSigner, used to sign every
+	// request right before it is sent, after compression and after the authenticator.
+	Signer *component.ID `mapstructure:"signer"`
+
+	// Mirrors are secondary endpoints receiving a copy of every request on a best-effort basis.
+	Mirrors []MirrorConfig `mapstructure:"mirrors"`
+
+	// Propagate selects the metadata and the auth attributes of the incoming requests sent as HTTP headers.
+	Propagate exporterhelper.PropagationSettings `mapstructure:"propagate"`
 }
 
+// MirrorConfig defines a secondary endpoint receiving a copy of the data.
+type MirrorConfig = mirror.Config
+
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
 func (cfg *Config) Validate() error {
-	if cfg.Endpoint == "" && cfg.TracesEndpoint == "" && cfg.MetricsEndpoint == "" && cfg.LogsEndpoint == "" {
+	if cfg.Endpoint == "" && cfg.TracesEndpoint == "" && cfg.MetricsEndpoint == "" && cfg.LogsEndpoint == "" && cfg.ProfilesEndpoint == "" {
 		return errors.New("at least one endpoint must be specified")
 	}
-	return nil
+	return mirror.Validate(cfg.Mirrors)
 }
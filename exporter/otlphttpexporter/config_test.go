@@ -73,3 +73,42 @@ func TestUnmarshalConfig(t *testing.T) {
 			},
 		}, cfg)
 }
+
+func TestUnmarshalMirrorsConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "mirrors.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	require.NoError(t, component.UnmarshalConfig(cm, cfg))
+	assert.NoError(t, component.ValidateConfig(cfg))
+	assert.Equal(t,
+		[]MirrorConfig{
+			{Endpoint: "https://shadow:4318"},
+			{
+				Endpoint: "https://migration:4318",
+				QueueConfig: &exporterhelper.QueueSettings{
+					Enabled:      true,
+					NumConsumers: 1,
+					QueueSize:    1000,
+				},
+			},
+			{
+				Endpoint: "https://backup:4318",
+				QueueConfig: &exporterhelper.QueueSettings{
+					Enabled:      true,
+					NumConsumers: 10,
+					QueueSize:    100,
+				},
+			},
+		}, cfg.(*Config).Mirrors)
+
+	mirrors := cfg.(*Config).Mirrors
+	cfg.(*Config).Mirrors = append(mirrors, MirrorConfig{})
+	assert.EqualError(t, component.ValidateConfig(cfg), "mirrors::3::endpoint must not be empty")
+
+	cfg.(*Config).Mirrors = append(mirrors, MirrorConfig{Endpoint: "https://backup:4318", QueueConfig: &exporterhelper.QueueSettings{}})
+	assert.EqualError(t, component.ValidateConfig(cfg), "mirrors::3::sending_queue must be enabled, the mirrors are sent asynchronously")
+
+	cfg.(*Config).Mirrors = append(mirrors, MirrorConfig{Endpoint: "https://backup:4318", QueueConfig: &exporterhelper.QueueSettings{Enabled: true}})
+	assert.EqualError(t, component.ValidateConfig(cfg), "queue size must be positive")
+}
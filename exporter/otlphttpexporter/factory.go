@@ -18,6 +18,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/exporter/internal/mirror"
 	"go.opentelemetry.io/collector/exporter/otlphttpexporter/internal/metadata"
 )
 
@@ -29,6 +30,7 @@ func NewFactory() exporter.Factory {
 		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
 		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
 		exporter.WithLogs(createLogsExporter, metadata.LogsStability),
+		exporter.WithProfiles(createProfilesExporter, metadata.ProfilesStability),
 	)
 }
 
@@ -48,7 +50,7 @@ func createDefaultConfig() component.Config {
 	}
 }
 
-func composeSignalURL(oCfg *Config, signalOverrideURL string, signalName string) (string, error) {
+func composeSignalURL(oCfg *Config, signalOverrideURL string, signalName string, signalVersion string) (string, error) {
 	switch {
 	case signalOverrideURL != "":
 		_, err := url.Parse(signalOverrideURL)
@@ -60,9 +62,9 @@ func composeSignalURL(oCfg *Config, signalOverrideURL string, signalName string)
 		return "", fmt.Errorf("either endpoint or %s_endpoint must be specified", signalName)
 	default:
 		if strings.HasSuffix(oCfg.Endpoint, "/") {
-			return oCfg.Endpoint + "v1/" + signalName, nil
+			return oCfg.Endpoint + signalVersion + "/" + signalName, nil
 		}
-		return oCfg.Endpoint + "/v1/" + signalName, nil
+		return oCfg.Endpoint + "/" + signalVersion + "/" + signalName, nil
 	}
 }
 
@@ -71,25 +73,34 @@ func createTracesExporter(
 	set exporter.CreateSettings,
 	cfg component.Config,
 ) (exporter.Traces, error) {
-	oce, err := newExporter(cfg, set)
+	oCfg := cfg.(*Config)
+	return mirror.Create(ctx, set, oCfg, oCfg.Mirrors, mirrorConfig, newTracesExporter, mirror.NewTraces)
+}
+
+func newTracesExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	oCfg *Config,
+) (exporter.Traces, error) {
+	oce, err := newExporter(oCfg, set)
 	if err != nil {
 		return nil, err
 	}
-	oCfg := cfg.(*Config)
 
-	oce.tracesURL, err = composeSignalURL(oCfg, oCfg.TracesEndpoint, "traces")
+	oce.tracesURL, err = composeSignalURL(oCfg, oCfg.TracesEndpoint, "traces", "v1")
 	if err != nil {
 		return nil, err
 	}
 
-	return exporterhelper.NewTracesExporter(ctx, set, cfg,
+	return exporterhelper.NewTracesExporter(ctx, set, oCfg,
 		oce.pushTraces,
 		exporterhelper.WithStart(oce.start),
 		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
 		// explicitly disable since we rely on http.Client timeout logic.
 		exporterhelper.WithTimeout(exporterhelper.TimeoutSettings{Timeout: 0}),
 		exporterhelper.WithRetry(oCfg.RetryConfig),
-		exporterhelper.WithQueue(oCfg.QueueConfig))
+		exporterhelper.WithQueue(oCfg.QueueConfig),
+		exporterhelper.WithClientInfoPropagation(oCfg.Propagate.MetadataKeys, oCfg.Propagate.AuthAttributes))
 }
 
 func createMetricsExporter(
@@ -97,25 +108,34 @@ func createMetricsExporter(
 	set exporter.CreateSettings,
 	cfg component.Config,
 ) (exporter.Metrics, error) {
-	oce, err := newExporter(cfg, set)
+	oCfg := cfg.(*Config)
+	return mirror.Create(ctx, set, oCfg, oCfg.Mirrors, mirrorConfig, newMetricsExporter, mirror.NewMetrics)
+}
+
+func newMetricsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	oCfg *Config,
+) (exporter.Metrics, error) {
+	oce, err := newExporter(oCfg, set)
 	if err != nil {
 		return nil, err
 	}
-	oCfg := cfg.(*Config)
 
-	oce.metricsURL, err = composeSignalURL(oCfg, oCfg.MetricsEndpoint, "metrics")
+	oce.metricsURL, err = composeSignalURL(oCfg, oCfg.MetricsEndpoint, "metrics", "v1")
 	if err != nil {
 		return nil, err
 	}
 
-	return exporterhelper.NewMetricsExporter(ctx, set, cfg,
+	return exporterhelper.NewMetricsExporter(ctx, set, oCfg,
 		oce.pushMetrics,
 		exporterhelper.WithStart(oce.start),
 		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
 		// explicitly disable since we rely on http.Client timeout logic.
 		exporterhelper.WithTimeout(exporterhelper.TimeoutSettings{Timeout: 0}),
 		exporterhelper.WithRetry(oCfg.RetryConfig),
-		exporterhelper.WithQueue(oCfg.QueueConfig))
+		exporterhelper.WithQueue(oCfg.QueueConfig),
+		exporterhelper.WithClientInfoPropagation(oCfg.Propagate.MetadataKeys, oCfg.Propagate.AuthAttributes))
 }
 
 func createLogsExporter(
@@ -123,23 +143,81 @@ func createLogsExporter(
 	set exporter.CreateSettings,
 	cfg component.Config,
 ) (exporter.Logs, error) {
-	oce, err := newExporter(cfg, set)
+	oCfg := cfg.(*Config)
+	return mirror.Create(ctx, set, oCfg, oCfg.Mirrors, mirrorConfig, newLogsExporter, mirror.NewLogs)
+}
+
+func newLogsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	oCfg *Config,
+) (exporter.Logs, error) {
+	oce, err := newExporter(oCfg, set)
 	if err != nil {
 		return nil, err
 	}
-	oCfg := cfg.(*Config)
 
-	oce.logsURL, err = composeSignalURL(oCfg, oCfg.LogsEndpoint, "logs")
+	oce.logsURL, err = composeSignalURL(oCfg, oCfg.LogsEndpoint, "logs", "v1")
 	if err != nil {
 		return nil, err
 	}
 
-	return exporterhelper.NewLogsExporter(ctx, set, cfg,
+	return exporterhelper.NewLogsExporter(ctx, set, oCfg,
 		oce.pushLogs,
 		exporterhelper.WithStart(oce.start),
 		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
 		// explicitly disable since we rely on http.Client timeout logic.
 		exporterhelper.WithTimeout(exporterhelper.TimeoutSettings{Timeout: 0}),
 		exporterhelper.WithRetry(oCfg.RetryConfig),
-		exporterhelper.WithQueue(oCfg.QueueConfig))
+		exporterhelper.WithQueue(oCfg.QueueConfig),
+		exporterhelper.WithClientInfoPropagation(oCfg.Propagate.MetadataKeys, oCfg.Propagate.AuthAttributes))
+}
+
+func createProfilesExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config,
+) (exporter.Profiles, error) {
+	oCfg := cfg.(*Config)
+	return mirror.Create(ctx, set, oCfg, oCfg.Mirrors, mirrorConfig, newProfilesExporter, mirror.NewProfiles)
+}
+
+func newProfilesExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	oCfg *Config,
+) (exporter.Profiles, error) {
+	oce, err := newExporter(oCfg, set)
+	if err != nil {
+		return nil, err
+	}
+
+	oce.profilesURL, err = composeSignalURL(oCfg, oCfg.ProfilesEndpoint, "profiles", "v1experimental")
+	if err != nil {
+		return nil, err
+	}
+
+	return exporterhelper.NewProfilesExporter(ctx, set, oCfg,
+		oce.pushProfiles,
+		exporterhelper.WithStart(oce.start),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		// explicitly disable since we rely on http.Client timeout logic.
+		exporterhelper.WithTimeout(exporterhelper.TimeoutSettings{Timeout: 0}),
+		exporterhelper.WithRetry(oCfg.RetryConfig),
+		exporterhelper.WithQueue(oCfg.QueueConfig),
+		exporterhelper.WithClientInfoPropagation(oCfg.Propagate.MetadataKeys, oCfg.Propagate.AuthAttributes))
+}
+
+// mirrorConfig returns the configuration of a mirror exporter, sharing all the settings
+// of the primary one but the endpoints and the sending queue.
+func mirrorConfig(oCfg *Config, m MirrorConfig) *Config {
+	mCfg := *oCfg
+	mCfg.Endpoint = m.Endpoint
+	mCfg.TracesEndpoint = ""
+	mCfg.MetricsEndpoint = ""
+	mCfg.LogsEndpoint = ""
+	mCfg.ProfilesEndpoint = ""
+	mCfg.Mirrors = nil
+	mCfg.QueueConfig = m.QueueSettings()
+	return &mCfg
 }
@@ -200,13 +200,18 @@ func TestComposeSignalURL(t *testing.T) {
 
 	// Has slash at end
 	cfg.HTTPClientConfig.Endpoint = "http://localhost:4318/"
-	url, err := composeSignalURL(cfg, "", "traces")
+	url, err := composeSignalURL(cfg, "", "traces", "v1")
 	require.NoError(t, err)
 	assert.Equal(t, "http://localhost:4318/v1/traces", url)
 
 	// No slash at end
 	cfg.HTTPClientConfig.Endpoint = "http://localhost:4318"
-	url, err = composeSignalURL(cfg, "", "traces")
+	url, err = composeSignalURL(cfg, "", "traces", "v1")
 	require.NoError(t, err)
 	assert.Equal(t, "http://localhost:4318/v1/traces", url)
+
+	// Development signal
+	url, err = composeSignalURL(cfg, "", "profiles", "v1experimental")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:4318/v1experimental/profiles", url)
 }
@@ -6,6 +6,7 @@ require (
 	github.com/stretchr/testify v1.8.4
 	go.opentelemetry.io/collector v0.93.0
 	go.opentelemetry.io/collector/component v0.93.0
+	go.opentelemetry.io/collector/config/configauth v0.93.0
 	go.opentelemetry.io/collector/config/configcompression v0.93.0
 	go.opentelemetry.io/collector/config/confighttp v0.93.0
 	go.opentelemetry.io/collector/config/configopaque v0.93.0
@@ -14,6 +15,8 @@ require (
 	go.opentelemetry.io/collector/confmap v0.93.0
 	go.opentelemetry.io/collector/consumer v0.93.0
 	go.opentelemetry.io/collector/exporter v0.93.0
+	go.opentelemetry.io/collector/extension/auth v0.93.0
+	go.opentelemetry.io/collector/featuregate v1.0.1
 	go.opentelemetry.io/collector/pdata v1.0.1
 	go.opentelemetry.io/otel/metric v1.22.0
 	go.opentelemetry.io/otel/trace v1.22.0
@@ -54,12 +57,10 @@ require (
 	github.com/prometheus/common v0.46.0 // indirect
 	github.com/prometheus/procfs v0.12.0 // indirect
 	github.com/rs/cors v1.10.1 // indirect
-	go.opentelemetry.io/collector/config/configauth v0.93.0 // indirect
+	go.opentelemetry.io/collector/config/confignet v0.93.0 // indirect
 	go.opentelemetry.io/collector/config/configtelemetry v0.93.0 // indirect
 	go.opentelemetry.io/collector/config/internal v0.93.0 // indirect
 	go.opentelemetry.io/collector/extension v0.93.0 // indirect
-	go.opentelemetry.io/collector/extension/auth v0.93.0 // indirect
-	go.opentelemetry.io/collector/featuregate v1.0.1 // indirect
 	go.opentelemetry.io/collector/receiver v0.93.0 // indirect
 	go.opentelemetry.io/contrib/config v0.2.0 // indirect
 	go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp v0.47.0 // indirect
@@ -88,6 +89,8 @@ replace go.opentelemetry.io/collector/config/configauth => ../../config/configau
 
 replace go.opentelemetry.io/collector/config/configcompression => ../../config/configcompression
 
+replace go.opentelemetry.io/collector/config/confignet => ../../config/confignet
+
 replace go.opentelemetry.io/collector/config/confighttp => ../../config/confighttp
 
 replace go.opentelemetry.io/collector/config/configopaque => ../../config/configopaque
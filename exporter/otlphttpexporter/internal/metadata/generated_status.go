@@ -10,10 +10,11 @@ import (
 )
 
 const (
-	Type             = "otlphttp"
-	LogsStability    = component.StabilityLevelBeta
-	TracesStability  = component.StabilityLevelStable
-	MetricsStability = component.StabilityLevelStable
+	Type              = "otlphttp"
+	LogsStability     = component.StabilityLevelBeta
+	ProfilesStability = component.StabilityLevelDevelopment
+	TracesStability   = component.StabilityLevelStable
+	MetricsStability  = component.StabilityLevelStable
 )
 
 func Meter(settings component.TelemetrySettings) metric.Meter {
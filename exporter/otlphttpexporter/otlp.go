@@ -19,27 +19,32 @@ import (
 	"google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/protobuf/proto"
 
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/exporter/internal/payloadsummary"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 )
 
 type baseExporter struct {
 	// Input configuration.
-	config     *Config
-	client     *http.Client
-	tracesURL  string
-	metricsURL string
-	logsURL    string
-	logger     *zap.Logger
-	settings   component.TelemetrySettings
+	config      *Config
+	client      *http.Client
+	tracesURL   string
+	metricsURL  string
+	logsURL     string
+	profilesURL string
+	logger      *zap.Logger
+	settings    component.TelemetrySettings
 	// Default user-agent header.
 	userAgent string
 }
@@ -77,7 +82,14 @@ func newExporter(cfg component.Config, set exporter.CreateSettings) (*baseExport
 // start actually creates the HTTP client. The client construction is deferred till this point as this
 // is the only place we get hold of Extensions which are required to construct auth round tripper.
 func (e *baseExporter) start(_ context.Context, host component.Host) error {
-	client, err := e.config.HTTPClientConfig.ToClient(host, e.settings)
+	clientConfig := e.config.HTTPClientConfig
+	if e.config.Signer != nil {
+		var err error
+		if clientConfig, host, err = withSigner(clientConfig, host, *e.config.Signer); err != nil {
+			return err
+		}
+	}
+	client, err := clientConfig.ToClient(host, e.settings)
 	if err != nil {
 		return err
 	}
@@ -86,6 +98,9 @@ func (e *baseExporter) start(_ context.Context, host component.Host) error {
 }
 
 func (e *baseExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
+	if payloadsummary.LogTraces(e.logger, td) {
+		return nil
+	}
 	tr := ptraceotlp.NewExportRequestFromTraces(td)
 	request, err := tr.MarshalProto()
 	if err != nil {
@@ -96,6 +111,9 @@ func (e *baseExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
 }
 
 func (e *baseExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	if payloadsummary.LogMetrics(e.logger, md) {
+		return nil
+	}
 	tr := pmetricotlp.NewExportRequestFromMetrics(md)
 	request, err := tr.MarshalProto()
 	if err != nil {
@@ -105,6 +123,9 @@ func (e *baseExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) erro
 }
 
 func (e *baseExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	if payloadsummary.LogLogs(e.logger, ld) {
+		return nil
+	}
 	tr := plogotlp.NewExportRequestFromLogs(ld)
 	request, err := tr.MarshalProto()
 	if err != nil {
@@ -114,6 +135,19 @@ func (e *baseExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
 	return e.export(ctx, e.logsURL, request, logsPartialSuccessHandler)
 }
 
+func (e *baseExporter) pushProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	if payloadsummary.LogProfiles(e.logger, pd) {
+		return nil
+	}
+	tr := pprofileotlp.NewExportRequestFromProfiles(pd)
+	request, err := tr.MarshalProto()
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+
+	return e.export(ctx, e.profilesURL, request, profilesPartialSuccessHandler)
+}
+
 func (e *baseExporter) export(ctx context.Context, url string, request []byte, partialSuccessHandler partialSuccessHandler) error {
 	e.logger.Debug("Preparing to make HTTP request", zap.String("url", url))
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(request))
@@ -122,6 +156,14 @@ func (e *baseExporter) export(ctx context.Context, url string, request []byte, p
 	}
 	req.Header.Set("Content-Type", protobufContentType)
 	req.Header.Set("User-Agent", e.userAgent)
+	if len(e.config.Propagate.MetadataKeys) > 0 || len(e.config.Propagate.AuthAttributes) > 0 {
+		// The headers set by the exporter and the configured ones take precedence over the propagated ones.
+		for key, vals := range client.ToOutgoingMetadata(ctx) {
+			if req.Header.Get(key) == "" {
+				req.Header[http.CanonicalHeaderKey(key)] = vals
+			}
+		}
+	}
 
 	resp, err := e.client.Do(req)
 	if err != nil {
@@ -306,3 +348,19 @@ func logsPartialSuccessHandler(protoBytes []byte, contentType string) error {
 	}
 	return nil
 }
+
+func profilesPartialSuccessHandler(protoBytes []byte, contentType string) error {
+	if contentType != protobufContentType {
+		return nil
+	}
+	exportResponse := pprofileotlp.NewExportResponse()
+	err := exportResponse.UnmarshalProto(protoBytes)
+	if err != nil {
+		return fmt.Errorf("error parsing protobuf response: %w", err)
+	}
+	partialSuccess := exportResponse.PartialSuccess()
+	if !(partialSuccess.ErrorMessage() == "" && partialSuccess.RejectedProfiles() == 0) {
+		return consumererror.NewPermanent(fmt.Errorf("OTLP partial success: %s (%d rejected)", partialSuccess.ErrorMessage(), partialSuccess.RejectedProfiles()))
+	}
+	return nil
+}
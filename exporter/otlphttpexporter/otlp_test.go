@@ -6,6 +6,8 @@ package otlphttpexporter
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -21,12 +23,19 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/exporter/internal/payloadsummary"
+	"go.opentelemetry.io/collector/extension/auth"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -600,6 +609,216 @@ func TestPartialSuccess_metrics(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestRequestSigner(t *testing.T) {
+	signerID := component.NewID("signer")
+	authID := component.NewID("auth")
+	srv := createBackend("/v1/traces", func(writer http.ResponseWriter, request *http.Request) {
+		// The signature covers the compressed payload and the headers set by the authenticator.
+		body, err := io.ReadAll(request.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "gzip", request.Header.Get("Content-Encoding"))
+		assert.Equal(t, "token", request.Header.Get("Authorization"))
+		assert.Equal(t, signature(request.Header.Get("Authorization"), body), request.Header.Get("X-Signature"))
+		writer.WriteHeader(200)
+	})
+	defer srv.Close()
+
+	tests := []struct {
+		name     string
+		ext      map[component.ID]component.Component
+		startErr string
+		sendErr  string
+	}{
+		{
+			name: "signed",
+			ext: map[component.ID]component.Component{
+				signerID: auth.NewRequestSigner(auth.WithSignRequest(func(req *http.Request, body []byte) error {
+					req.Header.Set("X-Signature", signature(req.Header.Get("Authorization"), body))
+					return nil
+				})),
+				authID: auth.NewClient(auth.WithClientRoundTripper(func(base http.RoundTripper) (http.RoundTripper, error) {
+					return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						req = req.Clone(req.Context())
+						req.Header.Set("Authorization", "token")
+						return base.RoundTrip(req)
+					}), nil
+				})),
+			},
+		},
+		{
+			name: "sign_error",
+			ext: map[component.ID]component.Component{
+				signerID: auth.NewRequestSigner(auth.WithSignRequest(func(*http.Request, []byte) error {
+					return errors.New("no credentials")
+				})),
+			},
+			sendErr: "failed to sign the HTTP request: no credentials",
+		},
+		{
+			name:     "not_found",
+			startErr: `failed to resolve request signer "signer": extension not found`,
+		},
+		{
+			name: "not_a_signer",
+			ext: map[component.ID]component.Component{
+				signerID: auth.NewClient(),
+			},
+			startErr: `extension "signer" is not a request signer`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				TracesEndpoint: fmt.Sprintf("%s/v1/traces", srv.URL),
+				HTTPClientConfig: confighttp.HTTPClientConfig{
+					Compression: configcompression.Gzip,
+				},
+				Signer: &signerID,
+			}
+			if _, ok := tt.ext[authID]; ok {
+				cfg.Auth = &configauth.Authentication{AuthenticatorID: authID}
+			}
+			exp, err := createTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				require.NoError(t, exp.Shutdown(context.Background()))
+			})
+
+			err = exp.Start(context.Background(), &mockHost{Host: componenttest.NewNopHost(), ext: tt.ext})
+			if tt.startErr != "" {
+				assert.EqualError(t, err, tt.startErr)
+				return
+			}
+			require.NoError(t, err)
+
+			err = exp.ConsumeTraces(context.Background(), ptrace.NewTraces())
+			if tt.sendErr != "" {
+				assert.ErrorContains(t, err, tt.sendErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func signature(token string, body []byte) string {
+	sum := sha256.Sum256(append([]byte(token), body...))
+	return hex.EncodeToString(sum[:])
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestMirrors(t *testing.T) {
+	received := make(chan string, 2)
+	srv := createBackend("/v1/logs", func(writer http.ResponseWriter, request *http.Request) {
+		received <- "primary"
+		writer.WriteHeader(200)
+	})
+	defer srv.Close()
+	mirrorSrv := createBackend("/v1/logs", func(writer http.ResponseWriter, request *http.Request) {
+		received <- "mirror"
+		writer.WriteHeader(200)
+	})
+	defer mirrorSrv.Close()
+	failingSrv := createBackend("/v1/logs", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusBadRequest)
+	})
+	defer failingSrv.Close()
+
+	cfg := &Config{
+		HTTPClientConfig: confighttp.HTTPClientConfig{
+			Endpoint: srv.URL,
+		},
+		Mirrors: []MirrorConfig{
+			{Endpoint: mirrorSrv.URL},
+			{Endpoint: failingSrv.URL},
+		},
+	}
+	exp, err := createLogsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, exp.Shutdown(context.Background()))
+	})
+
+	// The failing mirror does not fail the request.
+	require.NoError(t, exp.ConsumeLogs(context.Background(), plog.NewLogs()))
+	assert.ElementsMatch(t, []string{"primary", "mirror"}, []string{<-received, <-received})
+}
+
+func TestPropagation(t *testing.T) {
+	received := make(chan http.Header, 1)
+	srv := createBackend("/v1/logs", func(writer http.ResponseWriter, request *http.Request) {
+		received <- request.Header
+		writer.WriteHeader(200)
+	})
+	defer srv.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = srv.URL
+	cfg.Headers = map[string]configopaque.String{"header": "header-value"}
+	cfg.Propagate.MetadataKeys = []string{"X-Tenant", "header"}
+	cfg.Propagate.AuthAttributes = []string{"subject"}
+	exp, err := createLogsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, exp.Shutdown(context.Background()))
+	})
+
+	// The selected client info is sent once the data is dequeued.
+	ctx := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"X-Tenant": {"tenant"}, "header": {"other"}, "Other": {"other"}}),
+		Auth:     client.NewAuthData(map[string]any{"subject": "user", "token": "secret"}),
+	})
+	require.NoError(t, exp.ConsumeLogs(ctx, plog.NewLogs()))
+	header := <-received
+	assert.Equal(t, "tenant", header.Get("X-Tenant"))
+	assert.Equal(t, "user", header.Get("Subject"))
+	assert.Equal(t, "header-value", header.Get("Header"))
+	assert.Empty(t, header.Get("Other"))
+	assert.Empty(t, header.Get("Token"))
+}
+
+func TestDryRun(t *testing.T) {
+	gate := payloadsummary.DryRunFeatureGate
+	require.NoError(t, featuregate.GlobalRegistry().Set(gate.ID(), true))
+	defer func() {
+		require.NoError(t, featuregate.GlobalRegistry().Set(gate.ID(), false))
+	}()
+
+	srv := createBackend("/v1/traces", func(writer http.ResponseWriter, request *http.Request) {
+		t.Error("the payload must not be sent in dry-run mode")
+		writer.WriteHeader(200)
+	})
+	defer srv.Close()
+
+	cfg := &Config{
+		TracesEndpoint: fmt.Sprintf("%s/v1/traces", srv.URL),
+	}
+	exp, err := createTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, exp.Shutdown(context.Background()))
+	})
+
+	assert.NoError(t, exp.ConsumeTraces(context.Background(), ptrace.NewTraces()))
+}
+
+type mockHost struct {
+	component.Host
+	ext map[component.ID]component.Component
+}
+
+func (nh *mockHost) GetExtensions() map[component.ID]component.Component {
+	return nh.ext
+}
+
 func createBackend(endpoint string, handler func(writer http.ResponseWriter, request *http.Request)) *httptest.Server {
 	mux := http.NewServeMux()
 	mux.HandleFunc(endpoint, handler)
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlphttpexporter // import "go.opentelemetry.io/collector/exporter/otlphttpexporter"
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/extension/auth"
+)
+
+// withSigner returns the client settings and the host creating an HTTP client which signs the requests with the
+// signer. The signer is installed as the client authenticator, whose RoundTripper is the innermost one, so that the
+// requests are signed as they are sent: after being compressed, and after the configured headers and the configured
+// authenticator modified them.
+func withSigner(cfg confighttp.HTTPClientConfig, host component.Host, signerID component.ID) (confighttp.HTTPClientConfig, component.Host, error) {
+	ext, found := host.GetExtensions()[signerID]
+	if !found {
+		return cfg, nil, fmt.Errorf("failed to resolve request signer %q: extension not found", signerID)
+	}
+	signer, ok := ext.(auth.RequestSigner)
+	if !ok {
+		return cfg, nil, fmt.Errorf("extension %q is not a request signer", signerID)
+	}
+
+	var authenticator auth.Client
+	if cfg.Auth != nil {
+		var err error
+		if authenticator, err = cfg.Auth.GetClientAuthenticator(host.GetExtensions()); err != nil {
+			return cfg, nil, err
+		}
+	}
+	extensions := make(map[component.ID]component.Component, len(host.GetExtensions()))
+	for id, ext := range host.GetExtensions() {
+		extensions[id] = ext
+	}
+	extensions[signerID] = auth.NewClient(auth.WithClientRoundTripper(func(base http.RoundTripper) (http.RoundTripper, error) {
+		rt := http.RoundTripper(&signingRoundTripper{signer: signer, base: base})
+		if authenticator == nil {
+			return rt, nil
+		}
+		return authenticator.RoundTripper(rt)
+	}))
+	cfg.Auth = &configauth.Authentication{AuthenticatorID: signerID}
+	return cfg, &signingHost{Host: host, extensions: extensions}, nil
+}
+
+// signingHost exposes the signing client authenticator in place of the signer.
+type signingHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *signingHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+// signingRoundTripper signs the requests with the payload sent on the wire.
+type signingRoundTripper struct {
+	signer auth.RequestSigner
+	base   http.RoundTripper
+}
+
+func (rt *signingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// A RoundTripper must not modify the request it is given.
+	signed := req.Clone(req.Context())
+	signed.Body = io.NopCloser(bytes.NewReader(body))
+	signed.ContentLength = int64(len(body))
+	signed.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	if err := rt.signer.SignRequest(signed, body); err != nil {
+		return nil, fmt.Errorf("failed to sign the HTTP request: %w", err)
+	}
+	return rt.base.RoundTrip(signed)
+}
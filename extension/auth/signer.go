@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth // import "go.opentelemetry.io/collector/extension/auth"
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+)
+
+// RequestSigner is an Extension that signs or mutates outgoing HTTP requests right before they are sent,
+// for instance to compute AWS SigV4, OAuth or HMAC headers that depend on the request payload.
+type RequestSigner interface {
+	extension.Extension
+
+	// SignRequest signs the request. The body is the payload of the request as sent on the wire, after
+	// compression, it must not be modified and the request body must not be consumed.
+	SignRequest(req *http.Request, body []byte) error
+}
+
+// RequestSignerOption represents the possible options for NewRequestSigner.
+type RequestSignerOption func(*defaultRequestSigner)
+
+// SignRequestFunc specifies the function that signs an outgoing HTTP request.
+type SignRequestFunc func(req *http.Request, body []byte) error
+
+// SignRequest signs the request by calling f with the request and its payload, if f is not nil.
+func (f SignRequestFunc) SignRequest(req *http.Request, body []byte) error {
+	if f == nil {
+		return nil
+	}
+	return f(req, body)
+}
+
+type defaultRequestSigner struct {
+	component.StartFunc
+	component.ShutdownFunc
+	SignRequestFunc
+}
+
+// WithRequestSignerStart overrides the default `Start` function for a component.Component.
+// The default always returns nil.
+func WithRequestSignerStart(startFunc component.StartFunc) RequestSignerOption {
+	return func(o *defaultRequestSigner) {
+		o.StartFunc = startFunc
+	}
+}
+
+// WithRequestSignerShutdown overrides the default `Shutdown` function for a component.Component.
+// The default always returns nil.
+func WithRequestSignerShutdown(shutdownFunc component.ShutdownFunc) RequestSignerOption {
+	return func(o *defaultRequestSigner) {
+		o.ShutdownFunc = shutdownFunc
+	}
+}
+
+// WithSignRequest provides a `SignRequest` function for this request signer.
+// The default leaves the request unmodified.
+func WithSignRequest(signRequestFunc SignRequestFunc) RequestSignerOption {
+	return func(o *defaultRequestSigner) {
+		o.SignRequestFunc = signRequestFunc
+	}
+}
+
+// NewRequestSigner returns a RequestSigner configured with the provided options.
+func NewRequestSigner(options ...RequestSignerOption) RequestSigner {
+	rs := &defaultRequestSigner{}
+
+	for _, op := range options {
+		op(rs)
+	}
+
+	return rs
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func TestRequestSignerDefaultValues(t *testing.T) {
+	e := NewRequestSigner()
+
+	t.Run("start", func(t *testing.T) {
+		assert.NoError(t, e.Start(context.Background(), componenttest.NewNopHost()))
+	})
+
+	t.Run("sign-request", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "http://localhost", nil)
+		require.NoError(t, err)
+		assert.NoError(t, e.SignRequest(req, nil))
+		assert.Empty(t, req.Header)
+	})
+
+	t.Run("shutdown", func(t *testing.T) {
+		assert.NoError(t, e.Shutdown(context.Background()))
+	})
+}
+
+func TestWithRequestSignerStartAndShutdown(t *testing.T) {
+	started, stopped := false, false
+	e := NewRequestSigner(
+		WithRequestSignerStart(func(context.Context, component.Host) error {
+			started = true
+			return nil
+		}),
+		WithRequestSignerShutdown(func(context.Context) error {
+			stopped = true
+			return nil
+		}),
+	)
+
+	assert.NoError(t, e.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, e.Shutdown(context.Background()))
+	assert.True(t, started)
+	assert.True(t, stopped)
+}
+
+func TestWithSignRequest(t *testing.T) {
+	errSign := errors.New("no credentials")
+	e := NewRequestSigner(WithSignRequest(func(req *http.Request, body []byte) error {
+		if len(body) == 0 {
+			return errSign
+		}
+		req.Header.Set("X-Signature", string(body))
+		return nil
+	}))
+
+	req, err := http.NewRequest(http.MethodPost, "http://localhost", nil)
+	require.NoError(t, err)
+	assert.NoError(t, e.SignRequest(req, []byte("payload")))
+	assert.Equal(t, "payload", req.Header.Get("X-Signature"))
+	assert.ErrorIs(t, e.SignRequest(req, nil), errSign)
+}
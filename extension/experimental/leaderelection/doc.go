@@ -0,0 +1,6 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package leaderelection defines the interface of the extensions electing a leader
+// among the replicas of a collector, so only one of them performs some work.
+package leaderelection // import "go.opentelemetry.io/collector/extension/experimental/leaderelection"
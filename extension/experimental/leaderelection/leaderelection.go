@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leaderelection // import "go.opentelemetry.io/collector/extension/experimental/leaderelection"
+
+import (
+	"go.opentelemetry.io/collector/extension"
+)
+
+// Extension is the interface that leader election extensions must implement.
+type Extension interface {
+	extension.Extension
+
+	// IsLeader returns true if the collector currently holds the leadership.
+	IsLeader() bool
+
+	// Subscribe registers a callback called with the current leadership of the collector,
+	// and then every time it changes. The callback must not block.
+	// The returned function unregisters the callback.
+	Subscribe(callback func(isLeader bool)) (unsubscribe func())
+}
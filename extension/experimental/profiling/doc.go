@@ -0,0 +1,6 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package profiling defines the interface of the extensions capturing profiles of the collector on demand,
+// so the captured profiles can be sent through a profiles pipeline.
+package profiling // import "go.opentelemetry.io/collector/extension/experimental/profiling"
@@ -0,0 +1,20 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profiling // import "go.opentelemetry.io/collector/extension/experimental/profiling"
+
+import (
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension"
+)
+
+// Extension is the interface that profiling extensions must implement.
+type Extension interface {
+	extension.Extension
+
+	// RegisterConsumer registers the consumer the profiles captured for a pipeline are sent to,
+	// on behalf of the component with the given ID, usually a receiver.
+	// The returned function unregisters the consumer.
+	RegisterConsumer(id component.ID, next consumer.Profiles) (unregister func())
+}
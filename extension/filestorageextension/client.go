@@ -0,0 +1,369 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorageextension // import "go.opentelemetry.io/collector/extension/filestorageextension"
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+var defaultBucket = []byte(`default`)
+
+var errQuotaExceeded = errors.New("storage quota exceeded")
+
+// fileStorageClient stores the data of a client in a bbolt file.
+type fileStorageClient struct {
+	logger     *zap.Logger
+	path       string
+	options    *bbolt.Options
+	aead       cipher.AEAD
+	maxSize    int64
+	compaction CompactionConfig
+
+	// mu guards db, which is reopened by the compactions.
+	mu sync.RWMutex
+	db *bbolt.DB
+
+	// writeMu serializes the writes, so that size matches the content of the file.
+	writeMu sync.Mutex
+	// size is the total size of the stored keys and values.
+	size int64
+
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+var _ storage.Client = (*fileStorageClient)(nil)
+
+func newFileStorageClient(logger *zap.Logger, path string, key []byte, cfg *Config) (*fileStorageClient, error) {
+	c := &fileStorageClient{
+		logger:     logger,
+		path:       path,
+		options:    &bbolt.Options{Timeout: cfg.Timeout, NoSync: !cfg.FSync},
+		maxSize:    cfg.Quota.MaxSizeMiB << 20,
+		compaction: cfg.Compaction,
+		done:       make(chan struct{}),
+	}
+	if key != nil {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		if c.aead, err = cipher.NewGCM(block); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := bbolt.Open(path, 0600, c.options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %q: %w", path, err)
+	}
+	c.db = db
+	if err = c.init(); err != nil {
+		_ = c.db.Close()
+		return nil, err
+	}
+
+	if cfg.Compaction.Interval > 0 {
+		c.wg.Add(1)
+		go c.compactPeriodically(cfg.Compaction.Interval)
+	}
+	return c, nil
+}
+
+// init creates the bucket of the client, compacts the file if configured and computes the size of the stored data.
+func (c *fileStorageClient) init() error {
+	if err := c.db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(defaultBucket)
+		return err
+	}); err != nil {
+		return err
+	}
+	if c.compaction.OnStart {
+		if err := c.compact(); err != nil {
+			return fmt.Errorf("failed to compact %q: %w", c.path, err)
+		}
+	}
+	return c.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(defaultBucket).ForEach(func(k, v []byte) error {
+			c.size += entrySize(k, v)
+			return nil
+		})
+	})
+}
+
+// Get returns the value of the key, or nil if it is not found.
+func (c *fileStorageClient) Get(ctx context.Context, key string) ([]byte, error) {
+	op := storage.GetOperation(key)
+	err := c.Batch(ctx, op)
+	return op.Value, err
+}
+
+// Set stores the value of the key.
+func (c *fileStorageClient) Set(ctx context.Context, key string, value []byte) error {
+	return c.Batch(ctx, storage.SetOperation(key, value))
+}
+
+// Delete deletes the key.
+func (c *fileStorageClient) Delete(ctx context.Context, key string) error {
+	return c.Batch(ctx, storage.DeleteOperation(key))
+}
+
+// Batch executes the operations in a single transaction. The transaction is rolled back if any of the operations
+// fails, or if the writes would exceed the quota of the client.
+func (c *fileStorageClient) Batch(ctx context.Context, ops ...storage.Operation) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	writes := false
+	for _, op := range ops {
+		if op.Type != storage.Get {
+			writes = true
+			break
+		}
+	}
+	if !writes {
+		return c.db.View(func(tx *bbolt.Tx) error {
+			bucket := tx.Bucket(defaultBucket)
+			for _, op := range ops {
+				var err error
+				if op.Value, err = c.decrypt(op.Key, bucket.Get([]byte(op.Key))); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	var delta int64
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(defaultBucket)
+		for _, op := range ops {
+			key := []byte(op.Key)
+			var err error
+			switch op.Type {
+			case storage.Get:
+				op.Value, err = c.decrypt(op.Key, bucket.Get(key))
+			case storage.Set:
+				var value []byte
+				if value, err = c.encrypt(op.Key, op.Value); err != nil {
+					break
+				}
+				if old := bucket.Get(key); old != nil {
+					delta -= entrySize(key, old)
+				}
+				delta += entrySize(key, value)
+				err = bucket.Put(key, value)
+			case storage.Delete:
+				if old := bucket.Get(key); old != nil {
+					delta -= entrySize(key, old)
+				}
+				err = bucket.Delete(key)
+			default:
+				err = errors.New("wrong operation type")
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if c.maxSize > 0 && delta > 0 && c.size+delta > c.maxSize {
+			return errQuotaExceeded
+		}
+		return nil
+	})
+	if err == nil {
+		c.size += delta
+	}
+	return err
+}
+
+// Close stops the compactions and closes the file.
+func (c *fileStorageClient) Close(context.Context) error {
+	c.closeOnce.Do(func() { close(c.done) })
+	c.wg.Wait()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.db.Close()
+}
+
+func entrySize(key, value []byte) int64 {
+	return int64(len(key) + len(value))
+}
+
+// encrypt returns the value to store for the key: the nonce followed by the sealed value, authenticated with the key
+// so that values cannot be swapped between keys.
+func (c *fileStorageClient) encrypt(key string, value []byte) ([]byte, error) {
+	if c.aead == nil {
+		return value, nil
+	}
+	nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(value)+c.aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return c.aead.Seal(nonce, nonce, value, []byte(key)), nil
+}
+
+// decrypt returns a copy of the value stored for the key, which is only valid during the transaction.
+func (c *fileStorageClient) decrypt(key string, stored []byte) ([]byte, error) {
+	if stored == nil {
+		return nil, nil
+	}
+	if c.aead == nil {
+		return append([]byte{}, stored...), nil
+	}
+	nonceSize := c.aead.NonceSize()
+	if len(stored) < nonceSize {
+		return nil, fmt.Errorf("failed to decrypt the value of key %q: value too short", key)
+	}
+	value, err := c.aead.Open([]byte{}, stored[:nonceSize], stored[nonceSize:], []byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt the value of key %q: %w", key, err)
+	}
+	return value, nil
+}
+
+// compactPeriodically compacts the file when enough space can be reclaimed, until the client is closed.
+func (c *fileStorageClient) compactPeriodically(interval time.Duration) {
+	defer c.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			c.mu.RLock()
+			reclaimable := c.reclaimable()
+			c.mu.RUnlock()
+			if reclaimable == 0 || reclaimable < c.compaction.MinReclaimableMiB<<20 {
+				continue
+			}
+			if err := c.compact(); err != nil {
+				c.logger.Warn("Failed to compact the storage file", zap.Error(err))
+				continue
+			}
+			c.logger.Debug("Compacted the storage file", zap.Int64("reclaimed_bytes", reclaimable))
+		}
+	}
+}
+
+// reclaimable returns the size of the free pages of the file.
+func (c *fileStorageClient) reclaimable() int64 {
+	stats := c.db.Stats()
+	var pageSize int
+	// The file cannot be remapped during a read transaction.
+	_ = c.db.View(func(tx *bbolt.Tx) error {
+		pageSize = tx.DB().Info().PageSize
+		return nil
+	})
+	return int64(stats.FreePageN+stats.PendingPageN) * int64(pageSize)
+}
+
+// compact copies the data to a new file, which replaces the current one.
+// The operations are blocked during the compaction.
+func (c *fileStorageClient) compact() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	dir := c.compaction.Directory
+	if dir == "" {
+		dir = filepath.Dir(c.path)
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(c.path)+".compact.*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	compacted, err := bbolt.Open(tmpPath, 0600, c.options)
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err = bbolt.Compact(compacted, c.db, c.compaction.MaxTransactionSize); err != nil {
+		_ = compacted.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err = compacted.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err = c.db.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	// The current file is reopened if it could not be replaced.
+	moveErr := moveFile(tmpPath, c.path)
+	if moveErr != nil {
+		_ = os.Remove(tmpPath)
+	}
+	db, err := bbolt.Open(c.path, 0600, c.options)
+	if err != nil {
+		return fmt.Errorf("failed to reopen %q: %w", c.path, err)
+	}
+	c.db = db
+	return moveErr
+}
+
+// moveFile renames src to dst, or copies it next to dst first if they are on different file systems.
+func moveFile(src, dst string) error {
+	if os.Rename(src, dst) == nil {
+		return nil
+	}
+	tmpDst := dst + ".tmp"
+	if err := copyFile(src, tmpDst); err != nil {
+		_ = os.Remove(tmpDst)
+		return err
+	}
+	if err := os.Rename(tmpDst, dst); err != nil {
+		_ = os.Remove(tmpDst)
+		return err
+	}
+	return os.Remove(src)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	if err = out.Sync(); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
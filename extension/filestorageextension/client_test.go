@@ -0,0 +1,220 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorageextension
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+const testKey = "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+
+func newTestClient(t *testing.T, path string, cfg *Config) *fileStorageClient {
+	key, err := cfg.Encryption.key()
+	require.NoError(t, err)
+	client, err := newFileStorageClient(zap.NewNop(), path, key, cfg)
+	require.NoError(t, err)
+	return client
+}
+
+func TestClientOperations(t *testing.T) {
+	ctx := context.Background()
+	cfg := createDefaultConfig().(*Config)
+	path := filepath.Join(t.TempDir(), "client")
+	client := newTestClient(t, path, cfg)
+
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	require.NoError(t, client.Set(ctx, "empty", []byte{}))
+	value, err = client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+	value, err = client.Get(ctx, "empty")
+	require.NoError(t, err)
+	assert.NotNil(t, value)
+
+	ops := []storage.Operation{
+		storage.SetOperation("batch", []byte("batched")),
+		storage.GetOperation("batch"),
+		storage.DeleteOperation("key"),
+		storage.GetOperation("key"),
+	}
+	require.NoError(t, client.Batch(ctx, ops...))
+	assert.Equal(t, []byte("batched"), ops[1].Value)
+	assert.Nil(t, ops[3].Value)
+
+	require.NoError(t, client.Delete(ctx, "batch"))
+	require.NoError(t, client.Delete(ctx, "missing"))
+	require.NoError(t, client.Close(ctx))
+	assert.Error(t, client.Set(ctx, "key", []byte("value")))
+
+	// The data is persisted.
+	client = newTestClient(t, path, cfg)
+	value, err = client.Get(ctx, "empty")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{}, value)
+	require.NoError(t, client.Close(ctx))
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = client.Get(cancelled, "key")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestClientEncryption(t *testing.T) {
+	ctx := context.Background()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Encryption.Key = testKey
+	path := filepath.Join(t.TempDir(), "client")
+	client := newTestClient(t, path, cfg)
+	require.NoError(t, client.Set(ctx, "key", []byte("secret value")))
+	require.NoError(t, client.Set(ctx, "empty", nil))
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret value"), value)
+	value, err = client.Get(ctx, "empty")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{}, value)
+	require.NoError(t, client.Close(ctx))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "secret value")
+
+	// The values cannot be read without the key, nor moved to another key.
+	cfg.Encryption.Key = ""
+	client = newTestClient(t, path, cfg)
+	stored, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	require.NoError(t, client.Set(ctx, "other", stored))
+	require.NoError(t, client.Close(ctx))
+
+	cfg.Encryption.Key = configopaque.String("0f" + testKey[2:])
+	client = newTestClient(t, path, cfg)
+	_, err = client.Get(ctx, "key")
+	assert.ErrorContains(t, err, `failed to decrypt the value of key "key"`)
+	require.NoError(t, client.Close(ctx))
+
+	cfg.Encryption.Key = testKey
+	client = newTestClient(t, path, cfg)
+	_, err = client.Get(ctx, "other")
+	assert.ErrorContains(t, err, `failed to decrypt the value of key "other"`)
+	require.NoError(t, client.Close(ctx))
+}
+
+func TestClientQuota(t *testing.T) {
+	ctx := context.Background()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Quota.MaxSizeMiB = 1
+	path := filepath.Join(t.TempDir(), "client")
+	client := newTestClient(t, path, cfg)
+
+	half := make([]byte, 1<<19)
+	require.NoError(t, client.Set(ctx, "a", half))
+	assert.ErrorIs(t, client.Set(ctx, "b", half), errQuotaExceeded)
+	// The failed batch is rolled back.
+	assert.ErrorIs(t, client.Batch(ctx, storage.SetOperation("c", []byte("c")), storage.SetOperation("b", half)), errQuotaExceeded)
+	value, err := client.Get(ctx, "c")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	// Overwriting a value only accounts for the difference, and deletes free space.
+	require.NoError(t, client.Set(ctx, "a", half[1:]))
+	require.NoError(t, client.Batch(ctx, storage.DeleteOperation("a"), storage.SetOperation("b", half)))
+	require.NoError(t, client.Close(ctx))
+
+	// The size is restored when the file is reopened.
+	client = newTestClient(t, path, cfg)
+	assert.Equal(t, int64(1+len(half)), client.size)
+	assert.ErrorIs(t, client.Set(ctx, "c", half), errQuotaExceeded)
+	require.NoError(t, client.Close(ctx))
+}
+
+func fillAndDelete(t *testing.T, client *fileStorageClient, n int) {
+	ctx := context.Background()
+	value := make([]byte, 4096)
+	for i := 0; i < n; i++ {
+		require.NoError(t, client.Set(ctx, strconv.Itoa(i), value))
+	}
+	for i := 1; i < n; i++ {
+		require.NoError(t, client.Delete(ctx, strconv.Itoa(i)))
+	}
+}
+
+func fileSize(t *testing.T, path string) int64 {
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	return info.Size()
+}
+
+func TestClientCompactOnStart(t *testing.T) {
+	ctx := context.Background()
+	cfg := createDefaultConfig().(*Config)
+	path := filepath.Join(t.TempDir(), "client")
+	client := newTestClient(t, path, cfg)
+	fillAndDelete(t, client, 1000)
+	require.NoError(t, client.Close(ctx))
+	before := fileSize(t, path)
+
+	cfg.Compaction.OnStart = true
+	cfg.Compaction.Directory = t.TempDir()
+	client = newTestClient(t, path, cfg)
+	assert.Less(t, fileSize(t, path), before)
+	value, err := client.Get(ctx, "0")
+	require.NoError(t, err)
+	assert.Len(t, value, 4096)
+	require.NoError(t, client.Close(ctx))
+
+	entries, err := os.ReadDir(cfg.Compaction.Directory)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestClientCompactPeriodically(t *testing.T) {
+	ctx := context.Background()
+	cfg := createDefaultConfig().(*Config)
+	path := filepath.Join(t.TempDir(), "client")
+	client := newTestClient(t, path, cfg)
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	fillAndDelete(t, client, 1000)
+	require.NoError(t, client.Close(ctx))
+	before := fileSize(t, path)
+
+	cfg.Compaction.Interval = 10 * time.Millisecond
+	cfg.Compaction.MinReclaimableMiB = 1
+	client = newTestClient(t, path, cfg)
+	assert.Eventually(t, func() bool {
+		return fileSize(t, path) < before
+	}, 5*time.Second, 10*time.Millisecond)
+
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+	require.NoError(t, client.Close(ctx))
+}
+
+func TestClientLockTimeout(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Timeout = 10 * time.Millisecond
+	path := filepath.Join(t.TempDir(), "client")
+	client := newTestClient(t, path, cfg)
+	_, err := newFileStorageClient(zap.NewNop(), path, nil, cfg)
+	assert.ErrorIs(t, err, bbolt.ErrTimeout)
+	require.NoError(t, client.Close(context.Background()))
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorageextension // import "go.opentelemetry.io/collector/extension/filestorageextension"
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+)
+
+// Config defines the configuration of the file storage extension.
+type Config struct {
+	// Directory is the directory in which the files of the clients are stored. It must exist.
+	Directory string `mapstructure:"directory"`
+
+	// Timeout is the maximum time to wait for the lock on the file of a client, 0 waits indefinitely.
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// FSync syncs the files to disk after each write, at the cost of throughput.
+	FSync bool `mapstructure:"fsync"`
+
+	// Encryption configures the encryption of the stored values.
+	Encryption EncryptionConfig `mapstructure:"encryption"`
+
+	// Compaction configures the compaction of the files, which reclaims the space freed by deleted values.
+	Compaction CompactionConfig `mapstructure:"compaction"`
+
+	// Quota configures the space available to each client.
+	Quota QuotaConfig `mapstructure:"quota"`
+}
+
+// EncryptionConfig defines the encryption of the stored values with AES-GCM.
+type EncryptionConfig struct {
+	// Key is the hex-encoded AES key, of 16, 24 or 32 bytes. The values are stored unencrypted when it is not set.
+	// The files written with a key can only be read with the same key.
+	Key configopaque.String `mapstructure:"key"`
+}
+
+// CompactionConfig defines when and how the files are compacted.
+type CompactionConfig struct {
+	// OnStart compacts the file of each client when it is opened.
+	OnStart bool `mapstructure:"on_start"`
+
+	// Interval is the interval at which the files of the open clients are checked, and compacted if they have
+	// at least MinReclaimableMiB of free space. The files are not compacted online when it is 0.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// MinReclaimableMiB is the minimum free space in a file for it to be compacted online.
+	MinReclaimableMiB int64 `mapstructure:"min_reclaimable_mib"`
+
+	// Directory is the directory in which the compacted files are written before replacing the original ones.
+	// The directory of the storage is used when it is not set.
+	Directory string `mapstructure:"directory"`
+
+	// MaxTransactionSize is the maximum number of bytes copied in a single transaction during a compaction.
+	MaxTransactionSize int64 `mapstructure:"max_transaction_size"`
+}
+
+// QuotaConfig defines the space available to each client.
+type QuotaConfig struct {
+	// MaxSizeMiB is the maximum size of the keys and values stored by a client, after encryption.
+	// Writes exceeding it fail, while deletes are always possible. There is no limit when it is 0.
+	MaxSizeMiB int64 `mapstructure:"max_size_mib"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Directory == "" {
+		return errors.New("directory must be set")
+	}
+	if cfg.Timeout < 0 {
+		return errors.New("timeout must not be negative")
+	}
+	if _, err := cfg.Encryption.key(); err != nil {
+		return err
+	}
+	if cfg.Compaction.Interval < 0 {
+		return errors.New("compaction interval must not be negative")
+	}
+	if cfg.Compaction.MinReclaimableMiB < 0 {
+		return errors.New("compaction min_reclaimable_mib must not be negative")
+	}
+	if cfg.Compaction.MaxTransactionSize < 0 {
+		return errors.New("compaction max_transaction_size must not be negative")
+	}
+	if cfg.Quota.MaxSizeMiB < 0 {
+		return errors.New("quota max_size_mib must not be negative")
+	}
+	return nil
+}
+
+// key returns the decoded encryption key, or nil if the values are not encrypted.
+func (cfg EncryptionConfig) key() ([]byte, error) {
+	if cfg.Key == "" {
+		return nil, nil
+	}
+	key, err := hex.DecodeString(string(cfg.Key))
+	if err != nil {
+		return nil, errors.New("encryption key must be hex-encoded")
+	}
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	}
+	return nil, fmt.Errorf("encryption key must be 16, 24 or 32 bytes long, got %d", len(key))
+}
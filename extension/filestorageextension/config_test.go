@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorageextension
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	assert.NoError(t, component.UnmarshalConfig(confmap.New(), cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig()
+	require.NoError(t, component.UnmarshalConfig(cm, cfg))
+	assert.Equal(t, &Config{
+		Directory:  "/var/lib/otelcol/storage",
+		Timeout:    2 * time.Second,
+		FSync:      true,
+		Encryption: EncryptionConfig{Key: "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"},
+		Compaction: CompactionConfig{
+			OnStart:            true,
+			Interval:           5 * time.Minute,
+			MinReclaimableMiB:  64,
+			Directory:          "/tmp",
+			MaxTransactionSize: 1024,
+		},
+		Quota: QuotaConfig{MaxSizeMiB: 512},
+	}, cfg)
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		errMsg string
+	}{
+		{
+			name:   "no directory",
+			modify: func(cfg *Config) { cfg.Directory = "" },
+			errMsg: "directory must be set",
+		},
+		{
+			name:   "negative timeout",
+			modify: func(cfg *Config) { cfg.Timeout = -time.Second },
+			errMsg: "timeout must not be negative",
+		},
+		{
+			name:   "key not hex-encoded",
+			modify: func(cfg *Config) { cfg.Encryption.Key = "secret" },
+			errMsg: "encryption key must be hex-encoded",
+		},
+		{
+			name:   "wrong key length",
+			modify: func(cfg *Config) { cfg.Encryption.Key = "0001020304050607" },
+			errMsg: "encryption key must be 16, 24 or 32 bytes long, got 8",
+		},
+		{
+			name:   "negative compaction interval",
+			modify: func(cfg *Config) { cfg.Compaction.Interval = -time.Second },
+			errMsg: "compaction interval must not be negative",
+		},
+		{
+			name:   "negative min reclaimable",
+			modify: func(cfg *Config) { cfg.Compaction.MinReclaimableMiB = -1 },
+			errMsg: "compaction min_reclaimable_mib must not be negative",
+		},
+		{
+			name:   "negative max transaction size",
+			modify: func(cfg *Config) { cfg.Compaction.MaxTransactionSize = -1 },
+			errMsg: "compaction max_transaction_size must not be negative",
+		},
+		{
+			name:   "negative quota",
+			modify: func(cfg *Config) { cfg.Quota.MaxSizeMiB = -1 },
+			errMsg: "quota max_size_mib must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.errMsg)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorageextension // import "go.opentelemetry.io/collector/extension/filestorageextension"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+// localFileStorage stores the data of each client in its own file.
+type localFileStorage struct {
+	cfg    *Config
+	key    []byte
+	logger *zap.Logger
+}
+
+var _ storage.Extension = (*localFileStorage)(nil)
+
+func newLocalFileStorage(cfg *Config, logger *zap.Logger) (*localFileStorage, error) {
+	key, err := cfg.Encryption.key()
+	if err != nil {
+		return nil, err
+	}
+	return &localFileStorage{cfg: cfg, key: key, logger: logger}, nil
+}
+
+// Start checks that the directories of the storage exist.
+func (lfs *localFileStorage) Start(context.Context, component.Host) error {
+	if err := checkDirectory(lfs.cfg.Directory); err != nil {
+		return err
+	}
+	if lfs.cfg.Compaction.Directory != "" {
+		return checkDirectory(lfs.cfg.Compaction.Directory)
+	}
+	return nil
+}
+
+func checkDirectory(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("directory %q is not accessible: %w", dir, err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%q is not a directory", dir)
+	}
+	return nil
+}
+
+// Shutdown does nothing: the clients are closed by the components using them.
+func (lfs *localFileStorage) Shutdown(context.Context) error {
+	return nil
+}
+
+// GetClient returns a client storing its data in a file named after the component and the storage name.
+func (lfs *localFileStorage) GetClient(_ context.Context, kind component.Kind, id component.ID, storageName string) (storage.Client, error) {
+	name := strings.ToLower(kind.String()) + "_" + string(id.Type())
+	if id.Name() != "" {
+		name += "_" + id.Name()
+	}
+	if storageName != "" {
+		name += "_" + storageName
+	}
+	name = sanitize(name)
+	return newFileStorageClient(lfs.logger.With(zap.String("client", name)), filepath.Join(lfs.cfg.Directory, name), lfs.key, lfs.cfg)
+}
+
+// sanitize replaces the characters that are not safe in file names with '~' followed by their hex code.
+func sanitize(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-' || r == '.' {
+			b.WriteRune(r)
+			continue
+		}
+		fmt.Fprintf(&b, "~%04X", r)
+	}
+	return b.String()
+}
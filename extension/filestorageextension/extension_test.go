@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorageextension
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+)
+
+func newTestExtension(t *testing.T, cfg *Config) storage.Extension {
+	ext, err := NewFactory().CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, ext.Shutdown(context.Background())) })
+	return ext.(storage.Extension)
+}
+
+func TestStartInvalidDirectory(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Directory = filepath.Join(t.TempDir(), "missing")
+	ext, err := NewFactory().CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	assert.ErrorContains(t, ext.Start(context.Background(), componenttest.NewNopHost()), "is not accessible")
+
+	cfg.Directory = t.TempDir()
+	file := filepath.Join(cfg.Directory, "file")
+	require.NoError(t, os.WriteFile(file, nil, 0600))
+	cfg.Compaction.Directory = file
+	assert.ErrorContains(t, ext.Start(context.Background(), componenttest.NewNopHost()), "is not a directory")
+}
+
+func TestGetClientFiles(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	ext := newTestExtension(t, cfg)
+
+	ctx := context.Background()
+	receiver, err := ext.GetClient(ctx, component.KindReceiver, component.NewIDWithName("otlp", "in"), "")
+	require.NoError(t, err)
+	exporter, err := ext.GetClient(ctx, component.KindExporter, component.NewID("otlp"), "queue/1")
+	require.NoError(t, err)
+
+	require.NoError(t, receiver.Set(ctx, "key", []byte("receiver")))
+	require.NoError(t, exporter.Set(ctx, "key", []byte("exporter")))
+	value, err := receiver.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("receiver"), value)
+	require.NoError(t, receiver.Close(ctx))
+	require.NoError(t, exporter.Close(ctx))
+
+	assert.FileExists(t, filepath.Join(cfg.Directory, "receiver_otlp_in"))
+	assert.FileExists(t, filepath.Join(cfg.Directory, "exporter_otlp_queue~002F1"))
+}
+
+func TestSanitize(t *testing.T) {
+	assert.Equal(t, "exporter_otlp-http.2_a~0020b~002F~00E9", sanitize("exporter_otlp-http.2_a b/é"))
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorageextension // import "go.opentelemetry.io/collector/extension/filestorageextension"
+
+//go:generate mdatagen metadata.yaml
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/extension/filestorageextension/internal/metadata"
+)
+
+const (
+	defaultTimeout                      = time.Second
+	defaultCompactionMinReclaimableMiB  = 10
+	defaultCompactionMaxTransactionSize = 65536
+)
+
+// NewFactory returns a new factory for the file storage extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		createExtension,
+		metadata.ExtensionStability)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Directory: defaultDirectory(),
+		Timeout:   defaultTimeout,
+		Compaction: CompactionConfig{
+			MinReclaimableMiB:  defaultCompactionMinReclaimableMiB,
+			MaxTransactionSize: defaultCompactionMaxTransactionSize,
+		},
+	}
+}
+
+func defaultDirectory() string {
+	if runtime.GOOS == "windows" {
+		return filepath.Join(os.Getenv("ProgramData"), "Otelcol", "FileStorage")
+	}
+	return "/var/lib/otelcol/file_storage"
+}
+
+func createExtension(_ context.Context, set extension.CreateSettings, cfg component.Config) (extension.Extension, error) {
+	return newLocalFileStorage(cfg.(*Config), set.TelemetrySettings.Logger)
+}
@@ -0,0 +1,27 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorageextension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+	"go.opentelemetry.io/collector/extension/filestorageextension/internal/metadata"
+)
+
+func TestFactory(t *testing.T) {
+	factory := NewFactory()
+	assert.EqualValues(t, metadata.Type, factory.Type())
+	assert.NoError(t, componenttest.CheckConfigStruct(factory.CreateDefaultConfig()))
+
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Encryption.Key = "invalid"
+	_, err := factory.CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), cfg)
+	require.Error(t, err)
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gomemlimitextension // import "go.opentelemetry.io/collector/extension/gomemlimitextension"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines the configuration of the gomemlimit extension.
+type Config struct {
+	// LimitMiB is the soft memory limit of the Go runtime, in MiB.
+	// It has a higher precedence than LimitPercentage.
+	LimitMiB uint64 `mapstructure:"limit_mib"`
+
+	// LimitPercentage is the soft memory limit of the Go runtime, as a percentage of the total memory,
+	// which honors the cgroup limits on linux.
+	LimitPercentage uint64 `mapstructure:"limit_percentage"`
+
+	// CheckInterval is the interval at which the memory usage is checked to adjust GOGC,
+	// and at which the GC pauses are recorded.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+
+	// GOGC configures the dynamic adjustment of GOGC.
+	GOGC GOGCConfig `mapstructure:"gogc"`
+}
+
+// GOGCConfig defines how GOGC is lowered when the memory usage gets close to the limit,
+// so that the heap is collected more often before the limit is reached.
+type GOGCConfig struct {
+	// Max is the value of GOGC while the memory usage is below PressurePercentage of the limit.
+	Max int `mapstructure:"max"`
+
+	// Min is the value of GOGC when the memory usage reaches the limit.
+	// GOGC decreases linearly from Max to Min between PressurePercentage of the limit and the limit.
+	Min int `mapstructure:"min"`
+
+	// PressurePercentage is the percentage of the limit above which GOGC is lowered.
+	PressurePercentage uint64 `mapstructure:"pressure_percentage"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.LimitMiB == 0 && cfg.LimitPercentage == 0 {
+		return errors.New("either limit_mib or limit_percentage must be set")
+	}
+	if cfg.LimitPercentage > 100 {
+		return errors.New("limit_percentage is not in range 0 to 100")
+	}
+	if cfg.CheckInterval <= 0 {
+		return errors.New("check_interval must be positive")
+	}
+	if cfg.GOGC.Min <= 0 {
+		return errors.New("gogc min must be positive")
+	}
+	if cfg.GOGC.Max < cfg.GOGC.Min {
+		return errors.New("gogc max must not be lower than gogc min")
+	}
+	if cfg.GOGC.PressurePercentage == 0 || cfg.GOGC.PressurePercentage >= 100 {
+		return errors.New("gogc pressure_percentage is not in range 1 to 99")
+	}
+	return nil
+}
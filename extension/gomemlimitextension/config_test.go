@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gomemlimitextension
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	assert.NoError(t, component.UnmarshalConfig(confmap.New(), cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig()
+	require.NoError(t, component.UnmarshalConfig(cm, cfg))
+	assert.Equal(t, &Config{
+		LimitMiB:        1800,
+		LimitPercentage: 90,
+		CheckInterval:   5 * time.Second,
+		GOGC: GOGCConfig{
+			Max:                200,
+			Min:                50,
+			PressurePercentage: 80,
+		},
+	}, cfg)
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		errMsg string
+	}{
+		{
+			name:   "no limit",
+			modify: func(cfg *Config) { cfg.LimitPercentage = 0 },
+			errMsg: "either limit_mib or limit_percentage must be set",
+		},
+		{
+			name:   "limit percentage out of range",
+			modify: func(cfg *Config) { cfg.LimitPercentage = 101 },
+			errMsg: "limit_percentage is not in range 0 to 100",
+		},
+		{
+			name:   "no check interval",
+			modify: func(cfg *Config) { cfg.CheckInterval = 0 },
+			errMsg: "check_interval must be positive",
+		},
+		{
+			name:   "no min gogc",
+			modify: func(cfg *Config) { cfg.GOGC.Min = 0 },
+			errMsg: "gogc min must be positive",
+		},
+		{
+			name:   "max gogc lower than min",
+			modify: func(cfg *Config) { cfg.GOGC.Max = 10 },
+			errMsg: "gogc max must not be lower than gogc min",
+		},
+		{
+			name:   "pressure percentage out of range",
+			modify: func(cfg *Config) { cfg.GOGC.PressurePercentage = 100 },
+			errMsg: "gogc pressure_percentage is not in range 1 to 99",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.errMsg)
+		})
+	}
+}
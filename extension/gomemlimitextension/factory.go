@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gomemlimitextension // import "go.opentelemetry.io/collector/extension/gomemlimitextension"
+
+//go:generate mdatagen metadata.yaml
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/extension/gomemlimitextension/internal/metadata"
+)
+
+const (
+	defaultLimitPercentage        = 80
+	defaultCheckInterval          = time.Second
+	defaultGOGCMax                = 100
+	defaultGOGCMin                = 25
+	defaultGOGCPressurePercentage = 70
+)
+
+// NewFactory returns a new factory for the gomemlimit extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		createExtension,
+		metadata.ExtensionStability)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		LimitPercentage: defaultLimitPercentage,
+		CheckInterval:   defaultCheckInterval,
+		GOGC: GOGCConfig{
+			Max:                defaultGOGCMax,
+			Min:                defaultGOGCMin,
+			PressurePercentage: defaultGOGCPressurePercentage,
+		},
+	}
+}
+
+func createExtension(_ context.Context, set extension.CreateSettings, cfg component.Config) (extension.Extension, error) {
+	return newMemoryManager(cfg.(*Config), set.TelemetrySettings, newGoRuntime())
+}
@@ -0,0 +1,257 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gomemlimitextension // import "go.opentelemetry.io/collector/extension/gomemlimitextension"
+
+import (
+	"context"
+	"os"
+	"runtime/debug"
+	"runtime/metrics"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/internal/iruntime"
+)
+
+const (
+	scopeName    = "go.opentelemetry.io/collector/extension/gomemlimitextension"
+	metricPrefix = "extension/gomemlimit/"
+	mibBytes     = 1024 * 1024
+)
+
+// goRuntime is the access of the extension to the Go runtime, which is replaced in tests.
+type goRuntime struct {
+	getenv         func(string) string
+	totalMemory    func() (uint64, error)
+	setMemoryLimit func(int64) int64
+	setGCPercent   func(int) int
+	memoryUsage    func() uint64
+	readGCStats    func(*debug.GCStats)
+}
+
+func newGoRuntime() goRuntime {
+	return goRuntime{
+		getenv:         os.Getenv,
+		totalMemory:    iruntime.TotalMemory,
+		setMemoryLimit: debug.SetMemoryLimit,
+		setGCPercent:   debug.SetGCPercent,
+		memoryUsage:    memoryUsage,
+		readGCStats:    debug.ReadGCStats,
+	}
+}
+
+// memoryUsage returns the memory accounted for by the soft memory limit of the Go runtime.
+func memoryUsage() uint64 {
+	samples := []metrics.Sample{
+		{Name: "/memory/classes/total:bytes"},
+		{Name: "/memory/classes/heap/released:bytes"},
+	}
+	metrics.Read(samples)
+	return samples[0].Value.Uint64() - samples[1].Value.Uint64()
+}
+
+// memoryManager sets the soft memory limit of the Go runtime and lowers GOGC when the memory usage gets close to it.
+type memoryManager struct {
+	cfg    *Config
+	logger *zap.Logger
+	rt     goRuntime
+
+	limit atomic.Int64
+	gogc  atomic.Int64
+	numGC atomic.Int64
+
+	// adjustGOGC is false when GOGC is set in the environment.
+	adjustGOGC bool
+	limitSet   bool
+	prevLimit  int64
+	prevGOGC   int
+
+	pauseDuration metric.Float64Histogram
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newMemoryManager(cfg *Config, set component.TelemetrySettings, rt goRuntime) (*memoryManager, error) {
+	m := &memoryManager{
+		cfg:    cfg,
+		logger: set.Logger,
+		rt:     rt,
+	}
+	if err := m.createMetrics(set.MeterProvider.Meter(scopeName)); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *memoryManager) createMetrics(meter metric.Meter) error {
+	var errs, err error
+
+	_, err = meter.Int64ObservableGauge(
+		metricPrefix+"memory_limit",
+		metric.WithDescription("Soft memory limit of the Go runtime"),
+		metric.WithUnit("By"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(m.limit.Load())
+			return nil
+		}),
+	)
+	errs = multierr.Append(errs, err)
+
+	_, err = meter.Int64ObservableGauge(
+		metricPrefix+"gogc",
+		metric.WithDescription("Current value of GOGC"),
+		metric.WithUnit("1"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(m.gogc.Load())
+			return nil
+		}),
+	)
+	errs = multierr.Append(errs, err)
+
+	_, err = meter.Int64ObservableCounter(
+		metricPrefix+"gc_count",
+		metric.WithDescription("Number of completed GC cycles"),
+		metric.WithUnit("1"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(m.numGC.Load())
+			return nil
+		}),
+	)
+	errs = multierr.Append(errs, err)
+
+	m.pauseDuration, err = meter.Float64Histogram(
+		metricPrefix+"gc_pause_duration",
+		metric.WithDescription("Duration of the stop-the-world pauses of the GC"),
+		metric.WithUnit("s"),
+	)
+	errs = multierr.Append(errs, err)
+
+	return errs
+}
+
+// Start sets the memory limit, unless GOMEMLIMIT is set in the environment, and starts adjusting GOGC,
+// unless GOGC is set in the environment.
+func (m *memoryManager) Start(context.Context, component.Host) error {
+	if env := m.rt.getenv("GOMEMLIMIT"); env != "" {
+		m.limit.Store(m.rt.setMemoryLimit(-1))
+		m.logger.Info("GOMEMLIMIT is set in the environment, keeping its memory limit", zap.String("GOMEMLIMIT", env))
+	} else {
+		limit, err := m.computeLimit()
+		if err != nil {
+			return err
+		}
+		m.prevLimit = m.rt.setMemoryLimit(limit)
+		m.limitSet = true
+		m.limit.Store(limit)
+		m.logger.Info("Setting the memory limit of the Go runtime", zap.Int64("MiBs", limit/mibBytes))
+	}
+
+	if env := m.rt.getenv("GOGC"); env != "" {
+		m.logger.Info("GOGC is set in the environment, not adjusting it", zap.String("GOGC", env))
+		// GOGC can only be read by setting it.
+		gogc := m.rt.setGCPercent(-1)
+		m.rt.setGCPercent(gogc)
+		m.gogc.Store(int64(gogc))
+	} else {
+		m.adjustGOGC = true
+		m.prevGOGC = m.rt.setGCPercent(m.cfg.GOGC.Max)
+		m.gogc.Store(int64(m.cfg.GOGC.Max))
+	}
+
+	var stats debug.GCStats
+	m.rt.readGCStats(&stats)
+	m.numGC.Store(stats.NumGC)
+
+	m.done = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	return nil
+}
+
+// computeLimit returns the configured memory limit, in bytes.
+func (m *memoryManager) computeLimit() (int64, error) {
+	if m.cfg.LimitMiB > 0 {
+		return int64(m.cfg.LimitMiB * mibBytes), nil
+	}
+	totalMemory, err := m.rt.totalMemory()
+	if err != nil {
+		return 0, err
+	}
+	return int64(totalMemory * m.cfg.LimitPercentage / 100), nil
+}
+
+func (m *memoryManager) run() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(m.cfg.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+			m.check(context.Background())
+		}
+	}
+}
+
+// check adjusts GOGC to the memory usage and records the GC pauses since the previous check.
+func (m *memoryManager) check(ctx context.Context) {
+	if m.adjustGOGC {
+		gogc := computeGOGC(m.rt.memoryUsage(), uint64(m.limit.Load()), m.cfg.GOGC)
+		if prev := m.gogc.Load(); int64(gogc) != prev {
+			m.rt.setGCPercent(gogc)
+			m.gogc.Store(int64(gogc))
+			m.logger.Debug("Adjusted GOGC", zap.Int("GOGC", gogc), zap.Int64("previous", prev))
+		}
+	}
+
+	var stats debug.GCStats
+	m.rt.readGCStats(&stats)
+	// Only the most recent pauses are kept by the runtime.
+	n := stats.NumGC - m.numGC.Load()
+	if n > int64(len(stats.Pause)) {
+		n = int64(len(stats.Pause))
+	}
+	for i := int64(0); i < n; i++ {
+		m.pauseDuration.Record(ctx, stats.Pause[i].Seconds())
+	}
+	m.numGC.Store(stats.NumGC)
+}
+
+// computeGOGC returns cfg.Max while the usage is below the pressure percentage of the limit,
+// then decreases it linearly down to cfg.Min when the usage reaches the limit.
+func computeGOGC(usage, limit uint64, cfg GOGCConfig) int {
+	threshold := limit / 100 * cfg.PressurePercentage
+	switch {
+	case usage <= threshold:
+		return cfg.Max
+	case usage >= limit:
+		return cfg.Min
+	}
+	pressure := float64(usage-threshold) / float64(limit-threshold)
+	return cfg.Max - int(pressure*float64(cfg.Max-cfg.Min))
+}
+
+// Shutdown stops adjusting GOGC and restores the memory limit and GOGC set before the extension was started.
+func (m *memoryManager) Shutdown(context.Context) error {
+	if m.done == nil {
+		return nil
+	}
+	close(m.done)
+	m.wg.Wait()
+	if m.limitSet {
+		m.rt.setMemoryLimit(m.prevLimit)
+	}
+	if m.adjustGOGC {
+		m.rt.setGCPercent(m.prevGOGC)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gomemlimitextension
+
+import (
+	"context"
+	"errors"
+	"math"
+	"runtime/debug"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+// fakeRuntime records the settings of the extension instead of changing the Go runtime.
+type fakeRuntime struct {
+	env         map[string]string
+	totalMemory uint64
+	memoryLimit int64
+	gcPercent   int
+	usage       uint64
+	gcStats     debug.GCStats
+}
+
+func newFakeRuntime() *fakeRuntime {
+	return &fakeRuntime{env: map[string]string{}, totalMemory: 1000 * mibBytes, memoryLimit: math.MaxInt64, gcPercent: 100}
+}
+
+func (f *fakeRuntime) goRuntime() goRuntime {
+	return goRuntime{
+		getenv:      func(key string) string { return f.env[key] },
+		totalMemory: func() (uint64, error) { return f.totalMemory, nil },
+		setMemoryLimit: func(limit int64) int64 {
+			prev := f.memoryLimit
+			if limit >= 0 {
+				f.memoryLimit = limit
+			}
+			return prev
+		},
+		setGCPercent: func(percent int) int {
+			prev := f.gcPercent
+			f.gcPercent = percent
+			return prev
+		},
+		memoryUsage: func() uint64 { return f.usage },
+		readGCStats: func(stats *debug.GCStats) { *stats = f.gcStats },
+	}
+}
+
+func TestMemoryLimit(t *testing.T) {
+	rt := newFakeRuntime()
+	cfg := createDefaultConfig().(*Config)
+	m, err := newMemoryManager(cfg, componenttest.NewNopTelemetrySettings(), rt.goRuntime())
+	require.NoError(t, err)
+	require.NoError(t, m.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Equal(t, int64(800*mibBytes), rt.memoryLimit)
+	assert.Equal(t, cfg.GOGC.Max, rt.gcPercent)
+	require.NoError(t, m.Shutdown(context.Background()))
+	assert.Equal(t, int64(math.MaxInt64), rt.memoryLimit)
+	assert.Equal(t, 100, rt.gcPercent)
+
+	// The absolute limit has a higher precedence.
+	cfg.LimitMiB = 512
+	m, err = newMemoryManager(cfg, componenttest.NewNopTelemetrySettings(), rt.goRuntime())
+	require.NoError(t, err)
+	require.NoError(t, m.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Equal(t, int64(512*mibBytes), rt.memoryLimit)
+	require.NoError(t, m.Shutdown(context.Background()))
+}
+
+func TestMemoryLimitTotalMemoryError(t *testing.T) {
+	rt := newFakeRuntime()
+	goRT := rt.goRuntime()
+	goRT.totalMemory = func() (uint64, error) { return 0, errors.New("no cgroup") }
+	m, err := newMemoryManager(createDefaultConfig().(*Config), componenttest.NewNopTelemetrySettings(), goRT)
+	require.NoError(t, err)
+	assert.EqualError(t, m.Start(context.Background(), componenttest.NewNopHost()), "no cgroup")
+	assert.NoError(t, m.Shutdown(context.Background()))
+}
+
+func TestEnvironmentSettings(t *testing.T) {
+	rt := newFakeRuntime()
+	rt.env["GOMEMLIMIT"] = "300MiB"
+	rt.env["GOGC"] = "50"
+	rt.memoryLimit = 300 * mibBytes
+	rt.gcPercent = 50
+	m, err := newMemoryManager(createDefaultConfig().(*Config), componenttest.NewNopTelemetrySettings(), rt.goRuntime())
+	require.NoError(t, err)
+	require.NoError(t, m.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Equal(t, int64(300*mibBytes), rt.memoryLimit)
+	assert.Equal(t, 50, rt.gcPercent)
+
+	rt.usage = 300 * mibBytes
+	m.check(context.Background())
+	assert.Equal(t, 50, rt.gcPercent)
+	require.NoError(t, m.Shutdown(context.Background()))
+	assert.Equal(t, int64(300*mibBytes), rt.memoryLimit)
+	assert.Equal(t, 50, rt.gcPercent)
+}
+
+func TestComputeGOGC(t *testing.T) {
+	cfg := GOGCConfig{Max: 100, Min: 20, PressurePercentage: 60}
+	assert.Equal(t, 100, computeGOGC(0, 1000, cfg))
+	assert.Equal(t, 100, computeGOGC(600, 1000, cfg))
+	assert.Equal(t, 60, computeGOGC(800, 1000, cfg))
+	assert.Equal(t, 20, computeGOGC(1000, 1000, cfg))
+	assert.Equal(t, 20, computeGOGC(1200, 1000, cfg))
+}
+
+func TestCheck(t *testing.T) {
+	rt := newFakeRuntime()
+	reader := sdkmetric.NewManualReader()
+	set := componenttest.NewNopTelemetrySettings()
+	set.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	cfg := createDefaultConfig().(*Config)
+	cfg.CheckInterval = time.Hour
+	m, err := newMemoryManager(cfg, set, rt.goRuntime())
+	require.NoError(t, err)
+	rt.gcStats.NumGC = 10
+	require.NoError(t, m.Start(context.Background(), componenttest.NewNopHost()))
+
+	rt.usage = 700 * mibBytes
+	rt.gcStats.NumGC = 12
+	rt.gcStats.Pause = []time.Duration{2 * time.Millisecond, time.Millisecond, 5 * time.Millisecond}
+	m.check(context.Background())
+	assert.Equal(t, 57, rt.gcPercent)
+
+	rt.usage = 100 * mibBytes
+	m.check(context.Background())
+	assert.Equal(t, 100, rt.gcPercent)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	metrics := map[string]metricdata.Aggregation{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m.Data
+	}
+	assert.Equal(t, int64(800*mibBytes), metrics[metricPrefix+"memory_limit"].(metricdata.Gauge[int64]).DataPoints[0].Value)
+	assert.Equal(t, int64(100), metrics[metricPrefix+"gogc"].(metricdata.Gauge[int64]).DataPoints[0].Value)
+	assert.Equal(t, int64(12), metrics[metricPrefix+"gc_count"].(metricdata.Sum[int64]).DataPoints[0].Value)
+	pauses := metrics[metricPrefix+"gc_pause_duration"].(metricdata.Histogram[float64]).DataPoints[0]
+	assert.Equal(t, uint64(2), pauses.Count)
+	assert.InDelta(t, 0.003, pauses.Sum, 1e-9)
+
+	require.NoError(t, m.Shutdown(context.Background()))
+}
@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheckextension // import "go.opentelemetry.io/collector/extension/healthcheckextension"
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confignet"
+)
+
+// Config has the configuration of the health check extension.
+type Config struct {
+	// TCPAddr is the address and port on which the health endpoints are served.
+	TCPAddr confignet.TCPAddr `mapstructure:",squash"`
+
+	// LivenessPath is the path of the liveness endpoint, which fails when a component
+	// is in a permanent or fatal error state.
+	LivenessPath string `mapstructure:"liveness_path"`
+
+	// ReadinessPath is the path of the readiness endpoint, which fails when the pipelines are not running,
+	// or when a component is not in the OK state.
+	ReadinessPath string `mapstructure:"readiness_path"`
+
+	// RecoveryDuration is the duration for which a component can be in a recoverable error state,
+	// e.g. with a full sending queue, before the collector is reported as not ready.
+	RecoveryDuration time.Duration `mapstructure:"recovery_duration"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.TCPAddr.Endpoint == "" {
+		return errors.New("\"endpoint\" is required when using the \"health_check\" extension")
+	}
+	if !strings.HasPrefix(cfg.LivenessPath, "/") || !strings.HasPrefix(cfg.ReadinessPath, "/") {
+		return errors.New("liveness_path and readiness_path must start with \"/\"")
+	}
+	if cfg.LivenessPath == cfg.ReadinessPath {
+		return errors.New("liveness_path and readiness_path must be different")
+	}
+	if cfg.RecoveryDuration < 0 {
+		return errors.New("recovery_duration must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheckextension
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	assert.NoError(t, component.UnmarshalConfig(confmap.New(), cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig()
+	require.NoError(t, component.UnmarshalConfig(cm, cfg))
+	assert.Equal(t,
+		&Config{
+			TCPAddr: confignet.TCPAddr{
+				Endpoint: "localhost:8080",
+			},
+			LivenessPath:     "/health/live",
+			ReadinessPath:    "/health/ready",
+			RecoveryDuration: time.Minute,
+		}, cfg)
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		errMsg string
+	}{
+		{
+			name:   "no endpoint",
+			modify: func(cfg *Config) { cfg.TCPAddr.Endpoint = "" },
+			errMsg: "\"endpoint\" is required when using the \"health_check\" extension",
+		},
+		{
+			name:   "relative path",
+			modify: func(cfg *Config) { cfg.LivenessPath = "livez" },
+			errMsg: "liveness_path and readiness_path must start with \"/\"",
+		},
+		{
+			name:   "same paths",
+			modify: func(cfg *Config) { cfg.ReadinessPath = cfg.LivenessPath },
+			errMsg: "liveness_path and readiness_path must be different",
+		},
+		{
+			name:   "negative recovery duration",
+			modify: func(cfg *Config) { cfg.RecoveryDuration = -time.Second },
+			errMsg: "recovery_duration must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.errMsg)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheckextension // import "go.opentelemetry.io/collector/extension/healthcheckextension"
+
+//go:generate mdatagen metadata.yaml
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/extension/healthcheckextension/internal/metadata"
+)
+
+const (
+	defaultEndpoint         = "localhost:13133"
+	defaultLivenessPath     = "/livez"
+	defaultReadinessPath    = "/readyz"
+	defaultRecoveryDuration = 30 * time.Second
+)
+
+// NewFactory creates a factory for the health check extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(metadata.Type, createDefaultConfig, createExtension, metadata.ExtensionStability)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		TCPAddr: confignet.TCPAddr{
+			Endpoint: defaultEndpoint,
+		},
+		LivenessPath:     defaultLivenessPath,
+		ReadinessPath:    defaultReadinessPath,
+		RecoveryDuration: defaultRecoveryDuration,
+	}
+}
+
+func createExtension(_ context.Context, set extension.CreateSettings, cfg component.Config) (extension.Extension, error) {
+	return newHealthCheck(cfg.(*Config), set.TelemetrySettings), nil
+}
@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheckextension // import "go.opentelemetry.io/collector/extension/healthcheckextension"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+)
+
+// healthCheckExtension serves the liveness and readiness of the collector, derived from the status
+// reported by its components.
+type healthCheckExtension struct {
+	config    *Config
+	telemetry component.TelemetrySettings
+	now       func() time.Time
+
+	mu       sync.Mutex
+	statuses map[*component.InstanceID]*component.StatusEvent
+	ready    bool
+
+	server http.Server
+	stopCh chan struct{}
+}
+
+var (
+	_ extension.PipelineWatcher = (*healthCheckExtension)(nil)
+	_ extension.StatusWatcher   = (*healthCheckExtension)(nil)
+)
+
+// healthResponse is the body of the responses of the health endpoints.
+type healthResponse struct {
+	Healthy bool `json:"healthy"`
+	// Status is the aggregated status of the components.
+	Status string `json:"status"`
+	// PipelinesReady is only set by the readiness endpoint.
+	PipelinesReady *bool             `json:"pipelines_ready,omitempty"`
+	Components     []componentHealth `json:"components"`
+}
+
+// componentHealth is the health of a component instance.
+type componentHealth struct {
+	Kind      string    `json:"kind"`
+	ID        string    `json:"id"`
+	Pipelines []string  `json:"pipelines,omitempty"`
+	Healthy   bool      `json:"healthy"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func newHealthCheck(config *Config, telemetry component.TelemetrySettings) *healthCheckExtension {
+	return &healthCheckExtension{
+		config:    config,
+		telemetry: telemetry,
+		now:       time.Now,
+		statuses:  map[*component.InstanceID]*component.StatusEvent{},
+	}
+}
+
+func (hc *healthCheckExtension) Start(_ context.Context, _ component.Host) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc(hc.config.LivenessPath, hc.handleLiveness)
+	mux.HandleFunc(hc.config.ReadinessPath, hc.handleReadiness)
+
+	// Start the listener here so we can have earlier failure if port is
+	// already in use.
+	ln, err := hc.config.TCPAddr.Listen(context.Background())
+	if err != nil {
+		return err
+	}
+
+	hc.telemetry.Logger.Info("Starting health check extension", zap.Any("config", hc.config))
+	hc.server = http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	hc.stopCh = make(chan struct{})
+	go func() {
+		defer close(hc.stopCh)
+
+		if errHTTP := hc.server.Serve(ln); errHTTP != nil && !errors.Is(errHTTP, http.ErrServerClosed) {
+			hc.telemetry.ReportStatus(component.NewFatalErrorEvent(errHTTP))
+		}
+	}()
+
+	return nil
+}
+
+func (hc *healthCheckExtension) Shutdown(context.Context) error {
+	err := hc.server.Close()
+	if hc.stopCh != nil {
+		<-hc.stopCh
+	}
+	return err
+}
+
+// Ready is called once the pipelines are running.
+func (hc *healthCheckExtension) Ready() error {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	hc.ready = true
+	return nil
+}
+
+// NotReady is called before the receivers are stopped.
+func (hc *healthCheckExtension) NotReady() error {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	hc.ready = false
+	return nil
+}
+
+// ComponentStatusChanged records the latest status of the component.
+func (hc *healthCheckExtension) ComponentStatusChanged(source *component.InstanceID, event *component.StatusEvent) {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	hc.statuses[source] = event
+}
+
+func (hc *healthCheckExtension) handleLiveness(w http.ResponseWriter, _ *http.Request) {
+	resp := hc.health(func(ev *component.StatusEvent) bool {
+		return ev.Status() != component.StatusPermanentError && ev.Status() != component.StatusFatalError
+	})
+	writeHealth(w, resp)
+}
+
+func (hc *healthCheckExtension) handleReadiness(w http.ResponseWriter, _ *http.Request) {
+	now := hc.now()
+	resp := hc.health(func(ev *component.StatusEvent) bool {
+		switch ev.Status() {
+		case component.StatusOK:
+			return true
+		case component.StatusRecoverableError:
+			return now.Sub(ev.Timestamp()) < hc.config.RecoveryDuration
+		}
+		return false
+	})
+	hc.mu.Lock()
+	ready := hc.ready
+	hc.mu.Unlock()
+	resp.PipelinesReady = &ready
+	resp.Healthy = resp.Healthy && ready
+	writeHealth(w, resp)
+}
+
+// health returns the health of the components, which are healthy if their latest status event satisfies isHealthy.
+func (hc *healthCheckExtension) health(isHealthy func(*component.StatusEvent) bool) *healthResponse {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+
+	resp := &healthResponse{
+		Healthy:    true,
+		Status:     component.StatusNone.String(),
+		Components: make([]componentHealth, 0, len(hc.statuses)),
+	}
+	if len(hc.statuses) > 0 {
+		resp.Status = component.AggregateStatus(hc.statuses).String()
+	}
+	for source, ev := range hc.statuses {
+		ch := componentHealth{
+			Kind:      strings.ToLower(source.Kind.String()),
+			ID:        source.ID.String(),
+			Healthy:   isHealthy(ev),
+			Status:    ev.Status().String(),
+			Timestamp: ev.Timestamp(),
+		}
+		for pipelineID := range source.PipelineIDs {
+			ch.Pipelines = append(ch.Pipelines, pipelineID.String())
+		}
+		sort.Strings(ch.Pipelines)
+		if ev.Err() != nil {
+			ch.Error = ev.Err().Error()
+		}
+		resp.Healthy = resp.Healthy && ch.Healthy
+		resp.Components = append(resp.Components, ch)
+	}
+	sort.Slice(resp.Components, func(i, j int) bool {
+		if resp.Components[i].Kind != resp.Components[j].Kind {
+			return resp.Components[i].Kind < resp.Components[j].Kind
+		}
+		return resp.Components[i].ID < resp.Components[j].ID
+	})
+	return resp
+}
+
+func writeHealth(w http.ResponseWriter, resp *healthResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Healthy {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheckextension
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/internal/testutil"
+)
+
+func getHealth(t *testing.T, url string) (int, healthResponse) {
+	resp, err := http.Get(url) //nolint:gosec
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	var health healthResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&health))
+	return resp.StatusCode, health
+}
+
+func TestHealthCheck(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.TCPAddr.Endpoint = testutil.GetAvailableLocalAddress(t)
+	hc := newHealthCheck(cfg, componenttest.NewNopTelemetrySettings())
+	now := time.Now()
+	hc.now = func() time.Time { return now }
+	require.NoError(t, hc.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, hc.Shutdown(context.Background())) })
+	livez := "http://" + cfg.TCPAddr.Endpoint + "/livez"
+	readyz := "http://" + cfg.TCPAddr.Endpoint + "/readyz"
+
+	// The collector is alive but not ready before the pipelines are started.
+	code, health := getHealth(t, livez)
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, health.Healthy)
+	assert.Equal(t, "StatusNone", health.Status)
+	assert.Empty(t, health.Components)
+	assert.Nil(t, health.PipelinesReady)
+	code, health = getHealth(t, readyz)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.False(t, health.Healthy)
+	require.NotNil(t, health.PipelinesReady)
+	assert.False(t, *health.PipelinesReady)
+
+	receiver := &component.InstanceID{
+		ID:   component.NewID("otlp"),
+		Kind: component.KindReceiver,
+		PipelineIDs: map[component.ID]struct{}{
+			component.NewID("traces"):  {},
+			component.NewID("metrics"): {},
+		},
+	}
+	exporter := &component.InstanceID{
+		ID:          component.NewIDWithName("otlp", "backend"),
+		Kind:        component.KindExporter,
+		PipelineIDs: map[component.ID]struct{}{component.NewID("traces"): {}},
+	}
+	hc.ComponentStatusChanged(receiver, component.NewStatusEvent(component.StatusStarting))
+	hc.ComponentStatusChanged(exporter, component.NewStatusEvent(component.StatusStarting))
+	code, _ = getHealth(t, readyz)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	hc.ComponentStatusChanged(receiver, component.NewStatusEvent(component.StatusOK))
+	hc.ComponentStatusChanged(exporter, component.NewStatusEvent(component.StatusOK))
+	require.NoError(t, hc.Ready())
+	code, health = getHealth(t, readyz)
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, health.Healthy)
+	assert.Equal(t, "StatusOK", health.Status)
+	require.Len(t, health.Components, 2)
+	assert.Equal(t, "exporter", health.Components[0].Kind)
+	assert.Equal(t, "otlp/backend", health.Components[0].ID)
+	assert.Equal(t, []string{"traces"}, health.Components[0].Pipelines)
+	assert.Equal(t, "receiver", health.Components[1].Kind)
+	assert.Equal(t, []string{"metrics", "traces"}, health.Components[1].Pipelines)
+
+	// A recoverable error, like a full sending queue, is tolerated for the recovery duration.
+	hc.ComponentStatusChanged(exporter, component.NewRecoverableErrorEvent(errors.New("sending queue is full")))
+	code, health = getHealth(t, readyz)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "StatusRecoverableError", health.Status)
+	assert.Equal(t, "sending queue is full", health.Components[0].Error)
+	now = time.Now().Add(cfg.RecoveryDuration)
+	code, health = getHealth(t, readyz)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.False(t, health.Components[0].Healthy)
+	assert.True(t, health.Components[1].Healthy)
+	code, _ = getHealth(t, livez)
+	assert.Equal(t, http.StatusOK, code)
+
+	// A permanent error makes the collector not alive.
+	hc.ComponentStatusChanged(exporter, component.NewPermanentErrorEvent(errors.New("invalid credentials")))
+	code, health = getHealth(t, livez)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "StatusPermanentError", health.Status)
+
+	hc.ComponentStatusChanged(exporter, component.NewStatusEvent(component.StatusOK))
+	code, _ = getHealth(t, readyz)
+	assert.Equal(t, http.StatusOK, code)
+	require.NoError(t, hc.NotReady())
+	code, _ = getHealth(t, readyz)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
+
+func TestHealthCheckPortInUse(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.TCPAddr.Endpoint = testutil.GetAvailableLocalAddress(t)
+	hc := newHealthCheck(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, hc.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, hc.Shutdown(context.Background())) }()
+
+	other := newHealthCheck(cfg, componenttest.NewNopTelemetrySettings())
+	assert.Error(t, other.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, other.Shutdown(context.Background()))
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leaderelectionextension // import "go.opentelemetry.io/collector/extension/leaderelectionextension"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config has the configuration of the leader election extension.
+// Exactly one of Kubernetes and File must be set.
+type Config struct {
+	// Identity identifies the collector among the candidates. It defaults to the host name
+	// followed by a random suffix.
+	Identity string `mapstructure:"identity"`
+
+	// LeaseDuration is the duration for which the leadership is held without being renewed,
+	// before another candidate can take it over.
+	LeaseDuration time.Duration `mapstructure:"lease_duration"`
+
+	// RenewDeadline is the duration for which the leader tries to renew the leadership
+	// before giving it up.
+	RenewDeadline time.Duration `mapstructure:"renew_deadline"`
+
+	// RetryPeriod is the interval at which the candidates try to acquire or renew the leadership.
+	RetryPeriod time.Duration `mapstructure:"retry_period"`
+
+	// Kubernetes elects the leader with a Lease object of the Kubernetes cluster the collector runs in.
+	Kubernetes *KubernetesConfig `mapstructure:"kubernetes"`
+
+	// File elects the leader with a lock on a file shared by the candidates.
+	File *FileConfig `mapstructure:"file"`
+}
+
+// KubernetesConfig has the settings of the Lease object held by the leader.
+type KubernetesConfig struct {
+	// LeaseName is the name of the Lease object.
+	LeaseName string `mapstructure:"lease_name"`
+
+	// Namespace is the namespace of the Lease object. It defaults to the namespace of the collector pod.
+	Namespace string `mapstructure:"namespace"`
+}
+
+// FileConfig has the settings of the file locked by the leader.
+type FileConfig struct {
+	// Path is the path of the lock file, which is created if it does not exist.
+	Path string `mapstructure:"path"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	if (cfg.Kubernetes == nil) == (cfg.File == nil) {
+		return errors.New("exactly one of kubernetes or file must be set")
+	}
+	if cfg.Kubernetes != nil && cfg.Kubernetes.LeaseName == "" {
+		return errors.New("kubernetes lease_name must be set")
+	}
+	if cfg.File != nil && cfg.File.Path == "" {
+		return errors.New("file path must be set")
+	}
+	if cfg.RetryPeriod <= 0 {
+		return errors.New("retry_period must be positive")
+	}
+	if cfg.RenewDeadline <= cfg.RetryPeriod {
+		return errors.New("renew_deadline must be greater than retry_period")
+	}
+	if cfg.LeaseDuration <= cfg.RenewDeadline {
+		return errors.New("lease_duration must be greater than renew_deadline")
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leaderelectionextension
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	assert.NoError(t, component.UnmarshalConfig(confmap.New(), cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+	// A backend must be configured.
+	assert.EqualError(t, component.ValidateConfig(cfg), "exactly one of kubernetes or file must be set")
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id       component.ID
+		expected component.Config
+	}{
+		{
+			id: component.NewID("leader_election"),
+			expected: &Config{
+				Identity:      "collector-1",
+				LeaseDuration: 30 * time.Second,
+				RenewDeadline: 20 * time.Second,
+				RetryPeriod:   5 * time.Second,
+				Kubernetes: &KubernetesConfig{
+					LeaseName: "otelcol-scraper",
+					Namespace: "monitoring",
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName("leader_election", "file"),
+			expected: &Config{
+				LeaseDuration: defaultLeaseDuration,
+				RenewDeadline: defaultRenewDeadline,
+				RetryPeriod:   defaultRetryPeriod,
+				File:          &FileConfig{Path: "/var/lib/otelcol/leader.lock"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			cfg := NewFactory().CreateDefaultConfig()
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		errMsg string
+	}{
+		{
+			name:   "both backends",
+			modify: func(cfg *Config) { cfg.File = &FileConfig{Path: "leader.lock"} },
+			errMsg: "exactly one of kubernetes or file must be set",
+		},
+		{
+			name:   "no lease name",
+			modify: func(cfg *Config) { cfg.Kubernetes.LeaseName = "" },
+			errMsg: "kubernetes lease_name must be set",
+		},
+		{
+			name: "no file path",
+			modify: func(cfg *Config) {
+				cfg.Kubernetes = nil
+				cfg.File = &FileConfig{}
+			},
+			errMsg: "file path must be set",
+		},
+		{
+			name:   "no retry period",
+			modify: func(cfg *Config) { cfg.RetryPeriod = 0 },
+			errMsg: "retry_period must be positive",
+		},
+		{
+			name:   "renew deadline shorter than retry period",
+			modify: func(cfg *Config) { cfg.RenewDeadline = time.Second },
+			errMsg: "renew_deadline must be greater than retry_period",
+		},
+		{
+			name:   "lease duration shorter than renew deadline",
+			modify: func(cfg *Config) { cfg.LeaseDuration = cfg.RenewDeadline },
+			errMsg: "lease_duration must be greater than renew_deadline",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Kubernetes = &KubernetesConfig{LeaseName: "otelcol"}
+			require.NoError(t, cfg.Validate())
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.errMsg)
+		})
+	}
+}
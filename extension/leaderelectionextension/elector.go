@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leaderelectionextension // import "go.opentelemetry.io/collector/extension/leaderelectionextension"
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/leaderelection"
+)
+
+// locker is the lock held by the leader.
+type locker interface {
+	// tryAcquireOrRenew acquires the lock, or renews it if it is already held.
+	// It returns false if the lock is held by another candidate.
+	tryAcquireOrRenew(ctx context.Context) (bool, error)
+
+	// release releases the lock if it is held, so another candidate can acquire it immediately.
+	release(ctx context.Context) error
+}
+
+// leaderElector elects the leader by periodically trying to acquire or renew a lock.
+type leaderElector struct {
+	cfg       *Config
+	telemetry component.TelemetrySettings
+	identity  string
+	// newLock creates the lock when the extension starts.
+	newLock func(cfg *Config, identity string) (locker, error)
+
+	lock   locker
+	cancel context.CancelFunc
+	doneCh chan struct{}
+
+	isLeader atomic.Bool
+	// notifyMu serializes the notifications of the subscribers.
+	notifyMu    sync.Mutex
+	subscribers map[int]func(bool)
+	nextID      int
+}
+
+var _ leaderelection.Extension = (*leaderElector)(nil)
+
+func newLeaderElector(cfg *Config, telemetry component.TelemetrySettings) *leaderElector {
+	identity := cfg.Identity
+	if identity == "" {
+		identity = defaultIdentity()
+	}
+	return &leaderElector{
+		cfg:         cfg,
+		telemetry:   telemetry,
+		identity:    identity,
+		newLock:     newLock,
+		subscribers: map[int]func(bool){},
+	}
+}
+
+// defaultIdentity returns the host name followed by a random suffix, so the replicas of a collector
+// running on the same host have different identities.
+func defaultIdentity() string {
+	hostname, _ := os.Hostname()
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+	return hostname + "_" + hex.EncodeToString(suffix)
+}
+
+func newLock(cfg *Config, identity string) (locker, error) {
+	if cfg.Kubernetes != nil {
+		return newLeaseLock(cfg.Kubernetes, identity, cfg.LeaseDuration)
+	}
+	return &fileLock{path: cfg.File.Path, identity: identity}, nil
+}
+
+func (e *leaderElector) Start(_ context.Context, _ component.Host) error {
+	lock, err := e.newLock(e.cfg, e.identity)
+	if err != nil {
+		return err
+	}
+	e.lock = lock
+	ctx, cancel := context.WithCancel(context.Background())
+	e.cancel = cancel
+	e.doneCh = make(chan struct{})
+	go e.run(ctx)
+	return nil
+}
+
+// Shutdown gives up the leadership, and releases the lock so another candidate can take it over.
+func (e *leaderElector) Shutdown(ctx context.Context) error {
+	if e.cancel == nil {
+		return nil
+	}
+	e.cancel()
+	<-e.doneCh
+	e.setLeader(false)
+	return e.lock.release(ctx)
+}
+
+func (e *leaderElector) IsLeader() bool {
+	return e.isLeader.Load()
+}
+
+// Subscribe registers the callback, and calls it with the current leadership. The callback must not
+// call the returned function.
+func (e *leaderElector) Subscribe(callback func(isLeader bool)) func() {
+	e.notifyMu.Lock()
+	defer e.notifyMu.Unlock()
+	id := e.nextID
+	e.nextID++
+	e.subscribers[id] = callback
+	callback(e.isLeader.Load())
+	return func() {
+		e.notifyMu.Lock()
+		defer e.notifyMu.Unlock()
+		delete(e.subscribers, id)
+	}
+}
+
+func (e *leaderElector) run(ctx context.Context) {
+	defer close(e.doneCh)
+	ticker := time.NewTicker(e.cfg.RetryPeriod)
+	defer ticker.Stop()
+	var lastRenew time.Time
+	for {
+		renewCtx, cancel := context.WithTimeout(ctx, e.cfg.RetryPeriod)
+		held, err := e.lock.tryAcquireOrRenew(renewCtx)
+		cancel()
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			e.telemetry.Logger.Warn("Failed to acquire or renew the leadership", zap.Error(err))
+			// The leader keeps the leadership until the renew deadline, in case the failure is transient.
+			if e.IsLeader() && time.Since(lastRenew) > e.cfg.RenewDeadline {
+				e.setLeader(false)
+			}
+		case held:
+			lastRenew = time.Now()
+			e.setLeader(true)
+		default:
+			e.setLeader(false)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *leaderElector) setLeader(isLeader bool) {
+	e.notifyMu.Lock()
+	defer e.notifyMu.Unlock()
+	if e.isLeader.Swap(isLeader) == isLeader {
+		return
+	}
+	if isLeader {
+		e.telemetry.Logger.Info("Acquired the leadership", zap.String("identity", e.identity))
+	} else {
+		e.telemetry.Logger.Info("Lost the leadership", zap.String("identity", e.identity))
+	}
+	for _, callback := range e.subscribers {
+		callback(isLeader)
+	}
+}
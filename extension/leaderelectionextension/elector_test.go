@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leaderelectionextension
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+// fakeLock is a lock whose state is set by the tests.
+type fakeLock struct {
+	mu       sync.Mutex
+	held     bool
+	err      error
+	released bool
+}
+
+func (l *fakeLock) set(held bool, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.held = held
+	l.err = err
+}
+
+func (l *fakeLock) tryAcquireOrRenew(context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.held, l.err
+}
+
+func (l *fakeLock) release(context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.released = true
+	return nil
+}
+
+func newTestElector(t *testing.T, lock *fakeLock) *leaderElector {
+	cfg := &Config{
+		Identity:      "collector-1",
+		LeaseDuration: 300 * time.Millisecond,
+		RenewDeadline: 100 * time.Millisecond,
+		RetryPeriod:   5 * time.Millisecond,
+		File:          &FileConfig{Path: "unused"},
+	}
+	require.NoError(t, cfg.Validate())
+	e := newLeaderElector(cfg, componenttest.NewNopTelemetrySettings())
+	e.newLock = func(*Config, string) (locker, error) { return lock, nil }
+	return e
+}
+
+// recorder records the leadership notified to a subscriber.
+type recorder struct {
+	mu      sync.Mutex
+	changes []bool
+}
+
+func (r *recorder) notify(isLeader bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.changes = append(r.changes, isLeader)
+}
+
+func (r *recorder) get() []bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]bool{}, r.changes...)
+}
+
+func TestElector(t *testing.T) {
+	lock := &fakeLock{}
+	e := newTestElector(t, lock)
+	rec := &recorder{}
+	unsubscribe := e.Subscribe(rec.notify)
+	assert.Equal(t, []bool{false}, rec.get())
+
+	require.NoError(t, e.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Never(t, e.IsLeader, 50*time.Millisecond, 5*time.Millisecond)
+
+	lock.set(true, nil)
+	assert.Eventually(t, e.IsLeader, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []bool{false, true}, rec.get())
+
+	// A new subscriber is notified of the current leadership.
+	late := &recorder{}
+	e.Subscribe(late.notify)
+	assert.Equal(t, []bool{true}, late.get())
+
+	lock.set(false, nil)
+	assert.Eventually(t, func() bool { return !e.IsLeader() }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []bool{false, true, false}, rec.get())
+
+	unsubscribe()
+	lock.set(true, nil)
+	assert.Eventually(t, e.IsLeader, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []bool{false, true, false}, rec.get())
+
+	require.NoError(t, e.Shutdown(context.Background()))
+	assert.False(t, e.IsLeader())
+	assert.Equal(t, []bool{true, false, true, false}, late.get())
+	assert.True(t, lock.released)
+}
+
+func TestElectorRenewDeadline(t *testing.T) {
+	lock := &fakeLock{held: true}
+	e := newTestElector(t, lock)
+	require.NoError(t, e.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Eventually(t, e.IsLeader, time.Second, 5*time.Millisecond)
+
+	// The leadership is kept until the renew deadline when it cannot be renewed.
+	lock.set(false, errors.New("unavailable"))
+	start := time.Now()
+	assert.Eventually(t, func() bool { return !e.IsLeader() }, time.Second, 5*time.Millisecond)
+	assert.GreaterOrEqual(t, time.Since(start), e.cfg.RenewDeadline-e.cfg.RetryPeriod)
+	require.NoError(t, e.Shutdown(context.Background()))
+}
+
+func TestElectorStartError(t *testing.T) {
+	e := newTestElector(t, &fakeLock{})
+	e.newLock = func(*Config, string) (locker, error) { return nil, errors.New("no cluster") }
+	assert.EqualError(t, e.Start(context.Background(), componenttest.NewNopHost()), "no cluster")
+	assert.NoError(t, e.Shutdown(context.Background()))
+}
+
+func TestDefaultIdentity(t *testing.T) {
+	e := newLeaderElector(&Config{}, componenttest.NewNopTelemetrySettings())
+	other := newLeaderElector(&Config{}, componenttest.NewNopTelemetrySettings())
+	assert.NotEmpty(t, e.identity)
+	assert.NotEqual(t, e.identity, other.identity)
+}
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leaderelectionextension
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/experimental/leaderelection"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+	"go.opentelemetry.io/collector/extension/leaderelectionextension/internal/metadata"
+)
+
+func TestFactory(t *testing.T) {
+	factory := NewFactory()
+	assert.EqualValues(t, metadata.Type, factory.Type())
+	cfg := factory.CreateDefaultConfig().(*Config)
+	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
+
+	cfg.File = &FileConfig{Path: filepath.Join(t.TempDir(), "leader.lock")}
+	ext, err := factory.CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	assert.Implements(t, (*leaderelection.Extension)(nil), ext)
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, ext.Shutdown(context.Background()))
+}
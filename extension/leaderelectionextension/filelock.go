@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leaderelectionextension // import "go.opentelemetry.io/collector/extension/leaderelectionextension"
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"go.uber.org/multierr"
+)
+
+// errLocked is returned when the file is locked by another process.
+var errLocked = errors.New("file is locked")
+
+// fileLock is an exclusive lock on a file. The lock is released by the operating system
+// when the process holding it exits, so it never needs to expire.
+type fileLock struct {
+	path     string
+	identity string
+	file     *os.File
+}
+
+func (l *fileLock) tryAcquireOrRenew(context.Context) (bool, error) {
+	if l.file != nil {
+		return true, nil
+	}
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return false, err
+	}
+	if err = lockFile(f); err != nil {
+		_ = f.Close()
+		if errors.Is(err, errLocked) {
+			return false, nil
+		}
+		return false, err
+	}
+	l.file = f
+	// The identity of the leader is written for troubleshooting purposes only.
+	if err = f.Truncate(0); err == nil {
+		_, _ = f.WriteAt([]byte(l.identity+"\n"), 0)
+	}
+	return true, nil
+}
+
+func (l *fileLock) release(context.Context) error {
+	if l.file == nil {
+		return nil
+	}
+	err := multierr.Combine(unlockFile(l.file), l.file.Close())
+	l.file = nil
+	return err
+}
@@ -0,0 +1,20 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly)
+
+package leaderelectionextension // import "go.opentelemetry.io/collector/extension/leaderelectionextension"
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+)
+
+func lockFile(*os.File) error {
+	return fmt.Errorf("file locks are not supported on %s", runtime.GOOS)
+}
+
+func unlockFile(*os.File) error {
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+
+package leaderelectionextension
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileLock(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "leader.lock")
+	a := &fileLock{path: path, identity: "a"}
+	b := &fileLock{path: path, identity: "b"}
+
+	held, err := a.tryAcquireOrRenew(ctx)
+	require.NoError(t, err)
+	assert.True(t, held)
+	held, err = a.tryAcquireOrRenew(ctx)
+	require.NoError(t, err)
+	assert.True(t, held)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "a\n", string(content))
+
+	held, err = b.tryAcquireOrRenew(ctx)
+	require.NoError(t, err)
+	assert.False(t, held)
+
+	require.NoError(t, a.release(ctx))
+	held, err = b.tryAcquireOrRenew(ctx)
+	require.NoError(t, err)
+	assert.True(t, held)
+	require.NoError(t, b.release(ctx))
+	// Releasing a lock which is not held does nothing.
+	assert.NoError(t, b.release(ctx))
+}
+
+func TestFileLockInvalidPath(t *testing.T) {
+	l := &fileLock{path: filepath.Join(t.TempDir(), "missing", "leader.lock")}
+	_, err := l.tryAcquireOrRenew(context.Background())
+	assert.Error(t, err)
+}
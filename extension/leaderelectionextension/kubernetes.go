@@ -0,0 +1,250 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leaderelectionextension // import "go.opentelemetry.io/collector/extension/leaderelectionextension"
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+	// microTimeFormat is the format of the times of a Lease.
+	microTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// lease is a coordination.k8s.io/v1 Lease object.
+type lease struct {
+	APIVersion string        `json:"apiVersion"`
+	Kind       string        `json:"kind"`
+	Metadata   leaseMetadata `json:"metadata"`
+	Spec       leaseSpec     `json:"spec"`
+}
+
+type leaseMetadata struct {
+	Name            string            `json:"name"`
+	Namespace       string            `json:"namespace"`
+	ResourceVersion string            `json:"resourceVersion,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	Annotations     map[string]string `json:"annotations,omitempty"`
+}
+
+type leaseSpec struct {
+	HolderIdentity       string `json:"holderIdentity,omitempty"`
+	LeaseDurationSeconds int32  `json:"leaseDurationSeconds,omitempty"`
+	AcquireTime          string `json:"acquireTime,omitempty"`
+	RenewTime            string `json:"renewTime,omitempty"`
+	LeaseTransitions     int32  `json:"leaseTransitions,omitempty"`
+}
+
+// leaseLock is a Lease object of the Kubernetes API, held by the leader as long as it renews it.
+// As the clocks of the candidates may be skewed, the expiration of the lease is computed from the time
+// it was last observed to change, as done by the leader election of client-go.
+type leaseLock struct {
+	client    *http.Client
+	apiServer string
+	// tokenFile is read for each request, as the service account tokens are rotated.
+	tokenFile string
+
+	namespace     string
+	name          string
+	identity      string
+	leaseDuration time.Duration
+	now           func() time.Time
+
+	lease          *lease
+	observedSpec   leaseSpec
+	observedTime   time.Time
+	hasObservation bool
+}
+
+// newLeaseLock creates a lock on a Lease of the cluster the collector runs in, using the credentials
+// of the service account of its pod.
+func newLeaseLock(cfg *KubernetesConfig, identity string, leaseDuration time.Duration) (*leaseLock, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, errors.New("not running in a Kubernetes cluster: KUBERNETES_SERVICE_HOST and KUBERNETES_SERVICE_PORT must be set")
+	}
+	ca, err := os.ReadFile(filepath.Join(serviceAccountDir, "ca.crt"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the certificate authority of the cluster: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("invalid certificate authority of the cluster")
+	}
+	namespace := cfg.Namespace
+	if namespace == "" {
+		ns, err := os.ReadFile(filepath.Join(serviceAccountDir, "namespace"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the namespace of the collector, it must be configured: %w", err)
+		}
+		namespace = strings.TrimSpace(string(ns))
+	}
+	return &leaseLock{
+		client: &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12},
+		}},
+		apiServer:     "https://" + net.JoinHostPort(host, port),
+		tokenFile:     filepath.Join(serviceAccountDir, "token"),
+		namespace:     namespace,
+		name:          cfg.LeaseName,
+		identity:      identity,
+		leaseDuration: leaseDuration,
+		now:           time.Now,
+	}, nil
+}
+
+func (l *leaseLock) tryAcquireOrRenew(ctx context.Context) (bool, error) {
+	now := l.now()
+	current, err := l.get(ctx)
+	if err != nil {
+		return false, err
+	}
+	if current == nil {
+		created := &lease{
+			APIVersion: "coordination.k8s.io/v1",
+			Kind:       "Lease",
+			Metadata:   leaseMetadata{Name: l.name, Namespace: l.namespace},
+			Spec:       l.heldSpec(leaseSpec{}, now),
+		}
+		return l.write(ctx, http.MethodPost, l.collectionPath(), created)
+	}
+
+	if !l.hasObservation || current.Spec != l.observedSpec {
+		l.observedSpec = current.Spec
+		l.observedTime = now
+		l.hasObservation = true
+	}
+	holder := current.Spec.HolderIdentity
+	expiry := l.observedTime.Add(time.Duration(current.Spec.LeaseDurationSeconds) * time.Second)
+	if holder != "" && holder != l.identity && now.Before(expiry) {
+		return false, nil
+	}
+	updated := *current
+	updated.Spec = l.heldSpec(current.Spec, now)
+	return l.write(ctx, http.MethodPut, l.objectPath(), &updated)
+}
+
+// heldSpec returns the spec of the lease renewed by the collector at the given time.
+func (l *leaseLock) heldSpec(spec leaseSpec, now time.Time) leaseSpec {
+	nowStr := now.UTC().Format(microTimeFormat)
+	if spec.HolderIdentity != l.identity {
+		if spec.HolderIdentity != "" {
+			spec.LeaseTransitions++
+		}
+		spec.HolderIdentity = l.identity
+		spec.AcquireTime = nowStr
+	}
+	spec.LeaseDurationSeconds = int32(math.Ceil(l.leaseDuration.Seconds()))
+	spec.RenewTime = nowStr
+	return spec
+}
+
+// write creates or updates the lease. It returns false if the lease was modified by another candidate.
+func (l *leaseLock) write(ctx context.Context, method, path string, ls *lease) (bool, error) {
+	written := &lease{}
+	status, err := l.do(ctx, method, path, ls, written)
+	if err != nil || status == http.StatusConflict {
+		return false, err
+	}
+	l.lease = written
+	l.observedSpec = written.Spec
+	l.observedTime = l.now()
+	l.hasObservation = true
+	return true, nil
+}
+
+func (l *leaseLock) release(ctx context.Context) error {
+	if l.lease == nil || l.lease.Spec.HolderIdentity != l.identity {
+		return nil
+	}
+	released := *l.lease
+	released.Spec.HolderIdentity = ""
+	released.Spec.LeaseDurationSeconds = 1
+	released.Spec.RenewTime = l.now().UTC().Format(microTimeFormat)
+	_, err := l.do(ctx, http.MethodPut, l.objectPath(), &released, nil)
+	l.lease = nil
+	return err
+}
+
+// get returns the lease, or nil if it does not exist.
+func (l *leaseLock) get(ctx context.Context) (*lease, error) {
+	ls := &lease{}
+	status, err := l.do(ctx, http.MethodGet, l.objectPath(), nil, ls)
+	if err != nil || status == http.StatusNotFound {
+		return nil, err
+	}
+	return ls, nil
+}
+
+func (l *leaseLock) collectionPath() string {
+	return "/apis/coordination.k8s.io/v1/namespaces/" + l.namespace + "/leases"
+}
+
+func (l *leaseLock) objectPath() string {
+	return l.collectionPath() + "/" + l.name
+}
+
+// do sends a request to the API server, and decodes the response into out. The not found and conflict
+// statuses are returned without error, as they are expected when the candidates race for the lease.
+func (l *leaseLock) do(ctx context.Context, method, path string, in, out any) (int, error) {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return 0, err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, l.apiServer+path, body)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if l.tokenFile != "" {
+		token, err := os.ReadFile(l.tokenFile)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read the service account token: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusConflict:
+		return resp.StatusCode, nil
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return resp.StatusCode, fmt.Errorf("%s %s: unexpected response status %q: %s", method, path, resp.Status, bytes.TrimSpace(respBody))
+	}
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return resp.StatusCode, fmt.Errorf("invalid lease: %w", err)
+		}
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leaderelectionextension
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAPIServer serves a single Lease, enforcing the optimistic concurrency of the Kubernetes API.
+type fakeAPIServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	lease   *lease
+	version int
+}
+
+func newFakeAPIServer(t *testing.T) *fakeAPIServer {
+	srv := &fakeAPIServer{}
+	srv.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+		const collection = "/apis/coordination.k8s.io/v1/namespaces/monitoring/leases"
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == collection+"/otelcol":
+			if srv.lease == nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+		case r.Method == http.MethodPost && r.URL.Path == collection:
+			if srv.lease != nil {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			ls := &lease{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(ls))
+			srv.lease = srv.store(ls)
+		case r.Method == http.MethodPut && r.URL.Path == collection+"/otelcol":
+			ls := &lease{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(ls))
+			if srv.lease == nil || ls.Metadata.ResourceVersion != srv.lease.Metadata.ResourceVersion {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			srv.lease = srv.store(ls)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(srv.lease))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// store returns the lease with a new resource version.
+func (srv *fakeAPIServer) store(ls *lease) *lease {
+	srv.version++
+	ls.Metadata.ResourceVersion = strconv.Itoa(srv.version)
+	return ls
+}
+
+func (srv *fakeAPIServer) spec() leaseSpec {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	return srv.lease.Spec
+}
+
+func newTestLeaseLock(t *testing.T, srv *fakeAPIServer, identity string, now *time.Time) *leaseLock {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("token\n"), 0600))
+	return &leaseLock{
+		client:        srv.Client(),
+		apiServer:     srv.URL,
+		tokenFile:     tokenFile,
+		namespace:     "monitoring",
+		name:          "otelcol",
+		identity:      identity,
+		leaseDuration: 15 * time.Second,
+		now:           func() time.Time { return *now },
+	}
+}
+
+func TestLeaseLock(t *testing.T) {
+	srv := newFakeAPIServer(t)
+	ctx := context.Background()
+	now := time.Date(2024, 1, 30, 12, 0, 0, 0, time.UTC)
+	a := newTestLeaseLock(t, srv, "a", &now)
+	b := newTestLeaseLock(t, srv, "b", &now)
+
+	held, err := a.tryAcquireOrRenew(ctx)
+	require.NoError(t, err)
+	assert.True(t, held)
+	assert.Equal(t, leaseSpec{
+		HolderIdentity:       "a",
+		LeaseDurationSeconds: 15,
+		AcquireTime:          "2024-01-30T12:00:00.000000Z",
+		RenewTime:            "2024-01-30T12:00:00.000000Z",
+	}, srv.spec())
+
+	held, err = b.tryAcquireOrRenew(ctx)
+	require.NoError(t, err)
+	assert.False(t, held)
+
+	// The leader renews the lease.
+	now = now.Add(10 * time.Second)
+	held, err = a.tryAcquireOrRenew(ctx)
+	require.NoError(t, err)
+	assert.True(t, held)
+	assert.Equal(t, "2024-01-30T12:00:10.000000Z", srv.spec().RenewTime)
+
+	// The lease did not expire since it was last observed to be renewed.
+	now = now.Add(10 * time.Second)
+	held, err = b.tryAcquireOrRenew(ctx)
+	require.NoError(t, err)
+	assert.False(t, held)
+
+	// The leader stopped renewing the lease, which is taken over once expired.
+	now = now.Add(16 * time.Second)
+	held, err = b.tryAcquireOrRenew(ctx)
+	require.NoError(t, err)
+	assert.True(t, held)
+	assert.Equal(t, "b", srv.spec().HolderIdentity)
+	assert.Equal(t, int32(1), srv.spec().LeaseTransitions)
+
+	held, err = a.tryAcquireOrRenew(ctx)
+	require.NoError(t, err)
+	assert.False(t, held)
+
+	// The released lease is acquired immediately.
+	require.NoError(t, b.release(ctx))
+	assert.Equal(t, "", srv.spec().HolderIdentity)
+	held, err = a.tryAcquireOrRenew(ctx)
+	require.NoError(t, err)
+	assert.True(t, held)
+	assert.Equal(t, int32(1), srv.spec().LeaseTransitions)
+}
+
+func TestLeaseLockConflict(t *testing.T) {
+	srv := newFakeAPIServer(t)
+	ctx := context.Background()
+	now := time.Now()
+	a := newTestLeaseLock(t, srv, "a", &now)
+	held, err := a.tryAcquireOrRenew(ctx)
+	require.NoError(t, err)
+	require.True(t, held)
+
+	// The lease was modified since it was read.
+	a.lease.Metadata.ResourceVersion = "0"
+	held, err = a.write(ctx, http.MethodPut, a.objectPath(), a.lease)
+	require.NoError(t, err)
+	assert.False(t, held)
+}
+
+func TestLeaseLockError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "leases.coordination.k8s.io is forbidden", http.StatusForbidden)
+	}))
+	defer srv.Close()
+	now := time.Now()
+	l := newTestLeaseLock(t, &fakeAPIServer{Server: srv}, "a", &now)
+	_, err := l.tryAcquireOrRenew(context.Background())
+	assert.EqualError(t, err, "GET /apis/coordination.k8s.io/v1/namespaces/monitoring/leases/otelcol: "+
+		"unexpected response status \"403 Forbidden\": leases.coordination.k8s.io is forbidden")
+}
+
+func TestNewLeaseLockOutsideCluster(t *testing.T) {
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	_, err := newLeaseLock(&KubernetesConfig{LeaseName: "otelcol"}, "a", time.Second)
+	assert.EqualError(t, err, "not running in a Kubernetes cluster: KUBERNETES_SERVICE_HOST and KUBERNETES_SERVICE_PORT must be set")
+}
@@ -4,7 +4,24 @@
 package memorylimiterextension // import "go.opentelemetry.io/collector/extension/memorylimiterextension"
 
 import (
+	"errors"
+
 	"go.opentelemetry.io/collector/internal/memorylimiter"
 )
 
-type Config = memorylimiter.Config
+var errBudgetUnsupported = errors.New("'budget_mib' is only supported by the memory_limiter processor")
+
+// Config defines configuration for the memory limiter extension.
+type Config struct {
+	memorylimiter.Config `mapstructure:",squash"`
+}
+
+// Validate checks if the extension configuration is valid. The memory limits are validated with the embedded
+// memorylimiter.Config.
+func (cfg *Config) Validate() error {
+	// The extension does not pass data to next components, so there is no data to account against a budget.
+	if cfg.BudgetMiB != 0 {
+		return errBudgetUnsupported
+	}
+	return nil
+}
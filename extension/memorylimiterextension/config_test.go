@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package memorylimiterextension
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/internal/memorylimiter"
+)
+
+func TestConfigValidate(t *testing.T) {
+	cfg := &Config{Config: memorylimiter.Config{
+		CheckInterval:  time.Second,
+		MemoryLimitMiB: 100,
+	}}
+	assert.NoError(t, component.ValidateConfig(cfg))
+
+	cfg.BudgetMiB = 10
+	assert.ErrorIs(t, component.ValidateConfig(cfg), errBudgetUnsupported)
+
+	// The memory limits are still validated.
+	cfg.BudgetMiB = 0
+	cfg.CheckInterval = 0
+	assert.EqualError(t, component.ValidateConfig(cfg), "'check_interval' must be greater than zero")
+}
@@ -11,7 +11,7 @@ import (
 
 const (
 	Type               = "memory_limiter"
-	ExtensionStability = component.StabilityLevelDevelopment
+	ExtensionStability = component.StabilityLevelAlpha
 )
 
 func Meter(settings component.TelemetrySettings) metric.Meter {
@@ -18,7 +18,7 @@ type memoryLimiterExtension struct {
 
 // newMemoryLimiter returns a new memorylimiter extension.
 func newMemoryLimiter(cfg *Config, logger *zap.Logger) (*memoryLimiterExtension, error) {
-	ml, err := memorylimiter.NewMemoryLimiter(cfg, logger)
+	ml, err := memorylimiter.NewMemoryLimiter(&cfg.Config, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -28,41 +28,41 @@ func TestMemoryPressureResponse(t *testing.T) {
 	}{
 		{
 			name: "Below memAllocLimit",
-			mlCfg: &Config{
+			mlCfg: &Config{Config: memorylimiter.Config{
 				CheckInterval:         time.Second,
 				MemoryLimitPercentage: 50,
 				MemorySpikePercentage: 1,
-			},
+			}},
 			memAlloc:    800,
 			expectError: false,
 		},
 		{
 			name: "Above memAllocLimit",
-			mlCfg: &Config{
+			mlCfg: &Config{Config: memorylimiter.Config{
 				CheckInterval:         time.Second,
 				MemoryLimitPercentage: 50,
 				MemorySpikePercentage: 1,
-			},
+			}},
 			memAlloc:    1800,
 			expectError: true,
 		},
 		{
 			name: "Below memSpikeLimit",
-			mlCfg: &Config{
+			mlCfg: &Config{Config: memorylimiter.Config{
 				CheckInterval:         time.Second,
 				MemoryLimitPercentage: 50,
 				MemorySpikePercentage: 10,
-			},
+			}},
 			memAlloc:    800,
 			expectError: false,
 		},
 		{
 			name: "Above memSpikeLimit",
-			mlCfg: &Config{
+			mlCfg: &Config{Config: memorylimiter.Config{
 				CheckInterval:         time.Second,
 				MemoryLimitPercentage: 50,
 				MemorySpikePercentage: 11,
-			},
+			}},
 			memAlloc:    800,
 			expectError: true,
 		},
@@ -88,7 +88,7 @@ func TestMemoryPressureResponse(t *testing.T) {
 		})
 	}
 	t.Cleanup(func() {
-		memorylimiter.GetMemoryFn = iruntime.TotalMemory
+		memorylimiter.GetMemoryFn = iruntime.MemoryLimit
 		memorylimiter.ReadMemStatsFn = runtime.ReadMemStats
 	})
 }
@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opampextension // import "go.opentelemetry.io/collector/extension/opampextension"
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+// Config has the configuration of the OpAMP extension.
+type Config struct {
+	// Server is the OpAMP server the collector connects to.
+	Server ServerConfig `mapstructure:"server"`
+
+	// InstanceUID is the UUID identifying the collector, e.g. "01234567-89ab-cdef-0123-456789abcdef".
+	// A random one is generated when the collector starts if it is not set.
+	InstanceUID string `mapstructure:"instance_uid"`
+
+	// PollingInterval is the interval at which the state of the collector is sent to the server,
+	// and the remote configuration is fetched from it.
+	PollingInterval time.Duration `mapstructure:"polling_interval"`
+
+	// AgentDescription has the attributes describing the collector in addition to the ones
+	// derived from its build and host.
+	AgentDescription AgentDescription `mapstructure:"agent_description"`
+
+	// Capabilities are the OpAMP capabilities of the collector.
+	Capabilities Capabilities `mapstructure:"capabilities"`
+
+	// RemoteConfigPath is the file the remote configuration is written to. It must be one of the
+	// configuration files of the collector, which is reloaded when a new configuration is written.
+	// It is required when remote configuration is accepted.
+	RemoteConfigPath string `mapstructure:"remote_config_path"`
+}
+
+// ServerConfig has the settings of the connection to the OpAMP server.
+type ServerConfig struct {
+	// Endpoint is the URL of the OpAMP HTTP endpoint, e.g. "https://opamp.example.com/v1/opamp".
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Headers are added to the requests sent to the server.
+	Headers map[string]configopaque.String `mapstructure:"headers"`
+
+	// TLSSetting has the TLS settings used when the endpoint is an https URL.
+	TLSSetting configtls.TLSClientSetting `mapstructure:"tls"`
+
+	// Timeout is the timeout of the requests sent to the server.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// AgentDescription has the attributes describing the collector.
+type AgentDescription struct {
+	// NonIdentifyingAttributes are added to the non-identifying attributes of the collector,
+	// overriding the ones derived from the host.
+	NonIdentifyingAttributes map[string]string `mapstructure:"non_identifying_attributes"`
+}
+
+// Capabilities are the OpAMP capabilities of the collector.
+type Capabilities struct {
+	// ReportsEffectiveConfig enables reporting the configuration of the collector to the server.
+	ReportsEffectiveConfig bool `mapstructure:"reports_effective_config"`
+
+	// ReportsHealth enables reporting the health of the collector and of its components to the server.
+	ReportsHealth bool `mapstructure:"reports_health"`
+
+	// AcceptsRemoteConfig enables applying the configuration offered by the server.
+	AcceptsRemoteConfig bool `mapstructure:"accepts_remote_config"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Server.Endpoint == "" {
+		return errors.New("server endpoint must be set")
+	}
+	u, err := url.Parse(cfg.Server.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid server endpoint %q, it must be an http or https URL", cfg.Server.Endpoint)
+	}
+	if cfg.Server.Timeout < 0 {
+		return errors.New("server timeout must not be negative")
+	}
+	if cfg.PollingInterval <= 0 {
+		return errors.New("polling_interval must be positive")
+	}
+	if cfg.InstanceUID != "" {
+		if _, err := parseInstanceUID(cfg.InstanceUID); err != nil {
+			return err
+		}
+	}
+	if cfg.Capabilities.AcceptsRemoteConfig && cfg.RemoteConfigPath == "" {
+		return errors.New("remote_config_path must be set when accepts_remote_config is enabled")
+	}
+	return nil
+}
+
+// parseInstanceUID parses a UUID into the 16 bytes of an OpAMP instance UID.
+func parseInstanceUID(s string) ([]byte, error) {
+	uid, err := hex.DecodeString(strings.ReplaceAll(s, "-", ""))
+	if err != nil || len(uid) != 16 {
+		return nil, fmt.Errorf("invalid instance_uid %q, it must be a UUID", s)
+	}
+	return uid, nil
+}
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opampextension
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	assert.NoError(t, component.UnmarshalConfig(confmap.New(), cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+	// The server endpoint has no default.
+	assert.EqualError(t, component.ValidateConfig(cfg), "server endpoint must be set")
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig()
+	require.NoError(t, component.UnmarshalConfig(cm, cfg))
+	assert.Equal(t,
+		&Config{
+			Server: ServerConfig{
+				Endpoint: "https://opamp.example.com/v1/opamp",
+				Headers:  map[string]configopaque.String{"Authorization": "Bearer secret"},
+				TLSSetting: configtls.TLSClientSetting{
+					InsecureSkipVerify: true,
+				},
+				Timeout: 5 * time.Second,
+			},
+			InstanceUID:     "01234567-89ab-cdef-0123-456789abcdef",
+			PollingInterval: time.Minute,
+			AgentDescription: AgentDescription{
+				NonIdentifyingAttributes: map[string]string{"deployment.environment": "production"},
+			},
+			Capabilities: Capabilities{
+				ReportsEffectiveConfig: true,
+				AcceptsRemoteConfig:    true,
+			},
+			RemoteConfigPath: "/etc/otelcol/remote.yaml",
+		}, cfg)
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		errMsg string
+	}{
+		{
+			name:   "no endpoint",
+			modify: func(cfg *Config) { cfg.Server.Endpoint = "" },
+			errMsg: "server endpoint must be set",
+		},
+		{
+			name:   "websocket endpoint",
+			modify: func(cfg *Config) { cfg.Server.Endpoint = "wss://opamp.example.com/v1/opamp" },
+			errMsg: "invalid server endpoint \"wss://opamp.example.com/v1/opamp\", it must be an http or https URL",
+		},
+		{
+			name:   "negative timeout",
+			modify: func(cfg *Config) { cfg.Server.Timeout = -time.Second },
+			errMsg: "server timeout must not be negative",
+		},
+		{
+			name:   "no polling interval",
+			modify: func(cfg *Config) { cfg.PollingInterval = 0 },
+			errMsg: "polling_interval must be positive",
+		},
+		{
+			name:   "invalid instance uid",
+			modify: func(cfg *Config) { cfg.InstanceUID = "collector-1" },
+			errMsg: "invalid instance_uid \"collector-1\", it must be a UUID",
+		},
+		{
+			name:   "no remote config path",
+			modify: func(cfg *Config) { cfg.Capabilities.AcceptsRemoteConfig = true },
+			errMsg: "remote_config_path must be set when accepts_remote_config is enabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Server.Endpoint = "http://localhost:4320/v1/opamp"
+			require.NoError(t, cfg.Validate())
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.errMsg)
+		})
+	}
+}
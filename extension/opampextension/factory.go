@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opampextension // import "go.opentelemetry.io/collector/extension/opampextension"
+
+//go:generate mdatagen metadata.yaml
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/extension/opampextension/internal/metadata"
+)
+
+const (
+	defaultPollingInterval = 30 * time.Second
+	defaultServerTimeout   = 10 * time.Second
+)
+
+// NewFactory creates a factory for the OpAMP extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(metadata.Type, createDefaultConfig, createExtension, metadata.ExtensionStability)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Server: ServerConfig{
+			Timeout: defaultServerTimeout,
+		},
+		PollingInterval: defaultPollingInterval,
+		Capabilities: Capabilities{
+			ReportsEffectiveConfig: true,
+			ReportsHealth:          true,
+		},
+	}
+}
+
+func createExtension(_ context.Context, set extension.CreateSettings, cfg component.Config) (extension.Extension, error) {
+	return newOpAMPAgent(cfg.(*Config), set)
+}
@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opampextension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+	"go.opentelemetry.io/collector/extension/opampextension/internal/metadata"
+)
+
+func TestFactory(t *testing.T) {
+	srv := newFakeServer(t)
+	factory := NewFactory()
+	assert.EqualValues(t, metadata.Type, factory.Type())
+	cfg := factory.CreateDefaultConfig().(*Config)
+	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
+
+	cfg.Server.Endpoint = srv.URL
+	ext, err := factory.CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, ext.Shutdown(context.Background()))
+}
@@ -0,0 +1,502 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package protocol implements the subset of the OpAMP messages used by the extension,
+// encoded as defined by the opamp.proto file of the OpAMP specification.
+package protocol // import "go.opentelemetry.io/collector/extension/opampextension/internal/protocol"
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// AgentCapabilities are the capabilities of an agent, as a bit field.
+const (
+	AgentCapabilitiesReportsStatus          uint64 = 0x1
+	AgentCapabilitiesAcceptsRemoteConfig    uint64 = 0x2
+	AgentCapabilitiesReportsEffectiveConfig uint64 = 0x4
+	AgentCapabilitiesReportsHealth          uint64 = 0x800
+	AgentCapabilitiesReportsRemoteConfig    uint64 = 0x1000
+)
+
+// ServerToAgentFlagsReportFullState requests the agent to report its full state.
+const ServerToAgentFlagsReportFullState uint64 = 0x1
+
+// RemoteConfigStatuses is the status of the last remote configuration received by an agent.
+type RemoteConfigStatuses uint64
+
+const (
+	RemoteConfigStatusesUnset RemoteConfigStatuses = iota
+	RemoteConfigStatusesApplied
+	RemoteConfigStatusesApplying
+	RemoteConfigStatusesFailed
+)
+
+// AgentToServer is the message sent by an agent to the server.
+type AgentToServer struct {
+	InstanceUID        []byte
+	SequenceNum        uint64
+	AgentDescription   *AgentDescription
+	Capabilities       uint64
+	Health             *ComponentHealth
+	EffectiveConfig    AgentConfigMap
+	RemoteConfigStatus *RemoteConfigStatus
+	AgentDisconnect    bool
+	Flags              uint64
+}
+
+// AgentDescription identifies an agent.
+type AgentDescription struct {
+	IdentifyingAttributes    []KeyValue
+	NonIdentifyingAttributes []KeyValue
+}
+
+// KeyValue is an attribute of an agent. Only string values are supported.
+type KeyValue struct {
+	Key   string
+	Value string
+}
+
+// ComponentHealth is the health of an agent or of one of its components.
+type ComponentHealth struct {
+	Healthy            bool
+	StartTimeUnixNano  uint64
+	LastError          string
+	Status             string
+	StatusTimeUnixNano uint64
+	ComponentHealthMap map[string]*ComponentHealth
+}
+
+// AgentConfigMap holds the configuration files of an agent, by name.
+type AgentConfigMap map[string]AgentConfigFile
+
+// AgentConfigFile is a configuration file.
+type AgentConfigFile struct {
+	Body        []byte
+	ContentType string
+}
+
+// RemoteConfigStatus reports the status of the last remote configuration received by an agent.
+type RemoteConfigStatus struct {
+	LastRemoteConfigHash []byte
+	Status               RemoteConfigStatuses
+	ErrorMessage         string
+}
+
+// ServerToAgent is the message sent by the server to an agent.
+type ServerToAgent struct {
+	InstanceUID    []byte
+	ErrorResponse  *ServerErrorResponse
+	RemoteConfig   *AgentRemoteConfig
+	Flags          uint64
+	Capabilities   uint64
+	NewInstanceUID []byte
+}
+
+// ServerErrorResponse is the error returned by the server when it cannot process a message.
+type ServerErrorResponse struct {
+	Type         uint64
+	ErrorMessage string
+}
+
+// AgentRemoteConfig is the configuration offered by the server to an agent.
+type AgentRemoteConfig struct {
+	Config     AgentConfigMap
+	ConfigHash []byte
+}
+
+// Marshal encodes the message.
+func (m *AgentToServer) Marshal() []byte {
+	var b []byte
+	b = appendBytes(b, 1, m.InstanceUID)
+	b = appendVarint(b, 2, m.SequenceNum)
+	if m.AgentDescription != nil {
+		b = appendMessage(b, 3, m.AgentDescription.marshal())
+	}
+	b = appendVarint(b, 4, m.Capabilities)
+	if m.Health != nil {
+		b = appendMessage(b, 5, m.Health.marshal())
+	}
+	if m.EffectiveConfig != nil {
+		// EffectiveConfig only holds a config map.
+		b = appendMessage(b, 6, appendMessage(nil, 1, m.EffectiveConfig.marshal()))
+	}
+	if m.RemoteConfigStatus != nil {
+		b = appendMessage(b, 7, m.RemoteConfigStatus.marshal())
+	}
+	if m.AgentDisconnect {
+		b = appendMessage(b, 9, nil)
+	}
+	return appendVarint(b, 10, m.Flags)
+}
+
+// Unmarshal decodes the message.
+func (m *AgentToServer) Unmarshal(b []byte) error {
+	*m = AgentToServer{}
+	return parse(b, func(f field) error {
+		var err error
+		switch f.num {
+		case 1:
+			m.InstanceUID = f.bytes
+		case 2:
+			m.SequenceNum = f.varint
+		case 3:
+			m.AgentDescription = &AgentDescription{}
+			err = m.AgentDescription.unmarshal(f.bytes)
+		case 4:
+			m.Capabilities = f.varint
+		case 5:
+			m.Health = &ComponentHealth{}
+			err = m.Health.unmarshal(f.bytes)
+		case 6:
+			m.EffectiveConfig = AgentConfigMap{}
+			err = parse(f.bytes, func(f field) error {
+				if f.num == 1 {
+					return m.EffectiveConfig.unmarshal(f.bytes)
+				}
+				return nil
+			})
+		case 7:
+			m.RemoteConfigStatus = &RemoteConfigStatus{}
+			err = m.RemoteConfigStatus.unmarshal(f.bytes)
+		case 9:
+			m.AgentDisconnect = true
+		case 10:
+			m.Flags = f.varint
+		}
+		return err
+	})
+}
+
+// Marshal encodes the message.
+func (m *ServerToAgent) Marshal() []byte {
+	var b []byte
+	b = appendBytes(b, 1, m.InstanceUID)
+	if m.ErrorResponse != nil {
+		var eb []byte
+		eb = appendVarint(eb, 1, m.ErrorResponse.Type)
+		eb = appendString(eb, 2, m.ErrorResponse.ErrorMessage)
+		b = appendMessage(b, 2, eb)
+	}
+	if m.RemoteConfig != nil {
+		var rb []byte
+		rb = appendMessage(rb, 1, m.RemoteConfig.Config.marshal())
+		rb = appendBytes(rb, 2, m.RemoteConfig.ConfigHash)
+		b = appendMessage(b, 3, rb)
+	}
+	b = appendVarint(b, 6, m.Flags)
+	b = appendVarint(b, 7, m.Capabilities)
+	if m.NewInstanceUID != nil {
+		b = appendMessage(b, 8, appendBytes(nil, 1, m.NewInstanceUID))
+	}
+	return b
+}
+
+// Unmarshal decodes the message.
+func (m *ServerToAgent) Unmarshal(b []byte) error {
+	*m = ServerToAgent{}
+	return parse(b, func(f field) error {
+		switch f.num {
+		case 1:
+			m.InstanceUID = f.bytes
+		case 2:
+			m.ErrorResponse = &ServerErrorResponse{}
+			return parse(f.bytes, func(f field) error {
+				switch f.num {
+				case 1:
+					m.ErrorResponse.Type = f.varint
+				case 2:
+					m.ErrorResponse.ErrorMessage = string(f.bytes)
+				}
+				return nil
+			})
+		case 3:
+			m.RemoteConfig = &AgentRemoteConfig{Config: AgentConfigMap{}}
+			return parse(f.bytes, func(f field) error {
+				switch f.num {
+				case 1:
+					return m.RemoteConfig.Config.unmarshal(f.bytes)
+				case 2:
+					m.RemoteConfig.ConfigHash = f.bytes
+				}
+				return nil
+			})
+		case 6:
+			m.Flags = f.varint
+		case 7:
+			m.Capabilities = f.varint
+		case 8:
+			return parse(f.bytes, func(f field) error {
+				if f.num == 1 {
+					m.NewInstanceUID = f.bytes
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+func (m *AgentDescription) marshal() []byte {
+	var b []byte
+	for _, kv := range m.IdentifyingAttributes {
+		b = appendMessage(b, 1, kv.marshal())
+	}
+	for _, kv := range m.NonIdentifyingAttributes {
+		b = appendMessage(b, 2, kv.marshal())
+	}
+	return b
+}
+
+func (m *AgentDescription) unmarshal(b []byte) error {
+	return parse(b, func(f field) error {
+		var kv KeyValue
+		switch f.num {
+		case 1:
+			if err := kv.unmarshal(f.bytes); err != nil {
+				return err
+			}
+			m.IdentifyingAttributes = append(m.IdentifyingAttributes, kv)
+		case 2:
+			if err := kv.unmarshal(f.bytes); err != nil {
+				return err
+			}
+			m.NonIdentifyingAttributes = append(m.NonIdentifyingAttributes, kv)
+		}
+		return nil
+	})
+}
+
+func (kv KeyValue) marshal() []byte {
+	b := appendString(nil, 1, kv.Key)
+	// The string_value field of an AnyValue.
+	return appendMessage(b, 2, appendString(nil, 1, kv.Value))
+}
+
+func (kv *KeyValue) unmarshal(b []byte) error {
+	return parse(b, func(f field) error {
+		switch f.num {
+		case 1:
+			kv.Key = string(f.bytes)
+		case 2:
+			return parse(f.bytes, func(f field) error {
+				if f.num == 1 {
+					kv.Value = string(f.bytes)
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+func (m *ComponentHealth) marshal() []byte {
+	var b []byte
+	if m.Healthy {
+		b = appendVarint(b, 1, 1)
+	}
+	b = appendFixed64(b, 2, m.StartTimeUnixNano)
+	b = appendString(b, 3, m.LastError)
+	b = appendString(b, 4, m.Status)
+	b = appendFixed64(b, 5, m.StatusTimeUnixNano)
+	for _, key := range sortedKeys(m.ComponentHealthMap) {
+		entry := appendString(nil, 1, key)
+		entry = appendMessage(entry, 2, m.ComponentHealthMap[key].marshal())
+		b = appendMessage(b, 6, entry)
+	}
+	return b
+}
+
+func (m *ComponentHealth) unmarshal(b []byte) error {
+	return parse(b, func(f field) error {
+		switch f.num {
+		case 1:
+			m.Healthy = f.varint != 0
+		case 2:
+			m.StartTimeUnixNano = f.fixed64
+		case 3:
+			m.LastError = string(f.bytes)
+		case 4:
+			m.Status = string(f.bytes)
+		case 5:
+			m.StatusTimeUnixNano = f.fixed64
+		case 6:
+			var key string
+			value := &ComponentHealth{}
+			if err := parse(f.bytes, func(f field) error {
+				switch f.num {
+				case 1:
+					key = string(f.bytes)
+				case 2:
+					return value.unmarshal(f.bytes)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			if m.ComponentHealthMap == nil {
+				m.ComponentHealthMap = map[string]*ComponentHealth{}
+			}
+			m.ComponentHealthMap[key] = value
+		}
+		return nil
+	})
+}
+
+func (m AgentConfigMap) marshal() []byte {
+	var b []byte
+	for _, name := range sortedKeys(m) {
+		file := m[name]
+		fb := appendBytes(nil, 1, file.Body)
+		fb = appendString(fb, 2, file.ContentType)
+		entry := appendString(nil, 1, name)
+		entry = appendMessage(entry, 2, fb)
+		b = appendMessage(b, 1, entry)
+	}
+	return b
+}
+
+func (m AgentConfigMap) unmarshal(b []byte) error {
+	return parse(b, func(f field) error {
+		if f.num != 1 {
+			return nil
+		}
+		var name string
+		var file AgentConfigFile
+		if err := parse(f.bytes, func(f field) error {
+			switch f.num {
+			case 1:
+				name = string(f.bytes)
+			case 2:
+				return parse(f.bytes, func(f field) error {
+					switch f.num {
+					case 1:
+						file.Body = f.bytes
+					case 2:
+						file.ContentType = string(f.bytes)
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		m[name] = file
+		return nil
+	})
+}
+
+func (m *RemoteConfigStatus) marshal() []byte {
+	var b []byte
+	b = appendBytes(b, 1, m.LastRemoteConfigHash)
+	b = appendVarint(b, 2, uint64(m.Status))
+	return appendString(b, 3, m.ErrorMessage)
+}
+
+func (m *RemoteConfigStatus) unmarshal(b []byte) error {
+	return parse(b, func(f field) error {
+		switch f.num {
+		case 1:
+			m.LastRemoteConfigHash = f.bytes
+		case 2:
+			m.Status = RemoteConfigStatuses(f.varint)
+		case 3:
+			m.ErrorMessage = string(f.bytes)
+		}
+		return nil
+	})
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// The default values of the scalar fields are not encoded, as in proto3.
+
+func appendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func appendFixed64(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.Fixed64Type)
+	return protowire.AppendFixed64(b, v)
+}
+
+func appendBytes(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+func appendString(b []byte, num protowire.Number, v string) []byte {
+	if v == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, v)
+}
+
+// appendMessage appends an embedded message, even if it is empty.
+func appendMessage(b []byte, num protowire.Number, msg []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, msg)
+}
+
+// field is a decoded field of a message.
+type field struct {
+	num     protowire.Number
+	varint  uint64
+	fixed64 uint64
+	bytes   []byte
+}
+
+// parse calls fn for each field of the message. The unknown field types are skipped.
+func parse(b []byte, fn func(field) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		f := field{num: num}
+		switch typ {
+		case protowire.VarintType:
+			f.varint, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed64Type:
+			f.fixed64, n = protowire.ConsumeFixed64(b)
+		case protowire.BytesType:
+			f.bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n >= 0 {
+				b = b[n:]
+				continue
+			}
+		}
+		if n < 0 {
+			return fmt.Errorf("invalid field %d: %w", num, protowire.ParseError(n))
+		}
+		b = b[n:]
+		if err := fn(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
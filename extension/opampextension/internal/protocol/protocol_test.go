@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestAgentToServerRoundTrip(t *testing.T) {
+	msg := &AgentToServer{
+		InstanceUID: []byte("0123456789abcdef"),
+		SequenceNum: 42,
+		AgentDescription: &AgentDescription{
+			IdentifyingAttributes:    []KeyValue{{Key: "service.name", Value: "otelcol"}},
+			NonIdentifyingAttributes: []KeyValue{{Key: "os.type", Value: "linux"}, {Key: "host.arch", Value: "amd64"}},
+		},
+		Capabilities: AgentCapabilitiesReportsStatus | AgentCapabilitiesReportsHealth,
+		Health: &ComponentHealth{
+			Healthy:           true,
+			StartTimeUnixNano: 1,
+			Status:            "StatusOK",
+			ComponentHealthMap: map[string]*ComponentHealth{
+				"pipeline:traces": {
+					LastError:          "failed",
+					Status:             "StatusPermanentError",
+					StatusTimeUnixNano: 2,
+				},
+			},
+		},
+		EffectiveConfig: AgentConfigMap{"": {Body: []byte("receivers: {}"), ContentType: "text/yaml"}},
+		RemoteConfigStatus: &RemoteConfigStatus{
+			LastRemoteConfigHash: []byte("hash"),
+			Status:               RemoteConfigStatusesFailed,
+			ErrorMessage:         "invalid",
+		},
+		AgentDisconnect: true,
+	}
+	got := &AgentToServer{}
+	require.NoError(t, got.Unmarshal(msg.Marshal()))
+	assert.Equal(t, msg, got)
+}
+
+func TestServerToAgentRoundTrip(t *testing.T) {
+	msg := &ServerToAgent{
+		InstanceUID:   []byte("0123456789abcdef"),
+		ErrorResponse: &ServerErrorResponse{Type: 1, ErrorMessage: "unavailable"},
+		RemoteConfig: &AgentRemoteConfig{
+			Config:     AgentConfigMap{"collector.yaml": {Body: []byte("exporters: {}")}},
+			ConfigHash: []byte("hash"),
+		},
+		Flags:          ServerToAgentFlagsReportFullState,
+		Capabilities:   3,
+		NewInstanceUID: []byte("fedcba9876543210"),
+	}
+	got := &ServerToAgent{}
+	require.NoError(t, got.Unmarshal(msg.Marshal()))
+	assert.Equal(t, msg, got)
+}
+
+func TestUnmarshalSkipsUnknownFields(t *testing.T) {
+	b := protowire.AppendTag(nil, 100, protowire.Fixed32Type)
+	b = protowire.AppendFixed32(b, 1)
+	b = protowire.AppendTag(b, 6, protowire.VarintType)
+	b = protowire.AppendVarint(b, ServerToAgentFlagsReportFullState)
+	msg := &ServerToAgent{}
+	require.NoError(t, msg.Unmarshal(b))
+	assert.Equal(t, &ServerToAgent{Flags: ServerToAgentFlagsReportFullState}, msg)
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	msg := &ServerToAgent{}
+	assert.Error(t, msg.Unmarshal([]byte{0x1a, 0x05, 0x01}))
+}
+
+// The golden encodings are checked against the field numbers of the opamp.proto file of the OpAMP specification.
+func TestAgentToServerGolden(t *testing.T) {
+	msg := &AgentToServer{
+		InstanceUID: []byte{1, 2},
+		SequenceNum: 1,
+		AgentDescription: &AgentDescription{
+			IdentifyingAttributes: []KeyValue{{Key: "k", Value: "v"}},
+		},
+		Capabilities: AgentCapabilitiesReportsStatus | AgentCapabilitiesReportsHealth,
+		RemoteConfigStatus: &RemoteConfigStatus{
+			LastRemoteConfigHash: []byte("h"),
+			Status:               RemoteConfigStatusesApplied,
+		},
+	}
+	golden := []byte{
+		0x0a, 0x02, 0x01, 0x02, // instance_uid
+		0x10, 0x01, // sequence_num
+		0x1a, 0x0a, // agent_description
+		0x0a, 0x08, // identifying_attributes
+		0x0a, 0x01, 'k', // key
+		0x12, 0x03, 0x0a, 0x01, 'v', // value.string_value
+		0x20, 0x81, 0x10, // capabilities
+		0x3a, 0x05, // remote_config_status
+		0x0a, 0x01, 'h', // last_remote_config_hash
+		0x10, 0x01, // status
+	}
+	assert.Equal(t, golden, msg.Marshal())
+	got := &AgentToServer{}
+	require.NoError(t, got.Unmarshal(golden))
+	assert.Equal(t, msg, got)
+}
+
+func TestServerToAgentGolden(t *testing.T) {
+	msg := &ServerToAgent{
+		RemoteConfig: &AgentRemoteConfig{
+			Config:     AgentConfigMap{"c": {Body: []byte("b")}},
+			ConfigHash: []byte("h"),
+		},
+		NewInstanceUID: []byte{9},
+	}
+	golden := []byte{
+		0x1a, 0x0f, // remote_config
+		0x0a, 0x0a, // config
+		0x0a, 0x08, // config_map entry
+		0x0a, 0x01, 'c', // key
+		0x12, 0x03, 0x0a, 0x01, 'b', // value.body
+		0x12, 0x01, 'h', // config_hash
+		0x42, 0x03, // agent_identification
+		0x0a, 0x01, 0x09, // new_instance_uid
+	}
+	assert.Equal(t, golden, msg.Marshal())
+	got := &ServerToAgent{}
+	require.NoError(t, got.Unmarshal(golden))
+	assert.Equal(t, msg, got)
+}
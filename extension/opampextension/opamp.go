@@ -0,0 +1,479 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opampextension // import "go.opentelemetry.io/collector/extension/opampextension"
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/provider/fileprovider"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/extension/opampextension/internal/protocol"
+)
+
+const (
+	contentTypeProtobuf = "application/x-protobuf"
+	contentTypeYAML     = "text/yaml"
+
+	// maxResponseSize is the maximum size of a message accepted from the server.
+	maxResponseSize = 16 << 20
+)
+
+// processInstanceUID is the instance UID generated for the collector process. It is kept
+// when the configuration is reloaded, so the collector is still identified as the same agent.
+var processInstanceUID struct {
+	sync.Mutex
+	uid []byte
+}
+
+func generatedInstanceUID() []byte {
+	processInstanceUID.Lock()
+	defer processInstanceUID.Unlock()
+	if processInstanceUID.uid == nil {
+		uid := make([]byte, 16)
+		_, _ = rand.Read(uid)
+		// Version 4 UUID.
+		uid[6] = uid[6]&0x0f | 0x40
+		uid[8] = uid[8]&0x3f | 0x80
+		processInstanceUID.uid = uid
+	}
+	return processInstanceUID.uid
+}
+
+func setGeneratedInstanceUID(uid []byte) {
+	processInstanceUID.Lock()
+	defer processInstanceUID.Unlock()
+	processInstanceUID.uid = uid
+}
+
+// opampAgent is an OpAMP agent reporting the state of the collector to an OpAMP server over HTTP,
+// and applying the configuration offered by the server.
+type opampAgent struct {
+	cfg       *Config
+	telemetry component.TelemetrySettings
+	buildInfo component.BuildInfo
+	// reload notifies the config provider of the collector that the remote configuration file changed,
+	// so that the collector reloads its configuration.
+	reload func() error
+
+	client *http.Client
+	cancel context.CancelFunc
+	pollCh chan struct{}
+	doneCh chan struct{}
+
+	mu                 sync.Mutex
+	instanceUID        []byte
+	sequenceNum        uint64
+	startTime          time.Time
+	statuses           map[*component.InstanceID]*component.StatusEvent
+	effectiveConfig    []byte
+	remoteConfigStatus *protocol.RemoteConfigStatus
+}
+
+var (
+	_ extension.ConfigWatcher = (*opampAgent)(nil)
+	_ extension.StatusWatcher = (*opampAgent)(nil)
+)
+
+func newOpAMPAgent(cfg *Config, set extension.CreateSettings) (*opampAgent, error) {
+	uid := generatedInstanceUID()
+	if cfg.InstanceUID != "" {
+		var err error
+		if uid, err = parseInstanceUID(cfg.InstanceUID); err != nil {
+			return nil, err
+		}
+	}
+	return &opampAgent{
+		cfg:         cfg,
+		telemetry:   set.TelemetrySettings,
+		buildInfo:   set.BuildInfo,
+		reload:      func() error { return fileprovider.NotifyChange(cfg.RemoteConfigPath) },
+		pollCh:      make(chan struct{}, 1),
+		instanceUID: uid,
+		statuses:    map[*component.InstanceID]*component.StatusEvent{},
+	}, nil
+}
+
+func (a *opampAgent) Start(_ context.Context, _ component.Host) error {
+	tlsCfg, err := a.cfg.Server.TLSSetting.LoadTLSConfig()
+	if err != nil {
+		return err
+	}
+	a.client = &http.Client{
+		Timeout:   a.cfg.Server.Timeout,
+		Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsCfg},
+	}
+
+	if a.cfg.Capabilities.AcceptsRemoteConfig {
+		// The configuration written before the collector was reloaded is the one it is running with.
+		hash, err := os.ReadFile(a.hashPath())
+		switch {
+		case err == nil:
+			a.remoteConfigStatus = &protocol.RemoteConfigStatus{
+				LastRemoteConfigHash: hash,
+				Status:               protocol.RemoteConfigStatusesApplied,
+			}
+		case !errors.Is(err, os.ErrNotExist):
+			return fmt.Errorf("failed to read the hash of the remote configuration: %w", err)
+		}
+	}
+
+	a.startTime = time.Now()
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancel = cancel
+	a.doneCh = make(chan struct{})
+	go a.run(ctx)
+	return nil
+}
+
+// Shutdown stops polling the server, and notifies it that the collector is disconnecting.
+func (a *opampAgent) Shutdown(ctx context.Context) error {
+	if a.cancel == nil {
+		return nil
+	}
+	a.cancel()
+	<-a.doneCh
+
+	msg := a.agentToServer()
+	msg.AgentDisconnect = true
+	if _, err := a.send(ctx, msg); err != nil {
+		a.telemetry.Logger.Warn("Failed to notify the OpAMP server of the disconnection", zap.Error(err))
+	}
+	a.client.CloseIdleConnections()
+	return nil
+}
+
+// NotifyConfig records the configuration of the collector, which is reported as its effective configuration.
+func (a *opampAgent) NotifyConfig(_ context.Context, conf *confmap.Conf) error {
+	if !a.cfg.Capabilities.ReportsEffectiveConfig {
+		return nil
+	}
+	body, err := yaml.Marshal(conf.ToStringMap())
+	if err != nil {
+		return fmt.Errorf("failed to marshal the effective configuration: %w", err)
+	}
+	a.mu.Lock()
+	a.effectiveConfig = body
+	a.mu.Unlock()
+	a.triggerPoll()
+	return nil
+}
+
+// ComponentStatusChanged records the latest status of the component, which is reported in the health of the collector.
+func (a *opampAgent) ComponentStatusChanged(source *component.InstanceID, event *component.StatusEvent) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.statuses[source] = event
+}
+
+func (a *opampAgent) triggerPoll() {
+	select {
+	case a.pollCh <- struct{}{}:
+	default:
+	}
+}
+
+func (a *opampAgent) run(ctx context.Context) {
+	defer close(a.doneCh)
+	ticker := time.NewTicker(a.cfg.PollingInterval)
+	defer ticker.Stop()
+	for {
+		resp, err := a.send(ctx, a.agentToServer())
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			a.telemetry.Logger.Warn("Failed to send the state of the collector to the OpAMP server", zap.Error(err))
+		} else {
+			a.handle(resp)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-a.pollCh:
+		}
+	}
+}
+
+// send sends the message to the server, and returns its response.
+func (a *opampAgent) send(ctx context.Context, msg *protocol.AgentToServer) (*protocol.ServerToAgent, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.cfg.Server.Endpoint, bytes.NewReader(msg.Marshal()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentTypeProtobuf)
+	req.Header.Set("User-Agent", a.buildInfo.Command+"/"+a.buildInfo.Version)
+	for k, v := range a.cfg.Server.Headers {
+		req.Header.Set(k, string(v))
+	}
+	httpResp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(httpResp.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %q", httpResp.Status)
+	}
+	resp := &protocol.ServerToAgent{}
+	if err := resp.Unmarshal(body); err != nil {
+		return nil, fmt.Errorf("invalid response: %w", err)
+	}
+	return resp, nil
+}
+
+// agentToServer returns the message reporting the full state of the collector.
+func (a *opampAgent) agentToServer() *protocol.AgentToServer {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.sequenceNum++
+	msg := &protocol.AgentToServer{
+		InstanceUID:      a.instanceUID,
+		SequenceNum:      a.sequenceNum,
+		AgentDescription: a.agentDescription(),
+		Capabilities:     a.capabilities(),
+	}
+	if a.cfg.Capabilities.ReportsHealth {
+		msg.Health = a.health()
+	}
+	if a.cfg.Capabilities.ReportsEffectiveConfig && a.effectiveConfig != nil {
+		msg.EffectiveConfig = protocol.AgentConfigMap{
+			"": {Body: a.effectiveConfig, ContentType: contentTypeYAML},
+		}
+	}
+	if a.cfg.Capabilities.AcceptsRemoteConfig {
+		msg.RemoteConfigStatus = a.remoteConfigStatus
+	}
+	return msg
+}
+
+func (a *opampAgent) capabilities() uint64 {
+	capabilities := protocol.AgentCapabilitiesReportsStatus
+	if a.cfg.Capabilities.ReportsEffectiveConfig {
+		capabilities |= protocol.AgentCapabilitiesReportsEffectiveConfig
+	}
+	if a.cfg.Capabilities.ReportsHealth {
+		capabilities |= protocol.AgentCapabilitiesReportsHealth
+	}
+	if a.cfg.Capabilities.AcceptsRemoteConfig {
+		capabilities |= protocol.AgentCapabilitiesAcceptsRemoteConfig | protocol.AgentCapabilitiesReportsRemoteConfig
+	}
+	return capabilities
+}
+
+// agentDescription identifies the collector by its build and instance UID, and describes its host.
+func (a *opampAgent) agentDescription() *protocol.AgentDescription {
+	nonIdentifying := map[string]string{
+		"os.type":   runtime.GOOS,
+		"host.arch": runtime.GOARCH,
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		nonIdentifying["host.name"] = hostname
+	}
+	for k, v := range a.cfg.AgentDescription.NonIdentifyingAttributes {
+		nonIdentifying[k] = v
+	}
+	return &protocol.AgentDescription{
+		IdentifyingAttributes: sortedAttributes(map[string]string{
+			"service.name":        a.buildInfo.Command,
+			"service.version":     a.buildInfo.Version,
+			"service.instance.id": formatInstanceUID(a.instanceUID),
+		}),
+		NonIdentifyingAttributes: sortedAttributes(nonIdentifying),
+	}
+}
+
+func sortedAttributes(attrs map[string]string) []protocol.KeyValue {
+	kvs := make([]protocol.KeyValue, 0, len(attrs))
+	for k, v := range attrs {
+		kvs = append(kvs, protocol.KeyValue{Key: k, Value: v})
+	}
+	sort.Slice(kvs, func(i, j int) bool { return kvs[i].Key < kvs[j].Key })
+	return kvs
+}
+
+// formatInstanceUID formats the instance UID as a UUID.
+func formatInstanceUID(uid []byte) string {
+	s := hex.EncodeToString(uid)
+	if len(s) != 32 {
+		return s
+	}
+	return s[:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
+
+// health returns the health of the collector, with the health of its pipelines keyed by "pipeline:<id>", and of
+// its extensions keyed by "extension:<id>". The health of the components of a pipeline is keyed by "<kind>:<id>".
+// The components are healthy unless they are in a permanent or fatal error state.
+func (a *opampAgent) health() *protocol.ComponentHealth {
+	byPipeline := map[string]map[*component.InstanceID]*component.StatusEvent{}
+	groups := map[string]*protocol.ComponentHealth{}
+	for source, ev := range a.statuses {
+		ch := componentHealth(ev)
+		componentKey := strings.ToLower(source.Kind.String()) + ":" + source.ID.String()
+		if source.Kind == component.KindExtension {
+			groups[componentKey] = ch
+			continue
+		}
+		for pipelineID := range source.PipelineIDs {
+			key := "pipeline:" + pipelineID.String()
+			if byPipeline[key] == nil {
+				byPipeline[key] = map[*component.InstanceID]*component.StatusEvent{}
+				groups[key] = &protocol.ComponentHealth{ComponentHealthMap: map[string]*protocol.ComponentHealth{}}
+			}
+			byPipeline[key][source] = ev
+			groups[key].ComponentHealthMap[componentKey] = ch
+		}
+	}
+	for key, statuses := range byPipeline {
+		ch := componentHealth(component.AggregateStatusEvent(statuses))
+		ch.ComponentHealthMap = groups[key].ComponentHealthMap
+		groups[key] = ch
+	}
+
+	health := &protocol.ComponentHealth{
+		Healthy:            true,
+		StartTimeUnixNano:  uint64(a.startTime.UnixNano()),
+		Status:             component.StatusNone.String(),
+		ComponentHealthMap: groups,
+	}
+	if len(a.statuses) > 0 {
+		aggregate := componentHealth(component.AggregateStatusEvent(a.statuses))
+		health.Healthy = aggregate.Healthy
+		health.Status = aggregate.Status
+		health.LastError = aggregate.LastError
+		health.StatusTimeUnixNano = aggregate.StatusTimeUnixNano
+	}
+	return health
+}
+
+func componentHealth(ev *component.StatusEvent) *protocol.ComponentHealth {
+	ch := &protocol.ComponentHealth{
+		Healthy:            ev.Status() != component.StatusPermanentError && ev.Status() != component.StatusFatalError,
+		Status:             ev.Status().String(),
+		StatusTimeUnixNano: uint64(ev.Timestamp().UnixNano()),
+	}
+	if ev.Err() != nil {
+		ch.LastError = ev.Err().Error()
+	}
+	return ch
+}
+
+// handle processes the response of the server.
+func (a *opampAgent) handle(resp *protocol.ServerToAgent) {
+	if resp.ErrorResponse != nil {
+		a.telemetry.Logger.Warn("The OpAMP server returned an error", zap.String("error", resp.ErrorResponse.ErrorMessage))
+		return
+	}
+	if len(resp.NewInstanceUID) == 16 {
+		a.mu.Lock()
+		a.instanceUID = resp.NewInstanceUID
+		a.mu.Unlock()
+		if a.cfg.InstanceUID == "" {
+			setGeneratedInstanceUID(resp.NewInstanceUID)
+		}
+		a.telemetry.Logger.Info("The OpAMP server assigned a new instance UID", zap.String("instance_uid", formatInstanceUID(resp.NewInstanceUID)))
+		a.triggerPoll()
+	}
+	if resp.RemoteConfig != nil && a.cfg.Capabilities.AcceptsRemoteConfig {
+		a.applyRemoteConfig(resp.RemoteConfig)
+	}
+}
+
+// applyRemoteConfig writes the remote configuration to the remote configuration file, and reloads the
+// configuration of the collector. The remote configuration is applied once the collector is reloaded.
+func (a *opampAgent) applyRemoteConfig(rc *protocol.AgentRemoteConfig) {
+	a.mu.Lock()
+	current := a.remoteConfigStatus
+	a.mu.Unlock()
+	if current != nil && bytes.Equal(current.LastRemoteConfigHash, rc.ConfigHash) {
+		return
+	}
+
+	status := &protocol.RemoteConfigStatus{
+		LastRemoteConfigHash: rc.ConfigHash,
+		Status:               protocol.RemoteConfigStatusesApplying,
+	}
+	err := a.writeRemoteConfig(rc)
+	if err == nil {
+		a.telemetry.Logger.Info("Reloading the collector with the remote configuration", zap.String("path", a.cfg.RemoteConfigPath))
+		if err = a.reload(); err != nil {
+			err = fmt.Errorf("failed to reload the configuration: %w", err)
+		}
+	}
+	if err != nil {
+		a.telemetry.Logger.Warn("Failed to apply the remote configuration", zap.Error(err))
+		status.Status = protocol.RemoteConfigStatusesFailed
+		status.ErrorMessage = err.Error()
+	}
+	a.mu.Lock()
+	a.remoteConfigStatus = status
+	a.mu.Unlock()
+	a.triggerPoll()
+}
+
+func (a *opampAgent) writeRemoteConfig(rc *protocol.AgentRemoteConfig) error {
+	if len(rc.Config) != 1 {
+		return fmt.Errorf("the remote configuration must have a single file, got %d", len(rc.Config))
+	}
+	var body []byte
+	for _, file := range rc.Config {
+		body = file.Body
+	}
+	var conf map[string]any
+	if err := yaml.Unmarshal(body, &conf); err != nil {
+		return fmt.Errorf("invalid remote configuration: %w", err)
+	}
+	if err := writeFileAtomic(a.cfg.RemoteConfigPath, body); err != nil {
+		return fmt.Errorf("failed to write the remote configuration: %w", err)
+	}
+	if err := writeFileAtomic(a.hashPath(), rc.ConfigHash); err != nil {
+		return fmt.Errorf("failed to write the hash of the remote configuration: %w", err)
+	}
+	return nil
+}
+
+// hashPath is the path of the file holding the hash of the remote configuration.
+func (a *opampAgent) hashPath() string {
+	return a.cfg.RemoteConfigPath + ".hash"
+}
+
+// writeFileAtomic writes the file through a temporary file, so it is never partially written.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
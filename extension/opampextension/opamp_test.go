@@ -0,0 +1,341 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opampextension
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/provider/fileprovider"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+	"go.opentelemetry.io/collector/extension/opampextension/internal/protocol"
+)
+
+// fakeServer is an OpAMP server recording the messages it receives.
+type fakeServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	messages []*protocol.AgentToServer
+	headers  []http.Header
+	// respond returns the response to a message.
+	respond func(*protocol.AgentToServer) *protocol.ServerToAgent
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	srv := &fakeServer{respond: func(*protocol.AgentToServer) *protocol.ServerToAgent {
+		return &protocol.ServerToAgent{}
+	}}
+	srv.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, contentTypeProtobuf, r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		msg := &protocol.AgentToServer{}
+		assert.NoError(t, msg.Unmarshal(body))
+
+		srv.mu.Lock()
+		srv.messages = append(srv.messages, msg)
+		srv.headers = append(srv.headers, r.Header)
+		respond := srv.respond
+		srv.mu.Unlock()
+		w.Header().Set("Content-Type", contentTypeProtobuf)
+		_, _ = w.Write(respond(msg).Marshal())
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func (srv *fakeServer) setRespond(respond func(*protocol.AgentToServer) *protocol.ServerToAgent) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	srv.respond = respond
+}
+
+// lastMessage returns the last message received by the server, or nil if there is none.
+func (srv *fakeServer) lastMessage() *protocol.AgentToServer {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if len(srv.messages) == 0 {
+		return nil
+	}
+	return srv.messages[len(srv.messages)-1]
+}
+
+func newTestAgent(t *testing.T, srv *fakeServer, modify func(*Config)) *opampAgent {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Server.Endpoint = srv.URL
+	cfg.PollingInterval = 10 * time.Millisecond
+	if modify != nil {
+		modify(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+	set := extensiontest.NewNopCreateSettings()
+	set.BuildInfo = component.BuildInfo{Command: "otelcol", Version: "1.2.3"}
+	agent, err := newOpAMPAgent(cfg, set)
+	require.NoError(t, err)
+	return agent
+}
+
+func attributes(kvs []protocol.KeyValue) map[string]string {
+	attrs := map[string]string{}
+	for _, kv := range kvs {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestReportsState(t *testing.T) {
+	srv := newFakeServer(t)
+	agent := newTestAgent(t, srv, func(cfg *Config) {
+		cfg.InstanceUID = "01234567-89ab-cdef-0123-456789abcdef"
+		cfg.Server.Headers = map[string]configopaque.String{"Authorization": "Bearer secret"}
+		cfg.AgentDescription.NonIdentifyingAttributes = map[string]string{"deployment.environment": "production"}
+	})
+	require.NoError(t, agent.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, agent.NotifyConfig(context.Background(), confmap.NewFromStringMap(map[string]any{
+		"receivers": map[string]any{"otlp": nil},
+	})))
+	agent.ComponentStatusChanged(&component.InstanceID{ID: component.NewID("health_check"), Kind: component.KindExtension},
+		component.NewStatusEvent(component.StatusOK))
+	assert.Eventually(t, func() bool {
+		msg := srv.lastMessage()
+		return msg != nil && msg.EffectiveConfig != nil && len(msg.Health.ComponentHealthMap) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	msg := srv.lastMessage()
+	assert.Equal(t, []byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}, msg.InstanceUID)
+	assert.NotZero(t, msg.SequenceNum)
+	assert.Equal(t, protocol.AgentCapabilitiesReportsStatus|protocol.AgentCapabilitiesReportsEffectiveConfig|protocol.AgentCapabilitiesReportsHealth, msg.Capabilities)
+	assert.Equal(t, map[string]string{
+		"service.name":        "otelcol",
+		"service.version":     "1.2.3",
+		"service.instance.id": "01234567-89ab-cdef-0123-456789abcdef",
+	}, attributes(msg.AgentDescription.IdentifyingAttributes))
+	nonIdentifying := attributes(msg.AgentDescription.NonIdentifyingAttributes)
+	assert.Equal(t, "production", nonIdentifying["deployment.environment"])
+	assert.Contains(t, nonIdentifying, "os.type")
+	assert.Equal(t, protocol.AgentConfigMap{"": {Body: []byte("receivers:\n    otlp: null\n"), ContentType: "text/yaml"}}, msg.EffectiveConfig)
+	assert.True(t, msg.Health.Healthy)
+	assert.Equal(t, "StatusOK", msg.Health.Status)
+	assert.Equal(t, "StatusOK", msg.Health.ComponentHealthMap["extension:health_check"].Status)
+	assert.Nil(t, msg.RemoteConfigStatus)
+	srv.mu.Lock()
+	assert.Equal(t, "Bearer secret", srv.headers[0].Get("Authorization"))
+	srv.mu.Unlock()
+
+	require.NoError(t, agent.Shutdown(context.Background()))
+	assert.True(t, srv.lastMessage().AgentDisconnect)
+}
+
+func TestHealth(t *testing.T) {
+	agent := newTestAgent(t, newFakeServer(t), nil)
+	traces := component.NewID("traces")
+	metrics := component.NewID("metrics")
+	otlp := &component.InstanceID{
+		ID:          component.NewID("otlp"),
+		Kind:        component.KindReceiver,
+		PipelineIDs: map[component.ID]struct{}{traces: {}, metrics: {}},
+	}
+	batch := &component.InstanceID{
+		ID:          component.NewID("batch"),
+		Kind:        component.KindProcessor,
+		PipelineIDs: map[component.ID]struct{}{traces: {}},
+	}
+	agent.ComponentStatusChanged(otlp, component.NewStatusEvent(component.StatusOK))
+	agent.ComponentStatusChanged(batch, component.NewPermanentErrorEvent(errors.New("out of memory")))
+
+	health := agent.health()
+	assert.False(t, health.Healthy)
+	assert.Equal(t, "StatusPermanentError", health.Status)
+	assert.Equal(t, "out of memory", health.LastError)
+	require.Len(t, health.ComponentHealthMap, 2)
+
+	tracesHealth := health.ComponentHealthMap["pipeline:traces"]
+	assert.False(t, tracesHealth.Healthy)
+	assert.Equal(t, "StatusPermanentError", tracesHealth.Status)
+	assert.Len(t, tracesHealth.ComponentHealthMap, 2)
+	assert.False(t, tracesHealth.ComponentHealthMap["processor:batch"].Healthy)
+	assert.True(t, tracesHealth.ComponentHealthMap["receiver:otlp"].Healthy)
+
+	metricsHealth := health.ComponentHealthMap["pipeline:metrics"]
+	assert.True(t, metricsHealth.Healthy)
+	assert.Equal(t, "StatusOK", metricsHealth.Status)
+	assert.Len(t, metricsHealth.ComponentHealthMap, 1)
+}
+
+func TestNewInstanceUID(t *testing.T) {
+	srv := newFakeServer(t)
+	newUID := []byte("fedcba9876543210")
+	srv.setRespond(func(*protocol.AgentToServer) *protocol.ServerToAgent {
+		return &protocol.ServerToAgent{NewInstanceUID: newUID}
+	})
+	agent := newTestAgent(t, srv, func(cfg *Config) { cfg.InstanceUID = "01234567-89ab-cdef-0123-456789abcdef" })
+	require.NoError(t, agent.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Eventually(t, func() bool {
+		msg := srv.lastMessage()
+		return msg != nil && string(msg.InstanceUID) == string(newUID)
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, agent.Shutdown(context.Background()))
+}
+
+func TestServerUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+	agent := newTestAgent(t, &fakeServer{Server: srv}, nil)
+	agent.client = srv.Client()
+	_, err := agent.send(context.Background(), agent.agentToServer())
+	assert.EqualError(t, err, "unexpected response status \"503 Service Unavailable\"")
+}
+
+func remoteConfig(body string, hash string) func(*protocol.AgentToServer) *protocol.ServerToAgent {
+	return func(*protocol.AgentToServer) *protocol.ServerToAgent {
+		return &protocol.ServerToAgent{RemoteConfig: &protocol.AgentRemoteConfig{
+			Config:     protocol.AgentConfigMap{"": {Body: []byte(body), ContentType: "text/yaml"}},
+			ConfigHash: []byte(hash),
+		}}
+	}
+}
+
+func TestRemoteConfig(t *testing.T) {
+	srv := newFakeServer(t)
+	path := filepath.Join(t.TempDir(), "remote.yaml")
+	body := "exporters:\n  debug: {}\n"
+	srv.setRespond(remoteConfig(body, "hash1"))
+
+	// The remote configuration file is one of the configuration files of the collector, whose config provider
+	// is notified when it changes.
+	require.NoError(t, os.WriteFile(path, []byte("{}"), 0600))
+	var mu sync.Mutex
+	reloads := 0
+	fp := fileprovider.New()
+	ret, err := fp.Retrieve(context.Background(), "file:"+path, func(*confmap.ChangeEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		reloads++
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, ret.Close(context.Background()))
+		require.NoError(t, fp.Shutdown(context.Background()))
+	}()
+
+	start := func() *opampAgent {
+		agent := newTestAgent(t, srv, func(cfg *Config) {
+			cfg.Capabilities.AcceptsRemoteConfig = true
+			cfg.RemoteConfigPath = path
+		})
+		require.NoError(t, agent.Start(context.Background(), componenttest.NewNopHost()))
+		return agent
+	}
+
+	agent := start()
+	assert.Eventually(t, func() bool {
+		msg := srv.lastMessage()
+		return msg.RemoteConfigStatus != nil && msg.RemoteConfigStatus.Status == protocol.RemoteConfigStatusesApplying
+	}, time.Second, 10*time.Millisecond)
+	msg := srv.lastMessage()
+	assert.Equal(t, protocol.AgentCapabilitiesAcceptsRemoteConfig|protocol.AgentCapabilitiesReportsRemoteConfig,
+		msg.Capabilities&(protocol.AgentCapabilitiesAcceptsRemoteConfig|protocol.AgentCapabilitiesReportsRemoteConfig))
+	assert.Equal(t, []byte("hash1"), msg.RemoteConfigStatus.LastRemoteConfigHash)
+	require.NoError(t, agent.Shutdown(context.Background()))
+
+	written, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(written))
+
+	mu.Lock()
+	assert.Equal(t, 1, reloads)
+	mu.Unlock()
+
+	// Once reloaded, the remote configuration is reported as applied, and it is not applied again.
+	agent = start()
+	assert.Eventually(t, func() bool {
+		msg := srv.lastMessage()
+		return msg.RemoteConfigStatus != nil && msg.RemoteConfigStatus.Status == protocol.RemoteConfigStatusesApplied
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, agent.Shutdown(context.Background()))
+	assert.Equal(t, protocol.RemoteConfigStatusesApplied, srv.lastMessage().RemoteConfigStatus.Status)
+	mu.Lock()
+	assert.Equal(t, 1, reloads)
+	mu.Unlock()
+}
+
+func TestRemoteConfigFailed(t *testing.T) {
+	tests := []struct {
+		name    string
+		respond func(*protocol.AgentToServer) *protocol.ServerToAgent
+		// reload replaces the notification of the config provider if set.
+		reload func() error
+		errMsg string
+	}{
+		{
+			name:    "invalid yaml",
+			respond: remoteConfig("exporters: [", "hash"),
+			errMsg:  "invalid remote configuration: yaml: line 1: did not find expected node content",
+		},
+		{
+			name: "several files",
+			respond: func(*protocol.AgentToServer) *protocol.ServerToAgent {
+				return &protocol.ServerToAgent{RemoteConfig: &protocol.AgentRemoteConfig{
+					Config:     protocol.AgentConfigMap{"a.yaml": {}, "b.yaml": {}},
+					ConfigHash: []byte("hash"),
+				}}
+			},
+			errMsg: "the remote configuration must have a single file, got 2",
+		},
+		{
+			name:    "not a configuration file",
+			respond: remoteConfig("exporters: {}", "hash"),
+			errMsg:  "failed to reload the configuration: the configuration of the file %s is not watched",
+		},
+		{
+			name:    "reload failed",
+			respond: remoteConfig("exporters: {}", "hash"),
+			reload:  func() error { return errors.New("reload failed") },
+			errMsg:  "failed to reload the configuration: reload failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newFakeServer(t)
+			srv.setRespond(tt.respond)
+			path := filepath.Join(t.TempDir(), "remote.yaml")
+			agent := newTestAgent(t, srv, func(cfg *Config) {
+				cfg.Capabilities.AcceptsRemoteConfig = true
+				cfg.RemoteConfigPath = path
+			})
+			if tt.reload != nil {
+				agent.reload = tt.reload
+			}
+			require.NoError(t, agent.Start(context.Background(), componenttest.NewNopHost()))
+			assert.Eventually(t, func() bool {
+				msg := srv.lastMessage()
+				return msg.RemoteConfigStatus != nil && msg.RemoteConfigStatus.Status == protocol.RemoteConfigStatusesFailed
+			}, time.Second, 10*time.Millisecond)
+			require.NoError(t, agent.Shutdown(context.Background()))
+			status := srv.lastMessage().RemoteConfigStatus
+			assert.Equal(t, []byte("hash"), status.LastRemoteConfigHash)
+			assert.Equal(t, strings.ReplaceAll(tt.errMsg, "%s", path), status.ErrorMessage)
+		})
+	}
+}
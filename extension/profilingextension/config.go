@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profilingextension // import "go.opentelemetry.io/collector/extension/profilingextension"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+)
+
+// Config has the configuration of the profiling extension.
+type Config struct {
+	// HTTPServerConfig has the settings of the server serving the profiling endpoints. The endpoints are
+	// served over plain HTTP when tls is not set, and the requests are not authenticated when auth is not set.
+	confighttp.HTTPServerConfig `mapstructure:",squash"`
+
+	// DefaultDuration is the duration of the CPU profiles when the request does not specify one.
+	DefaultDuration time.Duration `mapstructure:"default_duration"`
+
+	// MaxDuration is the maximum duration of the CPU profiles that can be requested.
+	MaxDuration time.Duration `mapstructure:"max_duration"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("\"endpoint\" is required when using the \"profiling\" extension")
+	}
+	if cfg.MaxDuration <= 0 {
+		return errors.New("max_duration must be positive")
+	}
+	if cfg.DefaultDuration <= 0 || cfg.DefaultDuration > cfg.MaxDuration {
+		return errors.New("default_duration must be positive and not greater than max_duration")
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profilingextension
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	assert.NoError(t, component.UnmarshalConfig(confmap.New(), cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id       component.ID
+		expected component.Config
+	}{
+		{
+			id:       component.NewID("profiling"),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName("profiling", "secure"),
+			expected: &Config{
+				HTTPServerConfig: confighttp.HTTPServerConfig{
+					Endpoint: "0.0.0.0:8443",
+					TLSSetting: &configtls.TLSServerSetting{
+						TLSSetting: configtls.TLSSetting{
+							CertFile: "server.crt",
+							KeyFile:  "server.key",
+						},
+					},
+					Auth: &configauth.Authentication{AuthenticatorID: component.NewID("basicauth")},
+				},
+				DefaultDuration: 10 * time.Second,
+				MaxDuration:     time.Minute,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			cfg := NewFactory().CreateDefaultConfig()
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		errMsg string
+	}{
+		{
+			name:   "no endpoint",
+			modify: func(cfg *Config) { cfg.Endpoint = "" },
+			errMsg: "\"endpoint\" is required when using the \"profiling\" extension",
+		},
+		{
+			name:   "no max duration",
+			modify: func(cfg *Config) { cfg.MaxDuration = 0 },
+			errMsg: "max_duration must be positive",
+		},
+		{
+			name:   "no default duration",
+			modify: func(cfg *Config) { cfg.DefaultDuration = 0 },
+			errMsg: "default_duration must be positive and not greater than max_duration",
+		},
+		{
+			name:   "default duration greater than max duration",
+			modify: func(cfg *Config) { cfg.DefaultDuration = cfg.MaxDuration + time.Second },
+			errMsg: "default_duration must be positive and not greater than max_duration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.errMsg)
+		})
+	}
+}
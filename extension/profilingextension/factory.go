@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profilingextension // import "go.opentelemetry.io/collector/extension/profilingextension"
+
+//go:generate mdatagen metadata.yaml
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/extension/profilingextension/internal/metadata"
+)
+
+const (
+	defaultEndpoint        = "localhost:1778"
+	defaultDefaultDuration = 30 * time.Second
+	defaultMaxDuration     = 2 * time.Minute
+)
+
+// NewFactory creates a factory for the profiling extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(metadata.Type, createDefaultConfig, createExtension, metadata.ExtensionStability)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		HTTPServerConfig: confighttp.HTTPServerConfig{
+			Endpoint: defaultEndpoint,
+		},
+		DefaultDuration: defaultDefaultDuration,
+		MaxDuration:     defaultMaxDuration,
+	}
+}
+
+func createExtension(_ context.Context, set extension.CreateSettings, cfg component.Config) (extension.Extension, error) {
+	return newProfiling(cfg.(*Config), set), nil
+}
@@ -0,0 +1,451 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pprofconv converts profiles in the pprof format to pprofile profiles.
+package pprofconv // import "go.opentelemetry.io/collector/extension/profilingextension/internal/pprofconv"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+// PayloadFormat is the original payload format of the converted profiles.
+const PayloadFormat = "pprof"
+
+// The messages of the pprof format, see https://github.com/google/pprof/blob/main/proto/profile.proto.
+// The mappings, locations and functions reference each other by ID, while pprofile profiles
+// reference them by index.
+
+type profile struct {
+	sampleTypes       []valueType
+	samples           []sample
+	mappings          []mapping
+	locations         []location
+	functions         []function
+	stringTable       []string
+	dropFrames        int64
+	keepFrames        int64
+	timeNanos         int64
+	durationNanos     int64
+	periodType        valueType
+	period            int64
+	comments          []int64
+	defaultSampleType int64
+}
+
+type valueType struct {
+	typ, unit int64
+}
+
+type sample struct {
+	locationIDs []uint64
+	values      []int64
+	labels      []label
+}
+
+type label struct {
+	key, str, num, numUnit int64
+}
+
+type mapping struct {
+	id, memoryStart, memoryLimit, fileOffset                    uint64
+	filename, buildID                                           int64
+	hasFunctions, hasFilenames, hasLineNumbers, hasInlineFrames bool
+}
+
+type location struct {
+	id, mappingID, address uint64
+	lines                  []line
+	isFolded               bool
+}
+
+type line struct {
+	functionID   uint64
+	line, column int64
+}
+
+type function struct {
+	id                         uint64
+	name, systemName, filename int64
+	startLine                  int64
+}
+
+// Convert decodes a profile in the pprof format, compressed with gzip or not, into dest.
+// The profile is also kept as the original payload of dest.
+func Convert(data []byte, dest pprofile.ProfileContainer) error {
+	raw := data
+	if len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("invalid gzip payload: %w", err)
+		}
+		if raw, err = io.ReadAll(zr); err != nil {
+			return fmt.Errorf("invalid gzip payload: %w", err)
+		}
+	}
+	var p profile
+	if err := p.unmarshal(raw); err != nil {
+		return fmt.Errorf("invalid pprof profile: %w", err)
+	}
+	if len(p.stringTable) == 0 || p.stringTable[0] != "" {
+		return fmt.Errorf("invalid pprof profile: the first string of the string table must be empty")
+	}
+	if err := p.copyTo(dest.Profile()); err != nil {
+		return fmt.Errorf("invalid pprof profile: %w", err)
+	}
+	dest.SetStartTimestamp(pcommon.Timestamp(p.timeNanos))
+	dest.SetEndTimestamp(pcommon.Timestamp(p.timeNanos + p.durationNanos))
+	dest.SetOriginalPayloadFormat(PayloadFormat)
+	dest.OriginalPayload().FromRaw(data)
+	return nil
+}
+
+func (p *profile) copyTo(dest pprofile.Profile) error {
+	mappingIndex := make(map[uint64]uint64, len(p.mappings))
+	dest.Mapping().EnsureCapacity(len(p.mappings))
+	for i, m := range p.mappings {
+		mappingIndex[m.id] = uint64(i)
+		pm := dest.Mapping().AppendEmpty()
+		pm.SetID(m.id)
+		pm.SetMemoryStart(m.memoryStart)
+		pm.SetMemoryLimit(m.memoryLimit)
+		pm.SetFileOffset(m.fileOffset)
+		pm.SetFilename(m.filename)
+		pm.SetBuildID(m.buildID)
+		pm.SetHasFunctions(m.hasFunctions)
+		pm.SetHasFilenames(m.hasFilenames)
+		pm.SetHasLineNumbers(m.hasLineNumbers)
+		pm.SetHasInlineFrames(m.hasInlineFrames)
+	}
+
+	functionIndex := make(map[uint64]uint64, len(p.functions))
+	dest.Function().EnsureCapacity(len(p.functions))
+	for i, f := range p.functions {
+		functionIndex[f.id] = uint64(i)
+		pf := dest.Function().AppendEmpty()
+		pf.SetID(f.id)
+		pf.SetName(f.name)
+		pf.SetSystemName(f.systemName)
+		pf.SetFilename(f.filename)
+		pf.SetStartLine(f.startLine)
+	}
+
+	locationIndex := make(map[uint64]int64, len(p.locations))
+	dest.Location().EnsureCapacity(len(p.locations))
+	for i, l := range p.locations {
+		locationIndex[l.id] = int64(i)
+		pl := dest.Location().AppendEmpty()
+		pl.SetID(l.id)
+		// A mapping ID of 0 means that the location has no mapping.
+		if l.mappingID != 0 {
+			idx, ok := mappingIndex[l.mappingID]
+			if !ok {
+				return fmt.Errorf("location %d references the unknown mapping %d", l.id, l.mappingID)
+			}
+			pl.SetMappingIndex(idx)
+		}
+		pl.SetAddress(l.address)
+		pl.SetIsFolded(l.isFolded)
+		pl.Line().EnsureCapacity(len(l.lines))
+		for _, ln := range l.lines {
+			idx, ok := functionIndex[ln.functionID]
+			if !ok {
+				return fmt.Errorf("location %d references the unknown function %d", l.id, ln.functionID)
+			}
+			pln := pl.Line().AppendEmpty()
+			pln.SetFunctionIndex(idx)
+			pln.SetLine(ln.line)
+			pln.SetColumn(ln.column)
+		}
+	}
+
+	dest.Sample().EnsureCapacity(len(p.samples))
+	for _, s := range p.samples {
+		ps := dest.Sample().AppendEmpty()
+		ps.SetLocationsStartIndex(uint64(dest.LocationIndices().Len()))
+		ps.SetLocationsLength(uint64(len(s.locationIDs)))
+		for _, id := range s.locationIDs {
+			idx, ok := locationIndex[id]
+			if !ok {
+				return fmt.Errorf("sample references the unknown location %d", id)
+			}
+			dest.LocationIndices().Append(idx)
+		}
+		ps.Value().FromRaw(s.values)
+		ps.Label().EnsureCapacity(len(s.labels))
+		for _, l := range s.labels {
+			pl := ps.Label().AppendEmpty()
+			pl.SetKey(l.key)
+			pl.SetStr(l.str)
+			pl.SetNum(l.num)
+			pl.SetNumUnit(l.numUnit)
+		}
+	}
+
+	dest.SampleType().EnsureCapacity(len(p.sampleTypes))
+	for _, vt := range p.sampleTypes {
+		pvt := dest.SampleType().AppendEmpty()
+		pvt.SetType(vt.typ)
+		pvt.SetUnit(vt.unit)
+	}
+	dest.PeriodType().SetType(p.periodType.typ)
+	dest.PeriodType().SetUnit(p.periodType.unit)
+	dest.SetPeriod(p.period)
+	dest.StringTable().FromRaw(p.stringTable)
+	dest.SetDropFrames(p.dropFrames)
+	dest.SetKeepFrames(p.keepFrames)
+	dest.SetStartTime(pcommon.Timestamp(p.timeNanos))
+	dest.SetDuration(pcommon.Timestamp(p.durationNanos))
+	dest.Comment().FromRaw(p.comments)
+	dest.SetDefaultSampleType(p.defaultSampleType)
+	return nil
+}
+
+func (p *profile) unmarshal(b []byte) error {
+	return parse(b, func(f field) error {
+		var err error
+		switch f.num {
+		case 1:
+			var vt valueType
+			err = vt.unmarshal(f.bytes)
+			p.sampleTypes = append(p.sampleTypes, vt)
+		case 2:
+			var s sample
+			err = s.unmarshal(f.bytes)
+			p.samples = append(p.samples, s)
+		case 3:
+			var m mapping
+			err = m.unmarshal(f.bytes)
+			p.mappings = append(p.mappings, m)
+		case 4:
+			var l location
+			err = l.unmarshal(f.bytes)
+			p.locations = append(p.locations, l)
+		case 5:
+			var fn function
+			err = fn.unmarshal(f.bytes)
+			p.functions = append(p.functions, fn)
+		case 6:
+			p.stringTable = append(p.stringTable, string(f.bytes))
+		case 7:
+			p.dropFrames = int64(f.varint)
+		case 8:
+			p.keepFrames = int64(f.varint)
+		case 9:
+			p.timeNanos = int64(f.varint)
+		case 10:
+			p.durationNanos = int64(f.varint)
+		case 11:
+			err = p.periodType.unmarshal(f.bytes)
+		case 12:
+			p.period = int64(f.varint)
+		case 13:
+			p.comments, err = appendInt64s(p.comments, f)
+		case 14:
+			p.defaultSampleType = int64(f.varint)
+		}
+		return err
+	})
+}
+
+func (vt *valueType) unmarshal(b []byte) error {
+	return parse(b, func(f field) error {
+		switch f.num {
+		case 1:
+			vt.typ = int64(f.varint)
+		case 2:
+			vt.unit = int64(f.varint)
+		}
+		return nil
+	})
+}
+
+func (s *sample) unmarshal(b []byte) error {
+	return parse(b, func(f field) error {
+		var err error
+		switch f.num {
+		case 1:
+			s.locationIDs, err = appendUint64s(s.locationIDs, f)
+		case 2:
+			s.values, err = appendInt64s(s.values, f)
+		case 3:
+			var l label
+			err = l.unmarshal(f.bytes)
+			s.labels = append(s.labels, l)
+		}
+		return err
+	})
+}
+
+func (l *label) unmarshal(b []byte) error {
+	return parse(b, func(f field) error {
+		switch f.num {
+		case 1:
+			l.key = int64(f.varint)
+		case 2:
+			l.str = int64(f.varint)
+		case 3:
+			l.num = int64(f.varint)
+		case 4:
+			l.numUnit = int64(f.varint)
+		}
+		return nil
+	})
+}
+
+func (m *mapping) unmarshal(b []byte) error {
+	return parse(b, func(f field) error {
+		switch f.num {
+		case 1:
+			m.id = f.varint
+		case 2:
+			m.memoryStart = f.varint
+		case 3:
+			m.memoryLimit = f.varint
+		case 4:
+			m.fileOffset = f.varint
+		case 5:
+			m.filename = int64(f.varint)
+		case 6:
+			m.buildID = int64(f.varint)
+		case 7:
+			m.hasFunctions = f.varint != 0
+		case 8:
+			m.hasFilenames = f.varint != 0
+		case 9:
+			m.hasLineNumbers = f.varint != 0
+		case 10:
+			m.hasInlineFrames = f.varint != 0
+		}
+		return nil
+	})
+}
+
+func (l *location) unmarshal(b []byte) error {
+	return parse(b, func(f field) error {
+		var err error
+		switch f.num {
+		case 1:
+			l.id = f.varint
+		case 2:
+			l.mappingID = f.varint
+		case 3:
+			l.address = f.varint
+		case 4:
+			var ln line
+			err = ln.unmarshal(f.bytes)
+			l.lines = append(l.lines, ln)
+		case 5:
+			l.isFolded = f.varint != 0
+		}
+		return err
+	})
+}
+
+func (ln *line) unmarshal(b []byte) error {
+	return parse(b, func(f field) error {
+		switch f.num {
+		case 1:
+			ln.functionID = f.varint
+		case 2:
+			ln.line = int64(f.varint)
+		case 3:
+			ln.column = int64(f.varint)
+		}
+		return nil
+	})
+}
+
+func (fn *function) unmarshal(b []byte) error {
+	return parse(b, func(f field) error {
+		switch f.num {
+		case 1:
+			fn.id = f.varint
+		case 2:
+			fn.name = int64(f.varint)
+		case 3:
+			fn.systemName = int64(f.varint)
+		case 4:
+			fn.filename = int64(f.varint)
+		case 5:
+			fn.startLine = int64(f.varint)
+		}
+		return nil
+	})
+}
+
+// appendUint64s appends the values of a repeated varint field, which are either packed or not.
+func appendUint64s(dst []uint64, f field) ([]uint64, error) {
+	if f.typ == protowire.VarintType {
+		return append(dst, f.varint), nil
+	}
+	b := f.bytes
+	for len(b) > 0 {
+		v, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return nil, fmt.Errorf("invalid packed field %d: %w", f.num, protowire.ParseError(n))
+		}
+		dst = append(dst, v)
+		b = b[n:]
+	}
+	return dst, nil
+}
+
+func appendInt64s(dst []int64, f field) ([]int64, error) {
+	values, err := appendUint64s(nil, f)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range values {
+		dst = append(dst, int64(v))
+	}
+	return dst, nil
+}
+
+// field is a decoded field of a message.
+type field struct {
+	num    protowire.Number
+	typ    protowire.Type
+	varint uint64
+	bytes  []byte
+}
+
+// parse calls fn for each field of the message. The fields of other types than varint
+// and bytes are skipped, as the pprof format does not have any.
+func parse(b []byte, fn func(field) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		f := field{num: num, typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			f.varint, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			f.bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n >= 0 {
+				b = b[n:]
+				continue
+			}
+		}
+		if n < 0 {
+			return fmt.Errorf("invalid field %d: %w", num, protowire.ParseError(n))
+		}
+		b = b[n:]
+		if err := fn(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofconv
+
+import (
+	"bytes"
+	"runtime/pprof"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+func message(num protowire.Number, fields ...[]byte) []byte {
+	b := protowire.AppendTag(nil, num, protowire.BytesType)
+	return protowire.AppendBytes(b, bytes.Join(fields, nil))
+}
+
+func varint(num protowire.Number, v uint64) []byte {
+	return protowire.AppendVarint(protowire.AppendTag(nil, num, protowire.VarintType), v)
+}
+
+func packed(num protowire.Number, values ...uint64) []byte {
+	var b []byte
+	for _, v := range values {
+		b = protowire.AppendVarint(b, v)
+	}
+	return protowire.AppendBytes(protowire.AppendTag(nil, num, protowire.BytesType), b)
+}
+
+func str(s string) []byte {
+	return protowire.AppendString(protowire.AppendTag(nil, 6, protowire.BytesType), s)
+}
+
+// testProfile returns a profile in the pprof format whose IDs are not the indices of the referenced messages.
+func testProfile() []byte {
+	return bytes.Join([][]byte{
+		message(1, varint(1, 1), varint(2, 2)),
+		// The location IDs and values of the first sample are packed, the ones of the second one are not.
+		message(2, packed(1, 20, 10), packed(2, 5), message(3, varint(1, 3), varint(3, 64), varint(4, 2))),
+		message(2, varint(1, 10), varint(2, 7)),
+		message(3, varint(1, 7), varint(2, 0x1000), varint(3, 0x2000), varint(5, 4), varint(7, 1)),
+		message(4, varint(1, 10), varint(2, 7), varint(3, 0x1010), message(4, varint(1, 30), varint(2, 12))),
+		message(4, varint(1, 20), varint(3, 0x1020), message(4, varint(1, 40), varint(2, 3)), message(4, varint(1, 30), varint(2, 14))),
+		message(5, varint(1, 40), varint(2, 5), varint(4, 4)),
+		message(5, varint(1, 30), varint(2, 6), varint(4, 4), varint(5, 10)),
+		str(""), str("samples"), str("count"), str("size"), str("main.go"), str("main"), str("helper"),
+		varint(9, 1000),
+		varint(10, 500),
+		message(11, varint(1, 1), varint(2, 2)),
+		varint(12, 1),
+		packed(13, 3),
+	}, nil)
+}
+
+func TestConvert(t *testing.T) {
+	pc := pprofile.NewProfiles().ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty()
+	data := testProfile()
+	require.NoError(t, Convert(data, pc))
+	assert.Equal(t, PayloadFormat, pc.OriginalPayloadFormat())
+	assert.Equal(t, data, pc.OriginalPayload().AsRaw())
+	assert.EqualValues(t, 1000, pc.StartTimestamp())
+	assert.EqualValues(t, 1500, pc.EndTimestamp())
+
+	p := pc.Profile()
+	assert.Equal(t, []string{"", "samples", "count", "size", "main.go", "main", "helper"}, p.StringTable().AsRaw())
+	require.Equal(t, 1, p.SampleType().Len())
+	assert.EqualValues(t, 1, p.SampleType().At(0).Type())
+	assert.EqualValues(t, 2, p.SampleType().At(0).Unit())
+	assert.EqualValues(t, 1, p.PeriodType().Type())
+	assert.EqualValues(t, 1, p.Period())
+	assert.Equal(t, []int64{3}, p.Comment().AsRaw())
+
+	require.Equal(t, 1, p.Mapping().Len())
+	assert.EqualValues(t, 0x1000, p.Mapping().At(0).MemoryStart())
+	assert.EqualValues(t, 4, p.Mapping().At(0).Filename())
+	assert.True(t, p.Mapping().At(0).HasFunctions())
+
+	require.Equal(t, 2, p.Function().Len())
+	assert.EqualValues(t, 6, p.Function().At(1).Name())
+	assert.EqualValues(t, 10, p.Function().At(1).StartLine())
+
+	require.Equal(t, 2, p.Location().Len())
+	loc := p.Location().At(1)
+	assert.EqualValues(t, 0x1020, loc.Address())
+	require.Equal(t, 2, loc.Line().Len())
+	// The function with ID 40 is the first one, and the one with ID 30 the second one.
+	assert.EqualValues(t, 0, loc.Line().At(0).FunctionIndex())
+	assert.EqualValues(t, 1, loc.Line().At(1).FunctionIndex())
+	assert.EqualValues(t, 14, loc.Line().At(1).Line())
+	assert.EqualValues(t, 0, p.Location().At(0).MappingIndex())
+
+	require.Equal(t, 2, p.Sample().Len())
+	assert.Equal(t, []int64{1, 0, 0}, p.LocationIndices().AsRaw())
+	first := p.Sample().At(0)
+	assert.EqualValues(t, 0, first.LocationsStartIndex())
+	assert.EqualValues(t, 2, first.LocationsLength())
+	assert.Equal(t, []int64{5}, first.Value().AsRaw())
+	require.Equal(t, 1, first.Label().Len())
+	assert.EqualValues(t, 3, first.Label().At(0).Key())
+	assert.EqualValues(t, 64, first.Label().At(0).Num())
+	second := p.Sample().At(1)
+	assert.EqualValues(t, 2, second.LocationsStartIndex())
+	assert.EqualValues(t, 1, second.LocationsLength())
+	assert.Equal(t, []int64{7}, second.Value().AsRaw())
+}
+
+func TestConvertRuntimeProfile(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, pprof.Lookup("goroutine").WriteTo(&buf, 0))
+	pc := pprofile.NewProfiles().ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty()
+	require.NoError(t, Convert(buf.Bytes(), pc))
+
+	p := pc.Profile()
+	strs := p.StringTable().AsRaw()
+	require.Positive(t, p.SampleType().Len())
+	assert.Equal(t, "goroutine", strs[p.SampleType().At(0).Type()])
+	require.Positive(t, p.Sample().Len())
+
+	// The stack of the test is in the profile.
+	var functions []string
+	for i := 0; i < p.Sample().Len(); i++ {
+		s := p.Sample().At(i)
+		for j := s.LocationsStartIndex(); j < s.LocationsStartIndex()+s.LocationsLength(); j++ {
+			loc := p.Location().At(int(p.LocationIndices().At(int(j))))
+			for k := 0; k < loc.Line().Len(); k++ {
+				functions = append(functions, strs[p.Function().At(int(loc.Line().At(k).FunctionIndex())).Name()])
+			}
+		}
+	}
+	assert.Contains(t, functions, "go.opentelemetry.io/collector/extension/profilingextension/internal/pprofconv.TestConvertRuntimeProfile")
+}
+
+func TestConvertErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   []byte
+		errMsg string
+	}{
+		{
+			name:   "invalid gzip",
+			data:   []byte{0x1f, 0x8b, 0x00},
+			errMsg: "invalid gzip payload: unexpected EOF",
+		},
+		{
+			name:   "invalid message",
+			data:   []byte{0x12, 0x05},
+			errMsg: "invalid pprof profile: invalid field 2: unexpected EOF",
+		},
+		{
+			name:   "no string table",
+			data:   varint(9, 1000),
+			errMsg: "invalid pprof profile: the first string of the string table must be empty",
+		},
+		{
+			name:   "unknown location",
+			data:   bytes.Join([][]byte{message(2, packed(1, 1)), str("")}, nil),
+			errMsg: "invalid pprof profile: sample references the unknown location 1",
+		},
+		{
+			name:   "unknown function",
+			data:   bytes.Join([][]byte{message(4, varint(1, 1), message(4, varint(1, 2))), str("")}, nil),
+			errMsg: "invalid pprof profile: location 1 references the unknown function 2",
+		},
+		{
+			name:   "unknown mapping",
+			data:   bytes.Join([][]byte{message(4, varint(1, 1), varint(2, 3)), str("")}, nil),
+			errMsg: "invalid pprof profile: location 1 references the unknown mapping 3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pc := pprofile.NewProfiles().ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty()
+			assert.EqualError(t, Convert(tt.data, pc), tt.errMsg)
+		})
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profilingextension // import "go.opentelemetry.io/collector/extension/profilingextension"
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/extension/experimental/profiling"
+	"go.opentelemetry.io/collector/extension/profilingextension/internal/pprofconv"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+const (
+	scopeName = "go.opentelemetry.io/collector/extension/profilingextension"
+
+	// profilesPath is the path prefix of the endpoints, which are followed by the type of the profile.
+	profilesPath = "/v1/profiles/"
+
+	// cpuProfile is the type of the CPU profiles, the other types are the ones of runtime/pprof.
+	cpuProfile = "cpu"
+
+	outputDownload = "download"
+	outputPipeline = "pipeline"
+)
+
+// profilingExtension serves endpoints capturing profiles of the collector, which are either
+// downloaded or sent to the consumers registered by the profiles pipelines.
+type profilingExtension struct {
+	config    *Config
+	telemetry component.TelemetrySettings
+	buildInfo component.BuildInfo
+
+	mu        sync.Mutex
+	consumers map[component.ID]consumer.Profiles
+
+	server     *http.Server
+	stopCh     chan struct{}
+	shutdownCh chan struct{}
+}
+
+var _ profiling.Extension = (*profilingExtension)(nil)
+
+func newProfiling(config *Config, set extension.CreateSettings) *profilingExtension {
+	return &profilingExtension{
+		config:     config,
+		telemetry:  set.TelemetrySettings,
+		buildInfo:  set.BuildInfo,
+		consumers:  map[component.ID]consumer.Profiles{},
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+func (pe *profilingExtension) Start(_ context.Context, host component.Host) error {
+	if pe.config.Auth == nil {
+		pe.telemetry.Logger.Warn("The profiling endpoints are not authenticated, set auth to restrict who can capture profiles of the collector")
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(profilesPath, pe.handleProfile)
+	server, err := pe.config.ToServer(host, pe.telemetry, mux)
+	if err != nil {
+		return err
+	}
+	server.ReadHeaderTimeout = 10 * time.Second
+
+	// Start the listener here so we can have earlier failure if port is
+	// already in use.
+	ln, err := pe.config.ToListener()
+	if err != nil {
+		return err
+	}
+
+	pe.telemetry.Logger.Info("Starting profiling extension", zap.String("endpoint", pe.config.Endpoint))
+	pe.server = server
+	pe.stopCh = make(chan struct{})
+	go func() {
+		defer close(pe.stopCh)
+
+		if errHTTP := pe.server.Serve(ln); errHTTP != nil && !errors.Is(errHTTP, http.ErrServerClosed) {
+			pe.telemetry.ReportStatus(component.NewFatalErrorEvent(errHTTP))
+		}
+	}()
+
+	return nil
+}
+
+// Shutdown stops the server once the CPU profiles being captured are aborted.
+func (pe *profilingExtension) Shutdown(ctx context.Context) error {
+	if pe.stopCh == nil {
+		return nil
+	}
+	close(pe.shutdownCh)
+	err := pe.server.Shutdown(ctx)
+	<-pe.stopCh
+	return err
+}
+
+// RegisterConsumer registers the consumer the profiles captured for a pipeline are sent to.
+func (pe *profilingExtension) RegisterConsumer(id component.ID, next consumer.Profiles) func() {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	pe.consumers[id] = next
+	return func() {
+		pe.mu.Lock()
+		defer pe.mu.Unlock()
+		delete(pe.consumers, id)
+	}
+}
+
+// handleProfile captures the profile of the type following the path prefix, e.g. /v1/profiles/heap.
+// The following query parameters are supported:
+//   - seconds: the duration of a CPU profile, other profiles are snapshots.
+//   - output: "download" to return the profile in the pprof format, or "pipeline" to send it
+//     to the registered consumers.
+func (pe *profilingExtension) handleProfile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "only GET requests are supported", http.StatusMethodNotAllowed)
+		return
+	}
+	typ := strings.TrimPrefix(r.URL.Path, profilesPath)
+	if typ != cpuProfile && pprof.Lookup(typ) == nil {
+		http.Error(w, fmt.Sprintf("unknown profile %q", typ), http.StatusNotFound)
+		return
+	}
+
+	query := r.URL.Query()
+	duration := pe.config.DefaultDuration
+	if s := query.Get("seconds"); s != "" {
+		if typ != cpuProfile {
+			http.Error(w, "seconds is only supported by the cpu profile", http.StatusBadRequest)
+			return
+		}
+		seconds, err := strconv.Atoi(s)
+		if err != nil || seconds <= 0 {
+			http.Error(w, "seconds must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		if duration = time.Duration(seconds) * time.Second; duration > pe.config.MaxDuration {
+			http.Error(w, fmt.Sprintf("seconds must not be greater than %v", pe.config.MaxDuration.Seconds()), http.StatusBadRequest)
+			return
+		}
+	}
+	output := query.Get("output")
+	switch output {
+	case "", outputDownload:
+		output = outputDownload
+	case outputPipeline:
+		if len(pe.registeredConsumers()) == 0 {
+			http.Error(w, "no profiles pipeline is registered with the extension", http.StatusServiceUnavailable)
+			return
+		}
+	default:
+		http.Error(w, fmt.Sprintf("unknown output %q, must be %q or %q", output, outputDownload, outputPipeline), http.StatusBadRequest)
+		return
+	}
+
+	var buf bytes.Buffer
+	if typ == cpuProfile {
+		if status, err := pe.captureCPU(r.Context(), &buf, duration); err != nil {
+			http.Error(w, err.Error(), status)
+			return
+		}
+	} else if err := pprof.Lookup(typ).WriteTo(&buf, 0); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if output == outputDownload {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", typ+".pb.gz"))
+		_, _ = w.Write(buf.Bytes())
+		return
+	}
+	if err := pe.export(r.Context(), typ, buf.Bytes()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// captureCPU writes a CPU profile of the given duration to buf. The capture is aborted
+// when the request is canceled or the extension is shut down.
+func (pe *profilingExtension) captureCPU(ctx context.Context, buf *bytes.Buffer, duration time.Duration) (int, error) {
+	if err := pprof.StartCPUProfile(buf); err != nil {
+		return http.StatusConflict, fmt.Errorf("a CPU profile is already being captured: %w", err)
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		pprof.StopCPUProfile()
+		return http.StatusOK, nil
+	case <-ctx.Done():
+		pprof.StopCPUProfile()
+		return http.StatusRequestTimeout, ctx.Err()
+	case <-pe.shutdownCh:
+		pprof.StopCPUProfile()
+		return http.StatusServiceUnavailable, errors.New("the extension is shutting down")
+	}
+}
+
+func (pe *profilingExtension) registeredConsumers() []consumer.Profiles {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	consumers := make([]consumer.Profiles, 0, len(pe.consumers))
+	for _, next := range pe.consumers {
+		consumers = append(consumers, next)
+	}
+	return consumers
+}
+
+// export converts the profile of the given type from the pprof format, and sends it to the registered consumers.
+func (pe *profilingExtension) export(ctx context.Context, typ string, data []byte) error {
+	pd := pprofile.NewProfiles()
+	rp := pd.ResourceProfiles().AppendEmpty()
+	rp.Resource().Attributes().PutStr("service.name", pe.buildInfo.Command)
+	rp.Resource().Attributes().PutStr("service.version", pe.buildInfo.Version)
+	sp := rp.ScopeProfiles().AppendEmpty()
+	sp.Scope().SetName(scopeName)
+	sp.Scope().SetVersion(pe.buildInfo.Version)
+	pc := sp.Profiles().AppendEmpty()
+	var id pprofile.ProfileID
+	if _, err := rand.Read(id[:]); err != nil {
+		return err
+	}
+	pc.SetProfileID(id)
+	pc.Attributes().PutStr("profile.type", typ)
+	if err := pprofconv.Convert(data, pc); err != nil {
+		return fmt.Errorf("failed to convert the %s profile: %w", typ, err)
+	}
+
+	consumers := pe.registeredConsumers()
+	if len(consumers) == 0 {
+		return errors.New("no profiles pipeline is registered with the extension")
+	}
+	for i, next := range consumers {
+		// The consumers which are not the last one get a copy, in case they mutate the profiles.
+		profiles := pd
+		if i < len(consumers)-1 {
+			profiles = pprofile.NewProfiles()
+			pd.CopyTo(profiles)
+		}
+		if err := next.ConsumeProfiles(ctx, profiles); err != nil {
+			return fmt.Errorf("failed to send the %s profile: %w", typ, err)
+		}
+	}
+	return nil
+}
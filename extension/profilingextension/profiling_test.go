@@ -0,0 +1,219 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profilingextension
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"runtime/pprof"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/auth"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+	"go.opentelemetry.io/collector/extension/profilingextension/internal/pprofconv"
+	"go.opentelemetry.io/collector/internal/testutil"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+// extensionsHost is a host providing extensions.
+type extensionsHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h extensionsHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func startProfiling(t *testing.T, cfg *Config, host component.Host) (*profilingExtension, string) {
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	pe := newProfiling(cfg, extensiontest.NewNopCreateSettings())
+	require.NoError(t, pe.Start(context.Background(), host))
+	t.Cleanup(func() { assert.NoError(t, pe.Shutdown(context.Background())) })
+	return pe, "http://" + cfg.Endpoint + profilesPath
+}
+
+func get(t *testing.T, url string, header http.Header) (*http.Response, []byte) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	req.Header = header
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, body
+}
+
+func TestDownloadProfile(t *testing.T) {
+	_, url := startProfiling(t, createDefaultConfig().(*Config), componenttest.NewNopHost())
+
+	resp, body := get(t, url+"heap", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	assert.Equal(t, "application/octet-stream", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="heap.pb.gz"`, resp.Header.Get("Content-Disposition"))
+	pc := pprofile.NewProfiles().ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty()
+	require.NoError(t, pprofconv.Convert(body, pc))
+	assert.Contains(t, pc.Profile().StringTable().AsRaw(), "inuse_space")
+
+	resp, body = get(t, url+"cpu?seconds=1&output=download", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	assert.Equal(t, `attachment; filename="cpu.pb.gz"`, resp.Header.Get("Content-Disposition"))
+	pc = pprofile.NewProfiles().ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty()
+	require.NoError(t, pprofconv.Convert(body, pc))
+	assert.Contains(t, pc.Profile().StringTable().AsRaw(), "cpu")
+	assert.GreaterOrEqual(t, time.Duration(pc.Profile().Duration()), time.Second)
+}
+
+func TestCPUProfileAlreadyCaptured(t *testing.T) {
+	_, url := startProfiling(t, createDefaultConfig().(*Config), componenttest.NewNopHost())
+
+	require.NoError(t, pprof.StartCPUProfile(io.Discard))
+	resp, body := get(t, url+"cpu?seconds=1", nil)
+	pprof.StopCPUProfile()
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assert.Contains(t, string(body), "a CPU profile is already being captured")
+}
+
+func TestCaptureCPUAborted(t *testing.T) {
+	pe := newProfiling(createDefaultConfig().(*Config), extensiontest.NewNopCreateSettings())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	status, err := pe.captureCPU(ctx, new(bytes.Buffer), time.Minute)
+	assert.Equal(t, http.StatusRequestTimeout, status)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	close(pe.shutdownCh)
+	status, err = pe.captureCPU(context.Background(), new(bytes.Buffer), time.Minute)
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.EqualError(t, err, "the extension is shutting down")
+}
+
+func TestPipelineOutput(t *testing.T) {
+	pe, url := startProfiling(t, createDefaultConfig().(*Config), componenttest.NewNopHost())
+
+	// The profiles are not captured when no pipeline is registered.
+	resp, body := get(t, url+"goroutine?output=pipeline", nil)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Contains(t, string(body), "no profiles pipeline is registered with the extension")
+
+	first, second := new(consumertest.ProfilesSink), new(consumertest.ProfilesSink)
+	unregisterFirst := pe.RegisterConsumer(component.NewIDWithName("profiling", "first"), first)
+	unregisterSecond := pe.RegisterConsumer(component.NewIDWithName("profiling", "second"), second)
+	resp, body = get(t, url+"goroutine?output=pipeline", nil)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode, string(body))
+	require.Len(t, first.AllProfiles(), 1)
+	require.Len(t, second.AllProfiles(), 1)
+	assert.Equal(t, first.AllProfiles()[0], second.AllProfiles()[0])
+
+	rp := first.AllProfiles()[0].ResourceProfiles().At(0)
+	assert.Equal(t, map[string]any{"service.name": "otelcol", "service.version": "latest"}, rp.Resource().Attributes().AsRaw())
+	assert.Equal(t, scopeName, rp.ScopeProfiles().At(0).Scope().Name())
+	pc := rp.ScopeProfiles().At(0).Profiles().At(0)
+	assert.False(t, pc.ProfileID().IsEmpty())
+	assert.Equal(t, map[string]any{"profile.type": "goroutine"}, pc.Attributes().AsRaw())
+	assert.Equal(t, pprofconv.PayloadFormat, pc.OriginalPayloadFormat())
+	assert.Positive(t, pc.Profile().Sample().Len())
+
+	unregisterFirst()
+	resp, _ = get(t, url+"goroutine?output=pipeline", nil)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.Len(t, first.AllProfiles(), 1)
+	assert.Len(t, second.AllProfiles(), 2)
+
+	unregisterSecond()
+	pe.RegisterConsumer(component.NewID("profiling"), consumertest.NewErr(errors.New("pipeline error")))
+	resp, body = get(t, url+"goroutine?output=pipeline", nil)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Contains(t, string(body), "failed to send the goroutine profile: pipeline error")
+}
+
+func TestInvalidRequests(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MaxDuration = 10 * time.Second
+	_, url := startProfiling(t, cfg, componenttest.NewNopHost())
+
+	resp, err := http.Post(url+"heap", "text/plain", nil) //nolint:gosec
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	tests := []struct {
+		path   string
+		status int
+		errMsg string
+	}{
+		{path: "unknown", status: http.StatusNotFound, errMsg: `unknown profile "unknown"`},
+		{path: "heap?seconds=5", status: http.StatusBadRequest, errMsg: "seconds is only supported by the cpu profile"},
+		{path: "cpu?seconds=abc", status: http.StatusBadRequest, errMsg: "seconds must be a positive integer"},
+		{path: "cpu?seconds=-1", status: http.StatusBadRequest, errMsg: "seconds must be a positive integer"},
+		{path: "cpu?seconds=11", status: http.StatusBadRequest, errMsg: "seconds must not be greater than 10"},
+		{path: "heap?output=file", status: http.StatusBadRequest, errMsg: `unknown output "file", must be "download" or "pipeline"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			resp, body := get(t, url+tt.path, nil)
+			assert.Equal(t, tt.status, resp.StatusCode)
+			assert.Equal(t, tt.errMsg+"\n", string(body))
+		})
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	authID := component.NewID("testauth")
+	host := extensionsHost{
+		Host: componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{
+			authID: auth.NewServer(auth.WithServerAuthenticate(func(ctx context.Context, headers map[string][]string) (context.Context, error) {
+				if len(headers["Authorization"]) == 0 || headers["Authorization"][0] != "Bearer secret" {
+					return ctx, errors.New("invalid token")
+				}
+				return ctx, nil
+			})),
+		},
+	}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Auth = &configauth.Authentication{AuthenticatorID: authID}
+	_, url := startProfiling(t, cfg, host)
+
+	resp, body := get(t, url+"heap", nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, "Unauthorized\n", string(body))
+
+	resp, _ = get(t, url+"heap", http.Header{"Authorization": {"Bearer secret"}})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestWarnWithoutAuthenticator(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	set := extensiontest.NewNopCreateSettings()
+	set.Logger = zap.New(core)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	pe := newProfiling(cfg, set)
+	require.NoError(t, pe.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, pe.Shutdown(context.Background()))
+	assert.Equal(t, 1, logs.FilterMessageSnippet("not authenticated").Len())
+}
+
+func TestAuthenticatorNotFound(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Auth = &configauth.Authentication{AuthenticatorID: component.NewID("testauth")}
+	pe := newProfiling(cfg, extensiontest.NewNopCreateSettings())
+	assert.ErrorContains(t, pe.Start(context.Background(), componenttest.NewNopHost()), `failed to resolve authenticator "testauth"`)
+	assert.NoError(t, pe.Shutdown(context.Background()))
+}
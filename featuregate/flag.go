@@ -6,8 +6,6 @@ package featuregate // import "go.opentelemetry.io/collector/featuregate"
 import (
 	"flag"
 	"strings"
-
-	"go.uber.org/multierr"
 )
 
 const (
@@ -46,20 +44,5 @@ func (f *flagValue) Set(s string) error {
 	if s == "" {
 		return nil
 	}
-
-	var errs error
-	ids := strings.Split(s, ",")
-	for i := range ids {
-		id := ids[i]
-		val := true
-		switch id[0] {
-		case '-':
-			id = id[1:]
-			val = false
-		case '+':
-			id = id[1:]
-		}
-		errs = multierr.Append(errs, f.reg.Set(id, val))
-	}
-	return errs
+	return f.reg.SetFromList(strings.Split(s, ","))
 }
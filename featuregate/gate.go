@@ -5,12 +5,13 @@ package featuregate // import "go.opentelemetry.io/collector/featuregate"
 
 import (
 	"fmt"
+	"sync"
 	"sync/atomic"
 
 	"github.com/hashicorp/go-version"
 )
 
-// Gate is an immutable object that is owned by the Registry and represents an individual feature that
+// Gate is an object that is owned by the Registry and represents an individual feature that
 // may be enabled or disabled based on the lifecycle state of the feature and CLI flags specified by the user.
 type Gate struct {
 	id           string
@@ -19,7 +20,16 @@ type Gate struct {
 	fromVersion  *version.Version
 	toVersion    *version.Version
 	stage        Stage
+	runtimeSafe  bool
 	enabled      *atomic.Bool
+
+	// referenced is true when the Gate was set while stable or deprecated, see Registry.LifecycleReport.
+	referenced atomic.Bool
+
+	// The callbacks notified when the gate is enabled or disabled, by subscription.
+	mu             sync.Mutex
+	callbacks      map[int]func(enabled bool)
+	nextCallbackID int
 }
 
 // ID returns the id of the Gate.
@@ -56,3 +66,42 @@ func (g *Gate) FromVersion() string {
 func (g *Gate) ToVersion() string {
 	return fmt.Sprintf("v%s", g.toVersion)
 }
+
+// IsRuntimeSafe returns true if the Gate can be enabled or disabled while the collector is running.
+func (g *Gate) IsRuntimeSafe() bool {
+	return g.runtimeSafe
+}
+
+// Subscribe registers a callback called every time the Gate is enabled or disabled, for instance
+// when a runtime safe Gate is toggled while the collector is running. The callback must not block,
+// and must not subscribe to the Gate nor unregister a callback, since the callbacks are called with
+// the lock of the Gate held. The returned function unregisters the callback.
+func (g *Gate) Subscribe(callback func(enabled bool)) (unsubscribe func()) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.callbacks == nil {
+		g.callbacks = map[int]func(bool){}
+	}
+	id := g.nextCallbackID
+	g.nextCallbackID++
+	g.callbacks[id] = callback
+	return func() {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		delete(g.callbacks, id)
+	}
+}
+
+// set enables or disables the Gate, and notifies the subscribed callbacks if it changed. The change
+// and its notification are done under mu, so that concurrent changes are notified in the order
+// they are applied and the callbacks never observe a stale state.
+func (g *Gate) set(enabled bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.enabled.Swap(enabled) == enabled {
+		return
+	}
+	for _, callback := range g.callbacks {
+		callback(enabled)
+	}
+}
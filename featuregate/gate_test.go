@@ -4,6 +4,7 @@
 package featuregate
 
 import (
+	"sync"
 	"sync/atomic"
 	"testing"
 
@@ -38,3 +39,48 @@ func TestGate(t *testing.T) {
 	assert.Equal(t, "v0.61.0", g.FromVersion())
 	assert.Equal(t, "v0.64.0", g.ToVersion())
 }
+
+func TestGateSubscribe(t *testing.T) {
+	r := NewRegistry()
+	g := r.MustRegister("test", StageAlpha, WithRegisterRuntimeSafe())
+	assert.True(t, g.IsRuntimeSafe())
+
+	var first, second []bool
+	unsubscribe := g.Subscribe(func(enabled bool) { first = append(first, enabled) })
+	g.Subscribe(func(enabled bool) { second = append(second, enabled) })
+
+	require.NoError(t, r.Set("test", true))
+	// The callbacks are not called when the gate does not change.
+	require.NoError(t, r.Set("test", true))
+	unsubscribe()
+	require.NoError(t, r.Set("test", false))
+
+	assert.Equal(t, []bool{true}, first)
+	assert.Equal(t, []bool{true, false}, second)
+}
+
+func TestGateSubscribeConcurrentSet(t *testing.T) {
+	r := NewRegistry()
+	g := r.MustRegister("test", StageAlpha, WithRegisterRuntimeSafe())
+
+	var notified []bool
+	g.Subscribe(func(enabled bool) { notified = append(notified, enabled) })
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(enabled bool) {
+			defer wg.Done()
+			assert.NoError(t, r.SetAtRuntime("test", enabled))
+		}(i%2 == 0)
+	}
+	wg.Wait()
+
+	// The changes are notified in the order they are applied, so the last notification is the
+	// state of the gate, and two consecutive notifications are never the same.
+	require.NotEmpty(t, notified)
+	assert.Equal(t, g.IsEnabled(), notified[len(notified)-1])
+	for i := 1; i < len(notified); i++ {
+		assert.NotEqual(t, notified[i-1], notified[i])
+	}
+}
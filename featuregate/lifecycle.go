@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package featuregate // import "go.opentelemetry.io/collector/featuregate"
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
+
+// LifecycleIssue describes a stable or deprecated Gate which is still set, e.g. with the
+// --feature-gates flag. Its fields are serialized to JSON for tooling.
+type LifecycleIssue struct {
+	// ID is the identifier of the Gate.
+	ID string `json:"id"`
+	// Stage is the stage of the Gate, either "Stable" or "Deprecated".
+	Stage string `json:"stage"`
+	// ToVersion is the last release in which the Gate can be set.
+	ToVersion string `json:"to_version"`
+	// Removed is true when the release is past ToVersion: setting the Gate is an error.
+	Removed bool `json:"removed"`
+	// Message describes the issue and how to fix it.
+	Message string `json:"message"`
+}
+
+// LifecycleReport returns the issues of the stable and deprecated Gates which were set, in
+// lexicographical order of their ids. The Gates whose ToVersion is before the given release
+// are reported as removed. If release is not a valid version, e.g. for a development build,
+// no Gate is reported as removed.
+func (r *Registry) LifecycleReport(release string) []LifecycleIssue {
+	// current is nil if release is not a valid version.
+	current, _ := version.NewVersion(release)
+	var issues []LifecycleIssue
+	r.VisitAll(func(g *Gate) {
+		if !g.referenced.Load() {
+			return
+		}
+		issue := LifecycleIssue{
+			ID:        g.ID(),
+			Stage:     g.Stage().String(),
+			ToVersion: g.ToVersion(),
+			Removed:   current != nil && current.GreaterThan(g.toVersion),
+		}
+		state := "enabled"
+		if g.Stage() == StageDeprecated {
+			state = "disabled"
+		}
+		if issue.Removed {
+			issue.Message = fmt.Sprintf("feature gate %q is %s and can no longer be set after %s, remove it as the feature is always %s",
+				g.ID(), strings.ToLower(issue.Stage), issue.ToVersion, state)
+		} else {
+			issue.Message = fmt.Sprintf("feature gate %q is %s, remove it before it can no longer be set after %s as the feature is always %s",
+				g.ID(), strings.ToLower(issue.Stage), issue.ToVersion, state)
+		}
+		issues = append(issues, issue)
+	})
+	return issues
+}
+
+// LifecycleError is returned by Registry.CheckLifecycle when Gates which can no longer be set are set.
+type LifecycleError struct {
+	// Issues are the issues of the removed Gates.
+	Issues []LifecycleIssue
+}
+
+func (e *LifecycleError) Error() string {
+	msgs := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		msgs[i] = issue.Message
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// CheckLifecycle returns a *LifecycleError if the LifecycleReport for the given release
+// has removed Gates, so the startup of the collector can fail with actionable errors.
+func (r *Registry) CheckLifecycle(release string) error {
+	var removed []LifecycleIssue
+	for _, issue := range r.LifecycleReport(release) {
+		if issue.Removed {
+			removed = append(removed, issue)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	return &LifecycleError{Issues: removed}
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package featuregate
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLifecycleRegistry(t *testing.T) *Registry {
+	r := NewRegistry()
+	r.MustRegister("alpha", StageAlpha)
+	r.MustRegister("stable", StageStable, WithRegisterToVersion("v0.90.0"))
+	r.MustRegister("deprecated", StageDeprecated, WithRegisterToVersion("v0.95.0"))
+	r.MustRegister("unused", StageStable, WithRegisterToVersion("v0.80.0"))
+	require.NoError(t, r.SetFromList([]string{"alpha", "stable", "-deprecated"}))
+	return r
+}
+
+func TestLifecycleReport(t *testing.T) {
+	r := newLifecycleRegistry(t)
+
+	assert.Equal(t, []LifecycleIssue{
+		{
+			ID:        "deprecated",
+			Stage:     "Deprecated",
+			ToVersion: "v0.95.0",
+			Message:   `feature gate "deprecated" is deprecated, remove it before it can no longer be set after v0.95.0 as the feature is always disabled`,
+		},
+		{
+			ID:        "stable",
+			Stage:     "Stable",
+			ToVersion: "v0.90.0",
+			Message:   `feature gate "stable" is stable, remove it before it can no longer be set after v0.90.0 as the feature is always enabled`,
+		},
+	}, r.LifecycleReport("v0.90.0"))
+
+	issues := r.LifecycleReport("0.91.0")
+	require.Len(t, issues, 2)
+	assert.False(t, issues[0].Removed)
+	assert.True(t, issues[1].Removed)
+	assert.Equal(t, `feature gate "stable" is stable and can no longer be set after v0.90.0, remove it as the feature is always enabled`, issues[1].Message)
+
+	// The removal can not be checked for development builds.
+	for _, issue := range r.LifecycleReport("latest") {
+		assert.False(t, issue.Removed)
+	}
+
+	data, err := json.Marshal(issues[1])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": "stable",
+		"stage": "Stable",
+		"to_version": "v0.90.0",
+		"removed": true,
+		"message": "feature gate \"stable\" is stable and can no longer be set after v0.90.0, remove it as the feature is always enabled"
+	}`, string(data))
+}
+
+func TestCheckLifecycle(t *testing.T) {
+	r := newLifecycleRegistry(t)
+	require.NoError(t, r.CheckLifecycle("v0.90.0"))
+	require.NoError(t, r.CheckLifecycle("latest"))
+
+	err := r.CheckLifecycle("v1.0.0")
+	var lifecycleErr *LifecycleError
+	require.True(t, errors.As(err, &lifecycleErr))
+	require.Len(t, lifecycleErr.Issues, 2)
+	assert.Equal(t, `feature gate "deprecated" is deprecated and can no longer be set after v0.95.0, remove it as the feature is always disabled; `+
+		`feature gate "stable" is stable and can no longer be set after v0.90.0, remove it as the feature is always enabled`, err.Error())
+}
@@ -9,10 +9,12 @@ import (
 	"net/url"
 	"regexp"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 
 	"github.com/hashicorp/go-version"
+	"go.uber.org/multierr"
 )
 
 var (
@@ -107,6 +109,16 @@ func WithRegisterToVersion(toVersion string) RegisterOption {
 	})
 }
 
+// WithRegisterRuntimeSafe marks the Gate as safe to enable or disable while the collector is running,
+// e.g. through the zPages. The code checking a runtime safe Gate must not assume that it stays enabled or
+// disabled, and can subscribe to its changes with Gate.Subscribe.
+func WithRegisterRuntimeSafe() RegisterOption {
+	return registerOptionFunc(func(g *Gate) error {
+		g.runtimeSafe = true
+		return nil
+	})
+}
+
 // MustRegister like Register but panics if an invalid ID or gate options are provided.
 func (r *Registry) MustRegister(id string, stage Stage, opts ...RegisterOption) *Gate {
 	g, err := r.Register(id, stage, opts...)
@@ -185,18 +197,56 @@ func (r *Registry) Set(id string, enabled bool) error {
 		if !enabled {
 			return fmt.Errorf("feature gate %q is stable, can not be disabled", id)
 		}
+		g.referenced.Store(true)
 		fmt.Printf("Feature gate %q is stable and already enabled. It will be removed in version %v and continued use of the gate after version %v will result in an error.\n", id, g.toVersion, g.toVersion)
 	case StageDeprecated:
 		if enabled {
 			return fmt.Errorf("feature gate %q is deprecated, can not be enabled", id)
 		}
+		g.referenced.Store(true)
 		fmt.Printf("Feature gate %q is deprecated and already disabled. It will be removed in version %v and continued use of the gate after version %v will result in an error.\n", id, g.toVersion, g.toVersion)
 	default:
-		g.enabled.Store(enabled)
+		g.set(enabled)
 	}
 	return nil
 }
 
+// SetFromList sets the enabled value of the Gates identified by the given ids, using the syntax
+// of the --feature-gates flag: ids prefixed with '-' are disabled, ids prefixed with '+' or
+// without prefix are enabled. The ids are applied in order, the last value set for a Gate wins.
+func (r *Registry) SetFromList(ids []string) error {
+	var errs error
+	for _, id := range ids {
+		val := true
+		switch {
+		case strings.HasPrefix(id, "-"):
+			id = id[1:]
+			val = false
+		case strings.HasPrefix(id, "+"):
+			id = id[1:]
+		}
+		if id == "" {
+			errs = multierr.Append(errs, errors.New("empty feature gate identifier"))
+			continue
+		}
+		errs = multierr.Append(errs, r.Set(id, val))
+	}
+	return errs
+}
+
+// SetAtRuntime sets the enabled value of a Gate identified by the given id while the collector is running.
+// Only the gates registered with WithRegisterRuntimeSafe can be set at runtime.
+func (r *Registry) SetAtRuntime(id string, enabled bool) error {
+	v, ok := r.gates.Load(id)
+	if !ok {
+		return fmt.Errorf("no such feature gate %q", id)
+	}
+	if !v.(*Gate).IsRuntimeSafe() {
+		return fmt.Errorf("feature gate %q is not runtime safe, it can only be set when the collector starts", id)
+	}
+	return r.Set(id, enabled)
+}
+
 // VisitAll visits all the gates in lexicographical order, calling fn for each.
 func (r *Registry) VisitAll(fn func(*Gate)) {
 	var gates []*Gate
@@ -207,3 +207,40 @@ func TestRegisterGateLifecycle(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistrySetAtRuntime(t *testing.T) {
+	r := NewRegistry()
+	runtimeSafe := r.MustRegister("runtime.safe", StageBeta, WithRegisterRuntimeSafe())
+	startup := r.MustRegister("startup.only", StageAlpha)
+	assert.False(t, startup.IsRuntimeSafe())
+
+	require.NoError(t, r.SetAtRuntime("runtime.safe", false))
+	assert.False(t, runtimeSafe.IsEnabled())
+	require.NoError(t, r.SetAtRuntime("runtime.safe", true))
+	assert.True(t, runtimeSafe.IsEnabled())
+
+	assert.EqualError(t, r.SetAtRuntime("startup.only", true), `feature gate "startup.only" is not runtime safe, it can only be set when the collector starts`)
+	assert.False(t, startup.IsEnabled())
+	assert.EqualError(t, r.SetAtRuntime("unknown", true), `no such feature gate "unknown"`)
+}
+
+func TestRegistrySetFromList(t *testing.T) {
+	r := NewRegistry()
+	alpha := r.MustRegister("alpha", StageAlpha)
+	beta := r.MustRegister("beta", StageBeta)
+
+	require.NoError(t, r.SetFromList([]string{"alpha", "-beta"}))
+	assert.True(t, alpha.IsEnabled())
+	assert.False(t, beta.IsEnabled())
+
+	// The last value set for a gate wins.
+	require.NoError(t, r.SetFromList([]string{"-alpha", "+beta", "+alpha"}))
+	assert.True(t, alpha.IsEnabled())
+	assert.True(t, beta.IsEnabled())
+
+	err := r.SetFromList([]string{"-", "unknown", "-beta"})
+	assert.ErrorContains(t, err, "empty feature gate identifier")
+	assert.ErrorContains(t, err, `no such feature gate "unknown"`)
+	// The valid ids are applied despite the errors.
+	assert.False(t, beta.IsEnabled())
+}
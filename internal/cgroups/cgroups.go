@@ -30,6 +30,7 @@ import (
 	"bufio"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -149,10 +150,58 @@ func isCGroupV2(procPathMountInfo string) (bool, error) {
 }
 
 // MemoryQuotaV2 returns the total memory limit of the process
-// It is a result of cgroupv2 `memory.max`. If the value of
-// `memory.max` was not set (max), the method returns `(-1, false, nil)`.
+// It is a result of cgroupv2 `memory.max` of the cgroup of the process,
+// or of its closest ancestor with a lower limit. If the value of
+// `memory.max` was not set (max) in the whole hierarchy, the method
+// returns `(-1, false, nil)`.
 func MemoryQuotaV2() (int64, bool, error) {
-	return memoryQuotaV2(_cgroupv2MountPoint, _cgroupv2MemoryMax)
+	return memoryQuotaV2Hierarchy(_cgroupv2MountPoint, _procPathCGroup, _cgroupv2MemoryMax)
+}
+
+func memoryQuotaV2Hierarchy(cgroupv2MountPoint, procPathCGroup, cgroupv2MemoryMax string) (int64, bool, error) {
+	cgroupPath, err := cgroupV2Path(procPathCGroup)
+	if err != nil {
+		return -1, false, err
+	}
+	quota, defined := int64(-1), false
+	for dir := cgroupPath; ; dir = path.Dir(dir) {
+		q, d, err := memoryQuotaV2(filepath.Join(cgroupv2MountPoint, dir), cgroupv2MemoryMax)
+		if err != nil {
+			return -1, false, err
+		}
+		if d && (!defined || q < quota) {
+			quota, defined = q, true
+		}
+		if dir == "/" {
+			return quota, defined, nil
+		}
+	}
+}
+
+// cgroupV2Path returns the path of the cgroupv2 of the process, relative to the
+// cgroupv2 mount point. It is the `0::<path>` entry of `/proc/$PID/cgroup`, or
+// the root when the entry is missing, for instance in a cgroup namespace.
+func cgroupV2Path(procPathCGroup string) (string, error) {
+	cgroupFile, err := os.Open(filepath.Clean(procPathCGroup))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "/", nil
+		}
+		return "", err
+	}
+	defer cgroupFile.Close()
+
+	scanner := bufio.NewScanner(cgroupFile)
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), ":", 3)
+		if len(fields) == 3 && fields[0] == "0" && fields[1] == "" && strings.HasPrefix(fields[2], "/") {
+			return path.Clean(fields[2]), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "/", nil
 }
 
 func memoryQuotaV2(cgroupv2MountPoint, cgroupv2MemoryMax string) (int64, bool, error) {
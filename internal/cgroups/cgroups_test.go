@@ -225,3 +225,49 @@ func TestCGroupsMemoryQuotaV2(t *testing.T) {
 		}
 	}
 }
+
+func TestCGroupsMemoryQuotaV2Hierarchy(t *testing.T) {
+	testTable := []struct {
+		name            string
+		mountPoint      string
+		procPathCGroup  string
+		expectedQuota   int64
+		expectedDefined bool
+	}{
+		{
+			name:            "limit set on an ancestor",
+			mountPoint:      filepath.Join(testDataCGroupsPath, "v2", "hierarchy"),
+			procPathCGroup:  filepath.Join(testDataProcPath, "v2", "hierarchy", "cgroup"),
+			expectedQuota:   int64(500000000),
+			expectedDefined: true,
+		},
+		{
+			name:            "missing cgroup",
+			mountPoint:      filepath.Join(testDataCGroupsPath, "v2", "hierarchy"),
+			procPathCGroup:  filepath.Join(testDataProcPath, "v2", "hierarchy", "cgroup-missing"),
+			expectedQuota:   int64(-1),
+			expectedDefined: false,
+		},
+		{
+			name:            "cgroup namespace",
+			mountPoint:      filepath.Join(testDataCGroupsPath, "v2", "memory"),
+			procPathCGroup:  "nonexistent",
+			expectedQuota:   int64(250000000),
+			expectedDefined: true,
+		},
+		{
+			name:            "no cgroupv2 entry",
+			mountPoint:      filepath.Join(testDataCGroupsPath, "v2", "memory"),
+			procPathCGroup:  filepath.Join(testDataProcPath, "cgroups", "cgroup"),
+			expectedQuota:   int64(250000000),
+			expectedDefined: true,
+		},
+	}
+
+	for _, tt := range testTable {
+		quota, defined, err := memoryQuotaV2Hierarchy(tt.mountPoint, tt.procPathCGroup, "memory.max")
+		assert.NoError(t, err, tt.name)
+		assert.Equal(t, tt.expectedQuota, quota, tt.name)
+		assert.Equal(t, tt.expectedDefined, defined, tt.name)
+	}
+}
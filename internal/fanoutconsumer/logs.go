@@ -1,7 +1,7 @@
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
-// Package fanoutconsumer contains implementations of Traces/Metrics/Logs consumers
+// Package fanoutconsumer contains implementations of Traces/Metrics/Logs/Profiles consumers
 // that fan out the data to multiple other consumers.
 package fanoutconsumer // import "go.opentelemetry.io/collector/internal/fanoutconsumer"
 
@@ -18,31 +18,48 @@ import (
 // It fanouts the incoming data to all the consumers, and does smart routing:
 //   - Clones only to the consumer that needs to mutate the data.
 //   - If all consumers needs to mutate the data one will get the original mutable data.
+//   - Shares the data with the consumers which copy the read-only data before mutating it when other consumers only
+//     read it, otherwise handles them as the mutating consumers.
 func NewLogs(lcs []consumer.Logs) consumer.Logs {
 	// Don't wrap if there is only one non-mutating consumer.
-	if len(lcs) == 1 && !lcs[0].Capabilities().MutatesData {
+	if len(lcs) == 1 && !mutatesSharedData(lcs[0].Capabilities()) {
 		return lcs[0]
 	}
 
 	lc := &logsConsumer{}
+	var copying []consumer.Logs
 	for i := 0; i < len(lcs); i++ {
-		if lcs[i].Capabilities().MutatesData {
+		switch {
+		case mutatesSharedData(lcs[i].Capabilities()):
 			lc.mutable = append(lc.mutable, lcs[i])
-		} else {
+		case lcs[i].Capabilities().MutatesData:
+			copying = append(copying, lcs[i])
+		default:
 			lc.readonly = append(lc.readonly, lcs[i])
 		}
 	}
+	if len(lc.readonly) == 0 && len(copying) > 0 {
+		// Sharing the data would make each of them copy it, so the last one is given the original data instead.
+		lc.mutable = append(lc.mutable, copying...)
+		lc.lastCopies = true
+	} else {
+		lc.readonly = append(lc.readonly, copying...)
+	}
 	return lc
 }
 
 type logsConsumer struct {
 	mutable  []consumer.Logs
 	readonly []consumer.Logs
+	// lastCopies is set if the last mutable consumer copies the read-only data before mutating it.
+	lastCopies bool
 }
 
 func (lsc *logsConsumer) Capabilities() consumer.Capabilities {
 	// If all consumers are mutating, then the original data will be passed to one of them.
-	return consumer.Capabilities{MutatesData: len(lsc.mutable) > 0 && len(lsc.readonly) == 0}
+	// The read-only data is cloned for them, so it can be shared with the fanout.
+	mutates := len(lsc.mutable) > 0 && len(lsc.readonly) == 0
+	return consumer.Capabilities{MutatesData: mutates, CopiesReadOnlyData: mutates}
 }
 
 // ConsumeLogs exports the plog.Logs to all consumers wrapped by the current one.
@@ -55,10 +72,11 @@ func (lsc *logsConsumer) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 			errs = multierr.Append(errs, lsc.mutable[i].ConsumeLogs(ctx, cloneLogs(ld)))
 		}
 		// Send data as is to the last mutating consumer only if there are no other non-mutating consumers and the
-		// data is mutable. Never share the same data between a mutating and a non-mutating consumer since the
-		// non-mutating consumer may process data async and the mutating consumer may change the data before that.
+		// data is mutable, or copied by the consumer before mutating it. Never share the same data between a mutating
+		// and a non-mutating consumer since the non-mutating consumer may process data async and the mutating consumer
+		// may change the data before that.
 		lastConsumer := lsc.mutable[len(lsc.mutable)-1]
-		if len(lsc.readonly) == 0 && !ld.IsReadOnly() {
+		if len(lsc.readonly) == 0 && (!ld.IsReadOnly() || lsc.lastCopies) {
 			errs = multierr.Append(errs, lastConsumer.ConsumeLogs(ctx, ld))
 		} else {
 			errs = multierr.Append(errs, lastConsumer.ConsumeLogs(ctx, cloneLogs(ld)))
@@ -81,3 +99,10 @@ func cloneLogs(ld plog.Logs) plog.Logs {
 	ld.CopyTo(clonedLogs)
 	return clonedLogs
 }
+
+// mutatesSharedData returns whether a consumer with the given capabilities may mutate the data it is given even if
+// it is shared with other consumers, in which case it must be given its own copy. The consumers which copy the data
+// marked as read-only before mutating it are given the shared data, so that it is only copied when it is mutated.
+func mutatesSharedData(capabilities consumer.Capabilities) bool {
+	return capabilities.MutatesData && !capabilities.CopiesReadOnlyData
+}
@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/internal/testdata"
+	"go.opentelemetry.io/collector/pdata/plog"
 )
 
 func TestLogsNotMultiplexing(t *testing.T) {
@@ -245,3 +246,78 @@ type mutatingErr struct {
 func (mts mutatingErr) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: true}
 }
+
+func TestLogsMultiplexingCopyingReadOnly(t *testing.T) {
+	p1 := &copyingLogsSink{LogsSink: new(consumertest.LogsSink)}
+	p2 := new(consumertest.LogsSink)
+
+	// A single consumer copying the read-only data is not wrapped.
+	assert.Same(t, p1, NewLogs([]consumer.Logs{p1}))
+
+	// The data is shared as read-only with the consumer copying it, instead of being cloned for it,
+	// so it is only copied by the consumer mutating it.
+	fc := NewLogs([]consumer.Logs{p1, p2})
+	assert.False(t, fc.Capabilities().MutatesData)
+	ld := testdata.GenerateLogs(1)
+	assert.NoError(t, fc.ConsumeLogs(context.Background(), ld))
+	assert.True(t, ld.IsReadOnly())
+	assert.True(t, ld == p2.AllLogs()[0])
+	assert.Equal(t, 1, p1.copies)
+	assert.Equal(t, 1, countLogsCopies(ld, p1.AllLogs(), p2.AllLogs()))
+}
+
+func TestLogsMultiplexingAllCopying(t *testing.T) {
+	p1 := &copyingLogsSink{LogsSink: new(consumertest.LogsSink)}
+	p2 := &copyingLogsSink{LogsSink: new(consumertest.LogsSink)}
+
+	// Without read-only consumer, the data is cloned for the first consumer and the original data is given to the
+	// last one, as when all consumers mutate the data, instead of being copied by each of them.
+	fc := NewLogs([]consumer.Logs{p1, p2})
+	assert.True(t, fc.Capabilities().MutatesData)
+	assert.True(t, fc.Capabilities().CopiesReadOnlyData)
+	ld := testdata.GenerateLogs(1)
+	assert.NoError(t, fc.ConsumeLogs(context.Background(), ld))
+	assert.False(t, ld.IsReadOnly())
+	assert.True(t, ld == p2.AllLogs()[0])
+	assert.Equal(t, 0, p1.copies+p2.copies)
+	assert.Equal(t, 1, countLogsCopies(ld, p1.AllLogs(), p2.AllLogs()))
+
+	// The read-only data is not cloned for the last consumer, which copies it.
+	ld = testdata.GenerateLogs(1)
+	ld.MarkReadOnly()
+	assert.NoError(t, fc.ConsumeLogs(context.Background(), ld))
+	assert.Equal(t, 0, p1.copies)
+	assert.Equal(t, 1, p2.copies)
+	assert.Equal(t, 2, countLogsCopies(ld, p1.AllLogs()[1:], p2.AllLogs()[1:]))
+}
+
+// countLogsCopies returns the number of copies of the original data received by the consumers.
+func countLogsCopies(orig plog.Logs, received ...[]plog.Logs) int {
+	copies := 0
+	for _, r := range received {
+		for _, ld := range r {
+			if ld != orig {
+				copies++
+			}
+		}
+	}
+	return copies
+}
+
+// copyingLogsSink mutates the data it consumes, copying the read-only data first as the processors do.
+type copyingLogsSink struct {
+	*consumertest.LogsSink
+	copies int
+}
+
+func (s *copyingLogsSink) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true, CopiesReadOnlyData: true}
+}
+
+func (s *copyingLogsSink) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	if ld.IsReadOnly() {
+		ld = ld.Mutable()
+		s.copies++
+	}
+	return s.LogsSink.ConsumeLogs(ctx, ld)
+}
@@ -16,31 +16,48 @@ import (
 // It fanouts the incoming data to all the consumers, and does smart routing:
 //   - Clones only to the consumer that needs to mutate the data.
 //   - If all consumers needs to mutate the data one will get the original mutable data.
+//   - Shares the data with the consumers which copy the read-only data before mutating it when other consumers only
+//     read it, otherwise handles them as the mutating consumers.
 func NewMetrics(mcs []consumer.Metrics) consumer.Metrics {
 	// Don't wrap if there is only one non-mutating consumer.
-	if len(mcs) == 1 && !mcs[0].Capabilities().MutatesData {
+	if len(mcs) == 1 && !mutatesSharedData(mcs[0].Capabilities()) {
 		return mcs[0]
 	}
 
 	mc := &metricsConsumer{}
+	var copying []consumer.Metrics
 	for i := 0; i < len(mcs); i++ {
-		if mcs[i].Capabilities().MutatesData {
+		switch {
+		case mutatesSharedData(mcs[i].Capabilities()):
 			mc.mutable = append(mc.mutable, mcs[i])
-		} else {
+		case mcs[i].Capabilities().MutatesData:
+			copying = append(copying, mcs[i])
+		default:
 			mc.readonly = append(mc.readonly, mcs[i])
 		}
 	}
+	if len(mc.readonly) == 0 && len(copying) > 0 {
+		// Sharing the data would make each of them copy it, so the last one is given the original data instead.
+		mc.mutable = append(mc.mutable, copying...)
+		mc.lastCopies = true
+	} else {
+		mc.readonly = append(mc.readonly, copying...)
+	}
 	return mc
 }
 
 type metricsConsumer struct {
 	mutable  []consumer.Metrics
 	readonly []consumer.Metrics
+	// lastCopies is set if the last mutable consumer copies the read-only data before mutating it.
+	lastCopies bool
 }
 
 func (msc *metricsConsumer) Capabilities() consumer.Capabilities {
 	// If all consumers are mutating, then the original data will be passed to one of them.
-	return consumer.Capabilities{MutatesData: len(msc.mutable) > 0 && len(msc.readonly) == 0}
+	// The read-only data is cloned for them, so it can be shared with the fanout.
+	mutates := len(msc.mutable) > 0 && len(msc.readonly) == 0
+	return consumer.Capabilities{MutatesData: mutates, CopiesReadOnlyData: mutates}
 }
 
 // ConsumeMetrics exports the pmetric.Metrics to all consumers wrapped by the current one.
@@ -53,10 +70,11 @@ func (msc *metricsConsumer) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 			errs = multierr.Append(errs, msc.mutable[i].ConsumeMetrics(ctx, cloneMetrics(md)))
 		}
 		// Send data as is to the last mutating consumer only if there are no other non-mutating consumers and the
-		// data is mutable. Never share the same data between a mutating and a non-mutating consumer since the
-		// non-mutating consumer may process data async and the mutating consumer may change the data before that.
+		// data is mutable, or copied by the consumer before mutating it. Never share the same data between a mutating
+		// and a non-mutating consumer since the non-mutating consumer may process data async and the mutating consumer
+		// may change the data before that.
 		lastConsumer := msc.mutable[len(msc.mutable)-1]
-		if len(msc.readonly) == 0 && !md.IsReadOnly() {
+		if len(msc.readonly) == 0 && (!md.IsReadOnly() || msc.lastCopies) {
 			errs = multierr.Append(errs, lastConsumer.ConsumeMetrics(ctx, md))
 		} else {
 			errs = multierr.Append(errs, lastConsumer.ConsumeMetrics(ctx, cloneMetrics(md)))
@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/internal/testdata"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
 func TestMetricsNotMultiplexing(t *testing.T) {
@@ -237,3 +238,78 @@ type mutatingMetricsSink struct {
 func (mts *mutatingMetricsSink) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: true}
 }
+
+func TestMetricsMultiplexingCopyingReadOnly(t *testing.T) {
+	p1 := &copyingMetricsSink{MetricsSink: new(consumertest.MetricsSink)}
+	p2 := new(consumertest.MetricsSink)
+
+	// A single consumer copying the read-only data is not wrapped.
+	assert.Same(t, p1, NewMetrics([]consumer.Metrics{p1}))
+
+	// The data is shared as read-only with the consumer copying it, instead of being cloned for it,
+	// so it is only copied by the consumer mutating it.
+	fc := NewMetrics([]consumer.Metrics{p1, p2})
+	assert.False(t, fc.Capabilities().MutatesData)
+	md := testdata.GenerateMetrics(1)
+	assert.NoError(t, fc.ConsumeMetrics(context.Background(), md))
+	assert.True(t, md.IsReadOnly())
+	assert.True(t, md == p2.AllMetrics()[0])
+	assert.Equal(t, 1, p1.copies)
+	assert.Equal(t, 1, countMetricsCopies(md, p1.AllMetrics(), p2.AllMetrics()))
+}
+
+func TestMetricsMultiplexingAllCopying(t *testing.T) {
+	p1 := &copyingMetricsSink{MetricsSink: new(consumertest.MetricsSink)}
+	p2 := &copyingMetricsSink{MetricsSink: new(consumertest.MetricsSink)}
+
+	// Without read-only consumer, the data is cloned for the first consumer and the original data is given to the
+	// last one, as when all consumers mutate the data, instead of being copied by each of them.
+	fc := NewMetrics([]consumer.Metrics{p1, p2})
+	assert.True(t, fc.Capabilities().MutatesData)
+	assert.True(t, fc.Capabilities().CopiesReadOnlyData)
+	md := testdata.GenerateMetrics(1)
+	assert.NoError(t, fc.ConsumeMetrics(context.Background(), md))
+	assert.False(t, md.IsReadOnly())
+	assert.True(t, md == p2.AllMetrics()[0])
+	assert.Equal(t, 0, p1.copies+p2.copies)
+	assert.Equal(t, 1, countMetricsCopies(md, p1.AllMetrics(), p2.AllMetrics()))
+
+	// The read-only data is not cloned for the last consumer, which copies it.
+	md = testdata.GenerateMetrics(1)
+	md.MarkReadOnly()
+	assert.NoError(t, fc.ConsumeMetrics(context.Background(), md))
+	assert.Equal(t, 0, p1.copies)
+	assert.Equal(t, 1, p2.copies)
+	assert.Equal(t, 2, countMetricsCopies(md, p1.AllMetrics()[1:], p2.AllMetrics()[1:]))
+}
+
+// countMetricsCopies returns the number of copies of the original data received by the consumers.
+func countMetricsCopies(orig pmetric.Metrics, received ...[]pmetric.Metrics) int {
+	copies := 0
+	for _, r := range received {
+		for _, md := range r {
+			if md != orig {
+				copies++
+			}
+		}
+	}
+	return copies
+}
+
+// copyingMetricsSink mutates the data it consumes, copying the read-only data first as the processors do.
+type copyingMetricsSink struct {
+	*consumertest.MetricsSink
+	copies int
+}
+
+func (s *copyingMetricsSink) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true, CopiesReadOnlyData: true}
+}
+
+func (s *copyingMetricsSink) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	if md.IsReadOnly() {
+		md = md.Mutable()
+		s.copies++
+	}
+	return s.MetricsSink.ConsumeMetrics(ctx, md)
+}
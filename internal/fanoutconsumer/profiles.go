@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fanoutconsumer // import "go.opentelemetry.io/collector/internal/fanoutconsumer"
+
+import (
+	"context"
+
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+// NewProfiles wraps multiple profile consumers in a single one.
+// It fanouts the incoming data to all the consumers, and does smart routing:
+//   - Clones only to the consumer that needs to mutate the data.
+//   - If all consumers needs to mutate the data one will get the original mutable data.
+//   - Shares the data with the consumers which copy the read-only data before mutating it when other consumers only
+//     read it, otherwise handles them as the mutating consumers.
+func NewProfiles(pcs []consumer.Profiles) consumer.Profiles {
+	// Don't wrap if there is only one non-mutating consumer.
+	if len(pcs) == 1 && !mutatesSharedData(pcs[0].Capabilities()) {
+		return pcs[0]
+	}
+
+	pc := &profilesConsumer{}
+	var copying []consumer.Profiles
+	for i := 0; i < len(pcs); i++ {
+		switch {
+		case mutatesSharedData(pcs[i].Capabilities()):
+			pc.mutable = append(pc.mutable, pcs[i])
+		case pcs[i].Capabilities().MutatesData:
+			copying = append(copying, pcs[i])
+		default:
+			pc.readonly = append(pc.readonly, pcs[i])
+		}
+	}
+	if len(pc.readonly) == 0 && len(copying) > 0 {
+		// Sharing the data would make each of them copy it, so the last one is given the original data instead.
+		pc.mutable = append(pc.mutable, copying...)
+		pc.lastCopies = true
+	} else {
+		pc.readonly = append(pc.readonly, copying...)
+	}
+	return pc
+}
+
+type profilesConsumer struct {
+	mutable  []consumer.Profiles
+	readonly []consumer.Profiles
+	// lastCopies is set if the last mutable consumer copies the read-only data before mutating it.
+	lastCopies bool
+}
+
+func (psc *profilesConsumer) Capabilities() consumer.Capabilities {
+	// If all consumers are mutating, then the original data will be passed to one of them.
+	// The read-only data is cloned for them, so it can be shared with the fanout.
+	mutates := len(psc.mutable) > 0 && len(psc.readonly) == 0
+	return consumer.Capabilities{MutatesData: mutates, CopiesReadOnlyData: mutates}
+}
+
+// ConsumeProfiles exports the pprofile.Profiles to all consumers wrapped by the current one.
+func (psc *profilesConsumer) ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	var errs error
+
+	if len(psc.mutable) > 0 {
+		// Clone the data before sending to all mutating consumers except the last one.
+		for i := 0; i < len(psc.mutable)-1; i++ {
+			errs = multierr.Append(errs, psc.mutable[i].ConsumeProfiles(ctx, cloneProfiles(pd)))
+		}
+		// Send data as is to the last mutating consumer only if there are no other non-mutating consumers and the
+		// data is mutable, or copied by the consumer before mutating it. Never share the same data between a mutating
+		// and a non-mutating consumer since the non-mutating consumer may process data async and the mutating consumer
+		// may change the data before that.
+		lastConsumer := psc.mutable[len(psc.mutable)-1]
+		if len(psc.readonly) == 0 && (!pd.IsReadOnly() || psc.lastCopies) {
+			errs = multierr.Append(errs, lastConsumer.ConsumeProfiles(ctx, pd))
+		} else {
+			errs = multierr.Append(errs, lastConsumer.ConsumeProfiles(ctx, cloneProfiles(pd)))
+		}
+	}
+
+	// Mark the data as read-only if it will be sent to more than one read-only consumer.
+	if len(psc.readonly) > 1 && !pd.IsReadOnly() {
+		pd.MarkReadOnly()
+	}
+	for _, pc := range psc.readonly {
+		errs = multierr.Append(errs, pc.ConsumeProfiles(ctx, pd))
+	}
+
+	return errs
+}
+
+func cloneProfiles(pd pprofile.Profiles) pprofile.Profiles {
+	clonedProfiles := pprofile.NewProfiles()
+	pd.CopyTo(clonedProfiles)
+	return clonedProfiles
+}
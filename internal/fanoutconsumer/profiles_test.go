@@ -0,0 +1,315 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fanoutconsumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/internal/testdata"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+func TestProfilesNotMultiplexing(t *testing.T) {
+	nop := consumertest.NewNop()
+	pfc := NewProfiles([]consumer.Profiles{nop})
+	assert.Same(t, nop, pfc)
+}
+
+func TestProfilesNotMultiplexingMutating(t *testing.T) {
+	p := &mutatingProfilesSink{ProfilesSink: new(consumertest.ProfilesSink)}
+	pfc := NewProfiles([]consumer.Profiles{p})
+	assert.True(t, pfc.Capabilities().MutatesData)
+}
+
+func TestProfilesMultiplexingNonMutating(t *testing.T) {
+	p1 := new(consumertest.ProfilesSink)
+	p2 := new(consumertest.ProfilesSink)
+	p3 := new(consumertest.ProfilesSink)
+
+	pfc := NewProfiles([]consumer.Profiles{p1, p2, p3})
+	assert.False(t, pfc.Capabilities().MutatesData)
+	pd := testdata.GenerateProfiles(1)
+
+	for i := 0; i < 2; i++ {
+		err := pfc.ConsumeProfiles(context.Background(), pd)
+		if err != nil {
+			t.Errorf("Wanted nil got error")
+			return
+		}
+	}
+
+	assert.True(t, pd == p1.AllProfiles()[0])
+	assert.True(t, pd == p1.AllProfiles()[1])
+	assert.EqualValues(t, pd, p1.AllProfiles()[0])
+	assert.EqualValues(t, pd, p1.AllProfiles()[1])
+
+	assert.True(t, pd == p2.AllProfiles()[0])
+	assert.True(t, pd == p2.AllProfiles()[1])
+	assert.EqualValues(t, pd, p2.AllProfiles()[0])
+	assert.EqualValues(t, pd, p2.AllProfiles()[1])
+
+	assert.True(t, pd == p3.AllProfiles()[0])
+	assert.True(t, pd == p3.AllProfiles()[1])
+	assert.EqualValues(t, pd, p3.AllProfiles()[0])
+	assert.EqualValues(t, pd, p3.AllProfiles()[1])
+
+	// The data should be marked as read only.
+	assert.True(t, pd.IsReadOnly())
+}
+
+func TestProfilesMultiplexingMutating(t *testing.T) {
+	p1 := &mutatingProfilesSink{ProfilesSink: new(consumertest.ProfilesSink)}
+	p2 := &mutatingProfilesSink{ProfilesSink: new(consumertest.ProfilesSink)}
+	p3 := &mutatingProfilesSink{ProfilesSink: new(consumertest.ProfilesSink)}
+
+	pfc := NewProfiles([]consumer.Profiles{p1, p2, p3})
+	assert.True(t, pfc.Capabilities().MutatesData)
+	pd := testdata.GenerateProfiles(1)
+
+	for i := 0; i < 2; i++ {
+		err := pfc.ConsumeProfiles(context.Background(), pd)
+		if err != nil {
+			t.Errorf("Wanted nil got error")
+			return
+		}
+	}
+
+	assert.True(t, pd != p1.AllProfiles()[0])
+	assert.True(t, pd != p1.AllProfiles()[1])
+	assert.EqualValues(t, pd, p1.AllProfiles()[0])
+	assert.EqualValues(t, pd, p1.AllProfiles()[1])
+
+	assert.True(t, pd != p2.AllProfiles()[0])
+	assert.True(t, pd != p2.AllProfiles()[1])
+	assert.EqualValues(t, pd, p2.AllProfiles()[0])
+	assert.EqualValues(t, pd, p2.AllProfiles()[1])
+
+	// For this consumer, will receive the initial data.
+	assert.True(t, pd == p3.AllProfiles()[0])
+	assert.True(t, pd == p3.AllProfiles()[1])
+	assert.EqualValues(t, pd, p3.AllProfiles()[0])
+	assert.EqualValues(t, pd, p3.AllProfiles()[1])
+
+	// The data should not be marked as read only.
+	assert.False(t, pd.IsReadOnly())
+}
+
+func TestReadOnlyProfilesMultiplexingMutating(t *testing.T) {
+	p1 := &mutatingProfilesSink{ProfilesSink: new(consumertest.ProfilesSink)}
+	p2 := &mutatingProfilesSink{ProfilesSink: new(consumertest.ProfilesSink)}
+	p3 := &mutatingProfilesSink{ProfilesSink: new(consumertest.ProfilesSink)}
+
+	pfc := NewProfiles([]consumer.Profiles{p1, p2, p3})
+	assert.True(t, pfc.Capabilities().MutatesData)
+	ldOrig := testdata.GenerateProfiles(1)
+	pd := testdata.GenerateProfiles(1)
+	pd.MarkReadOnly()
+
+	for i := 0; i < 2; i++ {
+		err := pfc.ConsumeProfiles(context.Background(), pd)
+		if err != nil {
+			t.Errorf("Wanted nil got error")
+			return
+		}
+	}
+
+	// All consumers should receive the cloned data.
+
+	assert.True(t, pd != p1.AllProfiles()[0])
+	assert.True(t, pd != p1.AllProfiles()[1])
+	assert.EqualValues(t, ldOrig, p1.AllProfiles()[0])
+	assert.EqualValues(t, ldOrig, p1.AllProfiles()[1])
+
+	assert.True(t, pd != p2.AllProfiles()[0])
+	assert.True(t, pd != p2.AllProfiles()[1])
+	assert.EqualValues(t, ldOrig, p2.AllProfiles()[0])
+	assert.EqualValues(t, ldOrig, p2.AllProfiles()[1])
+
+	assert.True(t, pd != p3.AllProfiles()[0])
+	assert.True(t, pd != p3.AllProfiles()[1])
+	assert.EqualValues(t, ldOrig, p3.AllProfiles()[0])
+	assert.EqualValues(t, ldOrig, p3.AllProfiles()[1])
+}
+
+func TestProfilesMultiplexingMixLastMutating(t *testing.T) {
+	p1 := &mutatingProfilesSink{ProfilesSink: new(consumertest.ProfilesSink)}
+	p2 := new(consumertest.ProfilesSink)
+	p3 := &mutatingProfilesSink{ProfilesSink: new(consumertest.ProfilesSink)}
+
+	pfc := NewProfiles([]consumer.Profiles{p1, p2, p3})
+	assert.False(t, pfc.Capabilities().MutatesData)
+	pd := testdata.GenerateProfiles(1)
+
+	for i := 0; i < 2; i++ {
+		err := pfc.ConsumeProfiles(context.Background(), pd)
+		if err != nil {
+			t.Errorf("Wanted nil got error")
+			return
+		}
+	}
+
+	assert.True(t, pd != p1.AllProfiles()[0])
+	assert.True(t, pd != p1.AllProfiles()[1])
+	assert.EqualValues(t, pd, p1.AllProfiles()[0])
+	assert.EqualValues(t, pd, p1.AllProfiles()[1])
+
+	// For this consumer, will receive the initial data.
+	assert.True(t, pd == p2.AllProfiles()[0])
+	assert.True(t, pd == p2.AllProfiles()[1])
+	assert.EqualValues(t, pd, p2.AllProfiles()[0])
+	assert.EqualValues(t, pd, p2.AllProfiles()[1])
+
+	// For this consumer, will clone the initial data.
+	assert.True(t, pd != p3.AllProfiles()[0])
+	assert.True(t, pd != p3.AllProfiles()[1])
+	assert.EqualValues(t, pd, p3.AllProfiles()[0])
+	assert.EqualValues(t, pd, p3.AllProfiles()[1])
+
+	// The data should not be marked as read only.
+	assert.False(t, pd.IsReadOnly())
+}
+
+func TestProfilesMultiplexingMixLastNonMutating(t *testing.T) {
+	p1 := &mutatingProfilesSink{ProfilesSink: new(consumertest.ProfilesSink)}
+	p2 := &mutatingProfilesSink{ProfilesSink: new(consumertest.ProfilesSink)}
+	p3 := new(consumertest.ProfilesSink)
+
+	pfc := NewProfiles([]consumer.Profiles{p1, p2, p3})
+	assert.False(t, pfc.Capabilities().MutatesData)
+	pd := testdata.GenerateProfiles(1)
+
+	for i := 0; i < 2; i++ {
+		err := pfc.ConsumeProfiles(context.Background(), pd)
+		if err != nil {
+			t.Errorf("Wanted nil got error")
+			return
+		}
+	}
+
+	assert.True(t, pd != p1.AllProfiles()[0])
+	assert.True(t, pd != p1.AllProfiles()[1])
+	assert.EqualValues(t, pd, p1.AllProfiles()[0])
+	assert.EqualValues(t, pd, p1.AllProfiles()[1])
+
+	assert.True(t, pd != p2.AllProfiles()[0])
+	assert.True(t, pd != p2.AllProfiles()[1])
+	assert.EqualValues(t, pd, p2.AllProfiles()[0])
+	assert.EqualValues(t, pd, p2.AllProfiles()[1])
+
+	// For this consumer, will receive the initial data.
+	assert.True(t, pd == p3.AllProfiles()[0])
+	assert.True(t, pd == p3.AllProfiles()[1])
+	assert.EqualValues(t, pd, p3.AllProfiles()[0])
+	assert.EqualValues(t, pd, p3.AllProfiles()[1])
+
+	// The data should not be marked as read only.
+	assert.False(t, pd.IsReadOnly())
+}
+
+func TestProfilesWhenErrors(t *testing.T) {
+	p1 := mutatingErr{Consumer: consumertest.NewErr(errors.New("my error"))}
+	p2 := consumertest.NewErr(errors.New("my error"))
+	p3 := new(consumertest.ProfilesSink)
+
+	pfc := NewProfiles([]consumer.Profiles{p1, p2, p3})
+	pd := testdata.GenerateProfiles(1)
+
+	for i := 0; i < 2; i++ {
+		assert.Error(t, pfc.ConsumeProfiles(context.Background(), pd))
+	}
+
+	assert.True(t, pd == p3.AllProfiles()[0])
+	assert.True(t, pd == p3.AllProfiles()[1])
+	assert.EqualValues(t, pd, p3.AllProfiles()[0])
+	assert.EqualValues(t, pd, p3.AllProfiles()[1])
+}
+
+type mutatingProfilesSink struct {
+	*consumertest.ProfilesSink
+}
+
+func (mts *mutatingProfilesSink) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true}
+}
+
+func TestProfilesMultiplexingCopyingReadOnly(t *testing.T) {
+	p1 := &copyingProfilesSink{ProfilesSink: new(consumertest.ProfilesSink)}
+	p2 := new(consumertest.ProfilesSink)
+
+	// A single consumer copying the read-only data is not wrapped.
+	assert.Same(t, p1, NewProfiles([]consumer.Profiles{p1}))
+
+	// The data is shared as read-only with the consumer copying it, instead of being cloned for it,
+	// so it is only copied by the consumer mutating it.
+	fc := NewProfiles([]consumer.Profiles{p1, p2})
+	assert.False(t, fc.Capabilities().MutatesData)
+	pd := testdata.GenerateProfiles(1)
+	assert.NoError(t, fc.ConsumeProfiles(context.Background(), pd))
+	assert.True(t, pd.IsReadOnly())
+	assert.True(t, pd == p2.AllProfiles()[0])
+	assert.Equal(t, 1, p1.copies)
+	assert.Equal(t, 1, countProfilesCopies(pd, p1.AllProfiles(), p2.AllProfiles()))
+}
+
+func TestProfilesMultiplexingAllCopying(t *testing.T) {
+	p1 := &copyingProfilesSink{ProfilesSink: new(consumertest.ProfilesSink)}
+	p2 := &copyingProfilesSink{ProfilesSink: new(consumertest.ProfilesSink)}
+
+	// Without read-only consumer, the data is cloned for the first consumer and the original data is given to the
+	// last one, as when all consumers mutate the data, instead of being copied by each of them.
+	fc := NewProfiles([]consumer.Profiles{p1, p2})
+	assert.True(t, fc.Capabilities().MutatesData)
+	assert.True(t, fc.Capabilities().CopiesReadOnlyData)
+	pd := testdata.GenerateProfiles(1)
+	assert.NoError(t, fc.ConsumeProfiles(context.Background(), pd))
+	assert.False(t, pd.IsReadOnly())
+	assert.True(t, pd == p2.AllProfiles()[0])
+	assert.Equal(t, 0, p1.copies+p2.copies)
+	assert.Equal(t, 1, countProfilesCopies(pd, p1.AllProfiles(), p2.AllProfiles()))
+
+	// The read-only data is not cloned for the last consumer, which copies it.
+	pd = testdata.GenerateProfiles(1)
+	pd.MarkReadOnly()
+	assert.NoError(t, fc.ConsumeProfiles(context.Background(), pd))
+	assert.Equal(t, 0, p1.copies)
+	assert.Equal(t, 1, p2.copies)
+	assert.Equal(t, 2, countProfilesCopies(pd, p1.AllProfiles()[1:], p2.AllProfiles()[1:]))
+}
+
+// countProfilesCopies returns the number of copies of the original data received by the consumers.
+func countProfilesCopies(orig pprofile.Profiles, received ...[]pprofile.Profiles) int {
+	copies := 0
+	for _, r := range received {
+		for _, pd := range r {
+			if pd != orig {
+				copies++
+			}
+		}
+	}
+	return copies
+}
+
+// copyingProfilesSink mutates the data it consumes, copying the read-only data first as the processors do.
+type copyingProfilesSink struct {
+	*consumertest.ProfilesSink
+	copies int
+}
+
+func (s *copyingProfilesSink) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true, CopiesReadOnlyData: true}
+}
+
+func (s *copyingProfilesSink) ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	if pd.IsReadOnly() {
+		pd = pd.Mutable()
+		s.copies++
+	}
+	return s.ProfilesSink.ConsumeProfiles(ctx, pd)
+}
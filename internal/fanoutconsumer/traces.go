@@ -16,31 +16,48 @@ import (
 // It fanouts the incoming data to all the consumers, and does smart routing:
 //   - Clones only to the consumer that needs to mutate the data.
 //   - If all consumers needs to mutate the data one will get the original mutable data.
+//   - Shares the data with the consumers which copy the read-only data before mutating it when other consumers only
+//     read it, otherwise handles them as the mutating consumers.
 func NewTraces(tcs []consumer.Traces) consumer.Traces {
 	// Don't wrap if there is only one non-mutating consumer.
-	if len(tcs) == 1 && !tcs[0].Capabilities().MutatesData {
+	if len(tcs) == 1 && !mutatesSharedData(tcs[0].Capabilities()) {
 		return tcs[0]
 	}
 
 	tc := &tracesConsumer{}
+	var copying []consumer.Traces
 	for i := 0; i < len(tcs); i++ {
-		if tcs[i].Capabilities().MutatesData {
+		switch {
+		case mutatesSharedData(tcs[i].Capabilities()):
 			tc.mutable = append(tc.mutable, tcs[i])
-		} else {
+		case tcs[i].Capabilities().MutatesData:
+			copying = append(copying, tcs[i])
+		default:
 			tc.readonly = append(tc.readonly, tcs[i])
 		}
 	}
+	if len(tc.readonly) == 0 && len(copying) > 0 {
+		// Sharing the data would make each of them copy it, so the last one is given the original data instead.
+		tc.mutable = append(tc.mutable, copying...)
+		tc.lastCopies = true
+	} else {
+		tc.readonly = append(tc.readonly, copying...)
+	}
 	return tc
 }
 
 type tracesConsumer struct {
 	mutable  []consumer.Traces
 	readonly []consumer.Traces
+	// lastCopies is set if the last mutable consumer copies the read-only data before mutating it.
+	lastCopies bool
 }
 
 func (tsc *tracesConsumer) Capabilities() consumer.Capabilities {
 	// If all consumers are mutating, then the original data will be passed to one of them.
-	return consumer.Capabilities{MutatesData: len(tsc.mutable) > 0 && len(tsc.readonly) == 0}
+	// The read-only data is cloned for them, so it can be shared with the fanout.
+	mutates := len(tsc.mutable) > 0 && len(tsc.readonly) == 0
+	return consumer.Capabilities{MutatesData: mutates, CopiesReadOnlyData: mutates}
 }
 
 // ConsumeTraces exports the ptrace.Traces to all consumers wrapped by the current one.
@@ -53,10 +70,11 @@ func (tsc *tracesConsumer) ConsumeTraces(ctx context.Context, td ptrace.Traces)
 			errs = multierr.Append(errs, tsc.mutable[i].ConsumeTraces(ctx, cloneTraces(td)))
 		}
 		// Send data as is to the last mutating consumer only if there are no other non-mutating consumers and the
-		// data is mutable. Never share the same data between a mutating and a non-mutating consumer since the
-		// non-mutating consumer may process data async and the mutating consumer may change the data before that.
+		// data is mutable, or copied by the consumer before mutating it. Never share the same data between a mutating
+		// and a non-mutating consumer since the non-mutating consumer may process data async and the mutating consumer
+		// may change the data before that.
 		lastConsumer := tsc.mutable[len(tsc.mutable)-1]
-		if len(tsc.readonly) == 0 && !td.IsReadOnly() {
+		if len(tsc.readonly) == 0 && (!td.IsReadOnly() || tsc.lastCopies) {
 			errs = multierr.Append(errs, lastConsumer.ConsumeTraces(ctx, td))
 		} else {
 			errs = multierr.Append(errs, lastConsumer.ConsumeTraces(ctx, cloneTraces(td)))
@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/internal/testdata"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 )
 
 func TestTracesNotMultiplexing(t *testing.T) {
@@ -238,3 +239,78 @@ type mutatingTracesSink struct {
 func (mts *mutatingTracesSink) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: true}
 }
+
+func TestTracesMultiplexingCopyingReadOnly(t *testing.T) {
+	p1 := &copyingTracesSink{TracesSink: new(consumertest.TracesSink)}
+	p2 := new(consumertest.TracesSink)
+
+	// A single consumer copying the read-only data is not wrapped.
+	assert.Same(t, p1, NewTraces([]consumer.Traces{p1}))
+
+	// The data is shared as read-only with the consumer copying it, instead of being cloned for it,
+	// so it is only copied by the consumer mutating it.
+	fc := NewTraces([]consumer.Traces{p1, p2})
+	assert.False(t, fc.Capabilities().MutatesData)
+	td := testdata.GenerateTraces(1)
+	assert.NoError(t, fc.ConsumeTraces(context.Background(), td))
+	assert.True(t, td.IsReadOnly())
+	assert.True(t, td == p2.AllTraces()[0])
+	assert.Equal(t, 1, p1.copies)
+	assert.Equal(t, 1, countTracesCopies(td, p1.AllTraces(), p2.AllTraces()))
+}
+
+func TestTracesMultiplexingAllCopying(t *testing.T) {
+	p1 := &copyingTracesSink{TracesSink: new(consumertest.TracesSink)}
+	p2 := &copyingTracesSink{TracesSink: new(consumertest.TracesSink)}
+
+	// Without read-only consumer, the data is cloned for the first consumer and the original data is given to the
+	// last one, as when all consumers mutate the data, instead of being copied by each of them.
+	fc := NewTraces([]consumer.Traces{p1, p2})
+	assert.True(t, fc.Capabilities().MutatesData)
+	assert.True(t, fc.Capabilities().CopiesReadOnlyData)
+	td := testdata.GenerateTraces(1)
+	assert.NoError(t, fc.ConsumeTraces(context.Background(), td))
+	assert.False(t, td.IsReadOnly())
+	assert.True(t, td == p2.AllTraces()[0])
+	assert.Equal(t, 0, p1.copies+p2.copies)
+	assert.Equal(t, 1, countTracesCopies(td, p1.AllTraces(), p2.AllTraces()))
+
+	// The read-only data is not cloned for the last consumer, which copies it.
+	td = testdata.GenerateTraces(1)
+	td.MarkReadOnly()
+	assert.NoError(t, fc.ConsumeTraces(context.Background(), td))
+	assert.Equal(t, 0, p1.copies)
+	assert.Equal(t, 1, p2.copies)
+	assert.Equal(t, 2, countTracesCopies(td, p1.AllTraces()[1:], p2.AllTraces()[1:]))
+}
+
+// countTracesCopies returns the number of copies of the original data received by the consumers.
+func countTracesCopies(orig ptrace.Traces, received ...[]ptrace.Traces) int {
+	copies := 0
+	for _, r := range received {
+		for _, td := range r {
+			if td != orig {
+				copies++
+			}
+		}
+	}
+	return copies
+}
+
+// copyingTracesSink mutates the data it consumes, copying the read-only data first as the processors do.
+type copyingTracesSink struct {
+	*consumertest.TracesSink
+	copies int
+}
+
+func (s *copyingTracesSink) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true, CopiesReadOnlyData: true}
+}
+
+func (s *copyingTracesSink) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	if td.IsReadOnly() {
+		td = td.Mutable()
+		s.copies++
+	}
+	return s.TracesSink.ConsumeTraces(ctx, td)
+}
@@ -0,0 +1,29 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iruntime // import "go.opentelemetry.io/collector/internal/iruntime"
+
+import (
+	"math"
+	"runtime/debug"
+)
+
+// goMemoryLimit returns the soft memory limit of the Go runtime, set with
+// GOMEMLIMIT or debug.SetMemoryLimit. It is overridable by tests.
+var goMemoryLimit = func() int64 {
+	return debug.SetMemoryLimit(-1)
+}
+
+// MemoryLimit returns the memory available to the process: the total memory,
+// which honors the cgroup limits on linux, capped by the soft memory limit of
+// the Go runtime when one is set.
+func MemoryLimit() (uint64, error) {
+	totalMemory, err := TotalMemory()
+	if err != nil {
+		return 0, err
+	}
+	if limit := goMemoryLimit(); limit > 0 && limit != math.MaxInt64 && uint64(limit) < totalMemory {
+		return uint64(limit), nil
+	}
+	return totalMemory, nil
+}
@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iruntime
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryLimit(t *testing.T) {
+	totalMemory, err := TotalMemory()
+	require.NoError(t, err)
+	original := goMemoryLimit
+	t.Cleanup(func() { goMemoryLimit = original })
+
+	goMemoryLimit = func() int64 { return math.MaxInt64 }
+	limit, err := MemoryLimit()
+	require.NoError(t, err)
+	assert.Equal(t, totalMemory, limit)
+
+	goMemoryLimit = func() int64 { return 1024 }
+	limit, err = MemoryLimit()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1024), limit)
+
+	goMemoryLimit = func() int64 { return int64(totalMemory) + 1 }
+	limit, err = MemoryLimit()
+	require.NoError(t, err)
+	assert.Equal(t, totalMemory, limit)
+}
@@ -5,6 +5,7 @@ package memorylimiter // import "go.opentelemetry.io/collector/internal/memoryli
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -17,6 +18,7 @@ var (
 	errSpikeLimitPercentageOutOfRange = errors.New("'spike_limit_percentage' must be smaller than 'limit_percentage'")
 	errLimitPercentageOutOfRange      = errors.New(
 		"'limit_percentage' and 'spike_limit_percentage' must be greater than zero and less than or equal to hundred")
+	errMaxPauseOutOfRange = errors.New("'actions::max_pause' must not be negative")
 )
 
 // Config defines configuration for memory memoryLimiter processor.
@@ -41,6 +43,116 @@ type Config struct {
 	// MemorySpikePercentage is the maximum, in percents against the total memory,
 	// spike expected between the measurements of memory usage.
 	MemorySpikePercentage uint32 `mapstructure:"spike_limit_percentage"`
+
+	// BudgetMiB is the maximum amount of data, in MiB, that the processor lets
+	// through to the next components at the same time. When exceeded, the soft
+	// limit action is taken regardless of the memory usage of the process, so
+	// that processors with different configurations throttle their pipelines
+	// independently. The budget only covers the synchronous part of the pipeline
+	// following the processor: the data is only accounted while the next
+	// components consume it synchronously, and once returned by an asynchronous
+	// component, such as the batch processor or an exporter with a sending queue,
+	// it is not accounted anymore. Only supported by the memory_limiter processor,
+	// the extension rejects it. Defaults to zero, so no budget is enforced.
+	BudgetMiB uint32 `mapstructure:"budget_mib"`
+
+	// Actions defines what happens to the data when the memory usage is above the
+	// soft or hard limits. Defaults to refusing the data.
+	Actions ActionsConfig `mapstructure:"actions"`
+}
+
+// LimitAction is the action taken on the incoming data when a memory limit is exceeded.
+type LimitAction string
+
+const (
+	// LimitActionRefuse refuses the data with a non-permanent error, so that it is retried.
+	LimitActionRefuse LimitAction = "refuse"
+	// LimitActionDropNew drops the incoming data without returning an error.
+	LimitActionDropNew LimitAction = "drop_new"
+	// LimitActionDropOldest holds the incoming data until the memory usage goes back below the
+	// limit, like LimitActionPause, but only the newest data is held: the data held before is
+	// dropped without returning an error. The data still held after ActionsConfig.MaxPause is
+	// dropped too, and it is refused if the caller gives up first.
+	LimitActionDropOldest LimitAction = "drop_oldest"
+	// LimitActionGC accepts the data, and forces a garbage collection if none was done recently.
+	LimitActionGC LimitAction = "gc"
+	// LimitActionPause holds the data until the memory usage goes back below the limit,
+	// pausing the preceding components. The data is refused if the memory usage is still
+	// above the limit after ActionsConfig.MaxPause, or if the caller gives up first.
+	LimitActionPause LimitAction = "pause"
+)
+
+// defaultMaxPause is the maximum time the data is held by the pause and drop_oldest actions if not configured.
+const defaultMaxPause = 10 * time.Second
+
+// LimitActions defines the actions taken at each memory limit.
+type LimitActions struct {
+	// SoftLimit is the action taken when the memory usage is above the soft limit.
+	SoftLimit LimitAction `mapstructure:"soft_limit"`
+
+	// HardLimit is the action taken when the memory usage is above the hard limit,
+	// after a garbage collection was forced.
+	HardLimit LimitAction `mapstructure:"hard_limit"`
+}
+
+// ActionsConfig defines the actions taken at each memory limit, with optional overrides per signal.
+type ActionsConfig struct {
+	LimitActions `mapstructure:",squash"`
+
+	// Traces, Metrics and Logs override the actions for a signal, unset actions
+	// fall back to the ones shared by all the signals.
+	Traces  LimitActions `mapstructure:"traces"`
+	Metrics LimitActions `mapstructure:"metrics"`
+	Logs    LimitActions `mapstructure:"logs"`
+
+	// MaxPause is the maximum time the data is held by the pause and drop_oldest actions, after
+	// which the data is refused or dropped. Defaults to 10 seconds when zero.
+	MaxPause time.Duration `mapstructure:"max_pause"`
+}
+
+// PauseTimeout returns the maximum time the data is held by the pause and drop_oldest actions.
+func (cfg *ActionsConfig) PauseTimeout() time.Duration {
+	if cfg.MaxPause == 0 {
+		return defaultMaxPause
+	}
+	return cfg.MaxPause
+}
+
+// For returns the actions taken for the data of the given signal.
+func (cfg *ActionsConfig) For(dataType component.DataType) LimitActions {
+	actions := LimitActions{SoftLimit: LimitActionRefuse, HardLimit: LimitActionRefuse}
+	for _, override := range []LimitActions{cfg.LimitActions, cfg.signal(dataType)} {
+		if override.SoftLimit != "" {
+			actions.SoftLimit = override.SoftLimit
+		}
+		if override.HardLimit != "" {
+			actions.HardLimit = override.HardLimit
+		}
+	}
+	return actions
+}
+
+func (cfg *ActionsConfig) signal(dataType component.DataType) LimitActions {
+	switch dataType {
+	case component.DataTypeTraces:
+		return cfg.Traces
+	case component.DataTypeMetrics:
+		return cfg.Metrics
+	case component.DataTypeLogs:
+		return cfg.Logs
+	}
+	return LimitActions{}
+}
+
+func (la LimitActions) validate() error {
+	for _, action := range []LimitAction{la.SoftLimit, la.HardLimit} {
+		switch action {
+		case "", LimitActionRefuse, LimitActionDropNew, LimitActionDropOldest, LimitActionGC, LimitActionPause:
+		default:
+			return fmt.Errorf("unsupported memory limit action %q", action)
+		}
+	}
+	return nil
 }
 
 var _ component.Config = (*Config)(nil)
@@ -62,5 +174,13 @@ func (cfg *Config) Validate() error {
 	if cfg.MemoryLimitPercentage > 0 && cfg.MemoryLimitPercentage <= cfg.MemorySpikePercentage {
 		return errSpikeLimitPercentageOutOfRange
 	}
+	if cfg.Actions.MaxPause < 0 {
+		return errMaxPauseOutOfRange
+	}
+	for _, actions := range []LimitActions{cfg.Actions.LimitActions, cfg.Actions.Traces, cfg.Actions.Metrics, cfg.Actions.Logs} {
+		if err := actions.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
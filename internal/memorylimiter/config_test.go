@@ -4,6 +4,7 @@
 package memorylimiter
 
 import (
+	"errors"
 	"path/filepath"
 	"testing"
 	"time"
@@ -25,6 +26,12 @@ func TestUnmarshalConfig(t *testing.T) {
 			CheckInterval:       5 * time.Second,
 			MemoryLimitMiB:      4000,
 			MemorySpikeLimitMiB: 500,
+			Actions: ActionsConfig{
+				LimitActions: LimitActions{SoftLimit: LimitActionRefuse, HardLimit: LimitActionRefuse},
+				Metrics:      LimitActions{SoftLimit: LimitActionDropOldest},
+				Logs:         LimitActions{SoftLimit: LimitActionDropNew},
+				MaxPause:     5 * time.Second,
+			},
 		}, cfg)
 }
 
@@ -85,6 +92,26 @@ func TestConfigValidate(t *testing.T) {
 			},
 			err: errSpikeLimitPercentageOutOfRange,
 		},
+		{
+			name: "invalid limit action",
+			cfg: &Config{
+				CheckInterval:  1 * time.Second,
+				MemoryLimitMiB: 10,
+				Actions: ActionsConfig{
+					Traces: LimitActions{HardLimit: "block"},
+				},
+			},
+			err: errors.New(`unsupported memory limit action "block"`),
+		},
+		{
+			name: "negative max pause",
+			cfg: &Config{
+				CheckInterval:  1 * time.Second,
+				MemoryLimitMiB: 10,
+				Actions:        ActionsConfig{MaxPause: -time.Second},
+			},
+			err: errMaxPauseOutOfRange,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -93,3 +120,24 @@ func TestConfigValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestActionsFor(t *testing.T) {
+	cfg := ActionsConfig{}
+	assert.Equal(t, LimitActions{SoftLimit: LimitActionRefuse, HardLimit: LimitActionRefuse}, cfg.For(component.DataTypeTraces))
+
+	cfg = ActionsConfig{
+		LimitActions: LimitActions{SoftLimit: LimitActionGC},
+		Logs:         LimitActions{SoftLimit: LimitActionDropNew, HardLimit: LimitActionDropNew},
+		Metrics:      LimitActions{HardLimit: LimitActionPause},
+	}
+	assert.Equal(t, LimitActions{SoftLimit: LimitActionGC, HardLimit: LimitActionRefuse}, cfg.For(component.DataTypeTraces))
+	assert.Equal(t, LimitActions{SoftLimit: LimitActionGC, HardLimit: LimitActionPause}, cfg.For(component.DataTypeMetrics))
+	assert.Equal(t, LimitActions{SoftLimit: LimitActionDropNew, HardLimit: LimitActionDropNew}, cfg.For(component.DataTypeLogs))
+}
+
+func TestActionsPauseTimeout(t *testing.T) {
+	cfg := ActionsConfig{}
+	assert.Equal(t, defaultMaxPause, cfg.PauseTimeout())
+	cfg.MaxPause = time.Second
+	assert.Equal(t, time.Second, cfg.PauseTimeout())
+}
@@ -35,7 +35,7 @@ var (
 	ErrShutdownNotStarted = errors.New("no existing monitoring routine is running")
 
 	// GetMemoryFn and ReadMemStatsFn make it overridable by tests
-	GetMemoryFn    = iruntime.TotalMemory
+	GetMemoryFn    = iruntime.MemoryLimit
 	ReadMemStatsFn = runtime.ReadMemStats
 )
 
@@ -49,8 +49,15 @@ type MemoryLimiter struct {
 	// mustRefuse is used to indicate when data should be refused.
 	mustRefuse *atomic.Bool
 
+	// aboveHardLimit is used to indicate when the memory usage is above
+	// the hard limit, even after a forced GC.
+	aboveHardLimit atomic.Bool
+
 	ticker *time.Ticker
 
+	// gcLock guards lastGCDone, GCs are forced by the monitoring
+	// goroutine and by ForceGC.
+	gcLock     sync.Mutex
 	lastGCDone time.Time
 
 	// The function to read the mem values is set as a reference to help with
@@ -147,6 +154,31 @@ func (ml *MemoryLimiter) MustRefuse() bool {
 	return ml.mustRefuse.Load()
 }
 
+// AboveHardLimit returns if the memory usage was above the hard limit at the last check,
+// even after forcing a GC.
+func (ml *MemoryLimiter) AboveHardLimit() bool {
+	return ml.aboveHardLimit.Load()
+}
+
+// CheckInterval returns the time between measurements of memory usage.
+func (ml *MemoryLimiter) CheckInterval() time.Duration {
+	return ml.memCheckWait
+}
+
+// ForceGC forces a GC, unless one is in progress or was done less than
+// minGCIntervalWhenSoftLimited ago.
+func (ml *MemoryLimiter) ForceGC() {
+	if !ml.gcLock.TryLock() {
+		return
+	}
+	defer ml.gcLock.Unlock()
+	if time.Since(ml.lastGCDone) > minGCIntervalWhenSoftLimited {
+		ml.logger.Info("Memory usage is above limit. Forcing a GC.")
+		runtime.GC()
+		ml.lastGCDone = time.Now()
+	}
+}
+
 func getMemUsageChecker(cfg *Config, logger *zap.Logger) (*memUsageChecker, error) {
 	memAllocLimit := uint64(cfg.MemoryLimitMiB) * mibBytes
 	memSpikeLimit := uint64(cfg.MemorySpikeLimitMiB) * mibBytes
@@ -186,8 +218,10 @@ func memstatToZapField(ms *runtime.MemStats) zap.Field {
 }
 
 func (ml *MemoryLimiter) doGCandReadMemStats() *runtime.MemStats {
+	ml.gcLock.Lock()
 	runtime.GC()
 	ml.lastGCDone = time.Now()
+	ml.gcLock.Unlock()
 	ms := ml.readMemStats()
 	ml.logger.Info("Memory usage after GC.", memstatToZapField(ms))
 	return ms
@@ -218,7 +252,10 @@ func (ml *MemoryLimiter) CheckMemLimits() {
 	if !wasRefusing && mustRefuse {
 		// We are above soft limit, do a GC if it wasn't done recently and see if
 		// it brings memory usage below the soft limit.
-		if time.Since(ml.lastGCDone) > minGCIntervalWhenSoftLimited {
+		ml.gcLock.Lock()
+		lastGCDone := ml.lastGCDone
+		ml.gcLock.Unlock()
+		if time.Since(lastGCDone) > minGCIntervalWhenSoftLimited {
 			ml.logger.Info("Memory usage is above soft limit. Forcing a GC.", memstatToZapField(ms))
 			ms = ml.doGCandReadMemStats()
 			// Check the limit again to see if GC helped.
@@ -231,6 +268,7 @@ func (ml *MemoryLimiter) CheckMemLimits() {
 	}
 
 	ml.mustRefuse.Store(mustRefuse)
+	ml.aboveHardLimit.Store(ml.usageChecker.aboveHardLimit(ms))
 }
 
 type memUsageChecker struct {
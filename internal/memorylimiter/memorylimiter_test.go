@@ -42,6 +42,7 @@ func TestMemoryPressureResponse(t *testing.T) {
 	currentMemAlloc = 1800
 	ml.CheckMemLimits()
 	assert.True(t, ml.MustRefuse())
+	assert.True(t, ml.AboveHardLimit())
 
 	// Check ballast effect
 	ml.ballastSize = 1000
@@ -71,6 +72,7 @@ func TestMemoryPressureResponse(t *testing.T) {
 	currentMemAlloc = 550
 	ml.CheckMemLimits()
 	assert.True(t, ml.MustRefuse())
+	assert.False(t, ml.AboveHardLimit())
 }
 
 func TestGetDecision(t *testing.T) {
@@ -84,7 +86,7 @@ func TestGetDecision(t *testing.T) {
 	})
 
 	t.Cleanup(func() {
-		GetMemoryFn = iruntime.TotalMemory
+		GetMemoryFn = iruntime.MemoryLimit
 	})
 	GetMemoryFn = func() (uint64, error) {
 		return 100 * mibBytes, nil
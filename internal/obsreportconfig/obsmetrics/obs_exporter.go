@@ -27,6 +27,13 @@ const (
 	FailedToSendLogRecordsKey = "send_failed_log_records"
 	// FailedToEnqueueLogRecordsKey used to track logs that failed to be enqueued by exporters.
 	FailedToEnqueueLogRecordsKey = "enqueue_failed_log_records"
+
+	// SentSamplesKey used to track profile samples sent by exporters.
+	SentSamplesKey = "sent_samples"
+	// FailedToSendSamplesKey used to track profile samples that failed to be sent by exporters.
+	FailedToSendSamplesKey = "send_failed_samples"
+	// FailedToEnqueueSamplesKey used to track profile samples that failed to be enqueued by exporters.
+	FailedToEnqueueSamplesKey = "enqueue_failed_samples"
 )
 
 var (
@@ -34,4 +41,5 @@ var (
 	ExportTraceDataOperationSuffix = NameSep + "traces"
 	ExportMetricsOperationSuffix   = NameSep + "metrics"
 	ExportLogsOperationSuffix      = NameSep + "logs"
+	ExportProfilesOperationSuffix  = NameSep + "profiles"
 )
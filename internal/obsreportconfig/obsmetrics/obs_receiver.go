@@ -27,6 +27,21 @@ const (
 	// RefusedLogRecordsKey used to identify log records refused (ie.: not ingested) by the
 	// Collector.
 	RefusedLogRecordsKey = "refused_log_records"
+
+	// AcceptedSamplesKey used to identify profile samples accepted by the Collector.
+	AcceptedSamplesKey = "accepted_samples"
+	// RefusedSamplesKey used to identify profile samples refused (ie.: not ingested) by the
+	// Collector.
+	RefusedSamplesKey = "refused_samples"
+
+	// InFlightBytesKey used to identify the bytes of the requests being processed by a receiver.
+	InFlightBytesKey = "inflight_bytes"
+	// RejectedRequestsKey used to identify the requests rejected by a receiver before being
+	// processed, by reason.
+	RejectedRequestsKey = "rejected_requests"
+	// ReasonKey used to identify the reason of the rejection of the requests:
+	// "too_large" or "overloaded".
+	ReasonKey = "reason"
 )
 
 var (
@@ -34,4 +49,5 @@ var (
 	ReceiveTraceDataOperationSuffix = NameSep + "TraceDataReceived"
 	ReceiverMetricsOperationSuffix  = NameSep + "MetricsReceived"
 	ReceiverLogsOperationSuffix     = NameSep + "LogsReceived"
+	ReceiverProfilesOperationSuffix = NameSep + "ProfilesReceived"
 )
@@ -13,6 +13,11 @@ const (
 	// ErroredMetricPointsKey used to identify metric points errored (i.e.
 	// unable to be scraped) by the Collector.
 	ErroredMetricPointsKey = "errored_metric_points"
+	// ScrapeErrorsKey used to identify the scrapes which failed, by category.
+	ScrapeErrorsKey = "scrape_errors"
+	// ErrorCategoryKey used to identify the category of the errors of the scrapes:
+	// "timeout", "partial" or "permanent".
+	ErrorCategoryKey = "error_category"
 )
 
 const (
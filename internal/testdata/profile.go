@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package testdata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+var (
+	profileStartTimestamp = pcommon.NewTimestampFromTime(time.Date(2020, 2, 11, 20, 26, 12, 321, time.UTC))
+	profileEndTimestamp   = pcommon.NewTimestampFromTime(time.Date(2020, 2, 11, 20, 26, 13, 789, time.UTC))
+)
+
+// GenerateProfiles generates a single profile with count samples.
+func GenerateProfiles(count int) pprofile.Profiles {
+	pd := pprofile.NewProfiles()
+	initResource(pd.ResourceProfiles().AppendEmpty().Resource())
+	pc := pd.ResourceProfiles().At(0).ScopeProfiles().AppendEmpty().Profiles().AppendEmpty()
+	pc.SetProfileID([16]byte{0x01, 0x02, 0x03, 0x04})
+	pc.SetStartTimestamp(profileStartTimestamp)
+	pc.SetEndTimestamp(profileEndTimestamp)
+	pc.Attributes().PutStr("profile-attr", "profile-attr-val")
+
+	profile := pc.Profile()
+	profile.StringTable().FromRaw([]string{"", "samples", "count"})
+	st := profile.SampleType().AppendEmpty()
+	st.SetType(1)
+	st.SetUnit(2)
+	samples := profile.Sample()
+	samples.EnsureCapacity(count)
+	for i := 0; i < count; i++ {
+		samples.AppendEmpty().Value().FromRaw([]int64{int64(i + 1)})
+	}
+	return pd
+}
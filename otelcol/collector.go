@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"sort"
+	"strings"
 	"sync/atomic"
 	"syscall"
 
@@ -22,6 +24,7 @@ import (
 	"go.opentelemetry.io/collector/connector"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/otelcol/internal/grpclog"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/receiver"
@@ -75,6 +78,15 @@ type CollectorSettings struct {
 
 	// SkipSettingGRPCLogger avoids setting the grpc logger
 	SkipSettingGRPCLogger bool
+
+	// RestartOnConfigChange restarts the collector process when the configuration changes, on
+	// SIGHUP and on SIGUSR2, instead of reloading the configuration in the process. The listeners
+	// of the components are handed off to the new process, so that there is no gap in the ingestion.
+	// The process exits once the new one is ready, so it is not supported when the process runs as
+	// PID 1, as in a container without init process, nor by systemd services unless they are of
+	// Type=notify, in which case systemd is notified of the new main process. It is not supported
+	// on Windows.
+	RestartOnConfigChange bool
 }
 
 // (Internal note) Collector Lifecycle:
@@ -101,6 +113,10 @@ type Collector struct {
 	signalsChannel chan os.Signal
 	// asyncErrorChannel is used to signal a fatal error from any component.
 	asyncErrorChannel chan error
+
+	// gateDefaults holds the values of the feature gates set by service::feature_gates before the
+	// configuration set them, they are restored when the gates are removed from the configuration.
+	gateDefaults map[string]bool
 }
 
 // NewCollector creates and returns a new instance of Collector.
@@ -108,6 +124,11 @@ func NewCollector(set CollectorSettings) (*Collector, error) {
 	if set.ConfigProvider == nil {
 		return nil, errors.New("invalid nil config provider")
 	}
+	if set.RestartOnConfigChange {
+		if err := checkRestartSupported(); err != nil {
+			return nil, err
+		}
+	}
 
 	state := &atomic.Int32{}
 	state.Store(int32(StateStarting))
@@ -117,7 +138,7 @@ func NewCollector(set CollectorSettings) (*Collector, error) {
 		shutdownChan: make(chan struct{}),
 		// Per signal.Notify documentation, a size of the channel equaled with
 		// the number of signals getting notified on is recommended.
-		signalsChannel:    make(chan os.Signal, 3),
+		signalsChannel:    make(chan os.Signal, 4),
 		asyncErrorChannel: make(chan error),
 	}, nil
 }
@@ -164,17 +185,33 @@ func (col *Collector) setupConfigurationComponents(ctx context.Context) error {
 		return fmt.Errorf("failed to get config: %w", err)
 	}
 
+	if col.service == nil {
+		err = col.applyFeatureGates(cfg)
+	} else {
+		err = col.reloadFeatureGates(cfg)
+	}
+	if err != nil {
+		return err
+	}
+
 	if err = cfg.Validate(); err != nil {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	var confOrigins map[string]string
+	if op, ok := col.set.ConfigProvider.(ConfigOriginProvider); ok {
+		confOrigins = op.ConfigOrigins()
+	}
+
 	col.service, err = service.New(ctx, service.Settings{
 		BuildInfo:         col.set.BuildInfo,
 		CollectorConf:     conf,
+		EffectiveConf:     func() (*confmap.Conf, error) { return effectiveConf(cfg) },
+		ConfOrigins:       confOrigins,
 		Receivers:         receiver.NewBuilder(cfg.Receivers, factories.Receivers),
 		Processors:        processor.NewBuilder(cfg.Processors, factories.Processors),
 		Exporters:         exporter.NewBuilder(cfg.Exporters, factories.Exporters),
-		Connectors:        connector.NewBuilder(cfg.Connectors, factories.Connectors),
+		Connectors:        connector.NewBuilder(cfg.Connectors, factories.Connectors, connector.WithBuffering(cfg.Service.ConnectorBuffer)),
 		Extensions:        extension.NewBuilder(cfg.Extensions, factories.Extensions),
 		AsyncErrorChannel: col.asyncErrorChannel,
 		LoggingOptions:    col.set.LoggingOptions,
@@ -210,17 +247,115 @@ func (col *Collector) reloadConfiguration(ctx context.Context) error {
 	return nil
 }
 
+// DryRun validates the configuration without running the collector, as done by the validate
+// command. Besides the validation of the configuration and of its components, it checks that the
+// components support the data type of the pipelines they are used in, and that the connectors are
+// used in supported pairs of pipelines. All the issues found are returned.
 func (col *Collector) DryRun(ctx context.Context) error {
+	findings, err := col.validate(ctx)
+	if err != nil {
+		return err
+	}
+	var errs error
+	for _, finding := range findings {
+		errs = multierr.Append(errs, finding.err)
+	}
+	return errs
+}
+
+// validate returns the findings of the configuration. It returns an error if the factories
+// or the configuration can not be loaded, in which case the configuration can not be validated.
+func (col *Collector) validate(ctx context.Context) ([]validationFinding, error) {
 	factories, err := col.set.Factories()
 	if err != nil {
-		return fmt.Errorf("failed to initialize factories: %w", err)
+		return nil, fmt.Errorf("failed to initialize factories: %w", err)
 	}
 	cfg, err := col.set.ConfigProvider.Get(ctx, factories)
 	if err != nil {
-		return fmt.Errorf("failed to get config: %w", err)
+		return nil, fmt.Errorf("failed to get config: %w", err)
 	}
 
-	return cfg.Validate()
+	if err = col.applyFeatureGates(cfg); err != nil {
+		return []validationFinding{newFinding(categoryConfig, "service::feature_gates", err)}, nil
+	}
+
+	return deepFindings(cfg, factories), nil
+}
+
+// applyFeatureGates sets the feature gates of the service::feature_gates section. They are applied
+// after the ones of the --feature-gates flag, so the configuration takes precedence over the flag.
+// It fails if the flag or the configuration still set gates which were removed in this release.
+func (col *Collector) applyFeatureGates(cfg *Config) error {
+	col.saveGateDefaults(featureGateValues(cfg.Service.FeatureGates))
+	if err := featuregate.GlobalRegistry().SetFromList(cfg.Service.FeatureGates); err != nil {
+		return fmt.Errorf("failed to set service::feature_gates: %w", err)
+	}
+	return featuregate.GlobalRegistry().CheckLifecycle(col.set.BuildInfo.Version)
+}
+
+// reloadFeatureGates sets the feature gates of the service::feature_gates section when the configuration
+// is reloaded. The gates removed from the section get back the value they had before the configuration set
+// them. Only the runtime safe gates can change while the collector is running, the reload fails if the
+// value of another gate changes.
+func (col *Collector) reloadFeatureGates(cfg *Config) error {
+	values := featureGateValues(cfg.Service.FeatureGates)
+	col.saveGateDefaults(values)
+	for id, enabled := range col.gateDefaults {
+		if _, ok := values[id]; !ok {
+			values[id] = enabled
+		}
+	}
+	gates := map[string]*featuregate.Gate{}
+	featuregate.GlobalRegistry().VisitAll(func(g *featuregate.Gate) {
+		gates[g.ID()] = g
+	})
+	ids := make([]string, 0, len(values))
+	for id := range values {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var errs error
+	for _, id := range ids {
+		if g, ok := gates[id]; ok && g.IsEnabled() == values[id] {
+			continue
+		}
+		errs = multierr.Append(errs, featuregate.GlobalRegistry().SetAtRuntime(id, values[id]))
+	}
+	if errs != nil {
+		return fmt.Errorf("failed to set service::feature_gates: %w", errs)
+	}
+	return featuregate.GlobalRegistry().CheckLifecycle(col.set.BuildInfo.Version)
+}
+
+// saveGateDefaults saves the values of the given gates, unless the configuration already set them.
+func (col *Collector) saveGateDefaults(values map[string]bool) {
+	if col.gateDefaults == nil {
+		col.gateDefaults = map[string]bool{}
+	}
+	featuregate.GlobalRegistry().VisitAll(func(g *featuregate.Gate) {
+		if _, ok := values[g.ID()]; !ok {
+			return
+		}
+		if _, ok := col.gateDefaults[g.ID()]; !ok {
+			col.gateDefaults[g.ID()] = g.IsEnabled()
+		}
+	})
+}
+
+// featureGateValues returns the values of the gates set by the given ids, using the syntax of the
+// --feature-gates flag. The last value set for a gate wins, the empty ids are left to the validation.
+func featureGateValues(ids []string) map[string]bool {
+	values := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		enabled := !strings.HasPrefix(id, "-")
+		if strings.HasPrefix(id, "-") || strings.HasPrefix(id, "+") {
+			id = id[1:]
+		}
+		if id != "" {
+			values[id] = enabled
+		}
+	}
+	return values
 }
 
 // Run starts the collector according to the given configuration, and waits for it to complete.
@@ -231,8 +366,26 @@ func (col *Collector) Run(ctx context.Context) error {
 		return err
 	}
 
-	// Always notify with SIGHUP for configuration reloading.
+	// The listeners of the components and of the internal telemetry are bound once the service is started, but
+	// the collector is only ready if none of them failed since, as the parent process exits once notified.
+	select {
+	case err := <-col.asyncErrorChannel:
+		col.service.Logger().Error("Asynchronous error received, terminating process", zap.Error(err))
+		return col.shutdown(ctx)
+	default:
+	}
+	if err := notifyRestartReady(); err != nil {
+		col.service.Logger().Error("Failed to notify the parent process of the restart", zap.Error(err))
+	}
+	if err := sdNotify("READY=1"); err != nil {
+		col.service.Logger().Error("Failed to notify systemd that the collector is ready", zap.Error(err))
+	}
+
+	// Always notify with SIGHUP for configuration reloading, and with SIGUSR2 for restarting if enabled.
 	signal.Notify(col.signalsChannel, syscall.SIGHUP)
+	if col.set.RestartOnConfigChange {
+		notifyRestartSignal(col.signalsChannel)
+	}
 	defer signal.Stop(col.signalsChannel)
 
 	// Only notify with SIGTERM and SIGINT if graceful shutdown is enabled.
@@ -248,6 +401,12 @@ LOOP:
 				col.service.Logger().Error("Config watch failed", zap.Error(err))
 				break LOOP
 			}
+			if col.set.RestartOnConfigChange {
+				if col.tryRestart() {
+					break LOOP
+				}
+				continue
+			}
 			if err = col.reloadConfiguration(ctx); err != nil {
 				return err
 			}
@@ -256,6 +415,12 @@ LOOP:
 			break LOOP
 		case s := <-col.signalsChannel:
 			col.service.Logger().Info("Received signal from OS", zap.String("signal", s.String()))
+			if col.set.RestartOnConfigChange && (isRestartSignal(s) || s == syscall.SIGHUP) {
+				if col.tryRestart() {
+					break LOOP
+				}
+				continue
+			}
 			if s != syscall.SIGHUP {
 				break LOOP
 			}
@@ -274,6 +439,19 @@ LOOP:
 	return col.shutdown(ctx)
 }
 
+// tryRestart starts a new collector process which the listeners are handed off to, and returns
+// whether it succeeded, in which case the collector must shut down. Otherwise, the collector
+// keeps running.
+func (col *Collector) tryRestart() bool {
+	col.service.Logger().Info("Restarting the collector process")
+	if err := col.restart(); err != nil {
+		col.service.Logger().Error("Failed to restart the collector process, keep running", zap.Error(err))
+		return false
+	}
+	col.service.Logger().Info("The new collector process is ready, shutting down")
+	return true
+}
+
 func (col *Collector) shutdown(ctx context.Context) error {
 	col.setCollectorState(StateClosing)
 
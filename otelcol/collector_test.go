@@ -20,6 +20,7 @@ import (
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/confmap/converter/expandconverter"
 	"go.opentelemetry.io/collector/extension/extensiontest"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/processor/processortest"
 )
 
@@ -343,6 +344,43 @@ func TestCollectorStartWithTraceContextPropagation(t *testing.T) {
 	}
 }
 
+func TestCollectorConnectorBuffer(t *testing.T) {
+	tests := []struct {
+		file        string
+		errExpected string
+	}{
+		{file: "otelcol-invalid-connectorbuffer.yaml", errExpected: "service::connector_buffer config validation failed: queue_size must not be negative"},
+		{file: "otelcol-connectorbuffer.yaml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			cfgProvider, err := NewConfigProvider(newDefaultConfigProviderSettings([]string{filepath.Join("testdata", tt.file)}))
+			require.NoError(t, err)
+
+			col, err := NewCollector(CollectorSettings{
+				BuildInfo:      component.NewDefaultBuildInfo(),
+				Factories:      nopFactories,
+				ConfigProvider: cfgProvider,
+			})
+			require.NoError(t, err)
+
+			if tt.errExpected != "" {
+				require.ErrorContains(t, col.Run(context.Background()), tt.errExpected)
+				assert.Equal(t, StateClosed, col.GetState())
+				return
+			}
+			wg := startCollector(context.Background(), t, col)
+			assert.Eventually(t, func() bool {
+				return StateRunning == col.GetState()
+			}, 2*time.Second, 200*time.Millisecond)
+			col.Shutdown()
+			wg.Wait()
+			assert.Equal(t, StateClosed, col.GetState())
+		})
+	}
+}
+
 func TestCollectorRun(t *testing.T) {
 	tests := []struct {
 		file string
@@ -431,6 +469,71 @@ func TestCollectorDryRun(t *testing.T) {
 	require.Error(t, col.DryRun(context.Background()))
 }
 
+func TestCollectorDryRunFeatureGates(t *testing.T) {
+	cfgProvider, err := NewConfigProvider(newDefaultConfigProviderSettings([]string{filepath.Join("testdata", "otelcol-featuregates.yaml")}))
+	require.NoError(t, err)
+	set := CollectorSettings{
+		BuildInfo:      component.NewDefaultBuildInfo(),
+		Factories:      nopFactories,
+		ConfigProvider: cfgProvider,
+	}
+	col, err := NewCollector(set)
+	require.NoError(t, err)
+
+	// The gate is not registered yet.
+	require.ErrorContains(t, col.DryRun(context.Background()), `failed to set service::feature_gates: no such feature gate "otelcol.testgate"`)
+
+	gate := featuregate.GlobalRegistry().MustRegister("otelcol.testgate", featuregate.StageBeta)
+	require.True(t, gate.IsEnabled())
+	require.NoError(t, col.DryRun(context.Background()))
+	assert.False(t, gate.IsEnabled())
+}
+
+func TestCollectorReloadFeatureGates(t *testing.T) {
+	runtimeGate := featuregate.GlobalRegistry().MustRegister("otelcol.reloadtestgate", featuregate.StageAlpha, featuregate.WithRegisterRuntimeSafe())
+	startupGate := featuregate.GlobalRegistry().MustRegister("otelcol.startuptestgate", featuregate.StageAlpha)
+
+	cfg, factories, err := nopConfigBuilder().Build()
+	require.NoError(t, err)
+	cfg.Service.FeatureGates = []string{"otelcol.reloadtestgate", "otelcol.startuptestgate"}
+	watcher := make(chan error, 1)
+	col, err := NewCollector(CollectorSettings{
+		BuildInfo:      component.NewDefaultBuildInfo(),
+		Factories:      func() (Factories, error) { return factories, nil },
+		ConfigProvider: &mockCfgProvider{ConfigProvider: NewStaticConfigProvider(cfg), watcher: watcher},
+	})
+	require.NoError(t, err)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- col.Run(context.Background())
+	}()
+	assert.Eventually(t, func() bool {
+		return StateRunning == col.GetState()
+	}, 2*time.Second, 200*time.Millisecond)
+	assert.True(t, runtimeGate.IsEnabled())
+	assert.True(t, startupGate.IsEnabled())
+
+	// The runtime safe gate removed from the configuration gets back its default value.
+	cfg.Service.FeatureGates = []string{"otelcol.startuptestgate"}
+	watcher <- nil
+	assert.Eventually(t, func() bool {
+		return StateRunning == col.GetState() && !runtimeGate.IsEnabled()
+	}, 2*time.Second, 200*time.Millisecond)
+	assert.True(t, startupGate.IsEnabled())
+
+	// The other gates can not change once the collector started.
+	cfg.Service.FeatureGates = []string{"otelcol.reloadtestgate"}
+	watcher <- nil
+	select {
+	case err = <-errCh:
+		assert.ErrorContains(t, err, `feature gate "otelcol.startuptestgate" is not runtime safe, it can only be set when the collector starts`)
+	case <-time.After(2 * time.Second):
+		t.Fatal("the reload of the configuration did not fail")
+	}
+	assert.True(t, startupGate.IsEnabled())
+}
+
 func TestPassConfmapToServiceFailure(t *testing.T) {
 	cfgProvider, err := NewConfigProvider(ConfigProviderSettings{
 		ResolverSettings: confmap.ResolverSettings{
@@ -4,100 +4,135 @@
 package otelcol // import "go.opentelemetry.io/collector/otelcol"
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"go.uber.org/multierr"
 	"gopkg.in/yaml.v3"
 
 	"go.opentelemetry.io/collector/component"
 )
 
+const (
+	componentsFormatYAML = "yaml"
+	componentsFormatJSON = "json"
+)
+
 type componentWithStability struct {
-	Name      component.Type
-	Stability map[string]string
+	Name component.Type `json:"name"`
+	// Module and Version are the Go module providing the component and its version, if known.
+	Module       string            `yaml:",omitempty" json:"module,omitempty"`
+	Version      string            `yaml:",omitempty" json:"version,omitempty"`
+	Stability    map[string]string `json:"stability"`
+	ConfigSchema map[string]any    `yaml:",omitempty" json:"config_schema,omitempty"`
+}
+
+type buildInfoOutput struct {
+	Command     string `json:"command"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
 }
 
 type componentsOutput struct {
-	BuildInfo  component.BuildInfo
-	Receivers  []componentWithStability
-	Processors []componentWithStability
-	Exporters  []componentWithStability
-	Connectors []componentWithStability
-	Extensions []componentWithStability
+	BuildInfo  buildInfoOutput          `json:"build_info"`
+	Receivers  []componentWithStability `json:"receivers"`
+	Processors []componentWithStability `json:"processors"`
+	Exporters  []componentWithStability `json:"exporters"`
+	Connectors []componentWithStability `json:"connectors"`
+	Extensions []componentWithStability `json:"extensions"`
 }
 
 // newComponentsCommand constructs a new components command using the given CollectorSettings.
 func newComponentsCommand(set CollectorSettings) *cobra.Command {
-	return &cobra.Command{
+	var format string
+	var withConfigSchema bool
+	componentsCmd := &cobra.Command{
 		Use:   "components",
 		Short: "Outputs available components in this collector distribution",
-		Long:  "Outputs available components in this collector distribution including their stability levels. The output format is not stable and can change between releases.",
-		Args:  cobra.ExactArgs(0),
+		Long: "Outputs available components in this collector distribution including their Go module, " +
+			"their stability levels and optionally the JSON schema of their configuration. " +
+			"The yaml output format is not stable and can change between releases.",
+		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != componentsFormatYAML && format != componentsFormatJSON {
+				return fmt.Errorf("unsupported format %q, must be %q or %q", format, componentsFormatYAML, componentsFormatJSON)
+			}
 
 			factories, err := set.Factories()
 			if err != nil {
 				return fmt.Errorf("failed to initialize factories: %w", err)
 			}
 
-			components := componentsOutput{}
-			for con := range factories.Connectors {
-				components.Connectors = append(components.Connectors, componentWithStability{
-					Name: con,
-					Stability: map[string]string{
-						"logs-to-logs":    factories.Connectors[con].LogsToLogsStability().String(),
-						"logs-to-metrics": factories.Connectors[con].LogsToMetricsStability().String(),
-						"logs-to-traces":  factories.Connectors[con].LogsToTracesStability().String(),
+			components := componentsOutput{BuildInfo: buildInfoOutput(set.BuildInfo)}
+			var errs error
+			add := func(list *[]componentWithStability, factory component.Factory, modules map[component.Type]string, stability map[string]string) {
+				c := componentWithStability{Name: factory.Type(), Stability: stability}
+				c.Module, c.Version, _ = strings.Cut(modules[factory.Type()], " ")
+				if withConfigSchema {
+					var schemaErr error
+					if c.ConfigSchema, schemaErr = configSchema(factory.CreateDefaultConfig()); schemaErr != nil {
+						errs = multierr.Append(errs, fmt.Errorf("failed to generate the config schema of %q: %w", factory.Type(), schemaErr))
+					}
+				}
+				*list = append(*list, c)
+			}
+			for _, con := range factories.Connectors {
+				add(&components.Connectors, con, factories.ConnectorModules, map[string]string{
+					"logs-to-logs":    con.LogsToLogsStability().String(),
+					"logs-to-metrics": con.LogsToMetricsStability().String(),
+					"logs-to-traces":  con.LogsToTracesStability().String(),
 
-						"metrics-to-logs":    factories.Connectors[con].MetricsToLogsStability().String(),
-						"metrics-to-metrics": factories.Connectors[con].MetricsToMetricsStability().String(),
-						"metrics-to-traces":  factories.Connectors[con].MetricsToTracesStability().String(),
+					"metrics-to-logs":    con.MetricsToLogsStability().String(),
+					"metrics-to-metrics": con.MetricsToMetricsStability().String(),
+					"metrics-to-traces":  con.MetricsToTracesStability().String(),
 
-						"traces-to-logs":    factories.Connectors[con].TracesToLogsStability().String(),
-						"traces-to-metrics": factories.Connectors[con].TracesToMetricsStability().String(),
-						"traces-to-traces":  factories.Connectors[con].TracesToTracesStability().String(),
-					},
+					"traces-to-logs":    con.TracesToLogsStability().String(),
+					"traces-to-metrics": con.TracesToMetricsStability().String(),
+					"traces-to-traces":  con.TracesToTracesStability().String(),
 				})
 			}
-			for ext := range factories.Extensions {
-				components.Extensions = append(components.Extensions, componentWithStability{
-					Name: ext,
-					Stability: map[string]string{
-						"extension": factories.Extensions[ext].ExtensionStability().String(),
-					},
+			for _, ext := range factories.Extensions {
+				add(&components.Extensions, ext, factories.ExtensionModules, map[string]string{
+					"extension": ext.ExtensionStability().String(),
 				})
 			}
-			for prs := range factories.Processors {
-				components.Processors = append(components.Processors, componentWithStability{
-					Name: prs,
-					Stability: map[string]string{
-						"logs":    factories.Processors[prs].LogsProcessorStability().String(),
-						"metrics": factories.Processors[prs].MetricsProcessorStability().String(),
-						"traces":  factories.Processors[prs].TracesProcessorStability().String(),
-					},
+			for _, prs := range factories.Processors {
+				add(&components.Processors, prs, factories.ProcessorModules, map[string]string{
+					"logs":    prs.LogsProcessorStability().String(),
+					"metrics": prs.MetricsProcessorStability().String(),
+					"traces":  prs.TracesProcessorStability().String(),
 				})
 			}
-			for rcv := range factories.Receivers {
-				components.Receivers = append(components.Receivers, componentWithStability{
-					Name: rcv,
-					Stability: map[string]string{
-						"logs":    factories.Receivers[rcv].LogsReceiverStability().String(),
-						"metrics": factories.Receivers[rcv].MetricsReceiverStability().String(),
-						"traces":  factories.Receivers[rcv].TracesReceiverStability().String(),
-					},
+			for _, rcv := range factories.Receivers {
+				add(&components.Receivers, rcv, factories.ReceiverModules, map[string]string{
+					"logs":    rcv.LogsReceiverStability().String(),
+					"metrics": rcv.MetricsReceiverStability().String(),
+					"traces":  rcv.TracesReceiverStability().String(),
 				})
 			}
-			for exp := range factories.Exporters {
-				components.Exporters = append(components.Exporters, componentWithStability{
-					Name: exp,
-					Stability: map[string]string{
-						"logs":    factories.Exporters[exp].LogsExporterStability().String(),
-						"metrics": factories.Exporters[exp].MetricsExporterStability().String(),
-						"traces":  factories.Exporters[exp].TracesExporterStability().String(),
-					},
+			for _, exp := range factories.Exporters {
+				add(&components.Exporters, exp, factories.ExporterModules, map[string]string{
+					"logs":    exp.LogsExporterStability().String(),
+					"metrics": exp.MetricsExporterStability().String(),
+					"traces":  exp.TracesExporterStability().String(),
 				})
 			}
-			components.BuildInfo = set.BuildInfo
+			if errs != nil {
+				return errs
+			}
+			for _, list := range [][]componentWithStability{components.Receivers, components.Processors,
+				components.Exporters, components.Connectors, components.Extensions} {
+				sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+			}
+
+			if format == componentsFormatJSON {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(components)
+			}
 			yamlData, err := yaml.Marshal(components)
 			if err != nil {
 				return err
@@ -106,4 +141,9 @@ func newComponentsCommand(set CollectorSettings) *cobra.Command {
 			return nil
 		},
 	}
+	componentsCmd.Flags().StringVar(&format, "format", componentsFormatYAML,
+		fmt.Sprintf("Output format, %q or %q.", componentsFormatYAML, componentsFormatJSON))
+	componentsCmd.Flags().BoolVar(&withConfigSchema, "config-schema", false,
+		"Output the JSON schema of the configuration of the components, generated from their default configuration.")
+	return componentsCmd
 }
@@ -5,6 +5,7 @@ package otelcol
 
 import (
 	"bytes"
+	"encoding/json"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -29,7 +30,7 @@ func TestNewBuildSubCommand(t *testing.T) {
 	cmd.SetArgs([]string{"components"})
 
 	ExpectedYamlStruct := componentsOutput{
-		BuildInfo: component.NewDefaultBuildInfo(),
+		BuildInfo: buildInfoOutput(component.NewDefaultBuildInfo()),
 		Receivers: []componentWithStability{{
 			Name: component.Type("nop"),
 			Stability: map[string]string{
@@ -89,3 +90,46 @@ func TestNewBuildSubCommand(t *testing.T) {
 	// line that makes the test fail.
 	assert.Equal(t, strings.Trim(string(ExpectedOutput), "\n"), strings.Trim(b.String(), "\n"))
 }
+
+func TestComponentsSubCommandJSON(t *testing.T) {
+	set := CollectorSettings{
+		BuildInfo: component.NewDefaultBuildInfo(),
+		Factories: func() (Factories, error) {
+			factories, err := nopFactories()
+			factories.ReceiverModules = map[component.Type]string{"nop": "go.opentelemetry.io/collector/receiver/receivertest v0.93.0"}
+			return factories, err
+		},
+	}
+	cmd := NewCommand(set)
+	cmd.SetArgs([]string{"components", "--format", "json", "--config-schema"})
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	require.NoError(t, cmd.Execute())
+
+	var output map[string]any
+	require.NoError(t, json.Unmarshal(b.Bytes(), &output))
+	assert.Equal(t, map[string]any{"command": "otelcol", "description": "OpenTelemetry Collector", "version": "latest"}, output["build_info"])
+	assert.Equal(t, []any{map[string]any{
+		"name":    "nop",
+		"module":  "go.opentelemetry.io/collector/receiver/receivertest",
+		"version": "v0.93.0",
+		"stability": map[string]any{
+			"logs":    "Stable",
+			"metrics": "Stable",
+			"traces":  "Stable",
+		},
+		"config_schema": map[string]any{"type": "object", "properties": map[string]any{}, "additionalProperties": false},
+	}}, output["receivers"])
+	// The module of the other components is unknown.
+	exporters := output["exporters"].([]any)
+	require.Len(t, exporters, 1)
+	assert.NotContains(t, exporters[0], "module")
+}
+
+func TestComponentsSubCommandInvalidFormat(t *testing.T) {
+	cmd := NewCommand(CollectorSettings{BuildInfo: component.NewDefaultBuildInfo(), Factories: nopFactories})
+	cmd.SetArgs([]string{"components", "--format", "xml"})
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetErr(bytes.NewBufferString(""))
+	assert.EqualError(t, cmd.Execute(), `unsupported format "xml", must be "yaml" or "json"`)
+}
@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"encoding/json"
+	"flag"
+
+	"github.com/spf13/cobra"
+
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+// newFeatureGateSubCommand constructs a new featuregate sub command using the given CollectorSettings.
+func newFeatureGateSubCommand(set CollectorSettings, flagSet *flag.FlagSet) *cobra.Command {
+	featureGateCmd := &cobra.Command{
+		Use:   "featuregate",
+		Short: "Outputs the lifecycle report of the feature gates set with the --feature-gates flag",
+		Long: "Outputs, as a JSON array, the stable and deprecated feature gates set with the --feature-gates flag. " +
+			"The gates reported as removed can no longer be set in this release, and make the collector fail to start.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issues := featuregate.GlobalRegistry().LifecycleReport(set.BuildInfo.Version)
+			if issues == nil {
+				issues = []featuregate.LifecycleIssue{}
+			}
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(issues)
+		},
+	}
+	featureGateCmd.Flags().AddGoFlagSet(flagSet)
+	return featureGateCmd
+}
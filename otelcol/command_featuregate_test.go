@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+func TestFeatureGateSubCommand(t *testing.T) {
+	featuregate.GlobalRegistry().MustRegister("otelcol.stabletestgate", featuregate.StageStable, featuregate.WithRegisterToVersion("v0.90.0"))
+
+	set := CollectorSettings{BuildInfo: component.BuildInfo{Command: "otelcol", Version: "v0.91.0"}, Factories: nopFactories}
+	cmd := newFeatureGateSubCommand(set, flags(featuregate.GlobalRegistry()))
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"--feature-gates=otelcol.stabletestgate"})
+	require.NoError(t, cmd.Execute())
+
+	var issues []featuregate.LifecycleIssue
+	require.NoError(t, json.Unmarshal(out.Bytes(), &issues))
+	assert.Equal(t, []featuregate.LifecycleIssue{{
+		ID:        "otelcol.stabletestgate",
+		Stage:     "Stable",
+		ToVersion: "v0.90.0",
+		Removed:   true,
+		Message:   `feature gate "otelcol.stabletestgate" is stable and can no longer be set after v0.90.0, remove it as the feature is always enabled`,
+	}}, issues)
+}
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"go.opentelemetry.io/collector/confmap"
+)
+
+// defaultOrigin is the origin of the values which were not retrieved from any URI.
+const defaultOrigin = "(default)"
+
+// newPrintConfigSubCommand constructs a new print-config sub command using the given CollectorSettings.
+func newPrintConfigSubCommand(set CollectorSettings, flagSet *flag.FlagSet) *cobra.Command {
+	var path string
+	printConfigCmd := &cobra.Command{
+		Use:   "print-config",
+		Short: "Outputs the effective config without running the collector",
+		Long: "Outputs, as YAML, the effective config resolved from all the config locations, including the default values " +
+			"of the components. Sensitive values are redacted. Each value is commented with the location it was retrieved from, " +
+			"or with " + defaultOrigin + " if it was not set by any location. The output format is not stable and can change between releases.",
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if set.ConfigProvider == nil {
+				settings, err := getConfigProviderSettings(flagSet)
+				if err != nil {
+					return err
+				}
+				set.ConfigProvider, err = NewConfigProvider(settings)
+				if err != nil {
+					return err
+				}
+			}
+
+			factories, err := set.Factories()
+			if err != nil {
+				return fmt.Errorf("failed to initialize factories: %w", err)
+			}
+			cfg, err := set.ConfigProvider.Get(cmd.Context(), factories)
+			if err != nil {
+				return fmt.Errorf("failed to get config: %w", err)
+			}
+			conf, err := effectiveConf(cfg)
+			if err != nil {
+				return err
+			}
+			var origins map[string]string
+			if op, ok := set.ConfigProvider.(ConfigOriginProvider); ok {
+				origins = op.ConfigOrigins()
+			}
+
+			node, err := effectiveConfNode(conf, path, origins)
+			if err != nil {
+				return err
+			}
+			enc := yaml.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent(2)
+			if err = enc.Encode(node); err != nil {
+				return err
+			}
+			return enc.Close()
+		},
+	}
+	printConfigCmd.Flags().StringVar(&path, "component", "",
+		"Path of the config to output, e.g. receivers::otlp to output only the config of the otlp receiver.")
+	printConfigCmd.Flags().AddGoFlagSet(flagSet)
+	return printConfigCmd
+}
+
+// effectiveConfNode returns the YAML node of the configuration at the given path, or of the
+// whole configuration if path is empty. The parent keys of the path are kept, so that the
+// output can be used as a configuration.
+func effectiveConfNode(conf *confmap.Conf, path string, origins map[string]string) (*yaml.Node, error) {
+	if path == "" {
+		return confNode(conf.ToStringMap(), "", origins)
+	}
+	if !conf.IsSet(path) {
+		return nil, fmt.Errorf("no config at %q", path)
+	}
+	value := conf.Get(path)
+	parts := strings.Split(path, confmap.KeyDelimiter)
+	for i := len(parts) - 1; i >= 0; i-- {
+		value = map[string]any{parts[i]: value}
+	}
+	return confNode(value, "", origins)
+}
+
+// confNode returns the YAML node of the value at the given key. The values which are not maps
+// are commented with their origin, if the origins are known.
+func confNode(value any, key string, origins map[string]string) (*yaml.Node, error) {
+	if m, ok := value.(map[string]any); ok && len(m) > 0 {
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		for _, k := range keys {
+			childKey := k
+			if key != "" {
+				childKey = key + confmap.KeyDelimiter + k
+			}
+			child, err := confNode(m[k], childKey, origins)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: k}, child)
+		}
+		return node, nil
+	}
+
+	node := &yaml.Node{}
+	if err := node.Encode(value); err != nil {
+		return nil, fmt.Errorf("cannot encode the config at %q: %w", key, err)
+	}
+	if node.Kind == yaml.SequenceNode || node.Kind == yaml.MappingNode {
+		// Keep the value on the line of its key, along with its origin.
+		node.Style = yaml.FlowStyle
+	}
+	if origins != nil {
+		origin, ok := origins[key]
+		if !ok {
+			origin = defaultOrigin
+		}
+		node.LineComment = origin
+	}
+	return node, nil
+}
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/provider/fileprovider"
+	"go.opentelemetry.io/collector/confmap/provider/yamlprovider"
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+func TestPrintConfigSubCommandNoConfig(t *testing.T) {
+	cmd := newPrintConfigSubCommand(CollectorSettings{Factories: nopFactories}, flags(featuregate.GlobalRegistry()))
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	require.EqualError(t, cmd.Execute(), "at least one config flag must be provided")
+}
+
+func TestPrintConfigSubCommand(t *testing.T) {
+	fileURI := "file:" + filepath.Join("testdata", "otelcol-nop.yaml")
+	yamlURI := "yaml:service::telemetry::metrics::address: localhost:9999"
+	cfgProvider, err := NewConfigProvider(ConfigProviderSettings{
+		ResolverSettings: confmap.ResolverSettings{
+			URIs:      []string{fileURI, yamlURI},
+			Providers: makeMapProvidersMap(fileprovider.New(), yamlprovider.New()),
+		},
+	})
+	require.NoError(t, err)
+
+	cmd := newPrintConfigSubCommand(CollectorSettings{Factories: nopFactories, ConfigProvider: cfgProvider}, flags(featuregate.GlobalRegistry()))
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"--component", "service::pipelines::traces"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, `service:
+  pipelines:
+    traces:
+      exporters: [nop, nop/con] # `+fileURI+`
+      processors: [nop] # `+fileURI+`
+      receivers: [nop] # `+fileURI+`
+`, out.String())
+
+	cmd = newPrintConfigSubCommand(CollectorSettings{Factories: nopFactories, ConfigProvider: cfgProvider}, flags(featuregate.GlobalRegistry()))
+	out.Reset()
+	cmd.SetOut(out)
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "      address: localhost:9999 # "+yamlURI+"\n")
+	assert.Contains(t, out.String(), "      level: Basic # (default)\n")
+
+	cmd = newPrintConfigSubCommand(CollectorSettings{Factories: nopFactories, ConfigProvider: cfgProvider}, flags(featuregate.GlobalRegistry()))
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{"--component", "receivers::otlp"})
+	require.EqualError(t, cmd.Execute(), `no config at "receivers::otlp"`)
+}
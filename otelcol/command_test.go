@@ -4,6 +4,8 @@
 package otelcol
 
 import (
+	"errors"
+	"fmt"
 	"path/filepath"
 	"testing"
 
@@ -61,3 +63,9 @@ func TestNewCommandInvalidComponent(t *testing.T) {
 	cmd := NewCommand(CollectorSettings{Factories: nopFactories, ConfigProvider: cfgProvider})
 	require.Error(t, cmd.Execute())
 }
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, 0, ExitCode(nil))
+	assert.Equal(t, 1, ExitCode(errors.New("failed")))
+	assert.Equal(t, exitCodeConfigInvalid, ExitCode(fmt.Errorf("wrapped: %w", &exitError{code: exitCodeConfigInvalid, err: errors.New("invalid")})))
+}
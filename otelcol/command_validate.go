@@ -4,28 +4,54 @@
 package otelcol // import "go.opentelemetry.io/collector/otelcol"
 
 import (
-	"errors"
+	"encoding/json"
 	"flag"
+	"fmt"
 
 	"github.com/spf13/cobra"
 )
 
+// Exit codes of the validate command, so that CI can tell a configuration which can not
+// be loaded from a configuration which is loaded but invalid.
+const (
+	exitCodeConfigNotLoaded = 2
+	exitCodeConfigInvalid   = 3
+)
+
+const (
+	validateFormatText = "text"
+	validateFormatJSON = "json"
+)
+
+// validateResult is the output of the validate command in the json format.
+type validateResult struct {
+	Valid    bool                `json:"valid"`
+	Error    string              `json:"error,omitempty"`
+	Findings []validationFinding `json:"findings"`
+}
+
 // newValidateSubCommand constructs a new validate sub command using the given CollectorSettings.
 func newValidateSubCommand(set CollectorSettings, flagSet *flag.FlagSet) *cobra.Command {
+	var format string
 	validateCmd := &cobra.Command{
 		Use:   "validate",
 		Short: "Validates the config without running the collector",
-		Args:  cobra.ExactArgs(0),
+		Long: "Validates the config without running the collector. Besides the validation of the config of " +
+			"the components, it checks that the components support the data type of the pipelines they are used in, " +
+			"and that the connectors are used in supported pairs of pipelines. All the findings are output, and the " +
+			"command exits with code 2 if the config can not be loaded, or with code 3 if it is invalid.",
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != validateFormatText && format != validateFormatJSON {
+				return fmt.Errorf("unsupported format %q, must be %q or %q", format, validateFormatText, validateFormatJSON)
+			}
 			if set.ConfigProvider == nil {
-				var err error
-
-				configFlags := getConfigFlag(flagSet)
-				if len(configFlags) == 0 {
-					return errors.New("at least one config flag must be provided")
+				settings, err := getConfigProviderSettings(flagSet)
+				if err != nil {
+					return err
 				}
-
-				set.ConfigProvider, err = NewConfigProvider(newDefaultConfigProviderSettings(configFlags))
+				set.ConfigProvider, err = NewConfigProvider(settings)
 				if err != nil {
 					return err
 				}
@@ -34,9 +60,38 @@ func newValidateSubCommand(set CollectorSettings, flagSet *flag.FlagSet) *cobra.
 			if err != nil {
 				return err
 			}
-			return col.DryRun(cmd.Context())
+
+			findings, err := col.validate(cmd.Context())
+			result := validateResult{Valid: err == nil && len(findings) == 0, Findings: findings}
+			if result.Findings == nil {
+				result.Findings = []validationFinding{}
+			}
+			if err != nil {
+				result.Error = err.Error()
+			}
+			if format == validateFormatJSON {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				if encErr := enc.Encode(result); encErr != nil {
+					return encErr
+				}
+			} else {
+				for _, finding := range findings {
+					fmt.Fprintln(cmd.OutOrStdout(), finding)
+				}
+			}
+
+			switch {
+			case err != nil:
+				return &exitError{code: exitCodeConfigNotLoaded, err: err}
+			case len(findings) > 0:
+				return &exitError{code: exitCodeConfigInvalid, err: fmt.Errorf("invalid configuration: %d issue(s) found", len(findings))}
+			}
+			return nil
 		},
 	}
+	validateCmd.Flags().StringVar(&format, "format", validateFormatText,
+		fmt.Sprintf("Output format of the findings, %q or %q.", validateFormatText, validateFormatJSON))
 	validateCmd.Flags().AddGoFlagSet(flagSet)
 	return validateCmd
 }
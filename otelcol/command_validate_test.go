@@ -4,9 +4,12 @@
 package otelcol
 
 import (
+	"bytes"
+	"io"
 	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/collector/confmap"
@@ -38,3 +41,78 @@ func TestValidateSubCommandInvalidComponents(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "unknown type: \"nosuchprocessor\"")
 }
+
+func newValidateTestProvider(t *testing.T, file string) ConfigProvider {
+	cfgProvider, err := NewConfigProvider(
+		ConfigProviderSettings{
+			ResolverSettings: confmap.ResolverSettings{
+				URIs:       []string{filepath.Join("testdata", file)},
+				Providers:  map[string]confmap.Provider{"file": fileprovider.New()},
+				Converters: []confmap.Converter{expandconverter.New()},
+			},
+		})
+	require.NoError(t, err)
+	return cfgProvider
+}
+
+func TestValidateSubCommandExitCodes(t *testing.T) {
+	tests := []struct {
+		file     string
+		exitCode int
+	}{
+		{file: "otelcol-nop.yaml", exitCode: 0},
+		{file: "otelcol-invalid-components.yaml", exitCode: exitCodeConfigNotLoaded},
+		{file: "otelcol-invalid.yaml", exitCode: exitCodeConfigInvalid},
+		{file: "otelcol-unsupported-pipelines.yaml", exitCode: exitCodeConfigInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			set := CollectorSettings{Factories: tracesOnlyFactories, ConfigProvider: newValidateTestProvider(t, tt.file)}
+			cmd := newValidateSubCommand(set, flags(featuregate.GlobalRegistry()))
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			assert.Equal(t, tt.exitCode, ExitCode(cmd.Execute()))
+		})
+	}
+}
+
+func TestValidateSubCommandJSON(t *testing.T) {
+	set := CollectorSettings{Factories: tracesOnlyFactories, ConfigProvider: newValidateTestProvider(t, "otelcol-unsupported-pipelines.yaml")}
+	cmd := newValidateSubCommand(set, flags(featuregate.GlobalRegistry()))
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{"--format", "json"})
+	require.EqualError(t, cmd.Execute(), "invalid configuration: 2 issue(s) found")
+	assert.JSONEq(t, `{
+		"valid": false,
+		"findings": [
+			{
+				"category": "pipeline",
+				"path": "service::pipelines::metrics",
+				"message": "receiver \"tracesonly\" does not support metrics"
+			},
+			{
+				"category": "connector",
+				"path": "connectors::tracesonly/conn",
+				"message": "connector \"tracesonly/conn\" used as exporter in traces pipeline but not used in any supported receiver pipeline"
+			}
+		]
+	}`, out.String())
+
+	set.ConfigProvider = newValidateTestProvider(t, "otelcol-nop.yaml")
+	cmd = newValidateSubCommand(set, flags(featuregate.GlobalRegistry()))
+	out.Reset()
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"--format", "json"})
+	require.NoError(t, cmd.Execute())
+	assert.JSONEq(t, `{"valid": true, "findings": []}`, out.String())
+}
+
+func TestValidateSubCommandUnsupportedFormat(t *testing.T) {
+	cmd := newValidateSubCommand(CollectorSettings{Factories: nopFactories}, flags(featuregate.GlobalRegistry()))
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{"--format", "yaml"})
+	require.EqualError(t, cmd.Execute(), `unsupported format "yaml", must be "text" or "json"`)
+}
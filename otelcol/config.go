@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/service"
 )
 
@@ -42,78 +43,94 @@ type Config struct {
 // invalid cases that we currently don't check for but which we may want to add in
 // the future (e.g. disallowing receiving and exporting on the same endpoint).
 func (cfg *Config) Validate() error {
+	if findings := cfg.findings(); len(findings) > 0 {
+		return findings[0].err
+	}
+	return nil
+}
+
+// findings returns the findings of the basic validation of the configuration, in the
+// order in which Validate checks them.
+func (cfg *Config) findings() []validationFinding {
+	var findings []validationFinding
+
 	// Currently, there is no default receiver enabled.
 	// The configuration must specify at least one receiver to be valid.
 	if len(cfg.Receivers) == 0 {
-		return errMissingReceivers
+		findings = append(findings, newFinding(categoryConfig, "receivers", errMissingReceivers))
 	}
 
 	// Validate the receiver configuration.
 	for recvID, recvCfg := range cfg.Receivers {
 		if err := component.ValidateConfig(recvCfg); err != nil {
-			return fmt.Errorf("receivers::%s: %w", recvID, err)
+			findings = append(findings, newComponentFinding("receivers", recvID, err))
 		}
 	}
 
 	// Currently, there is no default exporter enabled.
 	// The configuration must specify at least one exporter to be valid.
 	if len(cfg.Exporters) == 0 {
-		return errMissingExporters
+		findings = append(findings, newFinding(categoryConfig, "exporters", errMissingExporters))
 	}
 
 	// Validate the exporter configuration.
 	for expID, expCfg := range cfg.Exporters {
 		if err := component.ValidateConfig(expCfg); err != nil {
-			return fmt.Errorf("exporters::%s: %w", expID, err)
+			findings = append(findings, newComponentFinding("exporters", expID, err))
 		}
 	}
 
 	// Validate the processor configuration.
 	for procID, procCfg := range cfg.Processors {
 		if err := component.ValidateConfig(procCfg); err != nil {
-			return fmt.Errorf("processors::%s: %w", procID, err)
+			findings = append(findings, newComponentFinding("processors", procID, err))
 		}
 	}
 
 	// Validate the connector configuration.
 	for connID, connCfg := range cfg.Connectors {
 		if err := component.ValidateConfig(connCfg); err != nil {
-			return fmt.Errorf("connectors::%s: %w", connID, err)
+			findings = append(findings, newComponentFinding("connectors", connID, err))
 		}
 
 		if _, ok := cfg.Exporters[connID]; ok {
-			return fmt.Errorf("connectors::%s: ambiguous ID: Found both %q exporter and %q connector. "+
-				"Change one of the components' IDs to eliminate ambiguity (e.g. rename %q connector to %q)",
-				connID, connID, connID, connID, connID.String()+"/connector")
+			findings = append(findings, newFinding(categoryConfig, "connectors::"+connID.String(), fmt.Errorf(
+				"connectors::%s: ambiguous ID: Found both %q exporter and %q connector. "+
+					"Change one of the components' IDs to eliminate ambiguity (e.g. rename %q connector to %q)",
+				connID, connID, connID, connID, connID.String()+"/connector")))
 		}
 		if _, ok := cfg.Receivers[connID]; ok {
-			return fmt.Errorf("connectors::%s: ambiguous ID: Found both %q receiver and %q connector. "+
-				"Change one of the components' IDs to eliminate ambiguity (e.g. rename %q connector to %q)",
-				connID, connID, connID, connID, connID.String()+"/connector")
+			findings = append(findings, newFinding(categoryConfig, "connectors::"+connID.String(), fmt.Errorf(
+				"connectors::%s: ambiguous ID: Found both %q receiver and %q connector. "+
+					"Change one of the components' IDs to eliminate ambiguity (e.g. rename %q connector to %q)",
+				connID, connID, connID, connID, connID.String()+"/connector")))
 		}
 	}
 
 	// Validate the extension configuration.
 	for extID, extCfg := range cfg.Extensions {
 		if err := component.ValidateConfig(extCfg); err != nil {
-			return fmt.Errorf("extensions::%s: %w", extID, err)
+			findings = append(findings, newComponentFinding("extensions", extID, err))
 		}
 	}
 
 	if err := cfg.Service.Validate(); err != nil {
-		return err
+		findings = append(findings, newFinding(categoryConfig, "service", err))
 	}
 
 	// Check that all enabled extensions in the service are configured.
 	for _, ref := range cfg.Service.Extensions {
 		// Check that the name referenced in the Service extensions exists in the top-level extensions.
 		if cfg.Extensions[ref] == nil {
-			return fmt.Errorf("service::extensions: references extension %q which is not configured", ref)
+			findings = append(findings, newFinding(categoryConfig, "service::extensions",
+				fmt.Errorf("service::extensions: references extension %q which is not configured", ref)))
 		}
 	}
 
 	// Check that all pipelines reference only configured components.
 	for pipelineID, pipeline := range cfg.Service.Pipelines {
+		path := "service::pipelines::" + pipelineID.String()
+
 		// Validate pipeline receiver name references.
 		for _, ref := range pipeline.Receivers {
 			// Check that the name referenced in the pipeline's receivers exists in the top-level receivers.
@@ -124,14 +141,16 @@ func (cfg *Config) Validate() error {
 			if _, ok := cfg.Connectors[ref]; ok {
 				continue
 			}
-			return fmt.Errorf("service::pipelines::%s: references receiver %q which is not configured", pipelineID, ref)
+			findings = append(findings, newFinding(categoryConfig, path,
+				fmt.Errorf("%s: references receiver %q which is not configured", path, ref)))
 		}
 
 		// Validate pipeline processor name references.
 		for _, ref := range pipeline.Processors {
 			// Check that the name referenced in the pipeline's processors exists in the top-level processors.
 			if cfg.Processors[ref] == nil {
-				return fmt.Errorf("service::pipelines::%s: references processor %q which is not configured", pipelineID, ref)
+				findings = append(findings, newFinding(categoryConfig, path,
+					fmt.Errorf("%s: references processor %q which is not configured", path, ref)))
 			}
 		}
 
@@ -144,8 +163,19 @@ func (cfg *Config) Validate() error {
 			if _, ok := cfg.Connectors[ref]; ok {
 				continue
 			}
-			return fmt.Errorf("service::pipelines::%s: references exporter %q which is not configured", pipelineID, ref)
+			findings = append(findings, newFinding(categoryConfig, path,
+				fmt.Errorf("%s: references exporter %q which is not configured", path, ref)))
 		}
 	}
-	return nil
+	return findings
+}
+
+// effectiveConf returns the configuration as a confmap.Conf, including the default values of the components.
+// The values marshaling to a redacted text, such as configopaque.String, are redacted.
+func effectiveConf(cfg *Config) (*confmap.Conf, error) {
+	conf := confmap.New()
+	if err := conf.Marshal(cfg); err != nil {
+		return nil, fmt.Errorf("cannot marshal the configuration: %w", err)
+	}
+	return conf, nil
 }
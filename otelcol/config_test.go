@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
 
 	"go.opentelemetry.io/collector/component"
@@ -231,6 +232,30 @@ func TestConfigValidate(t *testing.T) {
 	}
 }
 
+// redactedString marshals as a redacted text, as configopaque.String does.
+type redactedString string
+
+func (redactedString) MarshalText() ([]byte, error) {
+	return []byte("[REDACTED]"), nil
+}
+
+type secretConfig struct {
+	Endpoint string         `mapstructure:"endpoint"`
+	Token    redactedString `mapstructure:"token"`
+}
+
+func TestEffectiveConf(t *testing.T) {
+	cfg := generateConfig()
+	cfg.Receivers[component.NewID("nop")] = &secretConfig{Endpoint: "localhost:4317", Token: "secret"}
+
+	conf, err := effectiveConf(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:4317", conf.Get("receivers::nop::endpoint"))
+	assert.Equal(t, "[REDACTED]", conf.Get("receivers::nop::token"))
+	assert.Equal(t, ":8080", conf.Get("service::telemetry::metrics::address"))
+	assert.Equal(t, []any{"nop"}, conf.Get("service::pipelines::traces::receivers"))
+}
+
 func generateConfig() *Config {
 	return &Config{
 		Receivers: map[component.ID]component.Config{
@@ -264,6 +289,9 @@ func generateConfig() *Config {
 					Level:   configtelemetry.LevelNormal,
 					Address: ":8080",
 				},
+				CrashReports: telemetry.CrashReportsConfig{
+					LogEntries: 100,
+				},
 			},
 			Extensions: []component.ID{component.NewID("nop")},
 			Pipelines: pipelines.Config{
@@ -0,0 +1,183 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/service/pipelines"
+)
+
+// ConfigBuilder assembles in code the configuration of a collector and the factories of its
+// components, without any YAML, e.g. to embed the collector into a Go application or to run
+// it in integration tests. The typical usage is the following:
+//
+//	cfg, factories, err := otelcol.NewConfigBuilder().
+//		AddReceiver(component.NewID("otlp"), otlpreceiver.NewFactory(), nil).
+//		AddExporter(component.NewID("debug"), debugexporter.NewFactory(), nil).
+//		AddPipeline(component.NewID(component.DataTypeTraces),
+//			[]component.ID{component.NewID("otlp")}, nil, []component.ID{component.NewID("debug")}).
+//		Build()
+//	col, err := otelcol.NewCollector(otelcol.CollectorSettings{
+//		Factories:      func() (otelcol.Factories, error) { return factories, nil },
+//		ConfigProvider: otelcol.NewStaticConfigProvider(cfg),
+//	})
+//
+// The errors of the Add methods are returned by Build. The configuration is validated
+// when the collector starts, as with any ConfigProvider.
+type ConfigBuilder struct {
+	cfg       *Config
+	factories Factories
+	errs      error
+}
+
+// NewConfigBuilder returns a ConfigBuilder of an empty configuration, whose service telemetry
+// has the same default settings as when the configuration is unmarshaled.
+func NewConfigBuilder() *ConfigBuilder {
+	return &ConfigBuilder{
+		cfg: &Config{
+			Receivers:  map[component.ID]component.Config{},
+			Processors: map[component.ID]component.Config{},
+			Exporters:  map[component.ID]component.Config{},
+			Connectors: map[component.ID]component.Config{},
+			Extensions: map[component.ID]component.Config{},
+			Service:    defaultServiceConfig(),
+		},
+		factories: Factories{
+			Receivers:  map[component.Type]receiver.Factory{},
+			Processors: map[component.Type]processor.Factory{},
+			Exporters:  map[component.Type]exporter.Factory{},
+			Connectors: map[component.Type]connector.Factory{},
+			Extensions: map[component.Type]extension.Factory{},
+		},
+	}
+}
+
+// AddReceiver adds the receiver with the given ID, created by the factory with the given configuration.
+// If cfg is nil, the default configuration of the factory is used.
+func (b *ConfigBuilder) AddReceiver(id component.ID, factory receiver.Factory, cfg component.Config) *ConfigBuilder {
+	b.factories.Receivers[factory.Type()] = factory
+	b.add("receiver", b.cfg.Receivers, id, factory, cfg)
+	return b
+}
+
+// AddProcessor adds the processor with the given ID, created by the factory with the given configuration.
+// If cfg is nil, the default configuration of the factory is used.
+func (b *ConfigBuilder) AddProcessor(id component.ID, factory processor.Factory, cfg component.Config) *ConfigBuilder {
+	b.factories.Processors[factory.Type()] = factory
+	b.add("processor", b.cfg.Processors, id, factory, cfg)
+	return b
+}
+
+// AddExporter adds the exporter with the given ID, created by the factory with the given configuration.
+// If cfg is nil, the default configuration of the factory is used.
+func (b *ConfigBuilder) AddExporter(id component.ID, factory exporter.Factory, cfg component.Config) *ConfigBuilder {
+	b.factories.Exporters[factory.Type()] = factory
+	b.add("exporter", b.cfg.Exporters, id, factory, cfg)
+	return b
+}
+
+// AddConnector adds the connector with the given ID, created by the factory with the given configuration.
+// If cfg is nil, the default configuration of the factory is used.
+func (b *ConfigBuilder) AddConnector(id component.ID, factory connector.Factory, cfg component.Config) *ConfigBuilder {
+	b.factories.Connectors[factory.Type()] = factory
+	b.add("connector", b.cfg.Connectors, id, factory, cfg)
+	return b
+}
+
+// AddExtension adds the extension with the given ID, created by the factory with the given configuration,
+// and enables it in the service. If cfg is nil, the default configuration of the factory is used.
+// The extensions are started in the order they are added.
+func (b *ConfigBuilder) AddExtension(id component.ID, factory extension.Factory, cfg component.Config) *ConfigBuilder {
+	b.factories.Extensions[factory.Type()] = factory
+	if b.add("extension", b.cfg.Extensions, id, factory, cfg) {
+		b.cfg.Service.Extensions = append(b.cfg.Service.Extensions, id)
+	}
+	return b
+}
+
+// AddPipeline adds the pipeline with the given ID, whose data type is the type of the ID,
+// made of the components with the given IDs. The connectors are referenced as receivers
+// and exporters.
+func (b *ConfigBuilder) AddPipeline(id component.ID, receivers, processors, exporters []component.ID) *ConfigBuilder {
+	if _, ok := b.cfg.Service.Pipelines[id]; ok {
+		b.errs = multierr.Append(b.errs, fmt.Errorf("duplicate pipeline %q", id))
+		return b
+	}
+	if b.cfg.Service.Pipelines == nil {
+		b.cfg.Service.Pipelines = pipelines.Config{}
+	}
+	b.cfg.Service.Pipelines[id] = &pipelines.PipelineConfig{
+		Receivers:  receivers,
+		Processors: processors,
+		Exporters:  exporters,
+	}
+	return b
+}
+
+// Build returns the assembled configuration and the factories of its components,
+// or the errors of the Add methods.
+func (b *ConfigBuilder) Build() (*Config, Factories, error) {
+	if b.errs != nil {
+		return nil, Factories{}, b.errs
+	}
+	return b.cfg, b.factories, nil
+}
+
+// add adds the configuration of the component to configs, and returns whether it was added.
+func (b *ConfigBuilder) add(kind string, configs map[component.ID]component.Config, id component.ID, factory component.Factory, cfg component.Config) bool {
+	if id.Type() != factory.Type() {
+		b.errs = multierr.Append(b.errs, fmt.Errorf("%s %q does not match the type %q of its factory", kind, id, factory.Type()))
+		return false
+	}
+	if _, ok := configs[id]; ok {
+		b.errs = multierr.Append(b.errs, fmt.Errorf("duplicate %s %q", kind, id))
+		return false
+	}
+	if cfg == nil {
+		cfg = factory.CreateDefaultConfig()
+	}
+	configs[id] = cfg
+	return true
+}
+
+// staticConfigProvider is a ConfigProvider of a configuration which never changes.
+type staticConfigProvider struct {
+	cfg     *Config
+	watcher chan error
+}
+
+var _ ConfigProvider = (*staticConfigProvider)(nil)
+
+// NewStaticConfigProvider returns a ConfigProvider which provides the given configuration,
+// e.g. assembled with a ConfigBuilder. The configuration never changes, so the collector
+// never reloads it.
+func NewStaticConfigProvider(cfg *Config) ConfigProvider {
+	return &staticConfigProvider{
+		cfg:     cfg,
+		watcher: make(chan error, 1),
+	}
+}
+
+func (p *staticConfigProvider) Get(context.Context, Factories) (*Config, error) {
+	return p.cfg, nil
+}
+
+func (p *staticConfigProvider) Watch() <-chan error {
+	return p.watcher
+}
+
+func (p *staticConfigProvider) Shutdown(context.Context) error {
+	close(p.watcher)
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtelemetry"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func nopConfigBuilder() *ConfigBuilder {
+	nop := component.NewID("nop")
+	con := component.NewIDWithName("nop", "con")
+	return NewConfigBuilder().
+		AddReceiver(nop, receivertest.NewNopFactory(), nil).
+		AddProcessor(nop, processortest.NewNopFactory(), nil).
+		AddExporter(nop, exportertest.NewNopFactory(), nil).
+		AddExtension(nop, extensiontest.NewNopFactory(), nil).
+		AddConnector(con, connectortest.NewNopFactory(), nil).
+		AddPipeline(component.NewID(component.DataTypeTraces), []component.ID{nop}, []component.ID{nop}, []component.ID{nop, con}).
+		AddPipeline(component.NewID(component.DataTypeMetrics), []component.ID{nop}, []component.ID{nop}, []component.ID{nop}).
+		AddPipeline(component.NewID(component.DataTypeLogs), []component.ID{nop, con}, []component.ID{nop}, []component.ID{nop})
+}
+
+func TestConfigBuilder(t *testing.T) {
+	cfg, factories, err := nopConfigBuilder().Build()
+	require.NoError(t, err)
+	cfg.Service.Telemetry.Metrics.Address = "localhost:8888"
+
+	assert.Len(t, factories.Receivers, 1)
+	assert.Contains(t, factories.Receivers, component.Type("nop"))
+	assert.Len(t, factories.Processors, 1)
+	assert.Contains(t, factories.Processors, component.Type("nop"))
+	assert.Len(t, factories.Exporters, 1)
+	assert.Contains(t, factories.Exporters, component.Type("nop"))
+	assert.Len(t, factories.Connectors, 1)
+	assert.Contains(t, factories.Connectors, component.Type("nop"))
+	assert.Len(t, factories.Extensions, 1)
+	assert.Contains(t, factories.Extensions, component.Type("nop"))
+
+	provider, err := NewConfigProvider(newDefaultConfigProviderSettings([]string{filepath.Join("testdata", "otelcol-nop.yaml")}))
+	require.NoError(t, err)
+	expectedCfg, err := provider.Get(context.Background(), factories)
+	require.NoError(t, err)
+	assert.Equal(t, expectedCfg, cfg)
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestConfigBuilderConfig(t *testing.T) {
+	factory := receivertest.NewNopFactory()
+	cfg, _, err := NewConfigBuilder().
+		AddReceiver(component.NewIDWithName("nop", "custom"), factory, &struct{ Endpoint string }{Endpoint: "localhost:4317"}).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, map[component.ID]component.Config{
+		component.NewIDWithName("nop", "custom"): &struct{ Endpoint string }{Endpoint: "localhost:4317"},
+	}, cfg.Receivers)
+}
+
+func TestConfigBuilderErrors(t *testing.T) {
+	nop := component.NewID("nop")
+	_, _, err := NewConfigBuilder().
+		AddReceiver(component.NewID("otlp"), receivertest.NewNopFactory(), nil).
+		AddExporter(nop, exportertest.NewNopFactory(), nil).
+		AddExporter(nop, exportertest.NewNopFactory(), nil).
+		AddExtension(component.NewID("ext"), extensiontest.NewNopFactory(), nil).
+		AddPipeline(component.NewID(component.DataTypeTraces), []component.ID{nop}, nil, []component.ID{nop}).
+		AddPipeline(component.NewID(component.DataTypeTraces), []component.ID{nop}, nil, []component.ID{nop}).
+		Build()
+	assert.EqualError(t, err, `receiver "otlp" does not match the type "nop" of its factory; `+
+		`duplicate exporter "nop"; `+
+		`extension "ext" does not match the type "nop" of its factory; `+
+		`duplicate pipeline "traces"`)
+}
+
+func TestCollectorWithConfigBuilder(t *testing.T) {
+	cfg, factories, err := nopConfigBuilder().Build()
+	require.NoError(t, err)
+	cfg.Service.Telemetry.Metrics.Level = configtelemetry.LevelNone
+
+	col, err := NewCollector(CollectorSettings{
+		BuildInfo:      component.NewDefaultBuildInfo(),
+		Factories:      func() (Factories, error) { return factories, nil },
+		ConfigProvider: NewStaticConfigProvider(cfg),
+	})
+	require.NoError(t, err)
+
+	wg := startCollector(context.Background(), t, col)
+	assert.Eventually(t, func() bool {
+		return StateRunning == col.GetState()
+	}, 2*time.Second, 200*time.Millisecond)
+
+	col.Shutdown()
+	wg.Wait()
+	assert.Equal(t, StateClosed, col.GetState())
+}
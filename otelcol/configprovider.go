@@ -63,12 +63,23 @@ type ConfmapProvider interface {
 	GetConfmap(ctx context.Context) (*confmap.Conf, error)
 }
 
+// ConfigOriginProvider is an optional interface to be implemented by ConfigProviders
+// to provide the URI from which each key of the Collector's configuration was retrieved.
+type ConfigOriginProvider interface {
+	// ConfigOrigins returns the URI from which each key of the last resolved configuration was retrieved,
+	// using the key format of confmap.Conf.
+	//
+	// Should never be called concurrently with any ConfigProvider method.
+	ConfigOrigins() map[string]string
+}
+
 type configProvider struct {
 	mapResolver *confmap.Resolver
 }
 
 var _ ConfigProvider = &configProvider{}
 var _ ConfmapProvider = &configProvider{}
+var _ ConfigOriginProvider = &configProvider{}
 
 // ConfigProviderSettings are the settings to configure the behavior of the ConfigProvider.
 type ConfigProviderSettings struct {
@@ -131,6 +142,10 @@ func (cm *configProvider) GetConfmap(ctx context.Context) (*confmap.Conf, error)
 	return conf, nil
 }
 
+func (cm *configProvider) ConfigOrigins() map[string]string {
+	return cm.mapResolver.Origins()
+}
+
 func newDefaultConfigProviderSettings(uris []string) ConfigProviderSettings {
 	return ConfigProviderSettings{
 		ResolverSettings: confmap.ResolverSettings{
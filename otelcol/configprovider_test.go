@@ -127,3 +127,30 @@ func TestGetConfmap(t *testing.T) {
 
 	assert.EqualValues(t, yamlMap, cmap.ToStringMap())
 }
+
+func TestConfigOrigins(t *testing.T) {
+	fileURI := "file:" + filepath.Join("testdata", "otelcol-nop.yaml")
+	yamlURI := "yaml:service::telemetry::metrics::address: localhost:9999"
+	set := ConfigProviderSettings{
+		ResolverSettings: confmap.ResolverSettings{
+			URIs:      []string{fileURI, yamlURI},
+			Providers: makeMapProvidersMap(fileprovider.New(), yamlprovider.New()),
+		},
+	}
+
+	cp, err := NewConfigProvider(set)
+	require.NoError(t, err)
+
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	_, err = cp.Get(context.Background(), factories)
+	require.NoError(t, err)
+
+	cop, ok := cp.(ConfigOriginProvider)
+	require.True(t, ok)
+
+	origins := cop.ConfigOrigins()
+	assert.Equal(t, yamlURI, origins["service::telemetry::metrics::address"])
+	assert.Equal(t, fileURI, origins["service::pipelines::traces::receivers"])
+}
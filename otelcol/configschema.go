@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"encoding"
+	"reflect"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+)
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// configSchema generates the JSON schema of the configuration of a component from the Go type of
+// its default configuration, following the mapstructure tags, as it is unmarshaled by the collector.
+// The default values are the ones of the default configuration.
+func configSchema(cfg component.Config) (map[string]any, error) {
+	defaults := confmap.New()
+	if err := defaults.Marshal(cfg); err != nil {
+		return nil, err
+	}
+	schema := typeSchema(reflect.TypeOf(cfg), map[reflect.Type]bool{})
+	addDefaults(schema, defaults.ToStringMap())
+	return schema, nil
+}
+
+// typeSchema returns the JSON schema of the values of the type. The types already being
+// generated are skipped, so that recursive types do not loop.
+func typeSchema(t reflect.Type, generating map[reflect.Type]bool) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch {
+	case t == durationType:
+		return map[string]any{"type": "string", "format": "duration"}
+	case reflect.PointerTo(t).Implements(textUnmarshalerType):
+		return map[string]any{"type": "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": typeSchema(t.Elem(), generating)}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": typeSchema(t.Elem(), generating)}
+	case reflect.Struct:
+		if generating[t] {
+			return map[string]any{"type": "object"}
+		}
+		generating[t] = true
+		defer delete(generating, t)
+		properties := map[string]any{}
+		addProperties(properties, t, generating)
+		// The collector fails on the keys which do not match any field.
+		return map[string]any{"type": "object", "properties": properties, "additionalProperties": false}
+	}
+	// Interfaces and other types accept any value.
+	return map[string]any{}
+}
+
+// addProperties adds the schemas of the fields of the struct type to properties, by their mapstructure key.
+func addProperties(properties map[string]any, t reflect.Type, generating map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := strings.Split(field.Tag.Get("mapstructure"), ",")
+		name := tag[0]
+		if name == "-" {
+			continue
+		}
+		if hasOption(tag[1:], "squash") {
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				addProperties(properties, fieldType, generating)
+			}
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		properties[name] = typeSchema(field.Type, generating)
+	}
+}
+
+func hasOption(opts []string, opt string) bool {
+	for _, o := range opts {
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
+
+// addDefaults adds the default values of the configuration to the schemas of its properties.
+func addDefaults(schema map[string]any, value any) {
+	switch v := value.(type) {
+	case nil:
+		return
+	case []any:
+		// The lists are empty by default, there is no need to state it.
+		if len(v) == 0 {
+			return
+		}
+	case time.Duration:
+		value = v.String()
+	}
+	properties, ok := schema["properties"].(map[string]any)
+	values, isMap := value.(map[string]any)
+	if !ok || !isMap {
+		if !isMap || len(values) > 0 {
+			schema["default"] = value
+		}
+		return
+	}
+	for key, v := range values {
+		if propertySchema, ok := properties[key].(map[string]any); ok {
+			addDefaults(propertySchema, v)
+		}
+	}
+}
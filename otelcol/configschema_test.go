@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+type SchemaTestSettings struct {
+	Endpoint string `mapstructure:"endpoint"`
+}
+
+type schemaTestNode struct {
+	Name     string            `mapstructure:"name"`
+	Children []*schemaTestNode `mapstructure:"children"`
+}
+
+type schemaTestConfig struct {
+	SchemaTestSettings `mapstructure:",squash"`
+	Enabled            bool              `mapstructure:"enabled"`
+	Timeout            time.Duration     `mapstructure:"timeout"`
+	Ratio              float64           `mapstructure:"ratio"`
+	Retries            *int              `mapstructure:"retries"`
+	Headers            map[string]string `mapstructure:"headers"`
+	Exporters          []component.ID    `mapstructure:"exporters"`
+	Tree               schemaTestNode    `mapstructure:"tree"`
+	Untagged           string
+	Ignored            string `mapstructure:"-"`
+	unexported         string
+}
+
+func TestConfigSchema(t *testing.T) {
+	schema, err := configSchema(&schemaTestConfig{
+		SchemaTestSettings: SchemaTestSettings{Endpoint: "localhost:4317"},
+		Timeout:            5 * time.Second,
+		unexported:         "unexported",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties": map[string]any{
+			"endpoint": map[string]any{"type": "string", "default": "localhost:4317"},
+			"enabled":  map[string]any{"type": "boolean", "default": false},
+			"timeout":  map[string]any{"type": "string", "format": "duration", "default": "5s"},
+			"ratio":    map[string]any{"type": "number", "default": float64(0)},
+			"retries":  map[string]any{"type": "integer"},
+			"headers": map[string]any{
+				"type":                 "object",
+				"additionalProperties": map[string]any{"type": "string"},
+			},
+			"exporters": map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+			"tree": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"name":     map[string]any{"type": "string", "default": ""},
+					"children": map[string]any{"type": "array", "items": map[string]any{"type": "object"}},
+				},
+			},
+			"untagged": map[string]any{"type": "string", "default": ""},
+		},
+	}, schema)
+}
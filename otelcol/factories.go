@@ -29,4 +29,20 @@ type Factories struct {
 
 	// Connectors maps connector type names in the config to the respective factory.
 	Connectors map[component.Type]connector.Factory
+
+	// ReceiverModules maps receiver types to the Go module providing them, with its version,
+	// e.g. "go.opentelemetry.io/collector/receiver/otlpreceiver v0.93.0".
+	ReceiverModules map[component.Type]string
+
+	// ProcessorModules maps processor types to the Go module providing them, with its version.
+	ProcessorModules map[component.Type]string
+
+	// ExporterModules maps exporter types to the Go module providing them, with its version.
+	ExporterModules map[component.Type]string
+
+	// ExtensionModules maps extension types to the Go module providing them, with its version.
+	ExtensionModules map[component.Type]string
+
+	// ConnectorModules maps connector types to the Go module providing them, with its version.
+	ConnectorModules map[component.Type]string
 }
@@ -18,6 +18,8 @@ const (
 type configFlagValue struct {
 	values []string
 	sets   []string
+	// setOps are the --set flags addressing list elements, applied once the configuration is resolved.
+	setOps []*setOperation
 }
 
 func (s *configFlagValue) Set(val string) error {
@@ -38,14 +40,19 @@ func flags(reg *featuregate.Registry) *flag.FlagSet {
 
 	flagSet.Func("set",
 		"Set arbitrary component config property. The component has to be defined in the config file and the flag"+
-			" has a higher precedence. Array config properties are overridden and maps are joined. Example --set=processors.batch.timeout=2s",
+			" has a higher precedence. Array config properties are overridden and maps are joined. Example --set=processors.batch.timeout=2s."+
+			" Array elements are set by index, or appended with an empty index, once the other values are merged."+
+			" Example --set=processors.batch.metadata_keys[0]=tenant --set=processors.batch.metadata_keys[]=region",
 		func(s string) error {
-			idx := strings.Index(s, "=")
-			if idx == -1 {
-				// No need for more context, see TestSetFlag/invalid_set.
-				return errors.New("missing equal sign")
+			uri, op, err := parseSetFlag(s)
+			if err != nil {
+				return err
 			}
-			cfgs.sets = append(cfgs.sets, "yaml:"+strings.TrimSpace(strings.ReplaceAll(s[:idx], ".", "::"))+": "+strings.TrimSpace(s[idx+1:]))
+			if op != nil {
+				cfgs.setOps = append(cfgs.setOps, op)
+				return nil
+			}
+			cfgs.sets = append(cfgs.sets, uri)
 			return nil
 		})
 
@@ -57,3 +64,18 @@ func getConfigFlag(flagSet *flag.FlagSet) []string {
 	cfv := flagSet.Lookup(configFlag).Value.(*configFlagValue)
 	return append(cfv.values, cfv.sets...)
 }
+
+// getConfigProviderSettings returns the settings of the default ConfigProvider for the config and set flags.
+func getConfigProviderSettings(flagSet *flag.FlagSet) (ConfigProviderSettings, error) {
+	configFlags := getConfigFlag(flagSet)
+	setOps := flagSet.Lookup(configFlag).Value.(*configFlagValue).setOps
+	if len(configFlags) == 0 && len(setOps) == 0 {
+		return ConfigProviderSettings{}, errors.New("at least one config flag must be provided")
+	}
+
+	settings := newDefaultConfigProviderSettings(configFlags)
+	if len(setOps) > 0 {
+		settings.ResolverSettings.Converters = append(settings.ResolverSettings.Converters, setConverter{ops: setOps})
+	}
+	return settings, nil
+}
@@ -4,11 +4,13 @@
 package otelcol
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/featuregate"
 )
 
@@ -49,11 +51,30 @@ func TestSetFlag(t *testing.T) {
 			args:            []string{"--config=file:testdata/otelcol-nop.yaml", "--set=key=value"},
 			expectedConfigs: []string{"file:testdata/otelcol-nop.yaml", "yaml:key: value"},
 		},
+		{
+			name:            "set json",
+			args:            []string{"--set=key={\n  \"a\": [1, 2],\n  \"b\": \"c\"\n}"},
+			expectedConfigs: []string{`yaml:key: {"a":[1,2],"b":"c"}`},
+		},
+		{
+			name: "set list element",
+			args: []string{"--set=key[0]=value"},
+		},
 		{
 			name:        "invalid set",
 			args:        []string{"--set=key:name"},
 			expectedErr: `invalid value "key:name" for flag -set: missing equal sign`,
 		},
+		{
+			name:        "invalid list index",
+			args:        []string{"--set=key[a]=value"},
+			expectedErr: `invalid value "key[a]=value" for flag -set: invalid key "key[a]"`,
+		},
+		{
+			name:        "append in the middle of the key",
+			args:        []string{"--set=key[].inner=value"},
+			expectedErr: `invalid value "key[].inner=value" for flag -set: invalid key "key[].inner": elements can only be appended at the end of the key`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -69,3 +90,87 @@ func TestSetFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestSetFlagListElements(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		expected    map[string]any
+		expectedErr string
+	}{
+		{
+			name: "set element",
+			args: []string{"--set=service.pipelines.traces.exporters[1]=nop/2"},
+			expected: map[string]any{
+				"service::pipelines::traces::exporters": []any{"nop", "nop/2"},
+			},
+		},
+		{
+			name: "append element",
+			args: []string{"--set=service.pipelines.logs.receivers[]=nop/2", "--set=service.extensions[]=nop/2"},
+			expected: map[string]any{
+				"service::pipelines::logs::receivers": []any{"nop", "nop/con", "nop/2"},
+				"service::extensions":                 []any{"nop", "nop/2"},
+			},
+		},
+		{
+			name: "append to missing list",
+			args: []string{"--set=processors.nop.keys[]=tenant"},
+			expected: map[string]any{
+				"processors::nop::keys": []any{"tenant"},
+			},
+		},
+		{
+			name: "set elements in order",
+			args: []string{"--set=service.pipelines.metrics.exporters[]=nop/2", "--set=service.pipelines.metrics.exporters[1]=nop/3"},
+			expected: map[string]any{
+				"service::pipelines::metrics::exporters": []any{"nop", "nop/3"},
+			},
+		},
+		{
+			name: "set json element",
+			args: []string{`--set=receivers.nop.endpoints[]={"host": "localhost", "ports": [4317, 4318]}`},
+			expected: map[string]any{
+				"receivers::nop::endpoints": []any{map[string]any{"host": "localhost", "ports": []any{4317, 4318}}},
+			},
+		},
+		{
+			name:        "index out of range",
+			args:        []string{"--set=service.extensions[1]=nop"},
+			expectedErr: `cannot set "service::extensions[1]": index out of range, "service::extensions" has 1 element(s)`,
+		},
+		{
+			name:        "not a list",
+			args:        []string{"--set=service.telemetry.metrics.address[0]=localhost:8889"},
+			expectedErr: `cannot set "service::telemetry::metrics::address[0]": "service::telemetry::metrics::address" is not a list`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flgs := flags(featuregate.NewRegistry())
+			require.NoError(t, flgs.Parse(append([]string{"--config=file:testdata/otelcol-nop.yaml"}, tt.args...)))
+			set, err := getConfigProviderSettings(flgs)
+			require.NoError(t, err)
+			resolver, err := confmap.NewResolver(set.ResolverSettings)
+			require.NoError(t, err)
+
+			conf, err := resolver.Resolve(context.Background())
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			for key, val := range tt.expected {
+				assert.Equal(t, val, conf.Get(key), key)
+			}
+		})
+	}
+}
+
+func TestGetConfigProviderSettingsNoConfig(t *testing.T) {
+	flgs := flags(featuregate.NewRegistry())
+	require.NoError(t, flgs.Parse(nil))
+	_, err := getConfigProviderSettings(flgs)
+	require.EqualError(t, err, "at least one config flag must be provided")
+}
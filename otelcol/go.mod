@@ -6,9 +6,11 @@ require (
 	github.com/spf13/cobra v1.8.0
 	github.com/stretchr/testify v1.8.4
 	go.opentelemetry.io/collector/component v0.93.0
+	go.opentelemetry.io/collector/config/confignet v0.93.0
 	go.opentelemetry.io/collector/config/configtelemetry v0.93.0
 	go.opentelemetry.io/collector/confmap v0.93.0
 	go.opentelemetry.io/collector/connector v0.93.0
+	go.opentelemetry.io/collector/consumer v0.93.0
 	go.opentelemetry.io/collector/exporter v0.93.0
 	go.opentelemetry.io/collector/extension v0.93.0
 	go.opentelemetry.io/collector/featuregate v1.0.1
@@ -62,7 +64,6 @@ require (
 	github.com/yusufpapurcu/wmi v1.2.3 // indirect
 	go.opencensus.io v0.24.0 // indirect
 	go.opentelemetry.io/collector v0.93.0 // indirect
-	go.opentelemetry.io/collector/consumer v0.93.0 // indirect
 	go.opentelemetry.io/collector/pdata v1.0.1 // indirect
 	go.opentelemetry.io/collector/semconv v0.93.0 // indirect
 	go.opentelemetry.io/contrib/config v0.2.0 // indirect
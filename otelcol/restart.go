@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/config/confignet"
+)
+
+// restartReadyFDEnv is the environment variable holding the file descriptor on which the
+// process started by a restart notifies its parent that it is ready.
+const restartReadyFDEnv = "OTELCOL_RESTART_READY_FD"
+
+const (
+	// notifySocketEnv is the environment variable holding the socket on which the services of
+	// systemd with Type=notify notify their state, see sd_notify(3).
+	notifySocketEnv = "NOTIFY_SOCKET"
+	// invocationIDEnv is set by systemd in the environment of the services it runs.
+	invocationIDEnv = "INVOCATION_ID"
+)
+
+// restartReadyTimeout is how long the collector waits for the process started by a restart to be ready.
+var restartReadyTimeout = time.Minute
+
+// restart starts a new collector process, from the current executable and with the same arguments,
+// which inherits the listeners of the components, and waits until it is ready. The executable may
+// have been upgraded since the collector started. The collector must be shut down once restart
+// succeeds, so that the new process is the only one accepting connections; until then both
+// processes accept them, so that there is no gap in the ingestion.
+func (col *Collector) restart() error {
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to find the executable: %w", err)
+	}
+	files, listeners, err := confignet.ListenerFiles()
+	if err != nil {
+		return err
+	}
+	ready, readyW, err := os.Pipe()
+	if err != nil {
+		closeFiles(files)
+		return err
+	}
+	defer ready.Close()
+
+	cmd := exec.Command(exe, os.Args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.ExtraFiles = append(files, readyW)
+	cmd.Env = append(os.Environ(),
+		confignet.InheritedListenersEnv+"="+listeners,
+		restartReadyFDEnv+"="+strconv.Itoa(3+len(files)))
+	err = cmd.Start()
+	// The files are duplicated in the new process, the ones of this process are not needed anymore.
+	closeFiles(cmd.ExtraFiles)
+	if err != nil {
+		return fmt.Errorf("failed to start the new process: %w", err)
+	}
+
+	readyCh := make(chan error, 1)
+	go func() {
+		// The new process writes a byte once ready, or closes the pipe without writing when it exits.
+		_, readErr := ready.Read(make([]byte, 1))
+		readyCh <- readErr
+	}()
+	select {
+	case err = <-readyCh:
+		if err != nil {
+			err = errors.New("the new process exited before being ready")
+		}
+	case <-time.After(restartReadyTimeout):
+		err = fmt.Errorf("the new process was not ready after %v", restartReadyTimeout)
+	}
+	if err == nil {
+		// systemd must know the new process is the main process of the service, otherwise it
+		// stops the service when this process exits.
+		if err = sdNotify(fmt.Sprintf("MAINPID=%d", cmd.Process.Pid)); err != nil {
+			err = fmt.Errorf("failed to notify systemd of the new main process: %w", err)
+		}
+	}
+	if err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return err
+	}
+	return cmd.Process.Release()
+}
+
+// checkRestartSupported returns an error if the collector process can not be restarted in its
+// environment. The process exits once the new process is ready, which stops the container when
+// the process is its init process, and stops the systemd service unless systemd can be told that
+// the new process is the main process of the service, which requires Type=notify.
+func checkRestartSupported() error {
+	if os.Getpid() == 1 {
+		return errors.New("the collector process can not be restarted when it runs as PID 1, run it under an init process such as tini")
+	}
+	if os.Getenv(invocationIDEnv) != "" && os.Getenv(notifySocketEnv) == "" {
+		return errors.New("the collector process can only be restarted by systemd services with Type=notify")
+	}
+	return nil
+}
+
+// sdNotify sends the state to systemd, if the collector runs as a service with Type=notify.
+func sdNotify(state string) error {
+	socket := os.Getenv(notifySocketEnv)
+	if socket == "" {
+		return nil
+	}
+	conn, err := net.Dial("unixgram", socket)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Write([]byte(state))
+	if closeErr := conn.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// notifyRestartReady notifies the parent process that this process is ready, if it was started
+// by a restart, and closes the listeners inherited from the parent which are not used anymore.
+func notifyRestartReady() error {
+	fd := os.Getenv(restartReadyFDEnv)
+	if fd == "" {
+		return nil
+	}
+	// Unset the variable, so that a later restart of this process does not notify again.
+	if err := os.Unsetenv(restartReadyFDEnv); err != nil {
+		return err
+	}
+	n, err := strconv.Atoi(fd)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", restartReadyFDEnv, err)
+	}
+	f := os.NewFile(uintptr(n), "restart-ready")
+	_, err = f.Write([]byte{1})
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to notify the parent process: %w", err)
+	}
+	return confignet.CloseUnusedInheritedListeners()
+}
+
+func closeFiles(files []*os.File) {
+	for _, f := range files {
+		_ = f.Close()
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// notifyRestartSignal relays the signal restarting the collector, SIGUSR2, to c.
+func notifyRestartSignal(c chan<- os.Signal) {
+	signal.Notify(c, syscall.SIGUSR2)
+}
+
+func isRestartSignal(s os.Signal) bool {
+	return s == syscall.SIGUSR2
+}
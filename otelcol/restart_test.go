@@ -0,0 +1,231 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+
+package otelcol
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confignet"
+)
+
+const (
+	restartTestAddrEnv   = "OTELCOL_TEST_RESTART_ADDR"
+	restartTestResultEnv = "OTELCOL_TEST_RESTART_RESULT"
+)
+
+// setRestartArgs sets the arguments of the process started by a restart, so that it only runs the given test,
+// and returns a function waiting until the test passed in the new process, see reportRestartResult.
+func setRestartArgs(t *testing.T, test string) (waitResult func()) {
+	args := os.Args
+	os.Args = []string{args[0], "-test.run=^" + test + "$"}
+	t.Cleanup(func() { os.Args = args })
+	result := filepath.Join(t.TempDir(), "result")
+	t.Setenv(restartTestResultEnv, result)
+	return func() {
+		assert.Eventually(t, func() bool {
+			data, err := os.ReadFile(result)
+			return err == nil && string(data) == "PASS"
+		}, 10*time.Second, 100*time.Millisecond)
+	}
+}
+
+// reportRestartResult reports the result of the test run by the process started by a restart to its parent,
+// once the collector of the process is shut down, so that the next tests can bind the same addresses.
+func reportRestartResult(t *testing.T) {
+	result := "FAIL"
+	if !t.Failed() {
+		result = "PASS"
+	}
+	require.NoError(t, os.WriteFile(os.Getenv(restartTestResultEnv), []byte(result), 0600))
+}
+
+func TestCollectorRestart(t *testing.T) {
+	if os.Getenv(restartReadyFDEnv) != "" {
+		runRestartedCollector(t)
+		return
+	}
+	waitResult := setRestartArgs(t, "TestCollectorRestart")
+
+	ln, err := confignet.Listen(context.Background(), "tcp", "localhost:0")
+	require.NoError(t, err)
+	t.Setenv(restartTestAddrEnv, ln.Addr().String())
+	notifications := listenNotifySocket(t)
+
+	cfgProvider, err := NewConfigProvider(newDefaultConfigProviderSettings([]string{filepath.Join("testdata", "otelcol-nop.yaml")}))
+	require.NoError(t, err)
+	col, err := NewCollector(CollectorSettings{
+		BuildInfo:             component.NewDefaultBuildInfo(),
+		Factories:             nopFactories,
+		ConfigProvider:        cfgProvider,
+		RestartOnConfigChange: true,
+	})
+	require.NoError(t, err)
+
+	wg := startCollector(context.Background(), t, col)
+	assert.Eventually(t, func() bool {
+		return StateRunning == col.GetState()
+	}, 2*time.Second, 200*time.Millisecond)
+
+	// The collector shuts down once the new process is ready.
+	col.signalsChannel <- syscall.SIGUSR2
+	wg.Wait()
+	assert.Equal(t, StateClosed, col.GetState())
+	require.NoError(t, ln.Close())
+
+	// systemd is told that the new process is the main process of the service.
+	assert.Equal(t, "READY=1", readNotification(t, notifications))
+	for {
+		state := readNotification(t, notifications)
+		if strings.HasPrefix(state, "MAINPID=") {
+			assert.NotEqual(t, fmt.Sprintf("MAINPID=%d", os.Getpid()), state)
+			break
+		}
+	}
+	waitResult()
+}
+
+// listenNotifySocket listens on a socket set as the systemd notification socket of the collector.
+func listenNotifySocket(t *testing.T) *net.UnixConn {
+	addr := &net.UnixAddr{Name: filepath.Join(t.TempDir(), "notify.sock"), Net: "unixgram"}
+	conn, err := net.ListenUnixgram("unixgram", addr)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+	t.Setenv(notifySocketEnv, addr.Name)
+	return conn
+}
+
+func readNotification(t *testing.T, conn *net.UnixConn) string {
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	buf := make([]byte, 64)
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	return string(buf[:n])
+}
+
+func TestCollectorRestartUnsupported(t *testing.T) {
+	t.Setenv(invocationIDEnv, "0123456789abcdef")
+	t.Setenv(notifySocketEnv, "")
+	_, err := NewCollector(CollectorSettings{ConfigProvider: NewStaticConfigProvider(&Config{}), RestartOnConfigChange: true})
+	assert.EqualError(t, err, "the collector process can only be restarted by systemd services with Type=notify")
+
+	// The collector is not restarted, whatever the service type.
+	_, err = NewCollector(CollectorSettings{ConfigProvider: NewStaticConfigProvider(&Config{})})
+	require.NoError(t, err)
+
+	listenNotifySocket(t)
+	_, err = NewCollector(CollectorSettings{ConfigProvider: NewStaticConfigProvider(&Config{}), RestartOnConfigChange: true})
+	require.NoError(t, err)
+}
+
+// runRestartedCollector runs the collector of the process started by TestCollectorRestart,
+// which inherits the listener of its parent.
+func runRestartedCollector(t *testing.T) {
+	defer reportRestartResult(t)
+	ln, err := confignet.Listen(context.Background(), "tcp", "localhost:0")
+	require.NoError(t, err)
+	require.Equal(t, os.Getenv(restartTestAddrEnv), ln.Addr().String())
+
+	cfg, factories, err := nopConfigBuilder().Build()
+	require.NoError(t, err)
+	// The internal telemetry server is handed off as well, as in testdata/otelcol-nop.yaml.
+	cfg.Service.Telemetry.Metrics.Address = "localhost:8888"
+	col, err := NewCollector(CollectorSettings{
+		BuildInfo:      component.NewDefaultBuildInfo(),
+		Factories:      func() (Factories, error) { return factories, nil },
+		ConfigProvider: NewStaticConfigProvider(cfg),
+	})
+	require.NoError(t, err)
+
+	wg := startCollector(context.Background(), t, col)
+	assert.Eventually(t, func() bool {
+		return StateRunning == col.GetState()
+	}, 2*time.Second, 200*time.Millisecond)
+	assert.Empty(t, os.Getenv(restartReadyFDEnv))
+
+	col.Shutdown()
+	wg.Wait()
+	require.NoError(t, ln.Close())
+}
+
+// TestCollectorRestartDefaultTelemetry checks that the listener of the internal telemetry server, with the
+// default telemetry configuration, is handed off to the new process.
+func TestCollectorRestartDefaultTelemetry(t *testing.T) {
+	cfg, factories, err := nopConfigBuilder().Build()
+	require.NoError(t, err)
+	set := CollectorSettings{
+		BuildInfo:             component.NewDefaultBuildInfo(),
+		Factories:             func() (Factories, error) { return factories, nil },
+		ConfigProvider:        NewStaticConfigProvider(cfg),
+		RestartOnConfigChange: true,
+	}
+	if os.Getenv(restartReadyFDEnv) != "" {
+		// The new process serves the internal metrics on the listener inherited from its parent once ready.
+		defer reportRestartResult(t)
+		col, err := NewCollector(set)
+		require.NoError(t, err)
+		wg := startCollector(context.Background(), t, col)
+		assert.Eventually(t, func() bool {
+			return StateRunning == col.GetState()
+		}, 2*time.Second, 200*time.Millisecond)
+		assert.NoError(t, getMetrics())
+		col.Shutdown()
+		wg.Wait()
+		return
+	}
+	waitResult := setRestartArgs(t, "TestCollectorRestartDefaultTelemetry")
+
+	col, err := NewCollector(set)
+	require.NoError(t, err)
+	wg := startCollector(context.Background(), t, col)
+	assert.Eventually(t, func() bool {
+		return StateRunning == col.GetState()
+	}, 2*time.Second, 200*time.Millisecond)
+	require.NoError(t, getMetrics())
+
+	// The collector shuts down once the new process is ready, which then serves the internal metrics.
+	col.signalsChannel <- syscall.SIGUSR2
+	wg.Wait()
+	assert.Equal(t, StateClosed, col.GetState())
+	waitResult()
+}
+
+// getMetrics gets the internal metrics served with the default telemetry configuration.
+func getMetrics() error {
+	resp, err := http.Get("http://localhost:8888/metrics")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func TestCollectorRestartFailure(t *testing.T) {
+	if os.Getenv(restartReadyFDEnv) != "" {
+		// The process started by the restart exits without being ready.
+		return
+	}
+	setRestartArgs(t, "TestCollectorRestartFailure")
+
+	col, err := NewCollector(CollectorSettings{ConfigProvider: NewStaticConfigProvider(&Config{})})
+	require.NoError(t, err)
+	assert.EqualError(t, col.restart(), "the new process exited before being ready")
+}
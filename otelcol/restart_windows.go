@@ -0,0 +1,17 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"os"
+)
+
+// notifyRestartSignal does nothing, the listeners can not be handed off to a new process on Windows.
+func notifyRestartSignal(chan<- os.Signal) {}
+
+func isRestartSignal(os.Signal) bool {
+	return false
+}
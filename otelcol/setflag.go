@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"go.opentelemetry.io/collector/confmap"
+)
+
+// appendIndex is the index of the pathStep appending an element to a list, written as "[]".
+const appendIndex = -1
+
+// setKeyPartRegexp matches a part of a --set key: a map key followed by list indices, e.g. "metadata_keys[0]".
+var setKeyPartRegexp = regexp.MustCompile(`^([^\[\]]+)((?:\[\d*\])*)$`)
+
+// pathStep is a step of the path of a --set key, either a map key or a list index.
+type pathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func (s pathStep) String() string {
+	if !s.isIndex {
+		return s.key
+	}
+	if s.index == appendIndex {
+		return "[]"
+	}
+	return "[" + strconv.Itoa(s.index) + "]"
+}
+
+// setOperation is a --set flag addressing list elements, e.g. "processors.batch.metadata_keys[0]=tenant".
+// These flags can not be merged as YAML documents, as lists are overridden when merged, so they are
+// applied by the setConverter once the configuration is resolved.
+type setOperation struct {
+	path  []pathStep
+	value any
+}
+
+// parseSetFlag returns the YAML location of the --set flag, or its setOperation if its key addresses
+// list elements. The values which are JSON arrays or objects are compacted, so that they can be
+// written on multiple lines.
+func parseSetFlag(s string) (string, *setOperation, error) {
+	idx := strings.Index(s, "=")
+	if idx == -1 {
+		// No need for more context, see TestSetFlag/invalid_set.
+		return "", nil, errors.New("missing equal sign")
+	}
+	key := strings.TrimSpace(s[:idx])
+	value := strings.TrimSpace(s[idx+1:])
+	if (strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[")) && json.Valid([]byte(value)) {
+		compacted := &bytes.Buffer{}
+		if err := json.Compact(compacted, []byte(value)); err != nil {
+			return "", nil, err
+		}
+		value = compacted.String()
+	}
+
+	if !strings.Contains(key, "[") {
+		return "yaml:" + strings.ReplaceAll(key, ".", confmap.KeyDelimiter) + ": " + value, nil, nil
+	}
+
+	op := &setOperation{}
+	for _, part := range strings.Split(key, ".") {
+		matches := setKeyPartRegexp.FindStringSubmatch(part)
+		if matches == nil {
+			return "", nil, fmt.Errorf("invalid key %q", key)
+		}
+		op.path = append(op.path, pathStep{key: matches[1]})
+		for _, index := range strings.SplitAfter(matches[2], "]") {
+			if index == "" {
+				continue
+			}
+			step := pathStep{index: appendIndex, isIndex: true}
+			if index != "[]" {
+				var err error
+				if step.index, err = strconv.Atoi(strings.Trim(index, "[]")); err != nil {
+					return "", nil, fmt.Errorf("invalid index in key %q: %w", key, err)
+				}
+			}
+			op.path = append(op.path, step)
+		}
+	}
+	for _, step := range op.path[:len(op.path)-1] {
+		if step.isIndex && step.index == appendIndex {
+			return "", nil, fmt.Errorf("invalid key %q: elements can only be appended at the end of the key", key)
+		}
+	}
+	if err := yaml.Unmarshal([]byte(value), &op.value); err != nil {
+		return "", nil, fmt.Errorf("invalid value %q: %w", value, err)
+	}
+	return "", op, nil
+}
+
+// setConverter applies the setOperations, in their order, to the resolved configuration.
+type setConverter struct {
+	ops []*setOperation
+}
+
+func (c setConverter) Convert(_ context.Context, conf *confmap.Conf) error {
+	var cfg any = conf.ToStringMap()
+	for _, op := range c.ops {
+		var err error
+		if cfg, err = setValue(cfg, op.path, op.value, ""); err != nil {
+			return err
+		}
+	}
+	return conf.Merge(confmap.NewFromStringMap(cfg.(map[string]any)))
+}
+
+// setValue returns a copy of current where the value at the given path is replaced. The maps are
+// created if missing, but not the lists, except when appending.
+func setValue(current any, path []pathStep, value any, where string) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	step := path[0]
+	if !step.isIndex {
+		parent := where
+		if where != "" {
+			where += confmap.KeyDelimiter
+		}
+		where += step.key
+		m, ok := current.(map[string]any)
+		if !ok && current != nil {
+			return nil, fmt.Errorf("cannot set %q: %q is not a map", where, parent)
+		}
+		updated := make(map[string]any, len(m)+1)
+		for k, v := range m {
+			updated[k] = v
+		}
+		child, err := setValue(m[step.key], path[1:], value, where)
+		if err != nil {
+			return nil, err
+		}
+		updated[step.key] = child
+		return updated, nil
+	}
+
+	list, ok := current.([]any)
+	if !ok && (current != nil || step.index != appendIndex) {
+		return nil, fmt.Errorf("cannot set \"%s%s\": %q is not a list", where, step, where)
+	}
+	updated := append([]any(nil), list...)
+	if step.index == appendIndex {
+		child, err := setValue(nil, path[1:], value, where+step.String())
+		if err != nil {
+			return nil, err
+		}
+		return append(updated, child), nil
+	}
+	if step.index >= len(list) {
+		return nil, fmt.Errorf("cannot set \"%s%s\": index out of range, %q has %d element(s)", where, step, where, len(list))
+	}
+	child, err := setValue(list[step.index], path[1:], value, where+step.String())
+	if err != nil {
+		return nil, err
+	}
+	updated[step.index] = child
+	return updated, nil
+}
@@ -40,31 +40,39 @@ func unmarshal(v *confmap.Conf, factories Factories) (*configSettings, error) {
 		Connectors: configunmarshaler.NewConfigs(factories.Connectors),
 		Extensions: configunmarshaler.NewConfigs(factories.Extensions),
 		// TODO: Add a component.ServiceFactory to allow this to be defined by the Service.
-		Service: service.Config{
-			Telemetry: telemetry.Config{
-				Logs: telemetry.LogsConfig{
-					Level:       zapcore.InfoLevel,
-					Development: false,
-					Encoding:    "console",
-					Sampling: &telemetry.LogsSamplingConfig{
-						Enabled:    true,
-						Tick:       10 * time.Second,
-						Initial:    10,
-						Thereafter: 100,
-					},
-					OutputPaths:       []string{"stderr"},
-					ErrorOutputPaths:  []string{"stderr"},
-					DisableCaller:     false,
-					DisableStacktrace: false,
-					InitialFields:     map[string]any(nil),
-				},
-				Metrics: telemetry.MetricsConfig{
-					Level:   configtelemetry.LevelBasic,
-					Address: ":8888",
+		Service: defaultServiceConfig(),
+	}
+
+	return cfg, v.Unmarshal(&cfg)
+}
+
+// defaultServiceConfig returns the default configuration of the service.
+func defaultServiceConfig() service.Config {
+	return service.Config{
+		Telemetry: telemetry.Config{
+			Logs: telemetry.LogsConfig{
+				Level:       zapcore.InfoLevel,
+				Development: false,
+				Encoding:    "console",
+				Sampling: &telemetry.LogsSamplingConfig{
+					Enabled:    true,
+					Tick:       10 * time.Second,
+					Initial:    10,
+					Thereafter: 100,
 				},
+				OutputPaths:       []string{"stderr"},
+				ErrorOutputPaths:  []string{"stderr"},
+				DisableCaller:     false,
+				DisableStacktrace: false,
+				InitialFields:     map[string]any(nil),
+			},
+			Metrics: telemetry.MetricsConfig{
+				Level:   configtelemetry.LevelBasic,
+				Address: ":8888",
+			},
+			CrashReports: telemetry.CrashReportsConfig{
+				LogEntries: 100,
 			},
 		},
 	}
-
-	return cfg, v.Unmarshal(&cfg)
 }
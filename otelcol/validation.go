@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/receiver"
+)
+
+// Categories of the validation findings.
+const (
+	// categoryConfig is for the findings about the structure of the configuration,
+	// e.g. a pipeline referencing a component which is not configured.
+	categoryConfig = "config"
+	// categoryComponent is for the errors returned by the validation of a component configuration.
+	categoryComponent = "component"
+	// categoryPipeline is for the components used in pipelines of a data type they do not support.
+	categoryPipeline = "pipeline"
+	// categoryConnector is for the connectors not used in a supported pair of pipelines.
+	categoryConnector = "connector"
+)
+
+// validationFinding describes an issue of the configuration. Its fields are serialized
+// to JSON by the validate command for tooling.
+type validationFinding struct {
+	// Category is the category of the finding, e.g. "component".
+	Category string `json:"category"`
+	// Path is the path of the configuration the finding applies to, e.g. "receivers::otlp".
+	Path string `json:"path"`
+	// Message describes the issue.
+	Message string `json:"message"`
+
+	err error
+}
+
+func (f validationFinding) String() string {
+	return fmt.Sprintf("[%s] %s: %s", f.Category, f.Path, f.Message)
+}
+
+// newFinding returns a finding for the given error, whose message is expected
+// to be prefixed by the path.
+func newFinding(category string, path string, err error) validationFinding {
+	return validationFinding{
+		Category: category,
+		Path:     path,
+		Message:  strings.TrimPrefix(err.Error(), path+": "),
+		err:      err,
+	}
+}
+
+// newComponentFinding returns a finding for the error returned by the validation
+// of the configuration of a component of the given kind, e.g. "receivers".
+func newComponentFinding(kind string, id component.ID, err error) validationFinding {
+	path := kind + "::" + id.String()
+	return validationFinding{
+		Category: categoryComponent,
+		Path:     path,
+		Message:  err.Error(),
+		err:      fmt.Errorf("%s: %w", path, err),
+	}
+}
+
+// deepFindings returns all the findings of the configuration: the ones of the basic validation
+// and, when it passes, the components used in pipelines of a data type they do not support
+// and the connectors which are not used in a supported pair of pipelines, as the service would
+// fail to build them.
+func deepFindings(cfg *Config, factories Factories) []validationFinding {
+	findings := cfg.findings()
+	if len(findings) > 0 {
+		return findings
+	}
+
+	connectorsAsExporter := make(map[component.ID][]component.ID)
+	connectorsAsReceiver := make(map[component.ID][]component.ID)
+	for _, pipelineID := range sortedIDs(cfg.Service.Pipelines) {
+		pipeline := cfg.Service.Pipelines[pipelineID]
+		path := "service::pipelines::" + pipelineID.String()
+		dt := pipelineID.Type()
+		for _, ref := range pipeline.Receivers {
+			if _, ok := cfg.Connectors[ref]; ok {
+				connectorsAsReceiver[ref] = append(connectorsAsReceiver[ref], pipelineID)
+				continue
+			}
+			if f, ok := factories.Receivers[ref.Type()]; ok && receiverStability(f, dt) == component.StabilityLevelUndefined {
+				findings = append(findings, newFinding(categoryPipeline, path,
+					fmt.Errorf("receiver %q does not support %s", ref, dt)))
+			}
+		}
+		for _, ref := range pipeline.Processors {
+			if f, ok := factories.Processors[ref.Type()]; ok && processorStability(f, dt) == component.StabilityLevelUndefined {
+				findings = append(findings, newFinding(categoryPipeline, path,
+					fmt.Errorf("processor %q does not support %s", ref, dt)))
+			}
+		}
+		for _, ref := range pipeline.Exporters {
+			if _, ok := cfg.Connectors[ref]; ok {
+				connectorsAsExporter[ref] = append(connectorsAsExporter[ref], pipelineID)
+				continue
+			}
+			if f, ok := factories.Exporters[ref.Type()]; ok && exporterStability(f, dt) == component.StabilityLevelUndefined {
+				findings = append(findings, newFinding(categoryPipeline, path,
+					fmt.Errorf("exporter %q does not support %s", ref, dt)))
+			}
+		}
+	}
+
+	for _, connID := range sortedIDs(cfg.Connectors) {
+		f, ok := factories.Connectors[connID.Type()]
+		if !ok {
+			continue
+		}
+		findings = append(findings, connectorFindings(f, connID, connectorsAsExporter[connID], connectorsAsReceiver[connID])...)
+	}
+	return findings
+}
+
+// connectorFindings returns the findings of a connector used as exporter and as receiver in
+// the given pipelines: each use must be paired with a supported use of the other side, as
+// required by the service to build the pipelines.
+func connectorFindings(f connector.Factory, connID component.ID, asExporter, asReceiver []component.ID) []validationFinding {
+	path := "connectors::" + connID.String()
+	var expTypes, recTypes []component.DataType
+	for _, pipelineID := range asExporter {
+		expTypes = appendDataType(expTypes, pipelineID.Type())
+	}
+	for _, pipelineID := range asReceiver {
+		recTypes = appendDataType(recTypes, pipelineID.Type())
+	}
+
+	var findings []validationFinding
+	for _, expType := range expTypes {
+		supported := false
+		for _, recType := range recTypes {
+			supported = supported || connectorStability(f, expType, recType) != component.StabilityLevelUndefined
+		}
+		if !supported {
+			findings = append(findings, newFinding(categoryConnector, path, fmt.Errorf(
+				"connector %q used as exporter in %s pipeline but not used in any supported receiver pipeline", connID, expType)))
+		}
+	}
+	for _, recType := range recTypes {
+		supported := false
+		for _, expType := range expTypes {
+			supported = supported || connectorStability(f, expType, recType) != component.StabilityLevelUndefined
+		}
+		if !supported {
+			findings = append(findings, newFinding(categoryConnector, path, fmt.Errorf(
+				"connector %q used as receiver in %s pipeline but not used in any supported exporter pipeline", connID, recType)))
+		}
+	}
+	return findings
+}
+
+func appendDataType(types []component.DataType, dt component.DataType) []component.DataType {
+	for _, t := range types {
+		if t == dt {
+			return types
+		}
+	}
+	return append(types, dt)
+}
+
+// sortedIDs returns the keys of the map sorted, so that the findings are deterministic.
+func sortedIDs[V any](m map[component.ID]V) []component.ID {
+	ids := make([]component.ID, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+	return ids
+}
+
+func receiverStability(f receiver.Factory, dt component.DataType) component.StabilityLevel {
+	switch dt {
+	case component.DataTypeTraces:
+		return f.TracesReceiverStability()
+	case component.DataTypeMetrics:
+		return f.MetricsReceiverStability()
+	case component.DataTypeLogs:
+		return f.LogsReceiverStability()
+	case component.DataTypeProfiles:
+		return f.ProfilesReceiverStability()
+	}
+	return component.StabilityLevelUndefined
+}
+
+func processorStability(f processor.Factory, dt component.DataType) component.StabilityLevel {
+	switch dt {
+	case component.DataTypeTraces:
+		return f.TracesProcessorStability()
+	case component.DataTypeMetrics:
+		return f.MetricsProcessorStability()
+	case component.DataTypeLogs:
+		return f.LogsProcessorStability()
+	case component.DataTypeProfiles:
+		return f.ProfilesProcessorStability()
+	}
+	return component.StabilityLevelUndefined
+}
+
+func exporterStability(f exporter.Factory, dt component.DataType) component.StabilityLevel {
+	switch dt {
+	case component.DataTypeTraces:
+		return f.TracesExporterStability()
+	case component.DataTypeMetrics:
+		return f.MetricsExporterStability()
+	case component.DataTypeLogs:
+		return f.LogsExporterStability()
+	case component.DataTypeProfiles:
+		return f.ProfilesExporterStability()
+	}
+	return component.StabilityLevelUndefined
+}
+
+func connectorStability(f connector.Factory, expType, recType component.DataType) component.StabilityLevel {
+	switch expType {
+	case component.DataTypeTraces:
+		switch recType {
+		case component.DataTypeTraces:
+			return f.TracesToTracesStability()
+		case component.DataTypeMetrics:
+			return f.TracesToMetricsStability()
+		case component.DataTypeLogs:
+			return f.TracesToLogsStability()
+		}
+	case component.DataTypeMetrics:
+		switch recType {
+		case component.DataTypeTraces:
+			return f.MetricsToTracesStability()
+		case component.DataTypeMetrics:
+			return f.MetricsToMetricsStability()
+		case component.DataTypeLogs:
+			return f.MetricsToLogsStability()
+		}
+	case component.DataTypeLogs:
+		switch recType {
+		case component.DataTypeTraces:
+			return f.LogsToTracesStability()
+		case component.DataTypeMetrics:
+			return f.LogsToMetricsStability()
+		case component.DataTypeLogs:
+			return f.LogsToLogsStability()
+		}
+	}
+	return component.StabilityLevelUndefined
+}
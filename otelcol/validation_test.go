@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/service/pipelines"
+)
+
+func TestDeepFindingsComponents(t *testing.T) {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	cfg := generateConfig()
+	cfg.Receivers[component.NewID("nop")] = &errConfig{validateErr: errInvalidRecvConfig}
+	cfg.Exporters[component.NewID("nop")] = &errConfig{validateErr: errInvalidExpConfig}
+
+	// All the invalid components are reported, not only the first one.
+	assert.Equal(t, []validationFinding{
+		{Category: categoryComponent, Path: "receivers::nop", Message: "invalid receiver config", err: cfg.findings()[0].err},
+		{Category: categoryComponent, Path: "exporters::nop", Message: "invalid exporter config", err: cfg.findings()[1].err},
+	}, deepFindings(cfg, factories))
+	assert.EqualError(t, cfg.Validate(), "receivers::nop: invalid receiver config")
+}
+
+// tracesOnlyFactories returns the nop factories, with a "tracesonly" receiver and connector
+// supporting only traces.
+func tracesOnlyFactories() (Factories, error) {
+	factories, err := nopFactories()
+	if err != nil {
+		return Factories{}, err
+	}
+	createDefaultConfig := func() component.Config { return &struct{}{} }
+	factories.Receivers["tracesonly"] = receiver.NewFactory("tracesonly", createDefaultConfig,
+		receiver.WithTraces(func(context.Context, receiver.CreateSettings, component.Config, consumer.Traces) (receiver.Traces, error) {
+			return nil, nil
+		}, component.StabilityLevelStable))
+	factories.Connectors["tracesonly"] = connector.NewFactory("tracesonly", createDefaultConfig,
+		connector.WithTracesToTraces(func(context.Context, connector.CreateSettings, component.Config, consumer.Traces) (connector.Traces, error) {
+			return nil, nil
+		}, component.StabilityLevelStable))
+	return factories, nil
+}
+
+func TestDeepFindingsPipelines(t *testing.T) {
+	factories, err := tracesOnlyFactories()
+	require.NoError(t, err)
+
+	cfg := generateConfig()
+	cfg.Receivers[component.NewID("tracesonly")] = &struct{}{}
+	cfg.Connectors[component.NewIDWithName("tracesonly", "conn")] = &struct{}{}
+	cfg.Service.Pipelines = pipelines.Config{
+		component.NewID("logs"): {
+			Receivers: []component.ID{component.NewIDWithName("tracesonly", "conn")},
+			Exporters: []component.ID{component.NewIDWithName("tracesonly", "conn")},
+		},
+		component.NewID("metrics"): {
+			Receivers: []component.ID{component.NewID("tracesonly")},
+			Exporters: []component.ID{component.NewID("nop")},
+		},
+		component.NewID("traces"): {
+			Receivers: []component.ID{component.NewID("nop")},
+			Exporters: []component.ID{component.NewID("nop")},
+		},
+	}
+
+	findings := deepFindings(cfg, factories)
+	require.Len(t, findings, 3)
+	assert.Equal(t, `[pipeline] service::pipelines::metrics: receiver "tracesonly" does not support metrics`, findings[0].String())
+	assert.Equal(t, `[connector] connectors::tracesonly/conn: connector "tracesonly/conn" used as exporter in logs pipeline but not used in any supported receiver pipeline`, findings[1].String())
+	assert.Equal(t, `[connector] connectors::tracesonly/conn: connector "tracesonly/conn" used as receiver in logs pipeline but not used in any supported exporter pipeline`, findings[2].String())
+	// The basic validation does not require the factories.
+	assert.NoError(t, cfg.Validate())
+}
@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package identity computes stable hashes identifying resources, instrumentation scopes, metric streams and spans,
+// so that components deduplicating, sharding or counting them agree on their identity.
+package identity // import "go.opentelemetry.io/collector/pdata/identity"
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"hash/fnv"
+	"math"
+	"sort"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// Hash identifies a pdata entity. It only depends on the identifying content of the entity, e.g. it does not
+// depend on the order of the attributes, and is stable across processes and versions.
+type Hash [16]byte
+
+// String returns the hex representation of the Hash.
+func (h Hash) String() string {
+	return hex.EncodeToString(h[:])
+}
+
+// Resource returns the Hash identifying the resource, computed from its attributes.
+func Resource(res pcommon.Resource) Hash {
+	h := newHasher()
+	h.writeMap(res.Attributes())
+	return h.sum()
+}
+
+// Scope returns the Hash identifying the instrumentation scope, computed from its name, version and attributes.
+func Scope(scope pcommon.InstrumentationScope) Hash {
+	h := newHasher()
+	h.writeScope(scope)
+	return h.sum()
+}
+
+// Stream returns the Hash identifying a metric stream, computed from its resource, its instrumentation scope,
+// the name, unit, type, aggregation temporality and monotonicity of the metric and the attributes of its data point.
+func Stream(res pcommon.Resource, scope pcommon.InstrumentationScope, metric pmetric.Metric, attrs pcommon.Map) Hash {
+	h := newHasher()
+	h.writeMap(res.Attributes())
+	h.writeScope(scope)
+	h.writeString(metric.Name())
+	h.writeString(metric.Unit())
+	h.writeUint64(uint64(metric.Type()))
+	switch metric.Type() {
+	case pmetric.MetricTypeSum:
+		h.writeUint64(uint64(metric.Sum().AggregationTemporality()))
+		h.writeBool(metric.Sum().IsMonotonic())
+	case pmetric.MetricTypeHistogram:
+		h.writeUint64(uint64(metric.Histogram().AggregationTemporality()))
+	case pmetric.MetricTypeExponentialHistogram:
+		h.writeUint64(uint64(metric.ExponentialHistogram().AggregationTemporality()))
+	}
+	h.writeMap(attrs)
+	return h.sum()
+}
+
+// Span returns the Hash identifying the span, computed from its trace and span IDs.
+func Span(span ptrace.Span) Hash {
+	h := newHasher()
+	traceID, spanID := span.TraceID(), span.SpanID()
+	h.write(traceID[:])
+	h.write(spanID[:])
+	return h.sum()
+}
+
+type hasher struct {
+	hash.Hash
+	buf [8]byte
+}
+
+func newHasher() *hasher {
+	return &hasher{Hash: fnv.New128a()}
+}
+
+func (h *hasher) sum() Hash {
+	var sum Hash
+	h.Sum(sum[:0])
+	return sum
+}
+
+func (h *hasher) write(b []byte) {
+	// hash.Hash never returns an error.
+	_, _ = h.Write(b)
+}
+
+func (h *hasher) writeUint64(v uint64) {
+	binary.LittleEndian.PutUint64(h.buf[:], v)
+	h.write(h.buf[:])
+}
+
+func (h *hasher) writeBool(v bool) {
+	if v {
+		h.writeUint64(1)
+	} else {
+		h.writeUint64(0)
+	}
+}
+
+// writeString writes the length of the string before its content, so that consecutive strings can't collide.
+func (h *hasher) writeString(s string) {
+	h.writeUint64(uint64(len(s)))
+	h.write([]byte(s))
+}
+
+func (h *hasher) writeScope(scope pcommon.InstrumentationScope) {
+	h.writeString(scope.Name())
+	h.writeString(scope.Version())
+	h.writeMap(scope.Attributes())
+}
+
+// writeMap writes the entries of the map sorted by key.
+func (h *hasher) writeMap(m pcommon.Map) {
+	keys := make([]string, 0, m.Len())
+	m.Range(func(k string, _ pcommon.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Strings(keys)
+	h.writeUint64(uint64(len(keys)))
+	for _, k := range keys {
+		v, _ := m.Get(k)
+		h.writeString(k)
+		h.writeValue(v)
+	}
+}
+
+func (h *hasher) writeValue(v pcommon.Value) {
+	h.writeUint64(uint64(v.Type()))
+	switch v.Type() {
+	case pcommon.ValueTypeStr:
+		h.writeString(v.Str())
+	case pcommon.ValueTypeInt:
+		h.writeUint64(uint64(v.Int()))
+	case pcommon.ValueTypeDouble:
+		h.writeUint64(math.Float64bits(v.Double()))
+	case pcommon.ValueTypeBool:
+		h.writeBool(v.Bool())
+	case pcommon.ValueTypeBytes:
+		h.writeUint64(uint64(v.Bytes().Len()))
+		h.write(v.Bytes().AsRaw())
+	case pcommon.ValueTypeMap:
+		h.writeMap(v.Map())
+	case pcommon.ValueTypeSlice:
+		h.writeUint64(uint64(v.Slice().Len()))
+		for i := 0; i < v.Slice().Len(); i++ {
+			h.writeValue(v.Slice().At(i))
+		}
+	}
+}
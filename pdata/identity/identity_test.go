@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package identity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestResource(t *testing.T) {
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("service.name", "svc")
+	res.Attributes().PutInt("pid", 1)
+	res.Attributes().PutEmptySlice("list").FromRaw([]any{"a", 1.5, true, map[string]any{"k": "v"}})
+	// The hash must not change across versions, since it can be persisted or shared between collectors.
+	assert.Equal(t, "ebd574e860300e220cf38c21860dacb9", Resource(res).String())
+
+	reordered := pcommon.NewResource()
+	reordered.Attributes().PutEmptySlice("list").FromRaw([]any{"a", 1.5, true, map[string]any{"k": "v"}})
+	reordered.Attributes().PutInt("pid", 1)
+	reordered.Attributes().PutStr("service.name", "svc")
+	reordered.SetDroppedAttributesCount(1)
+	assert.Equal(t, Resource(res), Resource(reordered))
+
+	reordered.Attributes().PutStr("pid", "1")
+	assert.NotEqual(t, Resource(res), Resource(reordered))
+}
+
+func TestScope(t *testing.T) {
+	scope := pcommon.NewInstrumentationScope()
+	scope.SetName("name")
+	scope.SetVersion("version")
+	other := pcommon.NewInstrumentationScope()
+	other.SetName("nameversion")
+	assert.NotEqual(t, Scope(scope), Scope(other))
+
+	scope.CopyTo(other)
+	assert.Equal(t, Scope(scope), Scope(other))
+	other.Attributes().PutStr("key", "value")
+	assert.NotEqual(t, Scope(scope), Scope(other))
+}
+
+func TestStream(t *testing.T) {
+	res := pcommon.NewResource()
+	scope := pcommon.NewInstrumentationScope()
+	metric := pmetric.NewMetric()
+	metric.SetName("requests")
+	metric.SetDescription("description")
+	sum := metric.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	attrs := pcommon.NewMap()
+	attrs.PutStr("path", "/")
+	hash := Stream(res, scope, metric, attrs)
+
+	other := pmetric.NewMetric()
+	metric.CopyTo(other)
+	other.SetDescription("other description")
+	other.Sum().DataPoints().AppendEmpty().SetIntValue(1)
+	assert.Equal(t, hash, Stream(res, scope, other, attrs))
+
+	other.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	assert.NotEqual(t, hash, Stream(res, scope, other, attrs))
+
+	other.SetEmptyGauge()
+	assert.NotEqual(t, hash, Stream(res, scope, other, attrs))
+
+	assert.NotEqual(t, hash, Stream(res, scope, metric, pcommon.NewMap()))
+	res.Attributes().PutStr("service.name", "svc")
+	assert.NotEqual(t, hash, Stream(res, scope, metric, attrs))
+}
+
+func TestSpan(t *testing.T) {
+	span := ptrace.NewSpan()
+	span.SetTraceID([16]byte{1})
+	span.SetSpanID([8]byte{2})
+	other := ptrace.NewSpan()
+	span.CopyTo(other)
+	other.SetName("name")
+	assert.Equal(t, Span(span), Span(other))
+
+	other.SetSpanID([8]byte{3})
+	assert.NotEqual(t, Span(span), Span(other))
+}
@@ -66,13 +66,13 @@ const accessorsMessageValueTestTemplate = `func Test{{ .structName }}_{{ .fieldN
 
 const accessorsPrimitiveTemplate = `// {{ .fieldName }} returns the {{ .lowerFieldName }} associated with this {{ .structName }}.
 func (ms {{ .structName }}) {{ .fieldName }}() {{ .packageName }}{{ .returnType }} {
-	return ms.{{ .origAccessor }}.{{ .fieldName }}
+	return ms.{{ .origAccessor }}.{{ .originFieldName }}
 }
 
 // Set{{ .fieldName }} replaces the {{ .lowerFieldName }} associated with this {{ .structName }}.
 func (ms {{ .structName }}) Set{{ .fieldName }}(v {{ .returnType }}) {
 	ms.{{ .stateAccessor }}.AssertMutable()
-	ms.{{ .origAccessor }}.{{ .fieldName }} = v
+	ms.{{ .origAccessor }}.{{ .originFieldName }} = v
 }`
 
 const accessorsPrimitiveSliceTemplate = `// {{ .fieldName }} returns the {{ .lowerFieldName }} associated with this {{ .structName }}.
@@ -366,10 +366,11 @@ func (mf *messageValueField) templateFields(ms *messageValueStruct) map[string]a
 var _ baseField = (*messageValueField)(nil)
 
 type primitiveField struct {
-	fieldName  string
-	returnType string
-	defaultVal string
-	testVal    string
+	fieldName       string
+	originFieldName string
+	returnType      string
+	defaultVal      string
+	testVal         string
 }
 
 func (pf *primitiveField) GenerateAccessors(ms *messageValueStruct) string {
@@ -391,7 +392,7 @@ func (pf *primitiveField) GenerateAccessorsTest(ms *messageValueStruct) string {
 }
 
 func (pf *primitiveField) GenerateSetWithTestValue(_ *messageValueStruct) string {
-	return "\ttv.orig." + pf.fieldName + " = " + pf.testVal
+	return "\ttv.orig." + pf.getOriginFieldName() + " = " + pf.testVal
 }
 
 func (pf *primitiveField) GenerateCopyToValue(_ *messageValueStruct) string {
@@ -410,7 +411,15 @@ func (pf *primitiveField) templateFields(ms *messageValueStruct) map[string]any
 		"origAccessor":     origAccessor(ms),
 		"stateAccessor":    stateAccessor(ms),
 		"originStructName": ms.originFullName,
+		"originFieldName":  pf.getOriginFieldName(),
+	}
+}
+
+func (pf *primitiveField) getOriginFieldName() string {
+	if pf.originFieldName == "" {
+		return pf.fieldName
 	}
+	return pf.originFieldName
 }
 
 var _ baseField = (*primitiveField)(nil)
@@ -81,6 +81,31 @@ func (es {{ .structName }}) AppendEmpty() {{ .elementName }} {
 	return es.At(es.Len() - 1)
 }
 
+// AppendEmptyN will append to the end of the slice n empty {{ .elementName }}
+{{- if eq .type "sliceOfPtrs" }}, allocated together{{ end }}.
+// If fn is not nil, it is called with each newly added {{ .elementName }} and its index among them, e.g. to
+// fill them from a native Go slice:
+//   es.AppendEmptyN(len(items), func(i int, e {{ .elementName }}) {
+//       // Here should set all the values for e from items[i].
+//   })
+func (es {{ .structName }}) AppendEmptyN(n int, fn func(int, {{ .elementName }})) {
+	es.state.AssertMutable()
+	oldLen := len(*es.orig)
+	*es.orig = append(*es.orig, make([]{{ .originElementType }}, n)...)
+	{{- if eq .type "sliceOfPtrs" }}
+	elems := make([]{{ .originName }}, n)
+	for i := range elems {
+		(*es.orig)[oldLen+i] = &elems[i]
+	}
+	{{- end }}
+	if fn == nil {
+		return
+	}
+	for i := 0; i < n; i++ {
+		fn(i, es.At(oldLen+i))
+	}
+}
+
 // MoveAndAppendTo moves all elements from the current slice and appends them to the dest.
 // The current slice will be cleared.
 func (es {{ .structName }}) MoveAndAppendTo(dest {{ .structName }}) {
@@ -181,6 +206,7 @@ func Test{{ .structName }}ReadOnly(t *testing.T) {
 	es := new{{ .structName }}(&[]{{ .originElementType }}{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := New{{ .structName }}()
 	es.CopyTo(es2)
@@ -222,6 +248,25 @@ func Test{{ .structName }}_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTest{{ .structName }}(), es)
 }
 
+func Test{{ .structName }}_AppendEmptyN(t *testing.T) {
+	es := generateTest{{ .structName }}()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el {{ .elementName }}) {
+		indexes = append(indexes, i)
+		fillTest{{ .elementName }}(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTest{{ .elementName }}(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, New{{ .elementName }}(), es.At(es.Len()-1))
+}
+
 func Test{{ .structName }}_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTest{{ .structName }}()
@@ -23,6 +23,8 @@ var AllPackages = []*Package{
 	plogotlp,
 	pmetric,
 	pmetricotlp,
+	pprofile,
+	pprofileotlp,
 	ptrace,
 	ptraceotlp,
 }
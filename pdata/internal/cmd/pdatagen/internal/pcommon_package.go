@@ -24,6 +24,8 @@ var pcommon = &Package{
 		byteSlice,
 		float64Slice,
 		uInt64Slice,
+		int64Slice,
+		stringSlice,
 	},
 }
 
@@ -180,3 +182,16 @@ var uInt64Slice = &primitiveSliceStruct{
 	packageName: "pcommon",
 	itemType:    "uint64",
 }
+
+var int64Slice = &primitiveSliceStruct{
+	structName:  "Int64Slice",
+	packageName: "pcommon",
+	itemType:    "int64",
+}
+
+var stringSlice = &primitiveSliceStruct{
+	structName:  "StringSlice",
+	packageName: "pcommon",
+	itemType:    "string",
+	testVals:    []string{`"a"`, `"b"`, `"c"`, `"d"`, `"e"`},
+}
@@ -5,6 +5,7 @@ package internal // import "go.opentelemetry.io/collector/pdata/internal/cmd/pda
 
 import (
 	"bytes"
+	"strconv"
 	"strings"
 	"text/template"
 )
@@ -109,42 +110,42 @@ func copy{{ .structName }}(dst, src []{{ .itemType }}) []{{ .itemType }} {
 const immutableSliceTestTemplate = `func TestNew{{ .structName }}(t *testing.T) {
 	ms := New{{ .structName }}()
 	assert.Equal(t, 0, ms.Len())
-	ms.FromRaw([]{{ .itemType }}{1, 2, 3})
+	ms.FromRaw([]{{ .itemType }}{ {{- .testVal1 }}, {{ .testVal2 }}, {{ .testVal3 }}})
 	assert.Equal(t, 3, ms.Len())
-	assert.Equal(t, []{{ .itemType }}{1, 2, 3}, ms.AsRaw())
-	ms.SetAt(1, {{ .itemType }}(5))
-	assert.Equal(t, []{{ .itemType }}{1, 5, 3}, ms.AsRaw())
-	ms.FromRaw([]{{ .itemType }}{3})
+	assert.Equal(t, []{{ .itemType }}{ {{- .testVal1 }}, {{ .testVal2 }}, {{ .testVal3 }}}, ms.AsRaw())
+	ms.SetAt(1, {{ .itemType }}({{ .testVal5 }}))
+	assert.Equal(t, []{{ .itemType }}{ {{- .testVal1 }}, {{ .testVal5 }}, {{ .testVal3 }}}, ms.AsRaw())
+	ms.FromRaw([]{{ .itemType }}{ {{- .testVal3 }}})
 	assert.Equal(t, 1, ms.Len())
-	assert.Equal(t, {{ .itemType }}(3), ms.At(0))
+	assert.Equal(t, {{ .itemType }}({{ .testVal3 }}), ms.At(0))
 	
 	cp := New{{ .structName }}()
 	ms.CopyTo(cp)
-	ms.SetAt(0, {{ .itemType }}(2))
-	assert.Equal(t, {{ .itemType }}(2), ms.At(0))
-	assert.Equal(t, {{ .itemType }}(3), cp.At(0))
+	ms.SetAt(0, {{ .itemType }}({{ .testVal2 }}))
+	assert.Equal(t, {{ .itemType }}({{ .testVal2 }}), ms.At(0))
+	assert.Equal(t, {{ .itemType }}({{ .testVal3 }}), cp.At(0))
 	ms.CopyTo(cp)
-	assert.Equal(t, {{ .itemType }}(2), cp.At(0))
+	assert.Equal(t, {{ .itemType }}({{ .testVal2 }}), cp.At(0))
 	
 	mv := New{{ .structName }}()
 	ms.MoveTo(mv)
 	assert.Equal(t, 0, ms.Len())
 	assert.Equal(t, 1, mv.Len())
-	assert.Equal(t, {{ .itemType }}(2), mv.At(0))
-	ms.FromRaw([]{{ .itemType }}{1, 2, 3})
+	assert.Equal(t, {{ .itemType }}({{ .testVal2 }}), mv.At(0))
+	ms.FromRaw([]{{ .itemType }}{ {{- .testVal1 }}, {{ .testVal2 }}, {{ .testVal3 }}})
 	ms.MoveTo(mv)
 	assert.Equal(t, 3, mv.Len())
-	assert.Equal(t, {{ .itemType }}(1), mv.At(0))
+	assert.Equal(t, {{ .itemType }}({{ .testVal1 }}), mv.At(0))
 }
 
 func Test{{ .structName }}ReadOnly(t *testing.T) {
-	raw := []{{ .itemType }}{1, 2, 3}
+	raw := []{{ .itemType }}{ {{- .testVal1 }}, {{ .testVal2 }}, {{ .testVal3 }}}
 	state := internal.StateReadOnly
 	ms := {{ .structName }}(internal.New{{ .structName }}(&raw, &state))
 
 	assert.Equal(t, 3, ms.Len())
-	assert.Equal(t, {{ .itemType }}(1), ms.At(0))
-	assert.Panics(t, func() { ms.Append(1) })
+	assert.Equal(t, {{ .itemType }}({{ .testVal1 }}), ms.At(0))
+	assert.Panics(t, func() { ms.Append({{ .testVal1 }}) })
 	assert.Panics(t, func() { ms.EnsureCapacity(2) })
 	assert.Equal(t, raw, ms.AsRaw())
 	assert.Panics(t, func() { ms.FromRaw(raw) })
@@ -160,10 +161,10 @@ func Test{{ .structName }}ReadOnly(t *testing.T) {
 
 func Test{{ .structName }}Append(t *testing.T) {
 	ms := New{{ .structName }}()
-	ms.FromRaw([]{{ .itemType }}{1, 2, 3})
-	ms.Append(4, 5)
+	ms.FromRaw([]{{ .itemType }}{ {{- .testVal1 }}, {{ .testVal2 }}, {{ .testVal3 }}})
+	ms.Append({{ .testVal4 }}, {{ .testVal5 }})
 	assert.Equal(t, 5, ms.Len())
-	assert.Equal(t, {{ .itemType }}(5), ms.At(4))
+	assert.Equal(t, {{ .itemType }}({{ .testVal5 }}), ms.At(4))
 }
 
 func Test{{ .structName }}EnsureCapacity(t *testing.T) {
@@ -198,6 +199,9 @@ type primitiveSliceStruct struct {
 	structName  string
 	packageName string
 	itemType    string
+
+	// testVals are the five distinct item values used by the tests, defaults to 1 to 5.
+	testVals []string
 }
 
 func (iss *primitiveSliceStruct) getName() string {
@@ -232,9 +236,17 @@ func (iss *primitiveSliceStruct) generateInternal(sb *bytes.Buffer) {
 }
 
 func (iss *primitiveSliceStruct) templateFields() map[string]any {
-	return map[string]any{
+	fields := map[string]any{
 		"structName":      iss.structName,
 		"itemType":        iss.itemType,
 		"lowerStructName": strings.ToLower(iss.structName[:1]) + iss.structName[1:],
 	}
+	testVals := iss.testVals
+	if testVals == nil {
+		testVals = []string{"1", "2", "3", "4", "5"}
+	}
+	for i, v := range testVals {
+		fields["testVal"+strconv.Itoa(i+1)] = v
+	}
+	return fields
 }
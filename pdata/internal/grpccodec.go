@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/collector/pdata/internal"
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/proto"
+)
+
+// EncodedProto is a message which is already encoded as protobuf, sent as is by the gRPC clients
+// using EncodedProtoCodec, so that the retained encoding of a request is not decoded to be sent.
+type EncodedProto []byte
+
+// EncodedProtoCodec is the call option sending EncodedProto messages as is. The other messages,
+// like the responses, are handled by the default proto codec.
+var EncodedProtoCodec = grpc.ForceCodec(encodedProtoCodec{})
+
+type encodedProtoCodec struct{}
+
+func (encodedProtoCodec) Marshal(v any) ([]byte, error) {
+	if buf, ok := v.(EncodedProto); ok {
+		return buf, nil
+	}
+	return encoding.GetCodec(proto.Name).Marshal(v)
+}
+
+func (encodedProtoCodec) Unmarshal(data []byte, v any) error {
+	return encoding.GetCodec(proto.Name).Unmarshal(data, v)
+}
+
+func (encodedProtoCodec) Name() string {
+	return proto.Name
+}
@@ -4,12 +4,22 @@
 package json // import "go.opentelemetry.io/collector/pdata/internal/json"
 
 import (
+	"bytes"
 	"io"
+	"sync"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
+	jsoniter "github.com/json-iterator/go"
 )
 
+// readBufferSize is the size of the buffer used by the iterators reading from an io.Reader.
+const readBufferSize = 4096
+
+// maxPooledBufferSize is the capacity above which the marshal buffers are not returned to the pool,
+// so that a single large payload does not pin a large amount of memory.
+const maxPooledBufferSize = 1 << 20
+
 var marshaler = &jsonpb.Marshaler{
 	// https://github.com/open-telemetry/opentelemetry-specification/pull/2758
 	EnumsAsInts: true,
@@ -17,6 +27,52 @@ var marshaler = &jsonpb.Marshaler{
 	OrigName: false,
 }
 
+var bufferPool = sync.Pool{
+	New: func() any {
+		return new(bytes.Buffer)
+	},
+}
+
+var iteratorPool = sync.Pool{
+	New: func() any {
+		return jsoniter.Parse(jsoniter.ConfigFastest, nil, readBufferSize)
+	},
+}
+
 func Marshal(out io.Writer, pb proto.Message) error {
 	return marshaler.Marshal(out, pb)
 }
+
+// MarshalBytes marshals pb using a pooled buffer. The returned bytes are owned by the caller, so they are
+// still copied out of the buffer: the pool only saves growing the buffer while marshaling, see
+// BenchmarkMarshalBytes. Use Marshal to write large payloads without buffering them.
+func MarshalBytes(pb proto.Message) ([]byte, error) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	defer func() {
+		if buf.Cap() <= maxPooledBufferSize {
+			buf.Reset()
+			bufferPool.Put(buf)
+		}
+	}()
+	if err := marshaler.Marshal(buf, pb); err != nil {
+		return nil, err
+	}
+	out := make([]byte, buf.Len())
+	copy(out, buf.Bytes())
+	return out, nil
+}
+
+// BorrowIterator returns a pooled iterator reading from r, it must be returned
+// with ReturnIterator once the reading is done.
+func BorrowIterator(r io.Reader) *jsoniter.Iterator {
+	iter := iteratorPool.Get().(*jsoniter.Iterator)
+	return iter.Reset(r)
+}
+
+// ReturnIterator returns to the pool an iterator obtained with BorrowIterator.
+func ReturnIterator(iter *jsoniter.Iterator) {
+	iter.Error = nil
+	iter.Attachment = nil
+	iter.Reset(nil)
+	iteratorPool.Put(iter)
+}
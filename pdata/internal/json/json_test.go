@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package json
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	otlpcollectortrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/trace/v1"
+	otlpcommon "go.opentelemetry.io/collector/pdata/internal/data/protogen/common/v1"
+	otlptrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/trace/v1"
+)
+
+func TestMarshalBytes(t *testing.T) {
+	first, err := MarshalBytes(&otlpcommon.InstrumentationScope{Name: "first"})
+	require.NoError(t, err)
+	second, err := MarshalBytes(&otlpcommon.InstrumentationScope{Name: "second"})
+	require.NoError(t, err)
+
+	// The returned bytes must not be shared with the pooled buffers.
+	assert.Equal(t, `{"name":"first"}`, string(first))
+	assert.Equal(t, `{"name":"second"}`, string(second))
+
+	var buf bytes.Buffer
+	require.NoError(t, Marshal(&buf, &otlpcommon.InstrumentationScope{Name: "first"}))
+	assert.Equal(t, string(first), buf.String())
+}
+
+func TestBorrowIterator(t *testing.T) {
+	iter := BorrowIterator(iotest.OneByteReader(strings.NewReader(`{"name":"` + strings.Repeat("a", 2*readBufferSize) + `"}`)))
+	var got string
+	iter.ReadObjectCB(func(iter *jsoniter.Iterator, f string) bool {
+		got = iter.ReadString()
+		return true
+	})
+	require.NoError(t, iter.Error)
+	assert.Equal(t, strings.Repeat("a", 2*readBufferSize), got)
+	ReturnIterator(iter)
+
+	iter = BorrowIterator(strings.NewReader(`{"name":`))
+	defer ReturnIterator(iter)
+	iter.ReadObjectCB(func(iter *jsoniter.Iterator, f string) bool {
+		iter.Skip()
+		return true
+	})
+	assert.Error(t, iter.Error)
+}
+
+func BenchmarkMarshalBytes(b *testing.B) {
+	req := &otlpcollectortrace.ExportTraceServiceRequest{
+		ResourceSpans: []*otlptrace.ResourceSpans{{ScopeSpans: []*otlptrace.ScopeSpans{{}}}},
+	}
+	for i := 0; i < 100; i++ {
+		req.ResourceSpans[0].ScopeSpans[0].Spans = append(req.ResourceSpans[0].ScopeSpans[0].Spans, &otlptrace.Span{
+			TraceId:           [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			SpanId:            [8]byte{1, 2, 3, 4, 5, 6, 7, 8},
+			Name:              "operation",
+			StartTimeUnixNano: 1,
+			EndTimeUnixNano:   2,
+			Attributes: []otlpcommon.KeyValue{
+				{Key: "http.method", Value: otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_StringValue{StringValue: "GET"}}},
+			},
+		})
+	}
+
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, err := MarshalBytes(req)
+			require.NoError(b, err)
+		}
+	})
+	// unpooled marshals as before the buffers were pooled.
+	b.Run("unpooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf := bytes.Buffer{}
+			require.NoError(b, Marshal(&buf, req))
+		}
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/collector/pdata/internal"
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// LazyProto holds the protobuf encoding of pmetric.Metrics, plog.Logs or ptrace.Traces until their content is first accessed.
+// As long as the content was not modified, the encoding can be reused to marshal the data again.
+// The zero value has nothing to decode.
+type LazyProto struct {
+	decoded atomic.Bool
+	mu      sync.Mutex
+	buf     []byte
+	count   int
+	decode  func([]byte)
+}
+
+// NewLazyProto returns a LazyProto holding buf, which contains count items (log records, spans or data points).
+// The decode func is called with buf the first time the content is accessed.
+func NewLazyProto(buf []byte, count int, decode func([]byte)) *LazyProto {
+	return &LazyProto{buf: buf, count: count, decode: decode}
+}
+
+// Decode decodes the content if it was not yet decoded. If the state is StateMutable the encoding is discarded,
+// since the caller may modify the content.
+func (lp *LazyProto) Decode(state *State) {
+	if lp == nil || lp.decoded.Load() {
+		return
+	}
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	if lp.decoded.Load() {
+		return
+	}
+	if lp.decode != nil {
+		lp.decode(lp.buf)
+	}
+	// A read-only content cannot be modified anymore, so its encoding stays valid.
+	if *state == StateMutable {
+		lp.buf = nil
+	}
+	lp.decoded.Store(true)
+}
+
+// Encoded returns the protobuf encoding of the content, if it is still valid.
+func (lp *LazyProto) Encoded() ([]byte, bool) {
+	if lp == nil {
+		return nil, false
+	}
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	return lp.buf, lp.buf != nil
+}
+
+// Count returns the number of items in the encoded content, if it is still valid.
+func (lp *LazyProto) Count() (int, bool) {
+	if lp == nil {
+		return 0, false
+	}
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	return lp.count, lp.buf != nil
+}
+
+// RangeMessages validates the framing of the protobuf encoded message in buf and calls fn with the number
+// and the encoding of each of its length-delimited fields.
+func RangeMessages(buf []byte, fn func(num protowire.Number, msg []byte) error) error {
+	for len(buf) > 0 {
+		num, typ, n := protowire.ConsumeTag(buf)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		buf = buf[n:]
+		n = protowire.ConsumeFieldValue(num, typ, buf)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		if typ == protowire.BytesType {
+			msg, _ := protowire.ConsumeBytes(buf)
+			if err := fn(num, msg); err != nil {
+				return err
+			}
+		}
+		buf = buf[n:]
+	}
+	return nil
+}
+
+// CountMessages validates the framing of the protobuf encoded message in buf and returns the number
+// of length-delimited fields with the given number.
+func CountMessages(buf []byte, num protowire.Number) (int, error) {
+	count := 0
+	err := RangeMessages(buf, func(n protowire.Number, _ []byte) error {
+		if n == num {
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+// CountResourceItems validates the framing of the OTLP encoded request in buf and returns its number of items,
+// using countItems to count the items of each scope. As in the otlp.Migrate functions, the deprecated scopes
+// of a resource are only used if it has no scopes.
+func CountResourceItems(buf []byte, countItems func(scope []byte) (int, error)) (int, error) {
+	count := 0
+	err := RangeMessages(buf, func(num protowire.Number, resource []byte) error {
+		if num != 1 {
+			return nil
+		}
+		scopes, items, err := countScopeItems(resource, 2, countItems)
+		if err == nil && scopes == 0 {
+			_, items, err = countScopeItems(resource, 1000, countItems)
+		}
+		count += items
+		return err
+	})
+	return count, err
+}
+
+func countScopeItems(resource []byte, scopeNum protowire.Number, countItems func(scope []byte) (int, error)) (int, int, error) {
+	scopes, items := 0, 0
+	err := RangeMessages(resource, func(num protowire.Number, scope []byte) error {
+		if num != scopeNum {
+			return nil
+		}
+		n, err := countItems(scope)
+		scopes++
+		items += n
+		return err
+	})
+	return scopes, items, err
+}
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestLazyProto(t *testing.T) {
+	decodes := 0
+	lazy := NewLazyProto([]byte{1}, 3, func([]byte) { decodes++ })
+	state := StateMutable
+	buf, ok := lazy.Encoded()
+	assert.True(t, ok)
+	assert.Equal(t, []byte{1}, buf)
+	count, ok := lazy.Count()
+	assert.True(t, ok)
+	assert.Equal(t, 3, count)
+
+	lazy.Decode(&state)
+	lazy.Decode(&state)
+	assert.Equal(t, 1, decodes)
+	_, ok = lazy.Encoded()
+	assert.False(t, ok)
+	_, ok = lazy.Count()
+	assert.False(t, ok)
+}
+
+func TestLazyProtoReadOnly(t *testing.T) {
+	decodes := 0
+	lazy := NewLazyProto([]byte{1}, 3, func([]byte) { decodes++ })
+	state := StateReadOnly
+	lazy.Decode(&state)
+	assert.Equal(t, 1, decodes)
+	buf, ok := lazy.Encoded()
+	assert.True(t, ok)
+	assert.Equal(t, []byte{1}, buf)
+}
+
+func TestLazyProtoNil(t *testing.T) {
+	var lazy *LazyProto
+	state := StateMutable
+	lazy.Decode(&state)
+	_, ok := lazy.Encoded()
+	assert.False(t, ok)
+	_, ok = lazy.Count()
+	assert.False(t, ok)
+}
+
+func TestCountResourceItems(t *testing.T) {
+	scope := appendMessage(appendMessage(nil, 2, nil), 2, nil)
+	// A resource with scopes, the deprecated scopes are ignored.
+	resource := appendMessage(appendMessage(nil, 2, scope), 1000, scope)
+	buf := appendMessage(nil, 1, resource)
+	// A resource with only deprecated scopes.
+	buf = appendMessage(buf, 1, appendMessage(appendMessage(nil, 1000, scope), 1000, scope))
+
+	count, err := CountResourceItems(buf, func(scope []byte) (int, error) {
+		return CountMessages(scope, 2)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 6, count)
+}
+
+func TestCountResourceItemsInvalid(t *testing.T) {
+	buf := appendMessage(nil, 1, appendMessage(nil, 2, []byte{0xff}))
+	_, err := CountResourceItems(buf, func(scope []byte) (int, error) {
+		return CountMessages(scope, 2)
+	})
+	assert.Error(t, err)
+
+	_, err = CountResourceItems(buf[:len(buf)-1], func(scope []byte) (int, error) {
+		return CountMessages(scope, 2)
+	})
+	assert.Error(t, err)
+}
+
+func appendMessage(buf []byte, num protowire.Number, msg []byte) []byte {
+	buf = protowire.AppendTag(buf, num, protowire.BytesType)
+	return protowire.AppendBytes(buf, msg)
+}
@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pdatacmp contains the helpers shared by the ptracetest, plogtest and pmetrictest packages
+// to report the differences between two pdata structures.
+package pdatacmp // import "go.opentelemetry.io/collector/pdata/internal/pdatacmp"
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// Slice is implemented by all the pdata slices.
+type Slice[E any] interface {
+	Len() int
+	At(int) E
+}
+
+// Path returns the path of the element of the given kind identified by key, nested under parent.
+func Path(parent, kind, key string) string {
+	elem := fmt.Sprintf("%s %q", kind, key)
+	if parent == "" {
+		return elem
+	}
+	return parent + " > " + elem
+}
+
+// Field reports a difference in a field if expected and actual are not equal.
+func Field[T comparable](path, field string, expected, actual T) error {
+	if expected == actual {
+		return nil
+	}
+	return fmt.Errorf("%s: %s: expected %v, actual %v", path, field, expected, actual)
+}
+
+// Slices matches the elements of expected and actual using key, reports the elements that are only in one
+// of them and compares the matched elements using compare. The elements with the same key are matched in order.
+// If ignoreOrder is false, the matched elements found at a different position are also reported.
+func Slices[S Slice[E], E any](path, kind string, expected, actual S, key func(E) string, ignoreOrder bool, compare func(path string, expected, actual E) error) error {
+	var errs error
+	matched := make([]bool, actual.Len())
+	for i := 0; i < expected.Len(); i++ {
+		k := key(expected.At(i))
+		j := -1
+		for a := 0; a < actual.Len(); a++ {
+			if !matched[a] && key(actual.At(a)) == k {
+				j = a
+				break
+			}
+		}
+		if j == -1 {
+			errs = multierr.Append(errs, errorf(path, "missing expected %s %q", kind, k))
+			continue
+		}
+		matched[j] = true
+		elemPath := Path(path, kind, k)
+		if !ignoreOrder && i != j {
+			errs = multierr.Append(errs, fmt.Errorf("%s: expected at index %d, actual at index %d", elemPath, i, j))
+		}
+		errs = multierr.Append(errs, compare(elemPath, expected.At(i), actual.At(j)))
+	}
+	for j := 0; j < actual.Len(); j++ {
+		if !matched[j] {
+			errs = multierr.Append(errs, errorf(path, "unexpected %s %q", kind, key(actual.At(j))))
+		}
+	}
+	return errs
+}
+
+// Indexed compares the elements of expected and actual at the same index.
+func Indexed[S Slice[E], E any](path, kind string, expected, actual S, compare func(path string, expected, actual E) error) error {
+	if expected.Len() != actual.Len() {
+		return fmt.Errorf("%s: number of %s: expected %d, actual %d", path, kind, expected.Len(), actual.Len())
+	}
+	var errs error
+	for i := 0; i < expected.Len(); i++ {
+		errs = multierr.Append(errs, compare(Path(path, kind, fmt.Sprint(i)), expected.At(i), actual.At(i)))
+	}
+	return errs
+}
+
+// Map reports the attributes that are missing, unexpected or that have a different value.
+func Map(path, field string, expected, actual pcommon.Map) error {
+	var errs error
+	expected.Range(func(k string, ev pcommon.Value) bool {
+		av, ok := actual.Get(k)
+		switch {
+		case !ok:
+			errs = multierr.Append(errs, fmt.Errorf("%s: %s: missing expected key %q", path, field, k))
+		case !reflect.DeepEqual(ev.AsRaw(), av.AsRaw()):
+			errs = multierr.Append(errs, fmt.Errorf("%s: %s: key %q: expected %s, actual %s", path, field, k, describe(ev), describe(av)))
+		}
+		return true
+	})
+	actual.Range(func(k string, _ pcommon.Value) bool {
+		if _, ok := expected.Get(k); !ok {
+			errs = multierr.Append(errs, fmt.Errorf("%s: %s: unexpected key %q", path, field, k))
+		}
+		return true
+	})
+	return errs
+}
+
+// Value reports a difference if the values are not equal.
+func Value(path, field string, expected, actual pcommon.Value) error {
+	if reflect.DeepEqual(expected.AsRaw(), actual.AsRaw()) {
+		return nil
+	}
+	return fmt.Errorf("%s: %s: expected %s, actual %s", path, field, describe(expected), describe(actual))
+}
+
+// Resource compares the attributes and the dropped attributes count of the resources.
+func Resource(path string, expected, actual pcommon.Resource) error {
+	return multierr.Combine(
+		Map(path, "resource attributes", expected.Attributes(), actual.Attributes()),
+		Field(path, "resource dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+	)
+}
+
+// Scope compares all the fields of the instrumentation scopes.
+func Scope(path string, expected, actual pcommon.InstrumentationScope) error {
+	return multierr.Combine(
+		Field(path, "scope name", expected.Name(), actual.Name()),
+		Field(path, "scope version", expected.Version(), actual.Version()),
+		Map(path, "scope attributes", expected.Attributes(), actual.Attributes()),
+		Field(path, "scope dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+	)
+}
+
+// MapKey returns a key identifying the content of m, independently of the order of its entries.
+func MapKey(m pcommon.Map) string {
+	keys := make([]string, 0, m.Len())
+	m.Range(func(k string, v pcommon.Value) bool {
+		keys = append(keys, k+"="+v.AsString())
+		return true
+	})
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+// ScopeKey returns a key identifying the instrumentation scope.
+func ScopeKey(scope pcommon.InstrumentationScope) string {
+	if scope.Version() == "" {
+		return scope.Name()
+	}
+	return scope.Name() + "@" + scope.Version()
+}
+
+func errorf(path, format string, args ...any) error {
+	if path == "" {
+		return fmt.Errorf(format, args...)
+	}
+	return fmt.Errorf("%s: "+format, append([]any{path}, args...)...)
+}
+
+func describe(v pcommon.Value) string {
+	return fmt.Sprintf("%s(%s)", v.Type(), v.AsString())
+}
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pdatacmp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestMapKey(t *testing.T) {
+	m1 := pcommon.NewMap()
+	m1.PutStr("b", "2")
+	m1.PutInt("a", 1)
+	m2 := pcommon.NewMap()
+	m2.PutInt("a", 1)
+	m2.PutStr("b", "2")
+	assert.Equal(t, "a=1,b=2", MapKey(m1))
+	assert.Equal(t, MapKey(m1), MapKey(m2))
+	assert.NoError(t, Map("", "attributes", m1, m2))
+}
+
+func TestValue(t *testing.T) {
+	v1 := pcommon.NewValueSlice()
+	v1.Slice().AppendEmpty().SetStr("a")
+	v2 := pcommon.NewValueSlice()
+	v2.Slice().AppendEmpty().SetStr("a")
+	assert.NoError(t, Value("path", "body", v1, v2))
+
+	v2.Slice().AppendEmpty().SetInt(1)
+	assert.EqualError(t, Value("path", "body", v1, v2), `path: body: expected Slice(["a"]), actual Slice(["a",1])`)
+	assert.EqualError(t, Value("path", "body", v1, pcommon.NewValueStr("a")), `path: body: expected Slice(["a"]), actual Str(a)`)
+}
+
+func TestScopeKey(t *testing.T) {
+	scope := pcommon.NewInstrumentationScope()
+	scope.SetName("name")
+	assert.Equal(t, "name", ScopeKey(scope))
+	scope.SetVersion("v1")
+	assert.Equal(t, "name@v1", ScopeKey(scope))
+}
@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/collector/pdata/internal"
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// protoSchema describes the fields of a generated protobuf message which are checked by ValidateProto.
+type protoSchema struct {
+	name   string
+	fields map[protowire.Number]protoField
+}
+
+type protoField struct {
+	typ protowire.Type
+	// packed is set for the repeated scalar fields, which may also be encoded as a length-delimited field.
+	packed bool
+	// msg is the schema of the nested message, if the field is a message.
+	msg *protoSchema
+	// size is the length of the fixed size bytes fields (trace and span IDs), which may also be empty.
+	size int
+}
+
+var (
+	protoSchemasMu sync.Mutex
+	protoSchemas   = map[reflect.Type]*protoSchema{}
+)
+
+// ValidateProto checks that buf is a valid protobuf encoding of the generated message type of msg,
+// so that unmarshaling buf into a message of this type does not fail. As when unmarshaling, the unknown
+// fields are only checked to be well framed.
+func ValidateProto(buf []byte, msg any) error {
+	protoSchemasMu.Lock()
+	schema := schemaOf(reflect.TypeOf(msg))
+	protoSchemasMu.Unlock()
+	if err := schema.validate(buf); err != nil {
+		return fmt.Errorf("proto: %w", err)
+	}
+	return nil
+}
+
+// schemaOf returns the schema of the generated message type t, built from the protobuf tags of its fields.
+// It must be called with protoSchemasMu held.
+func schemaOf(t reflect.Type) *protoSchema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if schema, ok := protoSchemas[t]; ok {
+		return schema
+	}
+	// The schema is registered before its fields are added, for the recursive messages like AnyValue.
+	schema := &protoSchema{name: t.Name(), fields: map[protowire.Number]protoField{}}
+	protoSchemas[t] = schema
+	addFields(schema, t)
+	if wrappers, ok := reflect.New(t).Interface().(interface{ XXX_OneofWrappers() []any }); ok {
+		for _, wrapper := range wrappers.XXX_OneofWrappers() {
+			addFields(schema, reflect.TypeOf(wrapper).Elem())
+		}
+	}
+	return schema
+}
+
+func addFields(schema *protoSchema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, ok := sf.Tag.Lookup("protobuf")
+		if !ok {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		num, err := strconv.Atoi(parts[1])
+		if err != nil {
+			panic(fmt.Sprintf("invalid protobuf tag %q of %s.%s", tag, t.Name(), sf.Name))
+		}
+		ft := sf.Type
+		repeated := parts[2] == "rep"
+		if repeated && ft.Kind() == reflect.Slice {
+			ft = ft.Elem()
+		}
+		field := protoField{}
+		switch parts[0] {
+		case "varint", "zigzag32", "zigzag64":
+			field.typ = protowire.VarintType
+		case "fixed64":
+			field.typ = protowire.Fixed64Type
+		case "fixed32":
+			field.typ = protowire.Fixed32Type
+		case "bytes":
+			field.typ = protowire.BytesType
+		default:
+			panic(fmt.Sprintf("unsupported protobuf tag %q of %s.%s", tag, t.Name(), sf.Name))
+		}
+		field.packed = repeated && field.typ != protowire.BytesType
+		if field.typ == protowire.BytesType {
+			for ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			switch ft.Kind() {
+			case reflect.Struct:
+				field.msg = schemaOf(ft)
+			case reflect.Array:
+				field.size = ft.Len()
+			}
+		}
+		schema.fields[protowire.Number(num)] = field
+	}
+}
+
+func (s *protoSchema) validate(buf []byte) error {
+	for len(buf) > 0 {
+		num, typ, n := protowire.ConsumeTag(buf)
+		if n < 0 {
+			return fmt.Errorf("%s: %w", s.name, protowire.ParseError(n))
+		}
+		buf = buf[n:]
+		n = protowire.ConsumeFieldValue(num, typ, buf)
+		if n < 0 {
+			return fmt.Errorf("%s: field %d: %w", s.name, num, protowire.ParseError(n))
+		}
+		value := buf[:n]
+		buf = buf[n:]
+		field, ok := s.fields[num]
+		if !ok {
+			continue
+		}
+		if err := field.validate(num, typ, value); err != nil {
+			return fmt.Errorf("%s: %w", s.name, err)
+		}
+	}
+	return nil
+}
+
+func (f protoField) validate(num protowire.Number, typ protowire.Type, value []byte) error {
+	if typ != f.typ {
+		if !f.packed || typ != protowire.BytesType {
+			return fmt.Errorf("wrong wire type %d for field %d", typ, num)
+		}
+		packed, _ := protowire.ConsumeBytes(value)
+		for len(packed) > 0 {
+			n := protowire.ConsumeFieldValue(num, f.typ, packed)
+			if n < 0 {
+				return fmt.Errorf("field %d: %w", num, protowire.ParseError(n))
+			}
+			packed = packed[n:]
+		}
+		return nil
+	}
+	if typ != protowire.BytesType {
+		return nil
+	}
+	content, _ := protowire.ConsumeBytes(value)
+	switch {
+	case f.msg != nil:
+		return f.msg.validate(content)
+	case f.size > 0 && len(content) != 0 && len(content) != f.size:
+		return fmt.Errorf("invalid length %d for field %d, expected %d", len(content), num, f.size)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	otlpcollectormetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/metrics/v1"
+	otlpcollectortrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/trace/v1"
+	otlpcommon "go.opentelemetry.io/collector/pdata/internal/data/protogen/common/v1"
+	otlpmetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"
+	otlptrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/trace/v1"
+)
+
+func TestValidateProto(t *testing.T) {
+	buf, err := testTraceRequest().Marshal()
+	require.NoError(t, err)
+	assert.NoError(t, ValidateProto(buf, &otlpcollectortrace.ExportTraceServiceRequest{}))
+
+	// Unknown fields are skipped.
+	unknown := protowire.AppendTag(append([]byte(nil), buf...), 99, protowire.Fixed32Type)
+	unknown = protowire.AppendFixed32(unknown, 1)
+	assert.NoError(t, ValidateProto(unknown, &otlpcollectortrace.ExportTraceServiceRequest{}))
+
+	// The repeated scalars may be packed or not.
+	dp := protowire.AppendTag(nil, 6, protowire.Fixed64Type)
+	dp = protowire.AppendFixed64(dp, 1)
+	dp = protowire.AppendTag(dp, 6, protowire.BytesType)
+	dp = protowire.AppendBytes(dp, protowire.AppendFixed64(nil, 2))
+	assert.NoError(t, ValidateProto(dp, &otlpmetrics.HistogramDataPoint{}))
+}
+
+func TestValidateProtoInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		buf  []byte
+	}{
+		{
+			name: "truncated",
+			buf:  appendMessage(nil, 1, []byte{0x0a})[:2],
+		},
+		{
+			name: "wrong wire type",
+			buf:  protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 1),
+		},
+		{
+			name: "nested wrong wire type",
+			buf:  appendMessage(nil, 1, appendMessage(nil, 2, protowire.AppendVarint(protowire.AppendTag(nil, 2, protowire.VarintType), 1))),
+		},
+		{
+			name: "invalid trace id",
+			buf:  appendMessage(nil, 1, appendMessage(nil, 2, appendMessage(nil, 2, appendMessage(nil, 1, []byte{1, 2, 3})))),
+		},
+		{
+			name: "fixed field as bytes",
+			buf:  appendMessage(nil, 1, appendMessage(nil, 2, appendMessage(nil, 2, appendMessage(nil, 7, []byte{1})))),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, ValidateProto(tt.buf, &otlpcollectortrace.ExportTraceServiceRequest{}))
+			assert.Error(t, (&otlpcollectortrace.ExportTraceServiceRequest{}).Unmarshal(tt.buf))
+		})
+	}
+}
+
+// TestValidateProtoCorrupted checks that the encodings accepted by ValidateProto are unmarshaled without
+// error, by corrupting every byte of valid encodings. ValidateProto may be stricter than Unmarshal, which reads
+// past the end of malformed packed fields.
+func TestValidateProtoCorrupted(t *testing.T) {
+	traces, err := testTraceRequest().Marshal()
+	require.NoError(t, err)
+	metrics, err := testMetricsRequest().Marshal()
+	require.NoError(t, err)
+
+	for i := range traces {
+		for _, b := range []byte{0x00, 0x01, 0x02, 0x05, 0x08, 0x7f, 0x80, 0xff} {
+			buf := append([]byte(nil), traces...)
+			buf[i] = b
+			if ValidateProto(buf, &otlpcollectortrace.ExportTraceServiceRequest{}) == nil {
+				assert.NoError(t, (&otlpcollectortrace.ExportTraceServiceRequest{}).Unmarshal(buf), "byte %d set to %#x", i, b)
+			}
+		}
+	}
+	for i := range metrics {
+		for _, b := range []byte{0x00, 0x01, 0x02, 0x05, 0x08, 0x7f, 0x80, 0xff} {
+			buf := append([]byte(nil), metrics...)
+			buf[i] = b
+			if ValidateProto(buf, &otlpcollectormetrics.ExportMetricsServiceRequest{}) == nil {
+				assert.NoError(t, (&otlpcollectormetrics.ExportMetricsServiceRequest{}).Unmarshal(buf), "byte %d set to %#x", i, b)
+			}
+		}
+	}
+}
+
+func testTraceRequest() *otlpcollectortrace.ExportTraceServiceRequest {
+	return &otlpcollectortrace.ExportTraceServiceRequest{
+		ResourceSpans: []*otlptrace.ResourceSpans{{
+			ScopeSpans: []*otlptrace.ScopeSpans{{
+				Scope: otlpcommon.InstrumentationScope{Name: "scope"},
+				Spans: []*otlptrace.Span{{
+					TraceId:           [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+					SpanId:            [8]byte{1, 2, 3, 4, 5, 6, 7, 8},
+					Name:              "span",
+					Kind:              otlptrace.Span_SPAN_KIND_SERVER,
+					StartTimeUnixNano: 1,
+					EndTimeUnixNano:   2,
+					Attributes: []otlpcommon.KeyValue{
+						{Key: "str", Value: otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_StringValue{StringValue: "v"}}},
+						{Key: "int", Value: otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_IntValue{IntValue: 3}}},
+						{Key: "arr", Value: otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_ArrayValue{ArrayValue: &otlpcommon.ArrayValue{
+							Values: []otlpcommon.AnyValue{{Value: &otlpcommon.AnyValue_DoubleValue{DoubleValue: 1.5}}},
+						}}}},
+					},
+					Status: otlptrace.Status{Code: otlptrace.Status_STATUS_CODE_OK},
+				}},
+			}},
+		}},
+	}
+}
+
+func testMetricsRequest() *otlpcollectormetrics.ExportMetricsServiceRequest {
+	return &otlpcollectormetrics.ExportMetricsServiceRequest{
+		ResourceMetrics: []*otlpmetrics.ResourceMetrics{{
+			ScopeMetrics: []*otlpmetrics.ScopeMetrics{{
+				Metrics: []*otlpmetrics.Metric{
+					{
+						Name: "sum",
+						Data: &otlpmetrics.Metric_Sum{Sum: &otlpmetrics.Sum{
+							DataPoints: []*otlpmetrics.NumberDataPoint{{
+								TimeUnixNano: 1,
+								Value:        &otlpmetrics.NumberDataPoint_AsInt{AsInt: 2},
+							}},
+						}},
+					},
+					{
+						Name: "histogram",
+						Data: &otlpmetrics.Metric_Histogram{Histogram: &otlpmetrics.Histogram{
+							DataPoints: []*otlpmetrics.HistogramDataPoint{{
+								BucketCounts:   []uint64{1, 2},
+								ExplicitBounds: []float64{1},
+								Exemplars: []otlpmetrics.Exemplar{{
+									SpanId: [8]byte{1, 2, 3, 4, 5, 6, 7, 8},
+								}},
+							}},
+						}},
+					},
+				},
+			}},
+		}},
+	}
+}
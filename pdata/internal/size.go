@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/collector/pdata/internal"
+
+import "sync/atomic"
+
+// SizeCache holds the marshaled size of a pmetric.Metrics, plog.Logs, ptrace.Traces or pprofile.Profiles.
+// The size is only cached once the data is read-only, since it cannot change anymore after that.
+type SizeCache struct {
+	// size is the cached size plus one, so that the zero value means "not computed".
+	size atomic.Int64
+}
+
+// Size returns the size computed by sizeFunc, reusing the previously computed value if the state is StateReadOnly.
+func (c *SizeCache) Size(state *State, sizeFunc func() int) int {
+	if *state != StateReadOnly {
+		return sizeFunc()
+	}
+	if size := c.size.Load(); size > 0 {
+		return int(size - 1)
+	}
+	size := sizeFunc()
+	c.size.Store(int64(size) + 1)
+	return size
+}
@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSizeCache(t *testing.T) {
+	calls := 0
+	sizeFunc := func() int {
+		calls++
+		return 0
+	}
+	cache := &SizeCache{}
+	state := StateMutable
+	assert.Equal(t, 0, cache.Size(&state, sizeFunc))
+	assert.Equal(t, 0, cache.Size(&state, sizeFunc))
+	assert.Equal(t, 2, calls)
+
+	state = StateReadOnly
+	assert.Equal(t, 0, cache.Size(&state, sizeFunc))
+	assert.Equal(t, 0, cache.Size(&state, sizeFunc))
+	assert.Equal(t, 3, calls)
+}
@@ -3,6 +3,8 @@
 
 package internal // import "go.opentelemetry.io/collector/pdata/internal"
 
+import "errors"
+
 // State defines an ownership state of pmetric.Metrics, plog.Logs or ptrace.Traces.
 type State int32
 
@@ -14,9 +16,12 @@ const (
 	StateReadOnly
 )
 
-// AssertMutable panics if the state is not StateMutable.
+// ErrReadOnly is the value of the panic raised when read-only data is modified.
+var ErrReadOnly = errors.New("invalid access to shared data")
+
+// AssertMutable panics with ErrReadOnly if the state is not StateMutable.
 func (state *State) AssertMutable() {
 	if *state != StateMutable {
-		panic("invalid access to shared data")
+		panic(ErrReadOnly)
 	}
 }
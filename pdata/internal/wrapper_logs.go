@@ -11,28 +11,45 @@ import (
 type Logs struct {
 	orig  *otlpcollectorlog.ExportLogsServiceRequest
 	state *State
+	size  *SizeCache
+	lazy  *LazyProto
 }
 
 func GetOrigLogs(ms Logs) *otlpcollectorlog.ExportLogsServiceRequest {
+	ms.lazy.Decode(ms.state)
 	return ms.orig
 }
 
+// GetLazyLogs returns the orig without decoding it, along with the LazyProto that decodes it.
+func GetLazyLogs(ms Logs) (*otlpcollectorlog.ExportLogsServiceRequest, *LazyProto) {
+	return ms.orig, ms.lazy
+}
+
 func GetLogsState(ms Logs) *State {
 	return ms.state
 }
 
+func GetLogsSizeCache(ms Logs) *SizeCache {
+	return ms.size
+}
+
 func SetLogsState(ms Logs, state State) {
 	*ms.state = state
 }
 
 func NewLogs(orig *otlpcollectorlog.ExportLogsServiceRequest, state *State) Logs {
-	return Logs{orig: orig, state: state}
+	return Logs{orig: orig, state: state, size: &SizeCache{}}
+}
+
+// NewLazyLogs returns a Logs whose orig is decoded by lazy on first access.
+func NewLazyLogs(orig *otlpcollectorlog.ExportLogsServiceRequest, state *State, lazy *LazyProto) Logs {
+	return Logs{orig: orig, state: state, size: &SizeCache{}, lazy: lazy}
 }
 
 // LogsToProto internal helper to convert Logs to protobuf representation.
 func LogsToProto(l Logs) otlplogs.LogsData {
 	return otlplogs.LogsData{
-		ResourceLogs: l.orig.ResourceLogs,
+		ResourceLogs: GetOrigLogs(l).ResourceLogs,
 	}
 }
 
@@ -11,28 +11,45 @@ import (
 type Metrics struct {
 	orig  *otlpcollectormetrics.ExportMetricsServiceRequest
 	state *State
+	size  *SizeCache
+	lazy  *LazyProto
 }
 
 func GetOrigMetrics(ms Metrics) *otlpcollectormetrics.ExportMetricsServiceRequest {
+	ms.lazy.Decode(ms.state)
 	return ms.orig
 }
 
+// GetLazyMetrics returns the orig without decoding it, along with the LazyProto that decodes it.
+func GetLazyMetrics(ms Metrics) (*otlpcollectormetrics.ExportMetricsServiceRequest, *LazyProto) {
+	return ms.orig, ms.lazy
+}
+
 func GetMetricsState(ms Metrics) *State {
 	return ms.state
 }
 
+func GetMetricsSizeCache(ms Metrics) *SizeCache {
+	return ms.size
+}
+
 func SetMetricsState(ms Metrics, state State) {
 	*ms.state = state
 }
 
 func NewMetrics(orig *otlpcollectormetrics.ExportMetricsServiceRequest, state *State) Metrics {
-	return Metrics{orig: orig, state: state}
+	return Metrics{orig: orig, state: state, size: &SizeCache{}}
+}
+
+// NewLazyMetrics returns a Metrics whose orig is decoded by lazy on first access.
+func NewLazyMetrics(orig *otlpcollectormetrics.ExportMetricsServiceRequest, state *State, lazy *LazyProto) Metrics {
+	return Metrics{orig: orig, state: state, size: &SizeCache{}, lazy: lazy}
 }
 
 // MetricsToProto internal helper to convert Metrics to protobuf representation.
 func MetricsToProto(l Metrics) otlpmetrics.MetricsData {
 	return otlpmetrics.MetricsData{
-		ResourceMetrics: l.orig.ResourceMetrics,
+		ResourceMetrics: GetOrigMetrics(l).ResourceMetrics,
 	}
 }
 
@@ -11,28 +11,45 @@ import (
 type Traces struct {
 	orig  *otlpcollectortrace.ExportTraceServiceRequest
 	state *State
+	size  *SizeCache
+	lazy  *LazyProto
 }
 
 func GetOrigTraces(ms Traces) *otlpcollectortrace.ExportTraceServiceRequest {
+	ms.lazy.Decode(ms.state)
 	return ms.orig
 }
 
+// GetLazyTraces returns the orig without decoding it, along with the LazyProto that decodes it.
+func GetLazyTraces(ms Traces) (*otlpcollectortrace.ExportTraceServiceRequest, *LazyProto) {
+	return ms.orig, ms.lazy
+}
+
 func GetTracesState(ms Traces) *State {
 	return ms.state
 }
 
+func GetTracesSizeCache(ms Traces) *SizeCache {
+	return ms.size
+}
+
 func SetTracesState(ms Traces, state State) {
 	*ms.state = state
 }
 
 func NewTraces(orig *otlpcollectortrace.ExportTraceServiceRequest, state *State) Traces {
-	return Traces{orig: orig, state: state}
+	return Traces{orig: orig, state: state, size: &SizeCache{}}
+}
+
+// NewLazyTraces returns a Traces whose orig is decoded by lazy on first access.
+func NewLazyTraces(orig *otlpcollectortrace.ExportTraceServiceRequest, state *State, lazy *LazyProto) Traces {
+	return Traces{orig: orig, state: state, size: &SizeCache{}, lazy: lazy}
 }
 
 // TracesToProto internal helper to convert Traces to protobuf representation.
 func TracesToProto(l Traces) otlptrace.TracesData {
 	return otlptrace.TracesData{
-		ResourceSpans: l.orig.ResourceSpans,
+		ResourceSpans: GetOrigTraces(l).ResourceSpans,
 	}
 }
 
@@ -0,0 +1,10 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pcommon // import "go.opentelemetry.io/collector/pdata/pcommon"
+
+import "go.opentelemetry.io/collector/pdata/internal"
+
+// ErrReadOnly is the value of the panic raised when data marked as read-only is modified,
+// it can be used to recover from such invalid modifications.
+var ErrReadOnly = internal.ErrReadOnly
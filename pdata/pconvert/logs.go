@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pconvert provides helpers to convert data between the pdata signals, e.g. in connectors.
+package pconvert // import "go.opentelemetry.io/collector/pdata/pconvert"
+
+import (
+	"encoding/hex"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// EventNameAttribute is the attribute holding the name of the span event a log record was created from.
+const EventNameAttribute = "event.name"
+
+// LogsFromSpanEvents returns a log record for each span event of td, in the same resource and scope as its span.
+// The log records have the timestamp, attributes and dropped attributes count of their event, the event name
+// in the EventNameAttribute attribute and the trace context of their span.
+func LogsFromSpanEvents(td ptrace.Traces) plog.Logs {
+	ld := plog.NewLogs()
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		var rl plog.ResourceLogs
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			ss := sss.At(j)
+			var sl plog.ScopeLogs
+			spans := ss.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				events := span.Events()
+				if events.Len() == 0 {
+					continue
+				}
+				if sl == (plog.ScopeLogs{}) {
+					if rl == (plog.ResourceLogs{}) {
+						rl = ld.ResourceLogs().AppendEmpty()
+						rs.Resource().CopyTo(rl.Resource())
+						rl.SetSchemaUrl(rs.SchemaUrl())
+					}
+					sl = rl.ScopeLogs().AppendEmpty()
+					ss.Scope().CopyTo(sl.Scope())
+					sl.SetSchemaUrl(ss.SchemaUrl())
+				}
+				sl.LogRecords().AppendEmptyN(events.Len(), func(l int, lr plog.LogRecord) {
+					event := events.At(l)
+					lr.SetTimestamp(event.Timestamp())
+					lr.SetObservedTimestamp(event.Timestamp())
+					event.Attributes().CopyTo(lr.Attributes())
+					lr.Attributes().PutStr(EventNameAttribute, event.Name())
+					lr.SetDroppedAttributesCount(event.DroppedAttributesCount())
+					SetTraceContext(lr, span)
+				})
+			}
+		}
+	}
+	return ld
+}
+
+// SetTraceContext sets the trace and span IDs of the log record to the ones of the span,
+// to correlate the log record with the span.
+func SetTraceContext(lr plog.LogRecord, span ptrace.Span) {
+	lr.SetTraceID(span.TraceID())
+	lr.SetSpanID(span.SpanID())
+}
+
+// ExtractTraceContext sets the trace and span IDs of the log record from the hex encoded values of its
+// traceIDKey and spanIDKey attributes, and removes these attributes. It returns false, leaving the log
+// record unchanged, if any of them is missing or invalid.
+func ExtractTraceContext(lr plog.LogRecord, traceIDKey, spanIDKey string) bool {
+	var traceID pcommon.TraceID
+	var spanID pcommon.SpanID
+	if !decodeAttribute(lr.Attributes(), traceIDKey, traceID[:]) || !decodeAttribute(lr.Attributes(), spanIDKey, spanID[:]) {
+		return false
+	}
+	lr.SetTraceID(traceID)
+	lr.SetSpanID(spanID)
+	lr.Attributes().Remove(traceIDKey)
+	lr.Attributes().Remove(spanIDKey)
+	return true
+}
+
+func decodeAttribute(attrs pcommon.Map, key string, dest []byte) bool {
+	v, ok := attrs.Get(key)
+	if !ok || v.Type() != pcommon.ValueTypeStr || hex.DecodedLen(len(v.Str())) != len(dest) {
+		return false
+	}
+	_, err := hex.Decode(dest, []byte(v.Str()))
+	return err == nil
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pconvert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogtest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var (
+	testTraceID = pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	testSpanID  = pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+)
+
+func TestLogsFromSpanEvents(t *testing.T) {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "svc")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("scope")
+	span := ss.Spans().AppendEmpty()
+	span.SetTraceID(testTraceID)
+	span.SetSpanID(testSpanID)
+	event := span.Events().AppendEmpty()
+	event.SetName("exception")
+	event.SetTimestamp(10)
+	event.Attributes().PutStr("exception.message", "boom")
+	event.SetDroppedAttributesCount(1)
+	// Spans without events are skipped, as well as their resources and scopes.
+	ss.Spans().AppendEmpty()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+
+	expected := plog.NewLogs()
+	rl := expected.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "svc")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("scope")
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(10)
+	lr.SetObservedTimestamp(10)
+	lr.Attributes().PutStr("exception.message", "boom")
+	lr.Attributes().PutStr(EventNameAttribute, "exception")
+	lr.SetDroppedAttributesCount(1)
+	lr.SetTraceID(testTraceID)
+	lr.SetSpanID(testSpanID)
+
+	assert.NoError(t, plogtest.CompareLogs(expected, LogsFromSpanEvents(td)))
+}
+
+func TestExtractTraceContext(t *testing.T) {
+	lr := plog.NewLogRecord()
+	lr.Attributes().PutStr("trace_id", testTraceID.String())
+	lr.Attributes().PutStr("span_id", testSpanID.String())
+	lr.Attributes().PutStr("other", "value")
+	require.True(t, ExtractTraceContext(lr, "trace_id", "span_id"))
+	assert.Equal(t, testTraceID, lr.TraceID())
+	assert.Equal(t, testSpanID, lr.SpanID())
+	assert.Equal(t, map[string]any{"other": "value"}, lr.Attributes().AsRaw())
+}
+
+func TestExtractTraceContextInvalid(t *testing.T) {
+	lr := plog.NewLogRecord()
+	lr.Attributes().PutStr("trace_id", testTraceID.String())
+	assert.False(t, ExtractTraceContext(lr, "trace_id", "span_id"))
+
+	lr.Attributes().PutStr("span_id", "invalid")
+	assert.False(t, ExtractTraceContext(lr, "trace_id", "span_id"))
+
+	lr.Attributes().PutInt("span_id", 1)
+	assert.False(t, ExtractTraceContext(lr, "trace_id", "span_id"))
+	assert.True(t, lr.TraceID().IsEmpty())
+	assert.Equal(t, 2, lr.Attributes().Len())
+}
+
+func TestSetTraceContext(t *testing.T) {
+	span := ptrace.NewSpan()
+	span.SetTraceID(testTraceID)
+	span.SetSpanID(testSpanID)
+	lr := plog.NewLogRecord()
+	SetTraceContext(lr, span)
+	assert.Equal(t, testTraceID, lr.TraceID())
+	assert.Equal(t, testSpanID, lr.SpanID())
+}
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pconvert // import "go.opentelemetry.io/collector/pdata/pconvert"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// CopyResourceAttributesToDataPoints copies the resource attributes with the given keys, or all of them if no key
+// is given, to the attributes of the data points of md. The data point attributes with the same key are kept.
+func CopyResourceAttributesToDataPoints(md pmetric.Metrics, keys ...string) {
+	md.ForEachMetric(func(rm pmetric.ResourceMetrics, _ pmetric.ScopeMetrics, m pmetric.Metric) bool {
+		m.ForEachDataPointAttributes(func(attrs pcommon.Map) {
+			copyAttributes(rm.Resource().Attributes(), attrs, keys)
+		})
+		return true
+	})
+}
+
+func copyAttributes(src, dest pcommon.Map, keys []string) {
+	if len(keys) == 0 {
+		src.Range(func(k string, v pcommon.Value) bool {
+			if _, ok := dest.Get(k); !ok {
+				v.CopyTo(dest.PutEmpty(k))
+			}
+			return true
+		})
+		return
+	}
+	for _, k := range keys {
+		v, ok := src.Get(k)
+		if !ok {
+			continue
+		}
+		if _, ok := dest.Get(k); !ok {
+			v.CopyTo(dest.PutEmpty(k))
+		}
+	}
+}
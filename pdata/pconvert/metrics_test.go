@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pconvert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestCopyResourceAttributesToDataPoints(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []string
+		expected map[string]any
+	}{
+		{
+			name:     "all",
+			expected: map[string]any{"host": "a", "service": "svc", "region": "eu"},
+		},
+		{
+			name:     "keys",
+			keys:     []string{"region", "missing"},
+			expected: map[string]any{"host": "a", "region": "eu"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := pmetric.NewMetrics()
+			rm := md.ResourceMetrics().AppendEmpty()
+			rm.Resource().Attributes().PutStr("service", "svc")
+			rm.Resource().Attributes().PutStr("region", "eu")
+			rm.Resource().Attributes().PutStr("host", "b")
+			ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+			ms.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr("host", "a")
+			ms.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr("host", "a")
+			ms.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty().Attributes().PutStr("host", "a")
+			ms.AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty().Attributes().PutStr("host", "a")
+			ms.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty().Attributes().PutStr("host", "a")
+
+			CopyResourceAttributesToDataPoints(md, tt.keys...)
+			assert.Equal(t, tt.expected, ms.At(0).Gauge().DataPoints().At(0).Attributes().AsRaw())
+			assert.Equal(t, tt.expected, ms.At(1).Sum().DataPoints().At(0).Attributes().AsRaw())
+			assert.Equal(t, tt.expected, ms.At(2).Histogram().DataPoints().At(0).Attributes().AsRaw())
+			assert.Equal(t, tt.expected, ms.At(3).ExponentialHistogram().DataPoints().At(0).Attributes().AsRaw())
+			assert.Equal(t, tt.expected, ms.At(4).Summary().DataPoints().At(0).Attributes().AsRaw())
+		})
+	}
+}
@@ -39,6 +39,7 @@ func TestLogRecordSliceReadOnly(t *testing.T) {
 	es := newLogRecordSlice(&[]*otlplogs.LogRecord{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewLogRecordSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestLogRecordSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestLogRecordSlice(), es)
 }
 
+func TestLogRecordSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestLogRecordSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el LogRecord) {
+		indexes = append(indexes, i)
+		fillTestLogRecord(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestLogRecord(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewLogRecord(), es.At(es.Len()-1))
+}
+
 func TestLogRecordSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestLogRecordSlice()
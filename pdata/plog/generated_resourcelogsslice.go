@@ -88,6 +88,29 @@ func (es ResourceLogsSlice) AppendEmpty() ResourceLogs {
 	return es.At(es.Len() - 1)
 }
 
+// AppendEmptyN will append to the end of the slice n empty ResourceLogs, allocated together.
+// If fn is not nil, it is called with each newly added ResourceLogs and its index among them, e.g. to
+// fill them from a native Go slice:
+//
+//	es.AppendEmptyN(len(items), func(i int, e ResourceLogs) {
+//	    // Here should set all the values for e from items[i].
+//	})
+func (es ResourceLogsSlice) AppendEmptyN(n int, fn func(int, ResourceLogs)) {
+	es.state.AssertMutable()
+	oldLen := len(*es.orig)
+	*es.orig = append(*es.orig, make([]*otlplogs.ResourceLogs, n)...)
+	elems := make([]otlplogs.ResourceLogs, n)
+	for i := range elems {
+		(*es.orig)[oldLen+i] = &elems[i]
+	}
+	if fn == nil {
+		return
+	}
+	for i := 0; i < n; i++ {
+		fn(i, es.At(oldLen+i))
+	}
+}
+
 // MoveAndAppendTo moves all elements from the current slice and appends them to the dest.
 // The current slice will be cleared.
 func (es ResourceLogsSlice) MoveAndAppendTo(dest ResourceLogsSlice) {
@@ -39,6 +39,7 @@ func TestResourceLogsSliceReadOnly(t *testing.T) {
 	es := newResourceLogsSlice(&[]*otlplogs.ResourceLogs{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewResourceLogsSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestResourceLogsSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestResourceLogsSlice(), es)
 }
 
+func TestResourceLogsSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestResourceLogsSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el ResourceLogs) {
+		indexes = append(indexes, i)
+		fillTestResourceLogs(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestResourceLogs(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewResourceLogs(), es.At(es.Len()-1))
+}
+
 func TestResourceLogsSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestResourceLogsSlice()
@@ -88,6 +88,29 @@ func (es ScopeLogsSlice) AppendEmpty() ScopeLogs {
 	return es.At(es.Len() - 1)
 }
 
+// AppendEmptyN will append to the end of the slice n empty ScopeLogs, allocated together.
+// If fn is not nil, it is called with each newly added ScopeLogs and its index among them, e.g. to
+// fill them from a native Go slice:
+//
+//	es.AppendEmptyN(len(items), func(i int, e ScopeLogs) {
+//	    // Here should set all the values for e from items[i].
+//	})
+func (es ScopeLogsSlice) AppendEmptyN(n int, fn func(int, ScopeLogs)) {
+	es.state.AssertMutable()
+	oldLen := len(*es.orig)
+	*es.orig = append(*es.orig, make([]*otlplogs.ScopeLogs, n)...)
+	elems := make([]otlplogs.ScopeLogs, n)
+	for i := range elems {
+		(*es.orig)[oldLen+i] = &elems[i]
+	}
+	if fn == nil {
+		return
+	}
+	for i := 0; i < n; i++ {
+		fn(i, es.At(oldLen+i))
+	}
+}
+
 // MoveAndAppendTo moves all elements from the current slice and appends them to the dest.
 // The current slice will be cleared.
 func (es ScopeLogsSlice) MoveAndAppendTo(dest ScopeLogsSlice) {
@@ -39,6 +39,7 @@ func TestScopeLogsSliceReadOnly(t *testing.T) {
 	es := newScopeLogsSlice(&[]*otlplogs.ScopeLogs{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewScopeLogsSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestScopeLogsSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestScopeLogsSlice(), es)
 }
 
+func TestScopeLogsSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestScopeLogsSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el ScopeLogs) {
+		indexes = append(indexes, i)
+		fillTestScopeLogs(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestScopeLogs(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewScopeLogs(), es.At(es.Len()-1))
+}
+
 func TestScopeLogsSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestScopeLogsSlice()
@@ -4,8 +4,8 @@
 package plog // import "go.opentelemetry.io/collector/pdata/plog"
 
 import (
-	"bytes"
 	"fmt"
+	"io"
 
 	jsoniter "github.com/json-iterator/go"
 
@@ -20,10 +20,14 @@ type JSONMarshaler struct{}
 
 // MarshalLogs to the OTLP/JSON format.
 func (*JSONMarshaler) MarshalLogs(ld Logs) ([]byte, error) {
-	buf := bytes.Buffer{}
 	pb := internal.LogsToProto(internal.Logs(ld))
-	err := json.Marshal(&buf, &pb)
-	return buf.Bytes(), err
+	return json.MarshalBytes(&pb)
+}
+
+// MarshalLogsTo writes ld to w in the OTLP/JSON format, without buffering the whole payload in memory.
+func (*JSONMarshaler) MarshalLogsTo(w io.Writer, ld Logs) error {
+	pb := internal.LogsToProto(internal.Logs(ld))
+	return json.Marshal(w, &pb)
 }
 
 var _ Unmarshaler = (*JSONUnmarshaler)(nil)
@@ -35,6 +39,18 @@ type JSONUnmarshaler struct{}
 func (*JSONUnmarshaler) UnmarshalLogs(buf []byte) (Logs, error) {
 	iter := jsoniter.ConfigFastest.BorrowIterator(buf)
 	defer jsoniter.ConfigFastest.ReturnIterator(iter)
+	return unmarshalLogs(iter)
+}
+
+// UnmarshalLogsFrom reads OTLP/JSON formatted data from r into pdata.Logs, without
+// buffering the whole payload in memory.
+func (*JSONUnmarshaler) UnmarshalLogsFrom(r io.Reader) (Logs, error) {
+	iter := json.BorrowIterator(r)
+	defer json.ReturnIterator(iter)
+	return unmarshalLogs(iter)
+}
+
+func unmarshalLogs(iter *jsoniter.Iterator) (Logs, error) {
 	ld := NewLogs()
 	ld.unmarshalJsoniter(iter)
 	if iter.Error != nil {
@@ -4,7 +4,10 @@
 package plog
 
 import (
+	"bytes"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
@@ -71,11 +74,27 @@ func TestJSONMarshal(t *testing.T) {
 	assert.Equal(t, logsJSON, string(jsonBuf))
 }
 
+func TestJSONMarshalTo(t *testing.T) {
+	encoder := &JSONMarshaler{}
+	var buf bytes.Buffer
+	require.NoError(t, encoder.MarshalLogsTo(&buf, logsOTLP))
+	assert.Equal(t, logsJSON, buf.String())
+}
+
+func TestJSONUnmarshalFrom(t *testing.T) {
+	decoder := &JSONUnmarshaler{}
+	got, err := decoder.UnmarshalLogsFrom(iotest.OneByteReader(strings.NewReader(logsJSON)))
+	require.NoError(t, err)
+	assert.EqualValues(t, logsOTLP, got)
+}
+
 func TestJSONUnmarshalInvalid(t *testing.T) {
 	jsonStr := `{"extra":"", "resourceLogs": "extra"}`
 	decoder := &JSONUnmarshaler{}
 	_, err := decoder.UnmarshalLogs([]byte(jsonStr))
 	assert.Error(t, err)
+	_, err = decoder.UnmarshalLogsFrom(strings.NewReader(jsonStr))
+	assert.Error(t, err)
 }
 
 func TestUnmarshalJsoniterLogsData(t *testing.T) {
@@ -21,6 +21,12 @@ func (ms Logs) getOrig() *otlpcollectorlog.ExportLogsServiceRequest {
 	return internal.GetOrigLogs(internal.Logs(ms))
 }
 
+// getEncoded returns the OTLP protobuf encoding of the Logs if it was lazily unmarshaled and is still valid.
+func (ms Logs) getEncoded() ([]byte, bool) {
+	_, lazy := internal.GetLazyLogs(internal.Logs(ms))
+	return lazy.Encoded()
+}
+
 func (ms Logs) getState() *internal.State {
 	return internal.GetLogsState(internal.Logs(ms))
 }
@@ -40,8 +46,36 @@ func (ms Logs) CopyTo(dest Logs) {
 	ms.ResourceLogs().CopyTo(dest.ResourceLogs())
 }
 
+// Mutable returns the Logs itself if it is mutable, otherwise a mutable copy of it.
+// Consumers that need to modify data they may share with other consumers should
+// call it before doing any modification, so that the data is only copied when required.
+func (ms Logs) Mutable() Logs {
+	if !ms.IsReadOnly() {
+		return ms
+	}
+	dest := NewLogs()
+	ms.CopyTo(dest)
+	return dest
+}
+
+// ByteSize returns the size in bytes of the Logs marshaled in the OTLP protobuf format.
+// The size is computed once and cached after the Logs is marked as read-only.
+func (ms Logs) ByteSize() int {
+	if buf, ok := ms.getEncoded(); ok {
+		return len(buf)
+	}
+	return internal.GetLogsSizeCache(internal.Logs(ms)).Size(ms.getState(), func() int {
+		pb := internal.LogsToProto(internal.Logs(ms))
+		return pb.Size()
+	})
+}
+
 // LogRecordCount calculates the total number of log records.
 func (ms Logs) LogRecordCount() int {
+	_, lazy := internal.GetLazyLogs(internal.Logs(ms))
+	if count, ok := lazy.Count(); ok {
+		return count
+	}
 	logCount := 0
 	rss := ms.ResourceLogs()
 	for i := 0; i < rss.Len(); i++ {
@@ -64,3 +98,73 @@ func (ms Logs) ResourceLogs() ResourceLogsSlice {
 func (ms Logs) MarkReadOnly() {
 	internal.SetLogsState(internal.Logs(ms), internal.StateReadOnly)
 }
+
+// ForEachResourceLogs calls fn for each ResourceLogs.
+// The iteration stops if fn returns false.
+func (ms Logs) ForEachResourceLogs(fn func(ResourceLogs) bool) {
+	rls := ms.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		if !fn(rls.At(i)) {
+			return
+		}
+	}
+}
+
+// ForEachScopeLogs calls fn for each ScopeLogs along with the ResourceLogs containing it.
+// The iteration stops if fn returns false.
+func (ms Logs) ForEachScopeLogs(fn func(ResourceLogs, ScopeLogs) bool) {
+	rls := ms.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			if !fn(rl, sls.At(j)) {
+				return
+			}
+		}
+	}
+}
+
+// RemoveScopeLogsIf removes the ScopeLogs for which fn returns true, then removes the ResourceLogs
+// left without any ScopeLogs.
+func (ms Logs) RemoveScopeLogsIf(fn func(ResourceLogs, ScopeLogs) bool) {
+	ms.ResourceLogs().RemoveIf(func(rl ResourceLogs) bool {
+		rl.ScopeLogs().RemoveIf(func(sl ScopeLogs) bool {
+			return fn(rl, sl)
+		})
+		return rl.ScopeLogs().Len() == 0
+	})
+}
+
+// ForEachLogRecord calls fn for each log record along with the ResourceLogs and ScopeLogs containing it.
+// The iteration stops if fn returns false.
+func (ms Logs) ForEachLogRecord(fn func(ResourceLogs, ScopeLogs, LogRecord) bool) {
+	rls := ms.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			lrs := sl.LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				if !fn(rl, sl, lrs.At(k)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// RemoveLogRecordsIf removes the log records for which fn returns true, then removes the ScopeLogs
+// and ResourceLogs left without any log record.
+func (ms Logs) RemoveLogRecordsIf(fn func(ResourceLogs, ScopeLogs, LogRecord) bool) {
+	ms.ResourceLogs().RemoveIf(func(rl ResourceLogs) bool {
+		rl.ScopeLogs().RemoveIf(func(sl ScopeLogs) bool {
+			sl.LogRecords().RemoveIf(func(lr LogRecord) bool {
+				return fn(rl, sl, lr)
+			})
+			return sl.LogRecords().Len() == 0
+		})
+		return rl.ScopeLogs().Len() == 0
+	})
+}
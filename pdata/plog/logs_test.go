@@ -9,6 +9,7 @@ import (
 
 	gogoproto "github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	goproto "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -115,6 +116,35 @@ func TestLogsCopyTo(t *testing.T) {
 	assert.EqualValues(t, logs, logsCopy)
 }
 
+func TestLogsMutable(t *testing.T) {
+	logs := NewLogs()
+	fillTestResourceLogsSlice(logs.ResourceLogs())
+	assert.Equal(t, logs, logs.Mutable())
+
+	logs.MarkReadOnly()
+	mutable := logs.Mutable()
+	assert.False(t, mutable.IsReadOnly())
+	assert.Equal(t, logs.ByteSize(), mutable.ByteSize())
+	mutable.ResourceLogs().AppendEmpty()
+	assert.Equal(t, logs.ResourceLogs().Len()+1, mutable.ResourceLogs().Len())
+}
+
+func TestLogsByteSize(t *testing.T) {
+	logs := NewLogs()
+	assert.Equal(t, 0, logs.ByteSize())
+
+	fillTestResourceLogsSlice(logs.ResourceLogs())
+	buf, err := (&ProtoMarshaler{}).MarshalLogs(logs)
+	require.NoError(t, err)
+	assert.Equal(t, len(buf), logs.ByteSize())
+
+	logs.MarkReadOnly()
+	assert.Equal(t, len(buf), logs.ByteSize())
+	// The size of read-only data is computed once.
+	logs.getOrig().ResourceLogs = nil
+	assert.Equal(t, len(buf), logs.ByteSize())
+}
+
 func TestReadOnlyLogsInvalidUsage(t *testing.T) {
 	logs := NewLogs()
 	assert.False(t, logs.IsReadOnly())
@@ -171,3 +201,82 @@ func BenchmarkLogsUsage(b *testing.B) {
 		}
 	}
 }
+
+func TestForEachLogRecord(t *testing.T) {
+	var names []string
+	generateForEachTestLogs().ForEachLogRecord(func(_ ResourceLogs, _ ScopeLogs, e LogRecord) bool {
+		names = append(names, e.SeverityText())
+		return true
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+
+	names = nil
+	generateForEachTestLogs().ForEachLogRecord(func(_ ResourceLogs, _ ScopeLogs, e LogRecord) bool {
+		names = append(names, e.SeverityText())
+		return e.SeverityText() != "b"
+	})
+	assert.Equal(t, []string{"a", "b"}, names)
+}
+
+func TestRemoveLogRecordsIf(t *testing.T) {
+	data := generateForEachTestLogs()
+	data.RemoveLogRecordsIf(func(r ResourceLogs, _ ScopeLogs, e LogRecord) bool {
+		return e.SeverityText() == "c" || r.Resource().Attributes().Len() == 0 && e.SeverityText() == "a"
+	})
+	require.Equal(t, 1, data.ResourceLogs().Len())
+	logRecords := data.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 1, logRecords.Len())
+	assert.Equal(t, "b", logRecords.At(0).SeverityText())
+}
+
+func TestForEachResourceLogs(t *testing.T) {
+	var count int
+	generateForEachTestLogs().ForEachResourceLogs(func(ResourceLogs) bool {
+		count++
+		return true
+	})
+	assert.Equal(t, 2, count)
+
+	count = 0
+	generateForEachTestLogs().ForEachResourceLogs(func(ResourceLogs) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 1, count)
+}
+
+func TestForEachScopeLogs(t *testing.T) {
+	var names []string
+	generateForEachTestLogs().ForEachScopeLogs(func(_ ResourceLogs, s ScopeLogs) bool {
+		names = append(names, s.LogRecords().At(0).SeverityText())
+		return true
+	})
+	assert.Equal(t, []string{"a", "c"}, names)
+
+	names = nil
+	generateForEachTestLogs().ForEachScopeLogs(func(_ ResourceLogs, s ScopeLogs) bool {
+		names = append(names, s.LogRecords().At(0).SeverityText())
+		return false
+	})
+	assert.Equal(t, []string{"a"}, names)
+}
+
+func TestRemoveScopeLogsIf(t *testing.T) {
+	data := generateForEachTestLogs()
+	data.RemoveScopeLogsIf(func(r ResourceLogs, _ ScopeLogs) bool {
+		return r.Resource().Attributes().Len() == 0
+	})
+	require.Equal(t, 1, data.ResourceLogs().Len())
+	assert.Equal(t, "c", data.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).SeverityText())
+}
+
+func generateForEachTestLogs() Logs {
+	data := NewLogs()
+	logRecords := data.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	logRecords.AppendEmpty().SetSeverityText("a")
+	logRecords.AppendEmpty().SetSeverityText("b")
+	r := data.ResourceLogs().AppendEmpty()
+	r.Resource().Attributes().PutStr("key", "value")
+	r.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().SetSeverityText("c")
+	return data
+}
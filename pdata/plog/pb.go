@@ -4,8 +4,12 @@
 package plog // import "go.opentelemetry.io/collector/pdata/plog"
 
 import (
+	"google.golang.org/protobuf/encoding/protowire"
+
 	"go.opentelemetry.io/collector/pdata/internal"
+	otlpcollectorlog "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/logs/v1"
 	otlplogs "go.opentelemetry.io/collector/pdata/internal/data/protogen/logs/v1"
+	"go.opentelemetry.io/collector/pdata/internal/otlp"
 )
 
 var _ MarshalSizer = (*ProtoMarshaler)(nil)
@@ -13,13 +17,15 @@ var _ MarshalSizer = (*ProtoMarshaler)(nil)
 type ProtoMarshaler struct{}
 
 func (e *ProtoMarshaler) MarshalLogs(ld Logs) ([]byte, error) {
+	if buf, ok := ld.getEncoded(); ok {
+		return append([]byte(nil), buf...), nil
+	}
 	pb := internal.LogsToProto(internal.Logs(ld))
 	return pb.Marshal()
 }
 
 func (e *ProtoMarshaler) LogsSize(ld Logs) int {
-	pb := internal.LogsToProto(internal.Logs(ld))
-	return pb.Size()
+	return ld.ByteSize()
 }
 
 var _ Unmarshaler = (*ProtoUnmarshaler)(nil)
@@ -31,3 +37,43 @@ func (d *ProtoUnmarshaler) UnmarshalLogs(buf []byte) (Logs, error) {
 	err := pb.Unmarshal(buf)
 	return Logs(internal.LogsFromProto(pb)), err
 }
+
+var _ Unmarshaler = (*LazyProtoUnmarshaler)(nil)
+
+// LazyProtoUnmarshaler unmarshals Logs from the OTLP protobuf format, deferring the decoding until the content
+// of the Logs is first accessed. Until the Logs is modified, the ProtoMarshaler reuses the received bytes instead
+// of encoding it again, so that pipelines only forwarding the data skip most of the decoding and encoding cost.
+//
+// UnmarshalLogs validates the whole encoding up front and returns an error if it is invalid, so that the content
+// is always decoded without error. The resources are decoded one at a time, reusing the validated buffer,
+// which must not be modified after calling UnmarshalLogs.
+type LazyProtoUnmarshaler struct{}
+
+func (d *LazyProtoUnmarshaler) UnmarshalLogs(buf []byte) (Logs, error) {
+	if err := internal.ValidateProto(buf, &otlpcollectorlog.ExportLogsServiceRequest{}); err != nil {
+		return NewLogs(), err
+	}
+	count, err := internal.CountResourceItems(buf, func(scope []byte) (int, error) {
+		return internal.CountMessages(scope, 2)
+	})
+	if err != nil {
+		return NewLogs(), err
+	}
+	orig := &otlpcollectorlog.ExportLogsServiceRequest{}
+	state := internal.StateMutable
+	return Logs(internal.NewLazyLogs(orig, &state, internal.NewLazyProto(buf, count, func(buf []byte) {
+		// The encoding was validated by UnmarshalLogs, so decoding its resources does not fail.
+		_ = internal.RangeMessages(buf, func(num protowire.Number, resource []byte) error {
+			if num != 1 {
+				return nil
+			}
+			rs := &otlplogs.ResourceLogs{}
+			if err := rs.Unmarshal(resource); err != nil {
+				return err
+			}
+			orig.ResourceLogs = append(orig.ResourceLogs, rs)
+			return nil
+		})
+		otlp.MigrateLogs(orig.ResourceLogs)
+	}))), nil
+}
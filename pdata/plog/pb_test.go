@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 )
@@ -37,6 +38,64 @@ func TestProtoSizerEmptyLogs(t *testing.T) {
 	assert.Equal(t, 0, sizer.LogsSize(NewLogs()))
 }
 
+func TestLazyProtoUnmarshaler(t *testing.T) {
+	ld := generateBenchmarkLogs(2)
+	buf, err := (&ProtoMarshaler{}).MarshalLogs(ld)
+	require.NoError(t, err)
+	// Unknown fields are dropped when decoding, so they are only kept if the encoding is reused.
+	buf = protowire.AppendTag(buf, 99, protowire.VarintType)
+	buf = protowire.AppendVarint(buf, 1)
+
+	lazy, err := (&LazyProtoUnmarshaler{}).UnmarshalLogs(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, lazy.LogRecordCount())
+	assert.Equal(t, len(buf), lazy.ByteSize())
+	got, err := (&ProtoMarshaler{}).MarshalLogs(lazy)
+	require.NoError(t, err)
+	assert.Equal(t, buf, got)
+
+	// Accessing the content of a mutable Logs invalidates the encoding, since it may be modified.
+	lazy.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).SetSeverityText("modified")
+	got, err = (&ProtoMarshaler{}).MarshalLogs(lazy)
+	require.NoError(t, err)
+	assert.NotEqual(t, buf, got)
+	assert.Equal(t, 2, lazy.LogRecordCount())
+	decoded, err := (&ProtoUnmarshaler{}).UnmarshalLogs(got)
+	require.NoError(t, err)
+	assert.Equal(t, "modified", decoded.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).SeverityText())
+}
+
+func TestLazyProtoUnmarshalerReadOnly(t *testing.T) {
+	buf, err := (&ProtoMarshaler{}).MarshalLogs(generateBenchmarkLogs(2))
+	require.NoError(t, err)
+	buf = protowire.AppendTag(buf, 99, protowire.VarintType)
+	buf = protowire.AppendVarint(buf, 1)
+
+	lazy, err := (&LazyProtoUnmarshaler{}).UnmarshalLogs(buf)
+	require.NoError(t, err)
+	lazy.MarkReadOnly()
+	assert.Equal(t, 2, lazy.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().Len())
+	got, err := (&ProtoMarshaler{}).MarshalLogs(lazy)
+	require.NoError(t, err)
+	assert.Equal(t, buf, got)
+}
+
+func TestLazyProtoUnmarshalerError(t *testing.T) {
+	p := &LazyProtoUnmarshaler{}
+	_, err := p.UnmarshalLogs([]byte("+$%"))
+	assert.Error(t, err)
+}
+
+func TestLazyProtoUnmarshalerInvalidContent(t *testing.T) {
+	// A log record with a trace ID of 3 bytes, which is well framed but can not be decoded.
+	buf := protowire.AppendBytes(protowire.AppendTag(nil, 9, protowire.BytesType), []byte{1, 2, 3})
+	for _, num := range []protowire.Number{2, 2, 1} {
+		buf = protowire.AppendBytes(protowire.AppendTag(nil, num, protowire.BytesType), buf)
+	}
+	_, err := (&LazyProtoUnmarshaler{}).UnmarshalLogs(buf)
+	assert.Error(t, err)
+}
+
 func BenchmarkLogsToProto(b *testing.B) {
 	marshaler := &ProtoMarshaler{}
 	logs := generateBenchmarkLogs(128)
@@ -54,6 +54,47 @@ func TestGrpc(t *testing.T) {
 	assert.Equal(t, NewExportResponse(), resp)
 }
 
+func TestGrpcLazy(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterGRPCServer(s, &fakeLogsServer{t: t})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	buf, err := (&plog.ProtoMarshaler{}).MarshalLogs(generateLogsRequest().Logs())
+	require.NoError(t, err)
+	data, err := (&plog.LazyProtoUnmarshaler{}).UnmarshalLogs(buf)
+	require.NoError(t, err)
+	request := NewExportRequestFromLogs(data)
+
+	resp, err := NewGRPCClient(cc).Export(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, NewExportResponse(), resp)
+	// The retained encoding was sent, without decoding the request.
+	encoded, ok := request.lazy.Encoded()
+	assert.True(t, ok)
+	assert.Equal(t, buf, encoded)
+}
+
 func TestGrpcError(t *testing.T) {
 	lis := bufconn.Listen(1024 * 1024)
 	s := grpc.NewServer()
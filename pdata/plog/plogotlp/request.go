@@ -4,7 +4,7 @@
 package plogotlp // import "go.opentelemetry.io/collector/pdata/plog/plogotlp"
 
 import (
-	"bytes"
+	"io"
 
 	"go.opentelemetry.io/collector/pdata/internal"
 	otlpcollectorlog "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/logs/v1"
@@ -20,6 +20,7 @@ var jsonUnmarshaler = &plog.JSONUnmarshaler{}
 type ExportRequest struct {
 	orig  *otlpcollectorlog.ExportLogsServiceRequest
 	state *internal.State
+	lazy  *internal.LazyProto
 }
 
 // NewExportRequest returns an empty ExportRequest.
@@ -35,20 +36,25 @@ func NewExportRequest() ExportRequest {
 // Because ExportRequest is a wrapper for plog.Logs,
 // any changes to the provided Logs struct will be reflected in the ExportRequest and vice versa.
 func NewExportRequestFromLogs(ld plog.Logs) ExportRequest {
+	orig, lazy := internal.GetLazyLogs(internal.Logs(ld))
 	return ExportRequest{
-		orig:  internal.GetOrigLogs(internal.Logs(ld)),
+		orig:  orig,
 		state: internal.GetLogsState(internal.Logs(ld)),
+		lazy:  lazy,
 	}
 }
 
 // MarshalProto marshals ExportRequest into proto bytes.
 func (ms ExportRequest) MarshalProto() ([]byte, error) {
-	return ms.orig.Marshal()
+	if buf, ok := ms.lazy.Encoded(); ok {
+		return append([]byte(nil), buf...), nil
+	}
+	return ms.getOrig().Marshal()
 }
 
 // UnmarshalProto unmarshalls ExportRequest from proto bytes.
 func (ms ExportRequest) UnmarshalProto(data []byte) error {
-	if err := ms.orig.Unmarshal(data); err != nil {
+	if err := ms.getOrig().Unmarshal(data); err != nil {
 		return err
 	}
 	otlp.MigrateLogs(ms.orig.ResourceLogs)
@@ -57,11 +63,7 @@ func (ms ExportRequest) UnmarshalProto(data []byte) error {
 
 // MarshalJSON marshals ExportRequest into JSON bytes.
 func (ms ExportRequest) MarshalJSON() ([]byte, error) {
-	var buf bytes.Buffer
-	if err := json.Marshal(&buf, ms.orig); err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
+	return json.MarshalBytes(ms.getOrig())
 }
 
 // UnmarshalJSON unmarshalls ExportRequest from JSON bytes.
@@ -70,10 +72,32 @@ func (ms ExportRequest) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		return err
 	}
-	*ms.orig = *internal.GetOrigLogs(internal.Logs(ld))
+	*ms.getOrig() = *internal.GetOrigLogs(internal.Logs(ld))
+	return nil
+}
+
+// MarshalJSONTo writes ExportRequest to w in the JSON format, without buffering the whole payload in memory.
+func (ms ExportRequest) MarshalJSONTo(w io.Writer) error {
+	return json.Marshal(w, ms.getOrig())
+}
+
+// UnmarshalJSONFrom unmarshalls ExportRequest from the JSON data read from r, without buffering the whole
+// payload in memory.
+func (ms ExportRequest) UnmarshalJSONFrom(r io.Reader) error {
+	ld, err := jsonUnmarshaler.UnmarshalLogsFrom(r)
+	if err != nil {
+		return err
+	}
+	*ms.getOrig() = *internal.GetOrigLogs(internal.Logs(ld))
 	return nil
 }
 
 func (ms ExportRequest) Logs() plog.Logs {
-	return plog.Logs(internal.NewLogs(ms.orig, ms.state))
+	return plog.Logs(internal.NewLazyLogs(ms.orig, ms.state, ms.lazy))
+}
+
+// getOrig returns the orig of the ExportRequest, decoding it first if it was lazily unmarshaled.
+func (ms ExportRequest) getOrig() *otlpcollectorlog.ExportLogsServiceRequest {
+	ms.lazy.Decode(ms.state)
+	return ms.orig
 }
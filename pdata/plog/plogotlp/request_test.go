@@ -4,6 +4,7 @@
 package plogotlp
 
 import (
+	"bytes"
 	"encoding/json"
 	"strings"
 	"testing"
@@ -53,3 +54,15 @@ func TestRequestJSON(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, strings.Join(strings.Fields(string(logsRequestJSON)), ""), string(got))
 }
+
+func TestRequestJSONStreaming(t *testing.T) {
+	lr := NewExportRequest()
+	assert.NoError(t, lr.UnmarshalJSONFrom(bytes.NewReader(logsRequestJSON)))
+	assert.Equal(t, "test_log_record", lr.Logs().ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().AsString())
+
+	var got bytes.Buffer
+	assert.NoError(t, lr.MarshalJSONTo(&got))
+	assert.Equal(t, strings.Join(strings.Fields(string(logsRequestJSON)), ""), got.String())
+
+	assert.Error(t, NewExportRequest().UnmarshalJSONFrom(strings.NewReader(`{"resource`)))
+}
@@ -4,8 +4,6 @@
 package plogotlp // import "go.opentelemetry.io/collector/pdata/plog/plogotlp"
 
 import (
-	"bytes"
-
 	jsoniter "github.com/json-iterator/go"
 
 	"go.opentelemetry.io/collector/pdata/internal"
@@ -40,11 +38,7 @@ func (ms ExportResponse) UnmarshalProto(data []byte) error {
 
 // MarshalJSON marshals ExportResponse into JSON bytes.
 func (ms ExportResponse) MarshalJSON() ([]byte, error) {
-	var buf bytes.Buffer
-	if err := json.Marshal(&buf, ms.orig); err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
+	return json.MarshalBytes(ms.orig)
 }
 
 // UnmarshalJSON unmarshalls ExportResponse from JSON bytes.
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package plogtest provides helpers to compare plog.Logs, e.g. in component tests.
+package plogtest // import "go.opentelemetry.io/collector/pdata/plog/plogtest"
+
+import (
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/internal/pdatacmp"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// CompareLogs compares expected and actual and returns an error listing all the differences, or nil if they are equal.
+// Resources are matched by attributes, scopes by name and version and log records by body.
+func CompareLogs(expected, actual plog.Logs, options ...CompareOption) error {
+	opts := compareOptions{}
+	for _, op := range options {
+		op(&opts)
+	}
+	return pdatacmp.Slices("", "resource", expected.ResourceLogs(), actual.ResourceLogs(),
+		func(rl plog.ResourceLogs) string { return pdatacmp.MapKey(rl.Resource().Attributes()) },
+		opts.ignoreOrder, opts.compareResourceLogs)
+}
+
+func (opts compareOptions) compareResourceLogs(path string, expected, actual plog.ResourceLogs) error {
+	return multierr.Combine(
+		pdatacmp.Resource(path, expected.Resource(), actual.Resource()),
+		pdatacmp.Field(path, "schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		pdatacmp.Slices(path, "scope", expected.ScopeLogs(), actual.ScopeLogs(),
+			func(sl plog.ScopeLogs) string { return pdatacmp.ScopeKey(sl.Scope()) },
+			opts.ignoreOrder, opts.compareScopeLogs),
+	)
+}
+
+func (opts compareOptions) compareScopeLogs(path string, expected, actual plog.ScopeLogs) error {
+	return multierr.Combine(
+		pdatacmp.Scope(path, expected.Scope(), actual.Scope()),
+		pdatacmp.Field(path, "schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		pdatacmp.Slices(path, "log record", expected.LogRecords(), actual.LogRecords(),
+			func(lr plog.LogRecord) string { return lr.Body().AsString() },
+			opts.ignoreOrder, opts.compareLogRecord),
+	)
+}
+
+func (opts compareOptions) compareLogRecord(path string, expected, actual plog.LogRecord) error {
+	errs := multierr.Combine(
+		pdatacmp.Value(path, "body", expected.Body(), actual.Body()),
+		pdatacmp.Field(path, "severity number", expected.SeverityNumber(), actual.SeverityNumber()),
+		pdatacmp.Field(path, "severity text", expected.SeverityText(), actual.SeverityText()),
+		pdatacmp.Field(path, "trace id", expected.TraceID(), actual.TraceID()),
+		pdatacmp.Field(path, "span id", expected.SpanID(), actual.SpanID()),
+		pdatacmp.Field(path, "flags", expected.Flags(), actual.Flags()),
+		pdatacmp.Map(path, "attributes", expected.Attributes(), actual.Attributes()),
+		pdatacmp.Field(path, "dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+	)
+	if !opts.ignoreTimestamps {
+		errs = multierr.Combine(errs,
+			pdatacmp.Field(path, "timestamp", expected.Timestamp(), actual.Timestamp()),
+			pdatacmp.Field(path, "observed timestamp", expected.ObservedTimestamp(), actual.ObservedTimestamp()),
+		)
+	}
+	return errs
+}
@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plogtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestCompareLogs(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected plog.Logs
+		actual   plog.Logs
+		options  []CompareOption
+		errs     []string
+	}{
+		{
+			name:     "equal",
+			expected: generateLogs("log1", "log2"),
+			actual:   generateLogs("log1", "log2"),
+		},
+		{
+			name:     "log record order",
+			expected: generateLogs("log1", "log2"),
+			actual:   generateLogs("log2", "log1"),
+			errs: []string{
+				`resource "host.name=host" > scope "scope" > log record "log1": expected at index 0, actual at index 1`,
+				`resource "host.name=host" > scope "scope" > log record "log2": expected at index 1, actual at index 0`,
+			},
+		},
+		{
+			name:     "ignore log record order",
+			expected: generateLogs("log1", "log2"),
+			actual:   generateLogs("log2", "log1"),
+			options:  []CompareOption{IgnoreOrder()},
+		},
+		{
+			name:     "missing and unexpected log records",
+			expected: generateLogs("log1"),
+			actual:   generateLogs("log2"),
+			errs: []string{
+				`resource "host.name=host" > scope "scope": missing expected log record "log1"`,
+				`resource "host.name=host" > scope "scope": unexpected log record "log2"`,
+			},
+		},
+		{
+			name:     "different fields",
+			expected: generateLogs("log1"),
+			actual: func() plog.Logs {
+				ld := generateLogs("log1")
+				ld.ResourceLogs().At(0).ScopeLogs().At(0).Scope().Attributes().PutBool("enabled", true)
+				lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+				lr.SetSeverityNumber(plog.SeverityNumberWarn)
+				lr.Attributes().Remove("attr")
+				return ld
+			}(),
+			errs: []string{
+				`resource "host.name=host" > scope "scope": scope attributes: unexpected key "enabled"`,
+				`resource "host.name=host" > scope "scope" > log record "log1": severity number: expected Info, actual Warn`,
+				`resource "host.name=host" > scope "scope" > log record "log1": attributes: missing expected key "attr"`,
+			},
+		},
+		{
+			name:     "timestamps",
+			expected: generateLogs("log1"),
+			actual: func() plog.Logs {
+				ld := generateLogs("log1")
+				ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).SetObservedTimestamp(0)
+				return ld
+			}(),
+			errs: []string{
+				`resource "host.name=host" > scope "scope" > log record "log1": observed timestamp: expected 1970-01-01 00:00:00.000000002 +0000 UTC, actual 1970-01-01 00:00:00 +0000 UTC`,
+			},
+		},
+		{
+			name:     "ignore timestamps",
+			expected: generateLogs("log1"),
+			actual: func() plog.Logs {
+				ld := generateLogs("log1")
+				lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+				lr.SetTimestamp(10)
+				lr.SetObservedTimestamp(20)
+				return ld
+			}(),
+			options: []CompareOption{IgnoreTimestamps()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CompareLogs(tt.expected, tt.actual, tt.options...)
+			if len(tt.errs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var errs []string
+			for _, e := range multierr.Errors(err) {
+				errs = append(errs, e.Error())
+			}
+			assert.Equal(t, tt.errs, errs)
+		})
+	}
+}
+
+func generateLogs(bodies ...string) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("host.name", "host")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("scope")
+	for _, body := range bodies {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.Body().SetStr(body)
+		lr.SetSeverityNumber(plog.SeverityNumberInfo)
+		lr.SetTimestamp(1)
+		lr.SetObservedTimestamp(2)
+		lr.Attributes().PutStr("attr", "value")
+	}
+	return ld
+}
@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plogtest // import "go.opentelemetry.io/collector/pdata/plog/plogtest"
+
+// CompareOption configures the comparison done by CompareLogs.
+type CompareOption func(*compareOptions)
+
+type compareOptions struct {
+	ignoreOrder      bool
+	ignoreTimestamps bool
+}
+
+// IgnoreOrder makes the comparison ignore the order of the resources, scopes and log records.
+func IgnoreOrder() CompareOption {
+	return func(o *compareOptions) {
+		o.ignoreOrder = true
+	}
+}
+
+// IgnoreTimestamps makes the comparison ignore the timestamps and observed timestamps of the log records.
+func IgnoreTimestamps() CompareOption {
+	return func(o *compareOptions) {
+		o.ignoreTimestamps = true
+	}
+}
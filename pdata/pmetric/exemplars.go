@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetric // import "go.opentelemetry.io/collector/pdata/pmetric"
+
+import (
+	"sort"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// AppendDouble appends an Exemplar with the given value, measured at ts in the span identified by traceID and spanID,
+// e.g. to attach the span that produced a measurement to a data point. It returns the newly added Exemplar.
+func (es ExemplarSlice) AppendDouble(ts pcommon.Timestamp, value float64, traceID pcommon.TraceID, spanID pcommon.SpanID) Exemplar {
+	e := es.AppendEmpty()
+	e.SetTimestamp(ts)
+	e.SetDoubleValue(value)
+	e.SetTraceID(traceID)
+	e.SetSpanID(spanID)
+	return e
+}
+
+// RemoveOutsideRange removes the exemplars measured before start or after end,
+// e.g. to keep only the exemplars of the time range of a data point.
+func (es ExemplarSlice) RemoveOutsideRange(start, end pcommon.Timestamp) {
+	es.RemoveIf(func(e Exemplar) bool {
+		return e.Timestamp() < start || e.Timestamp() > end
+	})
+}
+
+// KeepLatest removes the exemplars but the n most recent ones, keeping their order.
+func (es ExemplarSlice) KeepLatest(n int) {
+	if es.Len() <= n {
+		return
+	}
+	if n <= 0 {
+		es.RemoveIf(func(Exemplar) bool { return true })
+		return
+	}
+	timestamps := make([]pcommon.Timestamp, es.Len())
+	for i := 0; i < es.Len(); i++ {
+		timestamps[i] = es.At(i).Timestamp()
+	}
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] > timestamps[j] })
+	oldest := timestamps[n-1]
+	// The exemplars measured at the same time as the oldest one kept are kept in order until n are left.
+	remaining := 0
+	for _, ts := range timestamps[:n] {
+		if ts == oldest {
+			remaining++
+		}
+	}
+	es.RemoveIf(func(e Exemplar) bool {
+		switch {
+		case e.Timestamp() > oldest:
+			return false
+		case e.Timestamp() == oldest && remaining > 0:
+			remaining--
+			return false
+		}
+		return true
+	})
+}
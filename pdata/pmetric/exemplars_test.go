@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestExemplarSliceAppendDouble(t *testing.T) {
+	es := NewExemplarSlice()
+	traceID := pcommon.TraceID([16]byte{1, 2, 3})
+	spanID := pcommon.SpanID([8]byte{4, 5, 6})
+	e := es.AppendDouble(10, 1.5, traceID, spanID)
+	assert.Equal(t, 1, es.Len())
+	assert.EqualValues(t, 10, e.Timestamp())
+	assert.Equal(t, ExemplarValueTypeDouble, e.ValueType())
+	assert.EqualValues(t, 1.5, e.DoubleValue())
+	assert.Equal(t, traceID, e.TraceID())
+	assert.Equal(t, spanID, e.SpanID())
+}
+
+func TestExemplarSliceRemoveOutsideRange(t *testing.T) {
+	es := generateExemplarsAt(5, 10, 15, 20)
+	es.RemoveOutsideRange(10, 15)
+	assert.Equal(t, []pcommon.Timestamp{10, 15}, exemplarTimestamps(es))
+}
+
+func TestExemplarSliceKeepLatest(t *testing.T) {
+	es := generateExemplarsAt(30, 10, 20, 20, 40)
+	es.KeepLatest(3)
+	assert.Equal(t, []pcommon.Timestamp{30, 20, 40}, exemplarTimestamps(es))
+
+	es.KeepLatest(5)
+	assert.Equal(t, 3, es.Len())
+
+	es.KeepLatest(0)
+	assert.Equal(t, 0, es.Len())
+}
+
+func generateExemplarsAt(timestamps ...pcommon.Timestamp) ExemplarSlice {
+	es := NewExemplarSlice()
+	for _, ts := range timestamps {
+		es.AppendEmpty().SetTimestamp(ts)
+	}
+	return es
+}
+
+func exemplarTimestamps(es ExemplarSlice) []pcommon.Timestamp {
+	var timestamps []pcommon.Timestamp
+	for i := 0; i < es.Len(); i++ {
+		timestamps = append(timestamps, es.At(i).Timestamp())
+	}
+	return timestamps
+}
@@ -86,6 +86,25 @@ func (es ExemplarSlice) AppendEmpty() Exemplar {
 	return es.At(es.Len() - 1)
 }
 
+// AppendEmptyN will append to the end of the slice n empty Exemplar.
+// If fn is not nil, it is called with each newly added Exemplar and its index among them, e.g. to
+// fill them from a native Go slice:
+//
+//	es.AppendEmptyN(len(items), func(i int, e Exemplar) {
+//	    // Here should set all the values for e from items[i].
+//	})
+func (es ExemplarSlice) AppendEmptyN(n int, fn func(int, Exemplar)) {
+	es.state.AssertMutable()
+	oldLen := len(*es.orig)
+	*es.orig = append(*es.orig, make([]otlpmetrics.Exemplar, n)...)
+	if fn == nil {
+		return
+	}
+	for i := 0; i < n; i++ {
+		fn(i, es.At(oldLen+i))
+	}
+}
+
 // MoveAndAppendTo moves all elements from the current slice and appends them to the dest.
 // The current slice will be cleared.
 func (es ExemplarSlice) MoveAndAppendTo(dest ExemplarSlice) {
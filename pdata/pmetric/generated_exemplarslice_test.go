@@ -38,6 +38,7 @@ func TestExemplarSliceReadOnly(t *testing.T) {
 	es := newExemplarSlice(&[]otlpmetrics.Exemplar{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewExemplarSlice()
 	es.CopyTo(es2)
@@ -79,6 +80,25 @@ func TestExemplarSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestExemplarSlice(), es)
 }
 
+func TestExemplarSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestExemplarSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el Exemplar) {
+		indexes = append(indexes, i)
+		fillTestExemplar(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestExemplar(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewExemplar(), es.At(es.Len()-1))
+}
+
 func TestExemplarSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestExemplarSlice()
@@ -39,6 +39,7 @@ func TestExponentialHistogramDataPointSliceReadOnly(t *testing.T) {
 	es := newExponentialHistogramDataPointSlice(&[]*otlpmetrics.ExponentialHistogramDataPoint{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewExponentialHistogramDataPointSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestExponentialHistogramDataPointSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestExponentialHistogramDataPointSlice(), es)
 }
 
+func TestExponentialHistogramDataPointSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestExponentialHistogramDataPointSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el ExponentialHistogramDataPoint) {
+		indexes = append(indexes, i)
+		fillTestExponentialHistogramDataPoint(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestExponentialHistogramDataPoint(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewExponentialHistogramDataPoint(), es.At(es.Len()-1))
+}
+
 func TestExponentialHistogramDataPointSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestExponentialHistogramDataPointSlice()
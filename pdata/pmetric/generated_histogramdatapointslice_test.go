@@ -39,6 +39,7 @@ func TestHistogramDataPointSliceReadOnly(t *testing.T) {
 	es := newHistogramDataPointSlice(&[]*otlpmetrics.HistogramDataPoint{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewHistogramDataPointSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestHistogramDataPointSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestHistogramDataPointSlice(), es)
 }
 
+func TestHistogramDataPointSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestHistogramDataPointSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el HistogramDataPoint) {
+		indexes = append(indexes, i)
+		fillTestHistogramDataPoint(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestHistogramDataPoint(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewHistogramDataPoint(), es.At(es.Len()-1))
+}
+
 func TestHistogramDataPointSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestHistogramDataPointSlice()
@@ -39,6 +39,7 @@ func TestMetricSliceReadOnly(t *testing.T) {
 	es := newMetricSlice(&[]*otlpmetrics.Metric{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewMetricSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestMetricSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestMetricSlice(), es)
 }
 
+func TestMetricSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestMetricSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el Metric) {
+		indexes = append(indexes, i)
+		fillTestMetric(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestMetric(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewMetric(), es.At(es.Len()-1))
+}
+
 func TestMetricSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestMetricSlice()
@@ -88,6 +88,29 @@ func (es NumberDataPointSlice) AppendEmpty() NumberDataPoint {
 	return es.At(es.Len() - 1)
 }
 
+// AppendEmptyN will append to the end of the slice n empty NumberDataPoint, allocated together.
+// If fn is not nil, it is called with each newly added NumberDataPoint and its index among them, e.g. to
+// fill them from a native Go slice:
+//
+//	es.AppendEmptyN(len(items), func(i int, e NumberDataPoint) {
+//	    // Here should set all the values for e from items[i].
+//	})
+func (es NumberDataPointSlice) AppendEmptyN(n int, fn func(int, NumberDataPoint)) {
+	es.state.AssertMutable()
+	oldLen := len(*es.orig)
+	*es.orig = append(*es.orig, make([]*otlpmetrics.NumberDataPoint, n)...)
+	elems := make([]otlpmetrics.NumberDataPoint, n)
+	for i := range elems {
+		(*es.orig)[oldLen+i] = &elems[i]
+	}
+	if fn == nil {
+		return
+	}
+	for i := 0; i < n; i++ {
+		fn(i, es.At(oldLen+i))
+	}
+}
+
 // MoveAndAppendTo moves all elements from the current slice and appends them to the dest.
 // The current slice will be cleared.
 func (es NumberDataPointSlice) MoveAndAppendTo(dest NumberDataPointSlice) {
@@ -39,6 +39,7 @@ func TestNumberDataPointSliceReadOnly(t *testing.T) {
 	es := newNumberDataPointSlice(&[]*otlpmetrics.NumberDataPoint{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewNumberDataPointSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestNumberDataPointSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestNumberDataPointSlice(), es)
 }
 
+func TestNumberDataPointSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestNumberDataPointSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el NumberDataPoint) {
+		indexes = append(indexes, i)
+		fillTestNumberDataPoint(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestNumberDataPoint(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewNumberDataPoint(), es.At(es.Len()-1))
+}
+
 func TestNumberDataPointSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestNumberDataPointSlice()
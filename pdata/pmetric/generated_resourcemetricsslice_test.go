@@ -39,6 +39,7 @@ func TestResourceMetricsSliceReadOnly(t *testing.T) {
 	es := newResourceMetricsSlice(&[]*otlpmetrics.ResourceMetrics{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewResourceMetricsSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestResourceMetricsSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestResourceMetricsSlice(), es)
 }
 
+func TestResourceMetricsSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestResourceMetricsSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el ResourceMetrics) {
+		indexes = append(indexes, i)
+		fillTestResourceMetrics(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestResourceMetrics(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewResourceMetrics(), es.At(es.Len()-1))
+}
+
 func TestResourceMetricsSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestResourceMetricsSlice()
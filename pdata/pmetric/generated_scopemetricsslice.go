@@ -88,6 +88,29 @@ func (es ScopeMetricsSlice) AppendEmpty() ScopeMetrics {
 	return es.At(es.Len() - 1)
 }
 
+// AppendEmptyN will append to the end of the slice n empty ScopeMetrics, allocated together.
+// If fn is not nil, it is called with each newly added ScopeMetrics and its index among them, e.g. to
+// fill them from a native Go slice:
+//
+//	es.AppendEmptyN(len(items), func(i int, e ScopeMetrics) {
+//	    // Here should set all the values for e from items[i].
+//	})
+func (es ScopeMetricsSlice) AppendEmptyN(n int, fn func(int, ScopeMetrics)) {
+	es.state.AssertMutable()
+	oldLen := len(*es.orig)
+	*es.orig = append(*es.orig, make([]*otlpmetrics.ScopeMetrics, n)...)
+	elems := make([]otlpmetrics.ScopeMetrics, n)
+	for i := range elems {
+		(*es.orig)[oldLen+i] = &elems[i]
+	}
+	if fn == nil {
+		return
+	}
+	for i := 0; i < n; i++ {
+		fn(i, es.At(oldLen+i))
+	}
+}
+
 // MoveAndAppendTo moves all elements from the current slice and appends them to the dest.
 // The current slice will be cleared.
 func (es ScopeMetricsSlice) MoveAndAppendTo(dest ScopeMetricsSlice) {
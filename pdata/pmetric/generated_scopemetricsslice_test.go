@@ -39,6 +39,7 @@ func TestScopeMetricsSliceReadOnly(t *testing.T) {
 	es := newScopeMetricsSlice(&[]*otlpmetrics.ScopeMetrics{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewScopeMetricsSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestScopeMetricsSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestScopeMetricsSlice(), es)
 }
 
+func TestScopeMetricsSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestScopeMetricsSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el ScopeMetrics) {
+		indexes = append(indexes, i)
+		fillTestScopeMetrics(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestScopeMetrics(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewScopeMetrics(), es.At(es.Len()-1))
+}
+
 func TestScopeMetricsSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestScopeMetricsSlice()
@@ -88,6 +88,29 @@ func (es SummaryDataPointSlice) AppendEmpty() SummaryDataPoint {
 	return es.At(es.Len() - 1)
 }
 
+// AppendEmptyN will append to the end of the slice n empty SummaryDataPoint, allocated together.
+// If fn is not nil, it is called with each newly added SummaryDataPoint and its index among them, e.g. to
+// fill them from a native Go slice:
+//
+//	es.AppendEmptyN(len(items), func(i int, e SummaryDataPoint) {
+//	    // Here should set all the values for e from items[i].
+//	})
+func (es SummaryDataPointSlice) AppendEmptyN(n int, fn func(int, SummaryDataPoint)) {
+	es.state.AssertMutable()
+	oldLen := len(*es.orig)
+	*es.orig = append(*es.orig, make([]*otlpmetrics.SummaryDataPoint, n)...)
+	elems := make([]otlpmetrics.SummaryDataPoint, n)
+	for i := range elems {
+		(*es.orig)[oldLen+i] = &elems[i]
+	}
+	if fn == nil {
+		return
+	}
+	for i := 0; i < n; i++ {
+		fn(i, es.At(oldLen+i))
+	}
+}
+
 // MoveAndAppendTo moves all elements from the current slice and appends them to the dest.
 // The current slice will be cleared.
 func (es SummaryDataPointSlice) MoveAndAppendTo(dest SummaryDataPointSlice) {
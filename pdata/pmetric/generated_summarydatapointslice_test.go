@@ -39,6 +39,7 @@ func TestSummaryDataPointSliceReadOnly(t *testing.T) {
 	es := newSummaryDataPointSlice(&[]*otlpmetrics.SummaryDataPoint{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewSummaryDataPointSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestSummaryDataPointSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestSummaryDataPointSlice(), es)
 }
 
+func TestSummaryDataPointSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestSummaryDataPointSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el SummaryDataPoint) {
+		indexes = append(indexes, i)
+		fillTestSummaryDataPoint(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestSummaryDataPoint(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewSummaryDataPoint(), es.At(es.Len()-1))
+}
+
 func TestSummaryDataPointSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestSummaryDataPointSlice()
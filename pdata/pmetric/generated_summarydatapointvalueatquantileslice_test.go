@@ -39,6 +39,7 @@ func TestSummaryDataPointValueAtQuantileSliceReadOnly(t *testing.T) {
 	es := newSummaryDataPointValueAtQuantileSlice(&[]*otlpmetrics.SummaryDataPoint_ValueAtQuantile{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewSummaryDataPointValueAtQuantileSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestSummaryDataPointValueAtQuantileSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestSummaryDataPointValueAtQuantileSlice(), es)
 }
 
+func TestSummaryDataPointValueAtQuantileSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestSummaryDataPointValueAtQuantileSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el SummaryDataPointValueAtQuantile) {
+		indexes = append(indexes, i)
+		fillTestSummaryDataPointValueAtQuantile(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestSummaryDataPointValueAtQuantile(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewSummaryDataPointValueAtQuantile(), es.At(es.Len()-1))
+}
+
 func TestSummaryDataPointValueAtQuantileSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestSummaryDataPointValueAtQuantileSlice()
@@ -0,0 +1,209 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetric // import "go.opentelemetry.io/collector/pdata/pmetric"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// Merge adds the measurements of other to the HistogramDataPoint. Both data points must have the same explicit bounds.
+// The counts, sums, minimums and maximums are combined, the exemplars of other are appended and the time range
+// is extended to cover both data points. The attributes are not modified.
+func (ms HistogramDataPoint) Merge(other HistogramDataPoint) error {
+	if !equalBounds(ms.ExplicitBounds(), other.ExplicitBounds()) {
+		return errors.New("cannot merge histogram data points with different explicit bounds")
+	}
+	counts, otherCounts := ms.BucketCounts(), other.BucketCounts()
+	switch {
+	case otherCounts.Len() == 0 && other.Count() == 0:
+	case counts.Len() == 0 && ms.Count() == 0:
+		otherCounts.CopyTo(counts)
+	case counts.Len() != otherCounts.Len():
+		return fmt.Errorf("cannot merge histogram data points with %d and %d bucket counts", counts.Len(), otherCounts.Len())
+	default:
+		for i := 0; i < counts.Len(); i++ {
+			counts.SetAt(i, counts.At(i)+otherCounts.At(i))
+		}
+	}
+	mergeHistogramFields(ms, other)
+	return nil
+}
+
+// Rescale lowers the scale of the ExponentialHistogramDataPoint, merging each 2^(Scale() - scale) consecutive
+// buckets into one. It returns an error if scale is greater than the current scale, since buckets cannot be split.
+func (ms ExponentialHistogramDataPoint) Rescale(scale int32) error {
+	if scale > ms.Scale() {
+		return fmt.Errorf("cannot rescale exponential histogram data point from scale %d to the greater scale %d", ms.Scale(), scale)
+	}
+	downscaleBuckets(ms.Positive(), ms.Scale()-scale)
+	downscaleBuckets(ms.Negative(), ms.Scale()-scale)
+	ms.SetScale(scale)
+	return nil
+}
+
+// Merge adds the measurements of other to the ExponentialHistogramDataPoint. Both data points are brought to the
+// lowest of their scales and to the greatest of their zero thresholds, the buckets below the zero threshold being
+// counted in the zero count. The counts, sums, minimums and maximums are combined, the exemplars of other are
+// appended and the time range is extended to cover both data points. The attributes are not modified.
+func (ms ExponentialHistogramDataPoint) Merge(other ExponentialHistogramDataPoint) {
+	positive, negative := NewExponentialHistogramDataPointBuckets(), NewExponentialHistogramDataPointBuckets()
+	other.Positive().CopyTo(positive)
+	other.Negative().CopyTo(negative)
+	scale := other.Scale()
+	if ms.Positive().BucketCounts().Len() == 0 && ms.Negative().BucketCounts().Len() == 0 {
+		// Without buckets, the data point takes the scale of other to not lose its precision.
+		ms.SetScale(scale)
+	} else if ms.Scale() < scale {
+		scale = ms.Scale()
+	}
+	_ = ms.Rescale(scale)
+	downscaleBuckets(positive, other.Scale()-scale)
+	downscaleBuckets(negative, other.Scale()-scale)
+
+	if other.ZeroThreshold() > ms.ZeroThreshold() {
+		ms.SetZeroThreshold(other.ZeroThreshold())
+		ms.SetZeroCount(ms.ZeroCount() +
+			removeBucketsBelow(ms.Positive(), scale, ms.ZeroThreshold()) +
+			removeBucketsBelow(ms.Negative(), scale, ms.ZeroThreshold()))
+	}
+	ms.SetZeroCount(ms.ZeroCount() + other.ZeroCount() +
+		removeBucketsBelow(positive, scale, ms.ZeroThreshold()) +
+		removeBucketsBelow(negative, scale, ms.ZeroThreshold()))
+	mergeBuckets(ms.Positive(), positive)
+	mergeBuckets(ms.Negative(), negative)
+	mergeHistogramFields(ms, other)
+}
+
+// histogramDataPoint is implemented by HistogramDataPoint and ExponentialHistogramDataPoint.
+type histogramDataPoint interface {
+	StartTimestamp() pcommon.Timestamp
+	SetStartTimestamp(pcommon.Timestamp)
+	Timestamp() pcommon.Timestamp
+	SetTimestamp(pcommon.Timestamp)
+	Count() uint64
+	SetCount(uint64)
+	Sum() float64
+	HasSum() bool
+	SetSum(float64)
+	RemoveSum()
+	Min() float64
+	HasMin() bool
+	SetMin(float64)
+	RemoveMin()
+	Max() float64
+	HasMax() bool
+	SetMax(float64)
+	RemoveMax()
+	Exemplars() ExemplarSlice
+}
+
+// mergeHistogramFields merges the fields shared by both kinds of histogram data points.
+func mergeHistogramFields[T histogramDataPoint](dest, src T) {
+	switch {
+	case src.Count() == 0:
+	case dest.Count() == 0:
+		copyOptional(src.HasSum(), src.Sum(), dest.SetSum, dest.RemoveSum)
+		copyOptional(src.HasMin(), src.Min(), dest.SetMin, dest.RemoveMin)
+		copyOptional(src.HasMax(), src.Max(), dest.SetMax, dest.RemoveMax)
+	default:
+		copyOptional(dest.HasSum() && src.HasSum(), dest.Sum()+src.Sum(), dest.SetSum, dest.RemoveSum)
+		copyOptional(dest.HasMin() && src.HasMin(), math.Min(dest.Min(), src.Min()), dest.SetMin, dest.RemoveMin)
+		copyOptional(dest.HasMax() && src.HasMax(), math.Max(dest.Max(), src.Max()), dest.SetMax, dest.RemoveMax)
+	}
+	dest.SetCount(dest.Count() + src.Count())
+	if src.StartTimestamp() != 0 && (dest.StartTimestamp() == 0 || src.StartTimestamp() < dest.StartTimestamp()) {
+		dest.SetStartTimestamp(src.StartTimestamp())
+	}
+	if src.Timestamp() > dest.Timestamp() {
+		dest.SetTimestamp(src.Timestamp())
+	}
+	for i := 0; i < src.Exemplars().Len(); i++ {
+		src.Exemplars().At(i).CopyTo(dest.Exemplars().AppendEmpty())
+	}
+}
+
+func copyOptional(has bool, v float64, set func(float64), remove func()) {
+	if has {
+		set(v)
+	} else {
+		remove()
+	}
+}
+
+func equalBounds(a, b pcommon.Float64Slice) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	for i := 0; i < a.Len(); i++ {
+		if a.At(i) != b.At(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// downscaleBuckets lowers the scale of the buckets by delta.
+func downscaleBuckets(buckets ExponentialHistogramDataPointBuckets, delta int32) {
+	if delta == 0 {
+		return
+	}
+	counts := buckets.BucketCounts()
+	offset := buckets.Offset()
+	buckets.SetOffset(offset >> delta)
+	if counts.Len() == 0 {
+		return
+	}
+	newCounts := make([]uint64, ((offset+int32(counts.Len())-1)>>delta)-buckets.Offset()+1)
+	for i := 0; i < counts.Len(); i++ {
+		newCounts[((offset+int32(i))>>delta)-buckets.Offset()] += counts.At(i)
+	}
+	counts.FromRaw(newCounts)
+}
+
+// removeBucketsBelow removes the buckets whose upper bound is not greater than threshold and returns their count.
+func removeBucketsBelow(buckets ExponentialHistogramDataPointBuckets, scale int32, threshold float64) uint64 {
+	counts := buckets.BucketCounts().AsRaw()
+	base := math.Exp2(math.Exp2(-float64(scale)))
+	removed, n := uint64(0), 0
+	for ; n < len(counts) && math.Pow(base, float64(buckets.Offset()+int32(n)+1)) <= threshold; n++ {
+		removed += counts[n]
+	}
+	if n > 0 {
+		buckets.SetOffset(buckets.Offset() + int32(n))
+		buckets.BucketCounts().FromRaw(counts[n:])
+	}
+	return removed
+}
+
+// mergeBuckets adds the counts of src to dest, both having the same scale.
+func mergeBuckets(dest, src ExponentialHistogramDataPointBuckets) {
+	srcCounts, destCounts := src.BucketCounts(), dest.BucketCounts()
+	if srcCounts.Len() == 0 {
+		return
+	}
+	if destCounts.Len() == 0 {
+		src.CopyTo(dest)
+		return
+	}
+	start, end := dest.Offset(), dest.Offset()+int32(destCounts.Len())
+	if src.Offset() < start {
+		start = src.Offset()
+	}
+	if srcEnd := src.Offset() + int32(srcCounts.Len()); srcEnd > end {
+		end = srcEnd
+	}
+	counts := make([]uint64, end-start)
+	for i := 0; i < destCounts.Len(); i++ {
+		counts[dest.Offset()-start+int32(i)] += destCounts.At(i)
+	}
+	for i := 0; i < srcCounts.Len(); i++ {
+		counts[src.Offset()-start+int32(i)] += srcCounts.At(i)
+	}
+	dest.SetOffset(start)
+	destCounts.FromRaw(counts)
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistogramDataPointMerge(t *testing.T) {
+	dp := NewHistogramDataPoint()
+	dp.SetStartTimestamp(20)
+	dp.SetTimestamp(30)
+	dp.ExplicitBounds().FromRaw([]float64{1, 10})
+	dp.BucketCounts().FromRaw([]uint64{1, 2, 0})
+	dp.SetCount(3)
+	dp.SetSum(12)
+	dp.SetMin(0.5)
+	dp.SetMax(6)
+
+	other := NewHistogramDataPoint()
+	other.SetStartTimestamp(10)
+	other.SetTimestamp(25)
+	other.ExplicitBounds().FromRaw([]float64{1, 10})
+	other.BucketCounts().FromRaw([]uint64{0, 1, 1})
+	other.SetCount(2)
+	other.SetSum(25)
+	other.SetMin(5)
+	other.SetMax(20)
+	other.Exemplars().AppendEmpty().SetDoubleValue(20)
+
+	require.NoError(t, dp.Merge(other))
+	assert.Equal(t, []uint64{1, 3, 1}, dp.BucketCounts().AsRaw())
+	assert.EqualValues(t, 5, dp.Count())
+	assert.EqualValues(t, 37, dp.Sum())
+	assert.EqualValues(t, 0.5, dp.Min())
+	assert.EqualValues(t, 20, dp.Max())
+	assert.EqualValues(t, 10, dp.StartTimestamp())
+	assert.EqualValues(t, 30, dp.Timestamp())
+	assert.Equal(t, 1, dp.Exemplars().Len())
+
+	other.RemoveMin()
+	require.NoError(t, dp.Merge(other))
+	assert.False(t, dp.HasMin())
+	assert.EqualValues(t, 20, dp.Max())
+}
+
+func TestHistogramDataPointMergeIntoEmpty(t *testing.T) {
+	other := NewHistogramDataPoint()
+	other.ExplicitBounds().FromRaw([]float64{1})
+	other.BucketCounts().FromRaw([]uint64{1, 1})
+	other.SetCount(2)
+	other.SetMin(0)
+
+	dp := NewHistogramDataPoint()
+	dp.ExplicitBounds().FromRaw([]float64{1})
+	require.NoError(t, dp.Merge(other))
+	assert.Equal(t, []uint64{1, 1}, dp.BucketCounts().AsRaw())
+	assert.True(t, dp.HasMin())
+	assert.False(t, dp.HasMax())
+}
+
+func TestHistogramDataPointMergeError(t *testing.T) {
+	dp := NewHistogramDataPoint()
+	dp.ExplicitBounds().FromRaw([]float64{1})
+	dp.BucketCounts().FromRaw([]uint64{1, 1})
+	dp.SetCount(2)
+
+	other := NewHistogramDataPoint()
+	other.ExplicitBounds().FromRaw([]float64{2})
+	other.BucketCounts().FromRaw([]uint64{1, 1})
+	other.SetCount(2)
+	assert.Error(t, dp.Merge(other))
+
+	other.ExplicitBounds().FromRaw([]float64{1})
+	other.BucketCounts().FromRaw(nil)
+	assert.Error(t, dp.Merge(other))
+	assert.EqualValues(t, 2, dp.Count())
+}
+
+func TestExponentialHistogramDataPointRescale(t *testing.T) {
+	dp := NewExponentialHistogramDataPoint()
+	dp.SetScale(2)
+	dp.Positive().SetOffset(-3)
+	dp.Positive().BucketCounts().FromRaw([]uint64{1, 2, 3, 4, 5})
+	dp.Negative().SetOffset(5)
+
+	require.NoError(t, dp.Rescale(1))
+	assert.EqualValues(t, 1, dp.Scale())
+	// Buckets -3, -2 | -1, 0 | 1 become -2, -1, 0.
+	assert.EqualValues(t, -2, dp.Positive().Offset())
+	assert.Equal(t, []uint64{1, 5, 9}, dp.Positive().BucketCounts().AsRaw())
+	assert.EqualValues(t, 2, dp.Negative().Offset())
+
+	assert.Error(t, dp.Rescale(2))
+}
+
+func TestExponentialHistogramDataPointMerge(t *testing.T) {
+	dp := NewExponentialHistogramDataPoint()
+	dp.SetScale(1)
+	dp.SetCount(4)
+	dp.SetZeroCount(1)
+	dp.Positive().SetOffset(0)
+	dp.Positive().BucketCounts().FromRaw([]uint64{1, 2})
+	dp.SetSum(10)
+
+	other := NewExponentialHistogramDataPoint()
+	other.SetScale(2)
+	other.SetCount(5)
+	other.SetZeroThreshold(1.5)
+	other.Positive().SetOffset(2)
+	other.Positive().BucketCounts().FromRaw([]uint64{1, 1, 1})
+	other.Negative().SetOffset(-8)
+	other.Negative().BucketCounts().FromRaw([]uint64{2})
+	other.SetSum(5)
+
+	dp.Merge(other)
+	assert.EqualValues(t, 1, dp.Scale())
+	assert.EqualValues(t, 9, dp.Count())
+	assert.EqualValues(t, 15, dp.Sum())
+	assert.EqualValues(t, 1.5, dp.ZeroThreshold())
+	// The bucket (1, 1.41] of dp and the negative bucket of other are below the zero threshold.
+	assert.EqualValues(t, 4, dp.ZeroCount())
+	assert.EqualValues(t, 1, dp.Positive().Offset())
+	assert.Equal(t, []uint64{4, 1}, dp.Positive().BucketCounts().AsRaw())
+	assert.Equal(t, 0, dp.Negative().BucketCounts().Len())
+}
+
+func TestExponentialHistogramDataPointMergeIntoEmpty(t *testing.T) {
+	other := NewExponentialHistogramDataPoint()
+	other.SetScale(5)
+	other.SetCount(1)
+	other.Positive().SetOffset(3)
+	other.Positive().BucketCounts().FromRaw([]uint64{1})
+
+	dp := NewExponentialHistogramDataPoint()
+	dp.Merge(other)
+	assert.EqualValues(t, 5, dp.Scale())
+	assert.EqualValues(t, 3, dp.Positive().Offset())
+	assert.Equal(t, []uint64{1}, dp.Positive().BucketCounts().AsRaw())
+}
@@ -4,8 +4,8 @@
 package pmetric // import "go.opentelemetry.io/collector/pdata/pmetric"
 
 import (
-	"bytes"
 	"fmt"
+	"io"
 
 	jsoniter "github.com/json-iterator/go"
 
@@ -22,10 +22,14 @@ type JSONMarshaler struct{}
 
 // MarshalMetrics to the OTLP/JSON format.
 func (*JSONMarshaler) MarshalMetrics(md Metrics) ([]byte, error) {
-	buf := bytes.Buffer{}
 	pb := internal.MetricsToProto(internal.Metrics(md))
-	err := json.Marshal(&buf, &pb)
-	return buf.Bytes(), err
+	return json.MarshalBytes(&pb)
+}
+
+// MarshalMetricsTo writes md to w in the OTLP/JSON format, without buffering the whole payload in memory.
+func (*JSONMarshaler) MarshalMetricsTo(w io.Writer, md Metrics) error {
+	pb := internal.MetricsToProto(internal.Metrics(md))
+	return json.Marshal(w, &pb)
 }
 
 // JSONUnmarshaler unmarshals OTLP/JSON formatted-bytes to pdata.Metrics.
@@ -35,6 +39,18 @@ type JSONUnmarshaler struct{}
 func (*JSONUnmarshaler) UnmarshalMetrics(buf []byte) (Metrics, error) {
 	iter := jsoniter.ConfigFastest.BorrowIterator(buf)
 	defer jsoniter.ConfigFastest.ReturnIterator(iter)
+	return unmarshalMetrics(iter)
+}
+
+// UnmarshalMetricsFrom reads OTLP/JSON formatted data from r into pdata.Metrics, without
+// buffering the whole payload in memory.
+func (*JSONUnmarshaler) UnmarshalMetricsFrom(r io.Reader) (Metrics, error) {
+	iter := json.BorrowIterator(r)
+	defer json.ReturnIterator(iter)
+	return unmarshalMetrics(iter)
+}
+
+func unmarshalMetrics(iter *jsoniter.Iterator) (Metrics, error) {
 	md := NewMetrics()
 	md.unmarshalJsoniter(iter)
 	if iter.Error != nil {
@@ -4,11 +4,14 @@
 package pmetric
 
 import (
+	"bytes"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	otlpmetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -305,6 +308,13 @@ func Test_jsonUnmarshaler_UnmarshalMetrics(t *testing.T) {
 			got, err := decoder.UnmarshalMetrics(jsonBuf)
 			assert.NoError(t, err)
 			assert.EqualValues(t, m, got)
+
+			var buf bytes.Buffer
+			require.NoError(t, encoder.MarshalMetricsTo(&buf, m))
+			assert.Equal(t, string(jsonBuf), buf.String())
+			got, err = decoder.UnmarshalMetricsFrom(iotest.OneByteReader(&buf))
+			require.NoError(t, err)
+			assert.EqualValues(t, m, got)
 		})
 	}
 }
@@ -6,6 +6,7 @@ package pmetric // import "go.opentelemetry.io/collector/pdata/pmetric"
 import (
 	"go.opentelemetry.io/collector/pdata/internal"
 	otlpcollectormetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/metrics/v1"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
 // Metrics is the top-level struct that is propagated through the metrics pipeline.
@@ -21,6 +22,12 @@ func (ms Metrics) getOrig() *otlpcollectormetrics.ExportMetricsServiceRequest {
 	return internal.GetOrigMetrics(internal.Metrics(ms))
 }
 
+// getEncoded returns the OTLP protobuf encoding of the Metrics if it was lazily unmarshaled and is still valid.
+func (ms Metrics) getEncoded() ([]byte, bool) {
+	_, lazy := internal.GetLazyMetrics(internal.Metrics(ms))
+	return lazy.Encoded()
+}
+
 func (ms Metrics) getState() *internal.State {
 	return internal.GetMetricsState(internal.Metrics(ms))
 }
@@ -60,8 +67,36 @@ func (ms Metrics) MetricCount() int {
 	return metricCount
 }
 
+// Mutable returns the Metrics itself if it is mutable, otherwise a mutable copy of it.
+// Consumers that need to modify data they may share with other consumers should
+// call it before doing any modification, so that the data is only copied when required.
+func (ms Metrics) Mutable() Metrics {
+	if !ms.IsReadOnly() {
+		return ms
+	}
+	dest := NewMetrics()
+	ms.CopyTo(dest)
+	return dest
+}
+
+// ByteSize returns the size in bytes of the Metrics marshaled in the OTLP protobuf format.
+// The size is computed once and cached after the Metrics is marked as read-only.
+func (ms Metrics) ByteSize() int {
+	if buf, ok := ms.getEncoded(); ok {
+		return len(buf)
+	}
+	return internal.GetMetricsSizeCache(internal.Metrics(ms)).Size(ms.getState(), func() int {
+		pb := internal.MetricsToProto(internal.Metrics(ms))
+		return pb.Size()
+	})
+}
+
 // DataPointCount calculates the total number of data points.
 func (ms Metrics) DataPointCount() (dataPointCount int) {
+	_, lazy := internal.GetLazyMetrics(internal.Metrics(ms))
+	if count, ok := lazy.Count(); ok {
+		return count
+	}
 	rms := ms.ResourceMetrics()
 	for i := 0; i < rms.Len(); i++ {
 		rm := rms.At(i)
@@ -93,3 +128,99 @@ func (ms Metrics) DataPointCount() (dataPointCount int) {
 func (ms Metrics) MarkReadOnly() {
 	internal.SetMetricsState(internal.Metrics(ms), internal.StateReadOnly)
 }
+
+// ForEachResourceMetrics calls fn for each ResourceMetrics.
+// The iteration stops if fn returns false.
+func (ms Metrics) ForEachResourceMetrics(fn func(ResourceMetrics) bool) {
+	rms := ms.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		if !fn(rms.At(i)) {
+			return
+		}
+	}
+}
+
+// ForEachScopeMetrics calls fn for each ScopeMetrics along with the ResourceMetrics containing it.
+// The iteration stops if fn returns false.
+func (ms Metrics) ForEachScopeMetrics(fn func(ResourceMetrics, ScopeMetrics) bool) {
+	rms := ms.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			if !fn(rm, sms.At(j)) {
+				return
+			}
+		}
+	}
+}
+
+// RemoveScopeMetricsIf removes the ScopeMetrics for which fn returns true, then removes the ResourceMetrics
+// left without any ScopeMetrics.
+func (ms Metrics) RemoveScopeMetricsIf(fn func(ResourceMetrics, ScopeMetrics) bool) {
+	ms.ResourceMetrics().RemoveIf(func(rm ResourceMetrics) bool {
+		rm.ScopeMetrics().RemoveIf(func(sm ScopeMetrics) bool {
+			return fn(rm, sm)
+		})
+		return rm.ScopeMetrics().Len() == 0
+	})
+}
+
+// ForEachMetric calls fn for each metric along with the ResourceMetrics and ScopeMetrics containing it.
+// The iteration stops if fn returns false.
+func (ms Metrics) ForEachMetric(fn func(ResourceMetrics, ScopeMetrics, Metric) bool) {
+	rms := ms.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sm := sms.At(j)
+			metrics := sm.Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				if !fn(rm, sm, metrics.At(k)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// RemoveMetricsIf removes the metrics for which fn returns true, then removes the ScopeMetrics
+// and ResourceMetrics left without any metric.
+func (ms Metrics) RemoveMetricsIf(fn func(ResourceMetrics, ScopeMetrics, Metric) bool) {
+	ms.ResourceMetrics().RemoveIf(func(rm ResourceMetrics) bool {
+		rm.ScopeMetrics().RemoveIf(func(sm ScopeMetrics) bool {
+			sm.Metrics().RemoveIf(func(m Metric) bool {
+				return fn(rm, sm, m)
+			})
+			return sm.Metrics().Len() == 0
+		})
+		return rm.ScopeMetrics().Len() == 0
+	})
+}
+
+// ForEachDataPointAttributes calls fn with the attributes of each data point of the metric, whatever its type.
+func (ms Metric) ForEachDataPointAttributes(fn func(pcommon.Map)) {
+	switch ms.Type() {
+	case MetricTypeGauge:
+		for i := 0; i < ms.Gauge().DataPoints().Len(); i++ {
+			fn(ms.Gauge().DataPoints().At(i).Attributes())
+		}
+	case MetricTypeSum:
+		for i := 0; i < ms.Sum().DataPoints().Len(); i++ {
+			fn(ms.Sum().DataPoints().At(i).Attributes())
+		}
+	case MetricTypeHistogram:
+		for i := 0; i < ms.Histogram().DataPoints().Len(); i++ {
+			fn(ms.Histogram().DataPoints().At(i).Attributes())
+		}
+	case MetricTypeExponentialHistogram:
+		for i := 0; i < ms.ExponentialHistogram().DataPoints().Len(); i++ {
+			fn(ms.ExponentialHistogram().DataPoints().At(i).Attributes())
+		}
+	case MetricTypeSummary:
+		for i := 0; i < ms.Summary().DataPoints().Len(); i++ {
+			fn(ms.Summary().DataPoints().At(i).Attributes())
+		}
+	}
+}
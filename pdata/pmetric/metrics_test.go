@@ -9,6 +9,7 @@ import (
 
 	gogoproto "github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	goproto "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -636,6 +637,35 @@ func TestMetricsCopyTo(t *testing.T) {
 	assert.EqualValues(t, metrics, metricsCopy)
 }
 
+func TestMetricsMutable(t *testing.T) {
+	metrics := NewMetrics()
+	fillTestResourceMetricsSlice(metrics.ResourceMetrics())
+	assert.Equal(t, metrics, metrics.Mutable())
+
+	metrics.MarkReadOnly()
+	mutable := metrics.Mutable()
+	assert.False(t, mutable.IsReadOnly())
+	assert.Equal(t, metrics.ByteSize(), mutable.ByteSize())
+	mutable.ResourceMetrics().AppendEmpty()
+	assert.Equal(t, metrics.ResourceMetrics().Len()+1, mutable.ResourceMetrics().Len())
+}
+
+func TestMetricsByteSize(t *testing.T) {
+	metrics := NewMetrics()
+	assert.Equal(t, 0, metrics.ByteSize())
+
+	fillTestResourceMetricsSlice(metrics.ResourceMetrics())
+	buf, err := (&ProtoMarshaler{}).MarshalMetrics(metrics)
+	require.NoError(t, err)
+	assert.Equal(t, len(buf), metrics.ByteSize())
+
+	metrics.MarkReadOnly()
+	assert.Equal(t, len(buf), metrics.ByteSize())
+	// The size of read-only data is computed once.
+	metrics.getOrig().ResourceMetrics = nil
+	assert.Equal(t, len(buf), metrics.ByteSize())
+}
+
 func TestReadOnlyMetricsInvalidUsage(t *testing.T) {
 	metrics := NewMetrics()
 	assert.False(t, metrics.IsReadOnly())
@@ -996,3 +1026,129 @@ func BenchmarkMetricsUsage(b *testing.B) {
 		}
 	}
 }
+
+func TestForEachMetric(t *testing.T) {
+	var names []string
+	generateForEachTestMetrics().ForEachMetric(func(_ ResourceMetrics, _ ScopeMetrics, e Metric) bool {
+		names = append(names, e.Name())
+		return true
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+
+	names = nil
+	generateForEachTestMetrics().ForEachMetric(func(_ ResourceMetrics, _ ScopeMetrics, e Metric) bool {
+		names = append(names, e.Name())
+		return e.Name() != "b"
+	})
+	assert.Equal(t, []string{"a", "b"}, names)
+}
+
+func TestRemoveMetricsIf(t *testing.T) {
+	data := generateForEachTestMetrics()
+	data.RemoveMetricsIf(func(r ResourceMetrics, _ ScopeMetrics, e Metric) bool {
+		return e.Name() == "c" || r.Resource().Attributes().Len() == 0 && e.Name() == "a"
+	})
+	require.Equal(t, 1, data.ResourceMetrics().Len())
+	metrics := data.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	assert.Equal(t, "b", metrics.At(0).Name())
+}
+
+func TestForEachResourceMetrics(t *testing.T) {
+	var count int
+	generateForEachTestMetrics().ForEachResourceMetrics(func(ResourceMetrics) bool {
+		count++
+		return true
+	})
+	assert.Equal(t, 2, count)
+
+	count = 0
+	generateForEachTestMetrics().ForEachResourceMetrics(func(ResourceMetrics) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 1, count)
+}
+
+func TestForEachScopeMetrics(t *testing.T) {
+	var names []string
+	generateForEachTestMetrics().ForEachScopeMetrics(func(_ ResourceMetrics, s ScopeMetrics) bool {
+		names = append(names, s.Metrics().At(0).Name())
+		return true
+	})
+	assert.Equal(t, []string{"a", "c"}, names)
+
+	names = nil
+	generateForEachTestMetrics().ForEachScopeMetrics(func(_ ResourceMetrics, s ScopeMetrics) bool {
+		names = append(names, s.Metrics().At(0).Name())
+		return false
+	})
+	assert.Equal(t, []string{"a"}, names)
+}
+
+func TestRemoveScopeMetricsIf(t *testing.T) {
+	data := generateForEachTestMetrics()
+	data.RemoveScopeMetricsIf(func(r ResourceMetrics, _ ScopeMetrics) bool {
+		return r.Resource().Attributes().Len() == 0
+	})
+	require.Equal(t, 1, data.ResourceMetrics().Len())
+	assert.Equal(t, "c", data.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+}
+
+func TestMetricForEachDataPointAttributes(t *testing.T) {
+	m := NewMetric()
+	assert.NotPanics(t, func() {
+		m.ForEachDataPointAttributes(func(pcommon.Map) { t.Fail() })
+	})
+
+	tests := []struct {
+		name    string
+		prepare func(Metric)
+	}{
+		{
+			name:    "gauge",
+			prepare: func(m Metric) { m.SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr("k", "v") },
+		},
+		{
+			name:    "sum",
+			prepare: func(m Metric) { m.SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr("k", "v") },
+		},
+		{
+			name:    "histogram",
+			prepare: func(m Metric) { m.SetEmptyHistogram().DataPoints().AppendEmpty().Attributes().PutStr("k", "v") },
+		},
+		{
+			name: "exponential histogram",
+			prepare: func(m Metric) {
+				m.SetEmptyExponentialHistogram().DataPoints().AppendEmpty().Attributes().PutStr("k", "v")
+			},
+		},
+		{
+			name:    "summary",
+			prepare: func(m Metric) { m.SetEmptySummary().DataPoints().AppendEmpty().Attributes().PutStr("k", "v") },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMetric()
+			tt.prepare(m)
+			var count int
+			m.ForEachDataPointAttributes(func(attrs pcommon.Map) {
+				count++
+				assert.Equal(t, map[string]any{"k": "v"}, attrs.AsRaw())
+			})
+			assert.Equal(t, 1, count)
+		})
+	}
+}
+
+func generateForEachTestMetrics() Metrics {
+	data := NewMetrics()
+	metrics := data.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	metrics.AppendEmpty().SetName("a")
+	metrics.AppendEmpty().SetName("b")
+	r := data.ResourceMetrics().AppendEmpty()
+	r.Resource().Attributes().PutStr("key", "value")
+	r.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("c")
+	return data
+}
@@ -4,8 +4,12 @@
 package pmetric // import "go.opentelemetry.io/collector/pdata/pmetric"
 
 import (
+	"google.golang.org/protobuf/encoding/protowire"
+
 	"go.opentelemetry.io/collector/pdata/internal"
+	otlpcollectormetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/metrics/v1"
 	otlpmetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"
+	"go.opentelemetry.io/collector/pdata/internal/otlp"
 )
 
 var _ MarshalSizer = (*ProtoMarshaler)(nil)
@@ -13,13 +17,15 @@ var _ MarshalSizer = (*ProtoMarshaler)(nil)
 type ProtoMarshaler struct{}
 
 func (e *ProtoMarshaler) MarshalMetrics(md Metrics) ([]byte, error) {
+	if buf, ok := md.getEncoded(); ok {
+		return append([]byte(nil), buf...), nil
+	}
 	pb := internal.MetricsToProto(internal.Metrics(md))
 	return pb.Marshal()
 }
 
 func (e *ProtoMarshaler) MetricsSize(md Metrics) int {
-	pb := internal.MetricsToProto(internal.Metrics(md))
-	return pb.Size()
+	return md.ByteSize()
 }
 
 type ProtoUnmarshaler struct{}
@@ -29,3 +35,61 @@ func (d *ProtoUnmarshaler) UnmarshalMetrics(buf []byte) (Metrics, error) {
 	err := pb.Unmarshal(buf)
 	return Metrics(internal.MetricsFromProto(pb)), err
 }
+
+var _ Unmarshaler = (*LazyProtoUnmarshaler)(nil)
+
+// LazyProtoUnmarshaler unmarshals Metrics from the OTLP protobuf format, deferring the decoding until the content
+// of the Metrics is first accessed. Until the Metrics is modified, the ProtoMarshaler reuses the received bytes instead
+// of encoding it again, so that pipelines only forwarding the data skip most of the decoding and encoding cost.
+//
+// UnmarshalMetrics validates the whole encoding up front and returns an error if it is invalid, so that the content
+// is always decoded without error. The resources are decoded one at a time, reusing the validated buffer,
+// which must not be modified after calling UnmarshalMetrics.
+type LazyProtoUnmarshaler struct{}
+
+func (d *LazyProtoUnmarshaler) UnmarshalMetrics(buf []byte) (Metrics, error) {
+	if err := internal.ValidateProto(buf, &otlpcollectormetrics.ExportMetricsServiceRequest{}); err != nil {
+		return NewMetrics(), err
+	}
+	count, err := internal.CountResourceItems(buf, countDataPoints)
+	if err != nil {
+		return NewMetrics(), err
+	}
+	orig := &otlpcollectormetrics.ExportMetricsServiceRequest{}
+	state := internal.StateMutable
+	return Metrics(internal.NewLazyMetrics(orig, &state, internal.NewLazyProto(buf, count, func(buf []byte) {
+		// The encoding was validated by UnmarshalMetrics, so decoding its resources does not fail.
+		_ = internal.RangeMessages(buf, func(num protowire.Number, resource []byte) error {
+			if num != 1 {
+				return nil
+			}
+			rs := &otlpmetrics.ResourceMetrics{}
+			if err := rs.Unmarshal(resource); err != nil {
+				return err
+			}
+			orig.ResourceMetrics = append(orig.ResourceMetrics, rs)
+			return nil
+		})
+		otlp.MigrateMetrics(orig.ResourceMetrics)
+	}))), nil
+}
+
+// countDataPoints validates the framing of the OTLP encoded scope metrics and returns its number of data points.
+func countDataPoints(scope []byte) (int, error) {
+	count := 0
+	err := internal.RangeMessages(scope, func(num protowire.Number, metric []byte) error {
+		if num != 2 {
+			return nil
+		}
+		return internal.RangeMessages(metric, func(num protowire.Number, data []byte) error {
+			switch num {
+			case 5, 7, 9, 10, 11: // gauge, sum, histogram, exponential_histogram, summary
+				n, err := internal.CountMessages(data, 1)
+				count += n
+				return err
+			}
+			return nil
+		})
+	})
+	return count, err
+}
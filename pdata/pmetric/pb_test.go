@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 )
@@ -36,6 +37,64 @@ func TestProtoSizerEmptyMetrics(t *testing.T) {
 	assert.Equal(t, 0, sizer.MetricsSize(NewMetrics()))
 }
 
+func TestLazyProtoUnmarshaler(t *testing.T) {
+	md := generateLazyTestMetrics()
+	buf, err := (&ProtoMarshaler{}).MarshalMetrics(md)
+	require.NoError(t, err)
+	// Unknown fields are dropped when decoding, so they are only kept if the encoding is reused.
+	buf = protowire.AppendTag(buf, 99, protowire.VarintType)
+	buf = protowire.AppendVarint(buf, 1)
+
+	lazy, err := (&LazyProtoUnmarshaler{}).UnmarshalMetrics(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 5, lazy.DataPointCount())
+	assert.Equal(t, len(buf), lazy.ByteSize())
+	got, err := (&ProtoMarshaler{}).MarshalMetrics(lazy)
+	require.NoError(t, err)
+	assert.Equal(t, buf, got)
+
+	// Accessing the content of a mutable Metrics invalidates the encoding, since it may be modified.
+	lazy.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).SetName("modified")
+	got, err = (&ProtoMarshaler{}).MarshalMetrics(lazy)
+	require.NoError(t, err)
+	assert.NotEqual(t, buf, got)
+	assert.Equal(t, 5, lazy.DataPointCount())
+	decoded, err := (&ProtoUnmarshaler{}).UnmarshalMetrics(got)
+	require.NoError(t, err)
+	assert.Equal(t, "modified", decoded.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+}
+
+func TestLazyProtoUnmarshalerReadOnly(t *testing.T) {
+	buf, err := (&ProtoMarshaler{}).MarshalMetrics(generateLazyTestMetrics())
+	require.NoError(t, err)
+	buf = protowire.AppendTag(buf, 99, protowire.VarintType)
+	buf = protowire.AppendVarint(buf, 1)
+
+	lazy, err := (&LazyProtoUnmarshaler{}).UnmarshalMetrics(buf)
+	require.NoError(t, err)
+	lazy.MarkReadOnly()
+	assert.Equal(t, 5, lazy.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().Len())
+	got, err := (&ProtoMarshaler{}).MarshalMetrics(lazy)
+	require.NoError(t, err)
+	assert.Equal(t, buf, got)
+}
+
+func TestLazyProtoUnmarshalerError(t *testing.T) {
+	p := &LazyProtoUnmarshaler{}
+	_, err := p.UnmarshalMetrics([]byte("+$%"))
+	assert.Error(t, err)
+}
+
+func TestLazyProtoUnmarshalerInvalidContent(t *testing.T) {
+	// A metric with a varint name, which is well framed but can not be decoded.
+	buf := protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 1)
+	for _, num := range []protowire.Number{2, 2, 1} {
+		buf = protowire.AppendBytes(protowire.AppendTag(nil, num, protowire.BytesType), buf)
+	}
+	_, err := (&LazyProtoUnmarshaler{}).UnmarshalMetrics(buf)
+	assert.Error(t, err)
+}
+
 func BenchmarkMetricsToProto(b *testing.B) {
 	marshaler := &ProtoMarshaler{}
 	metrics := generateBenchmarkMetrics(128)
@@ -81,3 +140,14 @@ func generateBenchmarkMetrics(metricsCount int) Metrics {
 	}
 	return md
 }
+
+func generateLazyTestMetrics() Metrics {
+	md := NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	ms.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	ms.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	ms.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
+	ms.AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+	ms.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty()
+	return md
+}
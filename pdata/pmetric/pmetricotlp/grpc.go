@@ -31,15 +31,26 @@ type GRPCClient interface {
 
 // NewGRPCClient returns a new GRPCClient connected using the given connection.
 func NewGRPCClient(cc *grpc.ClientConn) GRPCClient {
-	return &grpcClient{rawClient: otlpcollectormetrics.NewMetricsServiceClient(cc)}
+	return &grpcClient{cc: cc, rawClient: otlpcollectormetrics.NewMetricsServiceClient(cc)}
 }
 
 type grpcClient struct {
+	cc        *grpc.ClientConn
 	rawClient otlpcollectormetrics.MetricsServiceClient
 }
 
 func (c *grpcClient) Export(ctx context.Context, request ExportRequest, opts ...grpc.CallOption) (ExportResponse, error) {
-	rsp, err := c.rawClient.Export(ctx, request.orig, opts...)
+	if buf, ok := request.lazy.Encoded(); ok {
+		// Send the retained encoding instead of decoding the request to encode it again.
+		rsp := &otlpcollectormetrics.ExportMetricsServiceResponse{}
+		err := c.cc.Invoke(ctx, "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export", internal.EncodedProto(buf), rsp, append(opts, internal.EncodedProtoCodec)...)
+		if err != nil {
+			return ExportResponse{}, err
+		}
+		state := internal.StateMutable
+		return ExportResponse{orig: rsp, state: &state}, nil
+	}
+	rsp, err := c.rawClient.Export(ctx, request.getOrig(), opts...)
 	if err != nil {
 		return ExportResponse{}, err
 	}
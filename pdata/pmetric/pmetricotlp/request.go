@@ -4,7 +4,7 @@
 package pmetricotlp // import "go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
 
 import (
-	"bytes"
+	"io"
 
 	"go.opentelemetry.io/collector/pdata/internal"
 	otlpcollectormetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/metrics/v1"
@@ -19,6 +19,7 @@ var jsonUnmarshaler = &pmetric.JSONUnmarshaler{}
 type ExportRequest struct {
 	orig  *otlpcollectormetrics.ExportMetricsServiceRequest
 	state *internal.State
+	lazy  *internal.LazyProto
 }
 
 // NewExportRequest returns an empty ExportRequest.
@@ -34,29 +35,30 @@ func NewExportRequest() ExportRequest {
 // Because ExportRequest is a wrapper for pmetric.Metrics,
 // any changes to the provided Metrics struct will be reflected in the ExportRequest and vice versa.
 func NewExportRequestFromMetrics(md pmetric.Metrics) ExportRequest {
+	orig, lazy := internal.GetLazyMetrics(internal.Metrics(md))
 	return ExportRequest{
-		orig:  internal.GetOrigMetrics(internal.Metrics(md)),
+		orig:  orig,
 		state: internal.GetMetricsState(internal.Metrics(md)),
+		lazy:  lazy,
 	}
 }
 
 // MarshalProto marshals ExportRequest into proto bytes.
 func (ms ExportRequest) MarshalProto() ([]byte, error) {
-	return ms.orig.Marshal()
+	if buf, ok := ms.lazy.Encoded(); ok {
+		return append([]byte(nil), buf...), nil
+	}
+	return ms.getOrig().Marshal()
 }
 
 // UnmarshalProto unmarshalls ExportRequest from proto bytes.
 func (ms ExportRequest) UnmarshalProto(data []byte) error {
-	return ms.orig.Unmarshal(data)
+	return ms.getOrig().Unmarshal(data)
 }
 
 // MarshalJSON marshals ExportRequest into JSON bytes.
 func (ms ExportRequest) MarshalJSON() ([]byte, error) {
-	var buf bytes.Buffer
-	if err := json.Marshal(&buf, ms.orig); err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
+	return json.MarshalBytes(ms.getOrig())
 }
 
 // UnmarshalJSON unmarshalls ExportRequest from JSON bytes.
@@ -65,10 +67,32 @@ func (ms ExportRequest) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		return err
 	}
-	*ms.orig = *internal.GetOrigMetrics(internal.Metrics(md))
+	*ms.getOrig() = *internal.GetOrigMetrics(internal.Metrics(md))
+	return nil
+}
+
+// MarshalJSONTo writes ExportRequest to w in the JSON format, without buffering the whole payload in memory.
+func (ms ExportRequest) MarshalJSONTo(w io.Writer) error {
+	return json.Marshal(w, ms.getOrig())
+}
+
+// UnmarshalJSONFrom unmarshalls ExportRequest from the JSON data read from r, without buffering the whole
+// payload in memory.
+func (ms ExportRequest) UnmarshalJSONFrom(r io.Reader) error {
+	md, err := jsonUnmarshaler.UnmarshalMetricsFrom(r)
+	if err != nil {
+		return err
+	}
+	*ms.getOrig() = *internal.GetOrigMetrics(internal.Metrics(md))
 	return nil
 }
 
 func (ms ExportRequest) Metrics() pmetric.Metrics {
-	return pmetric.Metrics(internal.NewMetrics(ms.orig, ms.state))
+	return pmetric.Metrics(internal.NewLazyMetrics(ms.orig, ms.state, ms.lazy))
+}
+
+// getOrig returns the orig of the ExportRequest, decoding it first if it was lazily unmarshaled.
+func (ms ExportRequest) getOrig() *otlpcollectormetrics.ExportMetricsServiceRequest {
+	ms.lazy.Decode(ms.state)
+	return ms.orig
 }
@@ -4,6 +4,7 @@
 package pmetricotlp
 
 import (
+	"bytes"
 	"encoding/json"
 	"strings"
 	"testing"
@@ -49,3 +50,15 @@ func TestRequestJSON(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, strings.Join(strings.Fields(string(metricsRequestJSON)), ""), string(got))
 }
+
+func TestRequestJSONStreaming(t *testing.T) {
+	mr := NewExportRequest()
+	assert.NoError(t, mr.UnmarshalJSONFrom(bytes.NewReader(metricsRequestJSON)))
+	assert.Equal(t, "test_metric", mr.Metrics().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+
+	var got bytes.Buffer
+	assert.NoError(t, mr.MarshalJSONTo(&got))
+	assert.Equal(t, strings.Join(strings.Fields(string(metricsRequestJSON)), ""), got.String())
+
+	assert.Error(t, NewExportRequest().UnmarshalJSONFrom(strings.NewReader(`{"resource`)))
+}
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetrictest // import "go.opentelemetry.io/collector/pdata/pmetric/pmetrictest"
+
+import (
+	"fmt"
+
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/internal/pdatacmp"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func numberDataPointKey(dp pmetric.NumberDataPoint) string {
+	return pdatacmp.MapKey(dp.Attributes())
+}
+
+func histogramDataPointKey(dp pmetric.HistogramDataPoint) string {
+	return pdatacmp.MapKey(dp.Attributes())
+}
+
+func exponentialHistogramDataPointKey(dp pmetric.ExponentialHistogramDataPoint) string {
+	return pdatacmp.MapKey(dp.Attributes())
+}
+
+func summaryDataPointKey(dp pmetric.SummaryDataPoint) string {
+	return pdatacmp.MapKey(dp.Attributes())
+}
+
+func (opts compareOptions) compareNumberDataPoint(path string, expected, actual pmetric.NumberDataPoint) error {
+	return multierr.Combine(
+		opts.compareTimestamps(path, expected.StartTimestamp(), actual.StartTimestamp(), expected.Timestamp(), actual.Timestamp()),
+		pdatacmp.Field(path, "value type", expected.ValueType(), actual.ValueType()),
+		pdatacmp.Field(path, "int value", expected.IntValue(), actual.IntValue()),
+		pdatacmp.Field(path, "double value", expected.DoubleValue(), actual.DoubleValue()),
+		pdatacmp.Field(path, "flags", expected.Flags(), actual.Flags()),
+		pdatacmp.Indexed(path, "exemplar", expected.Exemplars(), actual.Exemplars(), opts.compareExemplar),
+	)
+}
+
+func (opts compareOptions) compareHistogramDataPoint(path string, expected, actual pmetric.HistogramDataPoint) error {
+	return multierr.Combine(
+		opts.compareTimestamps(path, expected.StartTimestamp(), actual.StartTimestamp(), expected.Timestamp(), actual.Timestamp()),
+		pdatacmp.Field(path, "count", expected.Count(), actual.Count()),
+		compareOptional(path, "sum", expected.HasSum(), actual.HasSum(), expected.Sum(), actual.Sum()),
+		compareOptional(path, "min", expected.HasMin(), actual.HasMin(), expected.Min(), actual.Min()),
+		compareOptional(path, "max", expected.HasMax(), actual.HasMax(), expected.Max(), actual.Max()),
+		pdatacmp.Field(path, "bucket counts", fmt.Sprint(expected.BucketCounts().AsRaw()), fmt.Sprint(actual.BucketCounts().AsRaw())),
+		pdatacmp.Field(path, "explicit bounds", fmt.Sprint(expected.ExplicitBounds().AsRaw()), fmt.Sprint(actual.ExplicitBounds().AsRaw())),
+		pdatacmp.Field(path, "flags", expected.Flags(), actual.Flags()),
+		pdatacmp.Indexed(path, "exemplar", expected.Exemplars(), actual.Exemplars(), opts.compareExemplar),
+	)
+}
+
+func (opts compareOptions) compareExponentialHistogramDataPoint(path string, expected, actual pmetric.ExponentialHistogramDataPoint) error {
+	return multierr.Combine(
+		opts.compareTimestamps(path, expected.StartTimestamp(), actual.StartTimestamp(), expected.Timestamp(), actual.Timestamp()),
+		pdatacmp.Field(path, "count", expected.Count(), actual.Count()),
+		compareOptional(path, "sum", expected.HasSum(), actual.HasSum(), expected.Sum(), actual.Sum()),
+		compareOptional(path, "min", expected.HasMin(), actual.HasMin(), expected.Min(), actual.Min()),
+		compareOptional(path, "max", expected.HasMax(), actual.HasMax(), expected.Max(), actual.Max()),
+		pdatacmp.Field(path, "scale", expected.Scale(), actual.Scale()),
+		pdatacmp.Field(path, "zero count", expected.ZeroCount(), actual.ZeroCount()),
+		pdatacmp.Field(path, "zero threshold", expected.ZeroThreshold(), actual.ZeroThreshold()),
+		compareBuckets(path, "positive", expected.Positive(), actual.Positive()),
+		compareBuckets(path, "negative", expected.Negative(), actual.Negative()),
+		pdatacmp.Field(path, "flags", expected.Flags(), actual.Flags()),
+		pdatacmp.Indexed(path, "exemplar", expected.Exemplars(), actual.Exemplars(), opts.compareExemplar),
+	)
+}
+
+func (opts compareOptions) compareSummaryDataPoint(path string, expected, actual pmetric.SummaryDataPoint) error {
+	return multierr.Combine(
+		opts.compareTimestamps(path, expected.StartTimestamp(), actual.StartTimestamp(), expected.Timestamp(), actual.Timestamp()),
+		pdatacmp.Field(path, "count", expected.Count(), actual.Count()),
+		pdatacmp.Field(path, "sum", expected.Sum(), actual.Sum()),
+		pdatacmp.Indexed(path, "quantile value", expected.QuantileValues(), actual.QuantileValues(), compareQuantileValue),
+		pdatacmp.Field(path, "flags", expected.Flags(), actual.Flags()),
+	)
+}
+
+func (opts compareOptions) compareExemplar(path string, expected, actual pmetric.Exemplar) error {
+	errs := multierr.Combine(
+		pdatacmp.Field(path, "value type", expected.ValueType(), actual.ValueType()),
+		pdatacmp.Field(path, "int value", expected.IntValue(), actual.IntValue()),
+		pdatacmp.Field(path, "double value", expected.DoubleValue(), actual.DoubleValue()),
+		pdatacmp.Map(path, "filtered attributes", expected.FilteredAttributes(), actual.FilteredAttributes()),
+		pdatacmp.Field(path, "trace id", expected.TraceID(), actual.TraceID()),
+		pdatacmp.Field(path, "span id", expected.SpanID(), actual.SpanID()),
+	)
+	if !opts.ignoreTimestamps {
+		errs = multierr.Append(errs, pdatacmp.Field(path, "timestamp", expected.Timestamp(), actual.Timestamp()))
+	}
+	return errs
+}
+
+func (opts compareOptions) compareTimestamps(path string, expectedStart, actualStart, expected, actual pcommon.Timestamp) error {
+	if opts.ignoreTimestamps {
+		return nil
+	}
+	return multierr.Combine(
+		pdatacmp.Field(path, "start timestamp", expectedStart, actualStart),
+		pdatacmp.Field(path, "timestamp", expected, actual),
+	)
+}
+
+func compareQuantileValue(path string, expected, actual pmetric.SummaryDataPointValueAtQuantile) error {
+	return multierr.Combine(
+		pdatacmp.Field(path, "quantile", expected.Quantile(), actual.Quantile()),
+		pdatacmp.Field(path, "value", expected.Value(), actual.Value()),
+	)
+}
+
+func compareBuckets(path, field string, expected, actual pmetric.ExponentialHistogramDataPointBuckets) error {
+	return multierr.Combine(
+		pdatacmp.Field(path, field+" offset", expected.Offset(), actual.Offset()),
+		pdatacmp.Field(path, field+" bucket counts", fmt.Sprint(expected.BucketCounts().AsRaw()), fmt.Sprint(actual.BucketCounts().AsRaw())),
+	)
+}
+
+func compareOptional(path, field string, expectedSet, actualSet bool, expected, actual float64) error {
+	if expectedSet != actualSet {
+		return fmt.Errorf("%s: %s: expected set %t, actual set %t", path, field, expectedSet, actualSet)
+	}
+	return pdatacmp.Field(path, field, expected, actual)
+}
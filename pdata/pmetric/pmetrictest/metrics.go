@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pmetrictest provides helpers to compare pmetric.Metrics, e.g. in component tests.
+package pmetrictest // import "go.opentelemetry.io/collector/pdata/pmetric/pmetrictest"
+
+import (
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/internal/pdatacmp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// CompareMetrics compares expected and actual and returns an error listing all the differences, or nil if they are equal.
+// Resources are matched by attributes, scopes by name and version, metrics by name and data points by attributes.
+func CompareMetrics(expected, actual pmetric.Metrics, options ...CompareOption) error {
+	opts := compareOptions{}
+	for _, op := range options {
+		op(&opts)
+	}
+	return pdatacmp.Slices("", "resource", expected.ResourceMetrics(), actual.ResourceMetrics(),
+		func(rm pmetric.ResourceMetrics) string { return pdatacmp.MapKey(rm.Resource().Attributes()) },
+		opts.ignoreOrder, opts.compareResourceMetrics)
+}
+
+func (opts compareOptions) compareResourceMetrics(path string, expected, actual pmetric.ResourceMetrics) error {
+	return multierr.Combine(
+		pdatacmp.Resource(path, expected.Resource(), actual.Resource()),
+		pdatacmp.Field(path, "schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		pdatacmp.Slices(path, "scope", expected.ScopeMetrics(), actual.ScopeMetrics(),
+			func(sm pmetric.ScopeMetrics) string { return pdatacmp.ScopeKey(sm.Scope()) },
+			opts.ignoreOrder, opts.compareScopeMetrics),
+	)
+}
+
+func (opts compareOptions) compareScopeMetrics(path string, expected, actual pmetric.ScopeMetrics) error {
+	return multierr.Combine(
+		pdatacmp.Scope(path, expected.Scope(), actual.Scope()),
+		pdatacmp.Field(path, "schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		pdatacmp.Slices(path, "metric", expected.Metrics(), actual.Metrics(),
+			pmetric.Metric.Name, opts.ignoreOrder, opts.compareMetric),
+	)
+}
+
+func (opts compareOptions) compareMetric(path string, expected, actual pmetric.Metric) error {
+	errs := multierr.Combine(
+		pdatacmp.Field(path, "description", expected.Description(), actual.Description()),
+		pdatacmp.Field(path, "unit", expected.Unit(), actual.Unit()),
+		pdatacmp.Field(path, "type", expected.Type(), actual.Type()),
+	)
+	if expected.Type() != actual.Type() {
+		return errs
+	}
+	switch expected.Type() {
+	case pmetric.MetricTypeGauge:
+		errs = multierr.Append(errs, pdatacmp.Slices(path, "data point", expected.Gauge().DataPoints(), actual.Gauge().DataPoints(),
+			numberDataPointKey, opts.ignoreOrder, opts.compareNumberDataPoint))
+	case pmetric.MetricTypeSum:
+		errs = multierr.Combine(errs,
+			pdatacmp.Field(path, "aggregation temporality", expected.Sum().AggregationTemporality(), actual.Sum().AggregationTemporality()),
+			pdatacmp.Field(path, "is monotonic", expected.Sum().IsMonotonic(), actual.Sum().IsMonotonic()),
+			pdatacmp.Slices(path, "data point", expected.Sum().DataPoints(), actual.Sum().DataPoints(),
+				numberDataPointKey, opts.ignoreOrder, opts.compareNumberDataPoint),
+		)
+	case pmetric.MetricTypeHistogram:
+		errs = multierr.Combine(errs,
+			pdatacmp.Field(path, "aggregation temporality", expected.Histogram().AggregationTemporality(), actual.Histogram().AggregationTemporality()),
+			pdatacmp.Slices(path, "data point", expected.Histogram().DataPoints(), actual.Histogram().DataPoints(),
+				histogramDataPointKey, opts.ignoreOrder, opts.compareHistogramDataPoint),
+		)
+	case pmetric.MetricTypeExponentialHistogram:
+		errs = multierr.Combine(errs,
+			pdatacmp.Field(path, "aggregation temporality", expected.ExponentialHistogram().AggregationTemporality(), actual.ExponentialHistogram().AggregationTemporality()),
+			pdatacmp.Slices(path, "data point", expected.ExponentialHistogram().DataPoints(), actual.ExponentialHistogram().DataPoints(),
+				exponentialHistogramDataPointKey, opts.ignoreOrder, opts.compareExponentialHistogramDataPoint),
+		)
+	case pmetric.MetricTypeSummary:
+		errs = multierr.Append(errs, pdatacmp.Slices(path, "data point", expected.Summary().DataPoints(), actual.Summary().DataPoints(),
+			summaryDataPointKey, opts.ignoreOrder, opts.compareSummaryDataPoint))
+	}
+	return errs
+}
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetrictest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestCompareMetrics(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected pmetric.Metrics
+		actual   pmetric.Metrics
+		options  []CompareOption
+		errs     []string
+	}{
+		{
+			name:     "equal",
+			expected: generateMetrics(),
+			actual:   generateMetrics(),
+		},
+		{
+			name:     "data point order",
+			expected: generateMetrics(),
+			actual: func() pmetric.Metrics {
+				md := generateMetrics()
+				dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+				dps.Sort(func(a, b pmetric.NumberDataPoint) bool { return a.IntValue() > b.IntValue() })
+				return md
+			}(),
+			errs: []string{
+				`resource "host.name=host" > scope "scope" > metric "sum" > data point "key=a": expected at index 0, actual at index 1`,
+				`resource "host.name=host" > scope "scope" > metric "sum" > data point "key=b": expected at index 1, actual at index 0`,
+			},
+		},
+		{
+			name:     "ignore order",
+			expected: generateMetrics(),
+			actual: func() pmetric.Metrics {
+				md := generateMetrics()
+				ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+				ms.Sort(func(a, b pmetric.Metric) bool { return a.Name() < b.Name() })
+				ms.At(1).Sum().DataPoints().Sort(func(a, b pmetric.NumberDataPoint) bool { return a.IntValue() > b.IntValue() })
+				return md
+			}(),
+			options: []CompareOption{IgnoreOrder()},
+		},
+		{
+			name:     "different type",
+			expected: generateMetrics(),
+			actual: func() pmetric.Metrics {
+				md := generateMetrics()
+				md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).SetEmptyGauge()
+				return md
+			}(),
+			errs: []string{
+				`resource "host.name=host" > scope "scope" > metric "sum": type: expected Sum, actual Gauge`,
+			},
+		},
+		{
+			name:     "different values",
+			expected: generateMetrics(),
+			actual: func() pmetric.Metrics {
+				md := generateMetrics()
+				ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+				ms.At(0).Sum().DataPoints().At(1).SetIntValue(3)
+				hdp := ms.At(1).Histogram().DataPoints().At(0)
+				hdp.RemoveSum()
+				hdp.BucketCounts().FromRaw([]uint64{1, 1})
+				hdp.Exemplars().At(0).SetDoubleValue(2)
+				return md
+			}(),
+			errs: []string{
+				`resource "host.name=host" > scope "scope" > metric "sum" > data point "key=b": int value: expected 2, actual 3`,
+				`resource "host.name=host" > scope "scope" > metric "histogram" > data point "": sum: expected set true, actual set false`,
+				`resource "host.name=host" > scope "scope" > metric "histogram" > data point "": bucket counts: expected [1 2], actual [1 1]`,
+				`resource "host.name=host" > scope "scope" > metric "histogram" > data point "" > exemplar "0": double value: expected 1, actual 2`,
+			},
+		},
+		{
+			name:     "ignore timestamps",
+			expected: generateMetrics(),
+			actual: func() pmetric.Metrics {
+				md := generateMetrics()
+				ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+				ms.At(0).Sum().DataPoints().At(0).SetTimestamp(10)
+				ms.At(1).Histogram().DataPoints().At(0).SetStartTimestamp(10)
+				ms.At(1).Histogram().DataPoints().At(0).Exemplars().At(0).SetTimestamp(10)
+				return md
+			}(),
+			options: []CompareOption{IgnoreTimestamps()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CompareMetrics(tt.expected, tt.actual, tt.options...)
+			if len(tt.errs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var errs []string
+			for _, e := range multierr.Errors(err) {
+				errs = append(errs, e.Error())
+			}
+			assert.Equal(t, tt.errs, errs)
+		})
+	}
+}
+
+func generateMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("host.name", "host")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("scope")
+
+	sum := sm.Metrics().AppendEmpty()
+	sum.SetName("sum")
+	sum.SetEmptySum().SetIsMonotonic(true)
+	for i, key := range []string{"a", "b"} {
+		dp := sum.Sum().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("key", key)
+		dp.SetIntValue(int64(i + 1))
+		dp.SetStartTimestamp(1)
+		dp.SetTimestamp(2)
+	}
+
+	hist := sm.Metrics().AppendEmpty()
+	hist.SetName("histogram")
+	dp := hist.SetEmptyHistogram().DataPoints().AppendEmpty()
+	dp.SetCount(3)
+	dp.SetSum(5)
+	dp.BucketCounts().FromRaw([]uint64{1, 2})
+	dp.ExplicitBounds().FromRaw([]float64{1})
+	dp.SetStartTimestamp(1)
+	dp.SetTimestamp(2)
+	ex := dp.Exemplars().AppendEmpty()
+	ex.SetDoubleValue(1)
+	ex.SetTimestamp(2)
+	return md
+}
@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetrictest // import "go.opentelemetry.io/collector/pdata/pmetric/pmetrictest"
+
+// CompareOption configures the comparison done by CompareMetrics.
+type CompareOption func(*compareOptions)
+
+type compareOptions struct {
+	ignoreOrder      bool
+	ignoreTimestamps bool
+}
+
+// IgnoreOrder makes the comparison ignore the order of the resources, scopes, metrics and data points.
+func IgnoreOrder() CompareOption {
+	return func(o *compareOptions) {
+		o.ignoreOrder = true
+	}
+}
+
+// IgnoreTimestamps makes the comparison ignore the start timestamps and timestamps of the data points and exemplars.
+func IgnoreTimestamps() CompareOption {
+	return func(o *compareOptions) {
+		o.ignoreTimestamps = true
+	}
+}
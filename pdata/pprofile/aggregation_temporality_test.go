@@ -0,0 +1,17 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofile // import "go.opentelemetry.io/collector/pdata/pprofile"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregationTemporalityString(t *testing.T) {
+	assert.Equal(t, "Unspecified", AggregationTemporalityUnspecified.String())
+	assert.Equal(t, "Delta", AggregationTemporalityDelta.String())
+	assert.Equal(t, "Cumulative", AggregationTemporalityCumulative.String())
+	assert.Equal(t, "", (AggregationTemporalityCumulative + 1).String())
+}
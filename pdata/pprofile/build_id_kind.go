@@ -0,0 +1,29 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofile // import "go.opentelemetry.io/collector/pdata/pprofile"
+
+import (
+	otlpprofiles "go.opentelemetry.io/collector/pdata/internal/data/protogen/profiles/v1experimental"
+)
+
+// BuildIDKind indicates how the build ID of a Mapping was computed.
+type BuildIDKind int32
+
+const (
+	// BuildIDKindLinker indicates that the build ID was generated by the linker.
+	BuildIDKindLinker = BuildIDKind(otlpprofiles.BuildIdKind_BUILD_ID_LINKER)
+	// BuildIDKindBinaryHash indicates that the build ID was generated by hashing the binary.
+	BuildIDKindBinaryHash = BuildIDKind(otlpprofiles.BuildIdKind_BUILD_ID_BINARY_HASH)
+)
+
+// String returns the string representation of the BuildIDKind.
+func (bk BuildIDKind) String() string {
+	switch bk {
+	case BuildIDKindLinker:
+		return "Linker"
+	case BuildIDKindBinaryHash:
+		return "BinaryHash"
+	}
+	return ""
+}
@@ -0,0 +1,16 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofile // import "go.opentelemetry.io/collector/pdata/pprofile"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildIDKindString(t *testing.T) {
+	assert.Equal(t, "Linker", BuildIDKindLinker.String())
+	assert.Equal(t, "BinaryHash", BuildIDKindBinaryHash.String())
+	assert.Equal(t, "", (BuildIDKindBinaryHash + 1).String())
+}
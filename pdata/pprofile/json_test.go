@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofile
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+var _ Marshaler = (*JSONMarshaler)(nil)
+var _ Unmarshaler = (*JSONUnmarshaler)(nil)
+
+var profilesOTLP = func() Profiles {
+	startTimestamp := pcommon.Timestamp(1684617382541971000)
+	endTimestamp := pcommon.Timestamp(1684623646539558000)
+	profileID := ProfileID([16]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10})
+	pd := NewProfiles()
+	// Add ResourceProfiles.
+	rp := pd.ResourceProfiles().AppendEmpty()
+	rp.SetSchemaUrl("schemaURL")
+	// Add resource.
+	rp.Resource().Attributes().PutStr("host.name", "testHost")
+	rp.Resource().SetDroppedAttributesCount(1)
+	// Add ScopeProfiles.
+	sp := rp.ScopeProfiles().AppendEmpty()
+	sp.Scope().SetName("scope name")
+	sp.Scope().SetVersion("scope version")
+	sp.SetSchemaUrl("schemaURL")
+	// Add profiles.
+	pc := sp.Profiles().AppendEmpty()
+	pc.SetProfileID(profileID)
+	pc.SetStartTimestamp(startTimestamp)
+	pc.SetEndTimestamp(endTimestamp)
+	pc.SetDroppedAttributesCount(1)
+	pc.SetOriginalPayloadFormat("pprof")
+	pc.OriginalPayload().FromRaw([]byte("foo"))
+	pc.Attributes().PutStr("string", "value")
+	// Add profile.
+	p := pc.Profile()
+	p.SetStartTime(startTimestamp)
+	p.SetDuration(pcommon.Timestamp(1000))
+	p.SetPeriod(10)
+	p.SetDefaultSampleType(1)
+	p.SetDropFrames(2)
+	p.SetKeepFrames(3)
+	p.PeriodType().SetType(1)
+	p.PeriodType().SetUnit(2)
+	p.PeriodType().SetAggregationTemporality(AggregationTemporalityCumulative)
+	p.StringTable().FromRaw([]string{"", "cpu", "nanoseconds"})
+	p.Comment().FromRaw([]int64{1})
+	p.LocationIndices().FromRaw([]int64{0})
+	p.AttributeTable().PutInt("int", 1)
+	st := p.SampleType().AppendEmpty()
+	st.SetType(1)
+	st.SetUnit(2)
+	s := p.Sample().AppendEmpty()
+	s.SetLocationsStartIndex(1)
+	s.SetLocationsLength(2)
+	s.SetStacktraceIDIndex(3)
+	s.SetLink(4)
+	s.Value().FromRaw([]int64{5})
+	s.Attributes().FromRaw([]uint64{0})
+	s.TimestampsUnixNano().FromRaw([]uint64{1684617382541971000})
+	lbl := s.Label().AppendEmpty()
+	lbl.SetKey(1)
+	lbl.SetNum(2)
+	m := p.Mapping().AppendEmpty()
+	m.SetID(1)
+	m.SetMemoryStart(2)
+	m.SetMemoryLimit(3)
+	m.SetFileOffset(4)
+	m.SetFilename(1)
+	m.SetBuildID(2)
+	m.SetBuildIDKind(BuildIDKindBinaryHash)
+	m.SetHasFunctions(true)
+	l := p.Location().AppendEmpty()
+	l.SetID(1)
+	l.SetMappingIndex(0)
+	l.SetAddress(5)
+	l.SetIsFolded(true)
+	l.SetTypeIndex(1)
+	ln := l.Line().AppendEmpty()
+	ln.SetFunctionIndex(0)
+	ln.SetLine(10)
+	ln.SetColumn(4)
+	f := p.Function().AppendEmpty()
+	f.SetID(1)
+	f.SetName(1)
+	f.SetSystemName(1)
+	f.SetFilename(2)
+	f.SetStartLine(7)
+	au := p.AttributeUnits().AppendEmpty()
+	au.SetAttributeKey(1)
+	au.SetUnit(2)
+	lk := p.LinkTable().AppendEmpty()
+	lk.SetTraceID(pcommon.TraceID([16]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10}))
+	lk.SetSpanID(pcommon.SpanID([8]byte{0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18}))
+	return pd
+}()
+
+var profilesJSON = `{"resourceProfiles":[{"resource":{"attributes":[{"key":"host.name","value":{"stringValue":"testHost"}}],"droppedAttributesCount":1},"scopeProfiles":[{"scope":{"name":"scope name","version":"scope version"},"profiles":[{"profileId":"0102030405060708090a0b0c0d0e0f10","startTimeUnixNano":"1684617382541971000","endTimeUnixNano":"1684623646539558000","attributes":[{"key":"string","value":{"stringValue":"value"}}],"droppedAttributesCount":1,"originalPayloadFormat":"pprof","originalPayload":"Zm9v","profile":{"sampleType":[{"type":"1","unit":"2"}],"sample":[{"locationsStartIndex":"1","locationsLength":"2","stacktraceIdIndex":3,"value":["5"],"label":[{"key":"1","num":"2"}],"attributes":["0"],"link":"4","timestampsUnixNano":["1684617382541971000"]}],"mapping":[{"id":"1","memoryStart":"2","memoryLimit":"3","fileOffset":"4","filename":"1","buildId":"2","buildIdKind":1,"hasFunctions":true}],"location":[{"id":"1","address":"5","line":[{"line":"10","column":"4"}],"isFolded":true,"typeIndex":1}],"locationIndices":["0"],"function":[{"id":"1","name":"1","systemName":"1","filename":"2","startLine":"7"}],"attributeTable":[{"key":"int","value":{"intValue":"1"}}],"attributeUnits":[{"attributeKey":"1","unit":"2"}],"linkTable":[{"traceId":"0102030405060708090a0b0c0d0e0f10","spanId":"1112131415161718"}],"stringTable":["","cpu","nanoseconds"],"dropFrames":"2","keepFrames":"3","timeNanos":"1684617382541971000","durationNanos":"1000","periodType":{"type":"1","unit":"2","aggregationTemporality":2},"period":"10","comment":["1"],"defaultSampleType":"1"}}],"schemaUrl":"schemaURL"}],"schemaUrl":"schemaURL"}]}`
+
+func TestJSONUnmarshal(t *testing.T) {
+	decoder := &JSONUnmarshaler{}
+	got, err := decoder.UnmarshalProfiles([]byte(profilesJSON))
+	assert.NoError(t, err)
+	assert.EqualValues(t, profilesOTLP, got)
+}
+
+func TestJSONMarshal(t *testing.T) {
+	encoder := &JSONMarshaler{}
+	jsonBuf, err := encoder.MarshalProfiles(profilesOTLP)
+	assert.NoError(t, err)
+	assert.Equal(t, profilesJSON, string(jsonBuf))
+}
+
+func TestJSONMarshalTo(t *testing.T) {
+	encoder := &JSONMarshaler{}
+	var buf bytes.Buffer
+	require.NoError(t, encoder.MarshalProfilesTo(&buf, profilesOTLP))
+	assert.Equal(t, profilesJSON, buf.String())
+}
+
+func TestJSONUnmarshalFrom(t *testing.T) {
+	decoder := &JSONUnmarshaler{}
+	got, err := decoder.UnmarshalProfilesFrom(iotest.OneByteReader(strings.NewReader(profilesJSON)))
+	require.NoError(t, err)
+	assert.EqualValues(t, profilesOTLP, got)
+}
+
+func TestJSONUnmarshalInvalid(t *testing.T) {
+	jsonStr := `{"extra":"", "resourceProfiles": "extra"}`
+	decoder := &JSONUnmarshaler{}
+	_, err := decoder.UnmarshalProfiles([]byte(jsonStr))
+	assert.Error(t, err)
+	_, err = decoder.UnmarshalProfilesFrom(strings.NewReader(jsonStr))
+	assert.Error(t, err)
+}
+
+func TestUnmarshalJsoniterProfileData(t *testing.T) {
+	jsonStr := `{"extra":"", "resource_profiles": [{"extra":""}]}`
+	iter := jsoniter.ConfigFastest.BorrowIterator([]byte(jsonStr))
+	defer jsoniter.ConfigFastest.ReturnIterator(iter)
+	val := NewProfiles()
+	val.unmarshalJsoniter(iter)
+	assert.NoError(t, iter.Error)
+	assert.Equal(t, 1, val.ResourceProfiles().Len())
+}
+
+func TestUnmarshalJsoniterProfileContainerInvalidProfileIDField(t *testing.T) {
+	jsonStr := `{"profileId":"--"}`
+	iter := jsoniter.ConfigFastest.BorrowIterator([]byte(jsonStr))
+	defer jsoniter.ConfigFastest.ReturnIterator(iter)
+	NewProfileContainer().unmarshalJsoniter(iter)
+	assert.ErrorContains(t, iter.Error, "parse profile_id")
+}
+
+func TestUnmarshalJsoniterProfileContainerInvalidOriginalPayload(t *testing.T) {
+	jsonStr := `{"originalPayload":"--"}`
+	iter := jsoniter.ConfigFastest.BorrowIterator([]byte(jsonStr))
+	defer jsoniter.ConfigFastest.ReturnIterator(iter)
+	NewProfileContainer().unmarshalJsoniter(iter)
+	assert.ErrorContains(t, iter.Error, "base64 decode")
+}
+
+func TestUnmarshalJsoniterLinkInvalidTraceIDField(t *testing.T) {
+	jsonStr := `{"traceId":"--"}`
+	iter := jsoniter.ConfigFastest.BorrowIterator([]byte(jsonStr))
+	defer jsoniter.ConfigFastest.ReturnIterator(iter)
+	NewLink().unmarshalJsoniter(iter)
+	assert.ErrorContains(t, iter.Error, "parse trace_id")
+}
+
+func TestUnmarshalJsoniterLinkInvalidSpanIDField(t *testing.T) {
+	jsonStr := `{"spanId":"--"}`
+	iter := jsoniter.ConfigFastest.BorrowIterator([]byte(jsonStr))
+	defer jsoniter.ConfigFastest.ReturnIterator(iter)
+	NewLink().unmarshalJsoniter(iter)
+	assert.ErrorContains(t, iter.Error, "parse span_id")
+}
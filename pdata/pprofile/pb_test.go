@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtoProfilesUnmarshalerError(t *testing.T) {
+	p := &ProtoUnmarshaler{}
+	_, err := p.UnmarshalProfiles([]byte("+$%"))
+	assert.Error(t, err)
+}
+
+func TestProtoSizer(t *testing.T) {
+	marshaler := &ProtoMarshaler{}
+	pd := NewProfiles()
+	pd.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty().SetOriginalPayloadFormat("pprof")
+
+	size := marshaler.ProfilesSize(pd)
+
+	bytes, err := marshaler.MarshalProfiles(pd)
+	require.NoError(t, err)
+	assert.Equal(t, len(bytes), size)
+}
+
+func TestProtoSizerEmptyProfiles(t *testing.T) {
+	sizer := &ProtoMarshaler{}
+	assert.Equal(t, 0, sizer.ProfilesSize(NewProfiles()))
+}
+
+func TestProtoRoundTrip(t *testing.T) {
+	marshaler := &ProtoMarshaler{}
+	unmarshaler := &ProtoUnmarshaler{}
+	buf, err := marshaler.MarshalProfiles(profilesOTLP)
+	require.NoError(t, err)
+	got, err := unmarshaler.UnmarshalProfiles(buf)
+	require.NoError(t, err)
+	assert.EqualValues(t, profilesOTLP, got)
+}
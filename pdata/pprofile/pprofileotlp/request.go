@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofileotlp // import "go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
+
+import (
+	"io"
+
+	"go.opentelemetry.io/collector/pdata/internal"
+	otlpcollectorprofile "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/profiles/v1experimental"
+	"go.opentelemetry.io/collector/pdata/internal/json"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+var jsonUnmarshaler = &pprofile.JSONUnmarshaler{}
+
+// ExportRequest represents the request for gRPC/HTTP client/server.
+// It's a wrapper for pprofile.Profiles data.
+type ExportRequest struct {
+	orig  *otlpcollectorprofile.ExportProfilesServiceRequest
+	state *internal.State
+}
+
+// NewExportRequest returns an empty ExportRequest.
+func NewExportRequest() ExportRequest {
+	state := internal.StateMutable
+	return ExportRequest{
+		orig:  &otlpcollectorprofile.ExportProfilesServiceRequest{},
+		state: &state,
+	}
+}
+
+// NewExportRequestFromProfiles returns a ExportRequest from pprofile.Profiles.
+// Because ExportRequest is a wrapper for pprofile.Profiles,
+// any changes to the provided Profiles struct will be reflected in the ExportRequest and vice versa.
+func NewExportRequestFromProfiles(td pprofile.Profiles) ExportRequest {
+	return ExportRequest{
+		orig:  internal.GetOrigProfiles(internal.Profiles(td)),
+		state: internal.GetProfilesState(internal.Profiles(td)),
+	}
+}
+
+// MarshalProto marshals ExportRequest into proto bytes.
+func (ms ExportRequest) MarshalProto() ([]byte, error) {
+	return ms.orig.Marshal()
+}
+
+// UnmarshalProto unmarshalls ExportRequest from proto bytes.
+func (ms ExportRequest) UnmarshalProto(data []byte) error {
+	return ms.orig.Unmarshal(data)
+}
+
+// MarshalJSON marshals ExportRequest into JSON bytes.
+func (ms ExportRequest) MarshalJSON() ([]byte, error) {
+	return json.MarshalBytes(ms.orig)
+}
+
+// UnmarshalJSON unmarshalls ExportRequest from JSON bytes.
+func (ms ExportRequest) UnmarshalJSON(data []byte) error {
+	td, err := jsonUnmarshaler.UnmarshalProfiles(data)
+	if err != nil {
+		return err
+	}
+	*ms.orig = *internal.GetOrigProfiles(internal.Profiles(td))
+	return nil
+}
+
+// MarshalJSONTo writes ExportRequest to w in the JSON format, without buffering the whole payload in memory.
+func (ms ExportRequest) MarshalJSONTo(w io.Writer) error {
+	return json.Marshal(w, ms.orig)
+}
+
+// UnmarshalJSONFrom unmarshalls ExportRequest from the JSON data read from r, without buffering the whole
+// payload in memory.
+func (ms ExportRequest) UnmarshalJSONFrom(r io.Reader) error {
+	td, err := jsonUnmarshaler.UnmarshalProfilesFrom(r)
+	if err != nil {
+		return err
+	}
+	*ms.orig = *internal.GetOrigProfiles(internal.Profiles(td))
+	return nil
+}
+
+func (ms ExportRequest) Profiles() pprofile.Profiles {
+	return pprofile.Profiles(internal.NewProfiles(ms.orig, ms.state))
+}
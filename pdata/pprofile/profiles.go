@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofile // import "go.opentelemetry.io/collector/pdata/pprofile"
+
+import (
+	"go.opentelemetry.io/collector/pdata/internal"
+	otlpcollectorprofile "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/profiles/v1experimental"
+)
+
+// Profiles is the top-level struct that is propagated through the profiles pipeline.
+// Use NewProfiles to create new instance, zero-initialized instance is not valid for use.
+type Profiles internal.Profiles
+
+func newProfiles(orig *otlpcollectorprofile.ExportProfilesServiceRequest) Profiles {
+	state := internal.StateMutable
+	return Profiles(internal.NewProfiles(orig, &state))
+}
+
+func (ms Profiles) getOrig() *otlpcollectorprofile.ExportProfilesServiceRequest {
+	return internal.GetOrigProfiles(internal.Profiles(ms))
+}
+
+func (ms Profiles) getState() *internal.State {
+	return internal.GetProfilesState(internal.Profiles(ms))
+}
+
+// NewProfiles creates a new Profiles struct.
+func NewProfiles() Profiles {
+	return newProfiles(&otlpcollectorprofile.ExportProfilesServiceRequest{})
+}
+
+// IsReadOnly returns true if this Profiles instance is read-only.
+func (ms Profiles) IsReadOnly() bool {
+	return *ms.getState() == internal.StateReadOnly
+}
+
+// CopyTo copies the Profiles instance overriding the destination.
+func (ms Profiles) CopyTo(dest Profiles) {
+	ms.ResourceProfiles().CopyTo(dest.ResourceProfiles())
+}
+
+// ResourceProfiles returns the ResourceProfilesSlice associated with this Profiles.
+func (ms Profiles) ResourceProfiles() ResourceProfilesSlice {
+	return newResourceProfilesSlice(&ms.getOrig().ResourceProfiles, internal.GetProfilesState(internal.Profiles(ms)))
+}
+
+// MarkReadOnly marks the Profiles as shared so that no further modifications can be done on it.
+func (ms Profiles) MarkReadOnly() {
+	internal.SetProfilesState(internal.Profiles(ms), internal.StateReadOnly)
+}
+
+// Mutable returns the Profiles itself if it is mutable, otherwise a mutable copy of it.
+// Consumers that need to modify data they may share with other consumers should
+// call it before doing any modification, so that the data is only copied when required.
+func (ms Profiles) Mutable() Profiles {
+	if !ms.IsReadOnly() {
+		return ms
+	}
+	dest := NewProfiles()
+	ms.CopyTo(dest)
+	return dest
+}
+
+// ByteSize returns the size in bytes of the Profiles marshaled in the OTLP protobuf format.
+// The size is computed once and cached after the Profiles is marked as read-only.
+func (ms Profiles) ByteSize() int {
+	return internal.GetProfilesSizeCache(internal.Profiles(ms)).Size(ms.getState(), func() int {
+		pb := internal.ProfilesToProto(internal.Profiles(ms))
+		return pb.Size()
+	})
+}
+
+// SampleCount calculates the total number of samples.
+func (ms Profiles) SampleCount() int {
+	sampleCount := 0
+	rps := ms.ResourceProfiles()
+	for i := 0; i < rps.Len(); i++ {
+		rp := rps.At(i)
+		sps := rp.ScopeProfiles()
+		for j := 0; j < sps.Len(); j++ {
+			pcs := sps.At(j).Profiles()
+			for k := 0; k < pcs.Len(); k++ {
+				sampleCount += pcs.At(k).Profile().Sample().Len()
+			}
+		}
+	}
+	return sampleCount
+}
+
+// ForEachResourceProfiles calls fn for each ResourceProfiles.
+// The iteration stops if fn returns false.
+func (ms Profiles) ForEachResourceProfiles(fn func(ResourceProfiles) bool) {
+	rps := ms.ResourceProfiles()
+	for i := 0; i < rps.Len(); i++ {
+		if !fn(rps.At(i)) {
+			return
+		}
+	}
+}
+
+// ForEachScopeProfiles calls fn for each ScopeProfiles along with the ResourceProfiles containing it.
+// The iteration stops if fn returns false.
+func (ms Profiles) ForEachScopeProfiles(fn func(ResourceProfiles, ScopeProfiles) bool) {
+	rps := ms.ResourceProfiles()
+	for i := 0; i < rps.Len(); i++ {
+		rp := rps.At(i)
+		sps := rp.ScopeProfiles()
+		for j := 0; j < sps.Len(); j++ {
+			if !fn(rp, sps.At(j)) {
+				return
+			}
+		}
+	}
+}
+
+// RemoveScopeProfilesIf removes the ScopeProfiles for which fn returns true, then removes the ResourceProfiles
+// left without any ScopeProfiles.
+func (ms Profiles) RemoveScopeProfilesIf(fn func(ResourceProfiles, ScopeProfiles) bool) {
+	ms.ResourceProfiles().RemoveIf(func(rp ResourceProfiles) bool {
+		rp.ScopeProfiles().RemoveIf(func(sp ScopeProfiles) bool {
+			return fn(rp, sp)
+		})
+		return rp.ScopeProfiles().Len() == 0
+	})
+}
+
+// ForEachProfile calls fn for each profile along with the ResourceProfiles and ScopeProfiles containing it.
+// The iteration stops if fn returns false.
+func (ms Profiles) ForEachProfile(fn func(ResourceProfiles, ScopeProfiles, ProfileContainer) bool) {
+	rps := ms.ResourceProfiles()
+	for i := 0; i < rps.Len(); i++ {
+		rp := rps.At(i)
+		sps := rp.ScopeProfiles()
+		for j := 0; j < sps.Len(); j++ {
+			sp := sps.At(j)
+			pcs := sp.Profiles()
+			for k := 0; k < pcs.Len(); k++ {
+				if !fn(rp, sp, pcs.At(k)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// RemoveProfilesIf removes the profiles for which fn returns true, then removes the ScopeProfiles
+// and ResourceProfiles left without any profile.
+func (ms Profiles) RemoveProfilesIf(fn func(ResourceProfiles, ScopeProfiles, ProfileContainer) bool) {
+	ms.ResourceProfiles().RemoveIf(func(rp ResourceProfiles) bool {
+		rp.ScopeProfiles().RemoveIf(func(sp ScopeProfiles) bool {
+			sp.Profiles().RemoveIf(func(pc ProfileContainer) bool {
+				return fn(rp, sp, pc)
+			})
+			return sp.Profiles().Len() == 0
+		})
+		return rp.ScopeProfiles().Len() == 0
+	})
+}
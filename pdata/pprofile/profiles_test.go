@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	otlpcollectorprofile "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/profiles/v1experimental"
+)
+
+func TestSampleCount(t *testing.T) {
+	profiles := NewProfiles()
+	assert.EqualValues(t, 0, profiles.SampleCount())
+
+	rp := profiles.ResourceProfiles().AppendEmpty()
+	assert.EqualValues(t, 0, profiles.SampleCount())
+
+	sp := rp.ScopeProfiles().AppendEmpty()
+	assert.EqualValues(t, 0, profiles.SampleCount())
+
+	pc := sp.Profiles().AppendEmpty()
+	assert.EqualValues(t, 0, profiles.SampleCount())
+
+	pc.Profile().Sample().AppendEmpty()
+	assert.EqualValues(t, 1, profiles.SampleCount())
+
+	samples := profiles.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty().Profile().Sample()
+	for i := 0; i < 5; i++ {
+		samples.AppendEmpty()
+	}
+	assert.EqualValues(t, 6, profiles.SampleCount())
+}
+
+func TestToFromOtlp(t *testing.T) {
+	otlp := &otlpcollectorprofile.ExportProfilesServiceRequest{}
+	profiles := newProfiles(otlp)
+	assert.EqualValues(t, NewProfiles(), profiles)
+	assert.EqualValues(t, otlp, profiles.getOrig())
+}
+
+func TestProfilesCopyTo(t *testing.T) {
+	profiles := NewProfiles()
+	fillTestResourceProfilesSlice(profiles.ResourceProfiles())
+	profilesCopy := NewProfiles()
+	profiles.CopyTo(profilesCopy)
+	assert.EqualValues(t, profiles, profilesCopy)
+}
+
+func TestProfilesMutable(t *testing.T) {
+	profiles := NewProfiles()
+	fillTestResourceProfilesSlice(profiles.ResourceProfiles())
+	assert.Equal(t, profiles, profiles.Mutable())
+
+	profiles.MarkReadOnly()
+	mutable := profiles.Mutable()
+	assert.False(t, mutable.IsReadOnly())
+	assert.Equal(t, profiles.ByteSize(), mutable.ByteSize())
+	mutable.ResourceProfiles().AppendEmpty()
+	assert.Equal(t, profiles.ResourceProfiles().Len()+1, mutable.ResourceProfiles().Len())
+}
+
+func TestProfilesByteSize(t *testing.T) {
+	profiles := NewProfiles()
+	assert.Equal(t, 0, profiles.ByteSize())
+
+	fillTestResourceProfilesSlice(profiles.ResourceProfiles())
+	buf, err := (&ProtoMarshaler{}).MarshalProfiles(profiles)
+	require.NoError(t, err)
+	assert.Equal(t, len(buf), profiles.ByteSize())
+
+	profiles.MarkReadOnly()
+	assert.Equal(t, len(buf), profiles.ByteSize())
+	// The size of read-only data is computed once.
+	profiles.getOrig().ResourceProfiles = nil
+	assert.Equal(t, len(buf), profiles.ByteSize())
+}
+
+func TestReadOnlyProfilesInvalidUsage(t *testing.T) {
+	profiles := NewProfiles()
+	assert.False(t, profiles.IsReadOnly())
+	res := profiles.ResourceProfiles().AppendEmpty().Resource()
+	res.Attributes().PutStr("k1", "v1")
+	profiles.MarkReadOnly()
+	assert.True(t, profiles.IsReadOnly())
+	assert.Panics(t, func() { res.Attributes().PutStr("k2", "v2") })
+}
+
+func TestForEachProfile(t *testing.T) {
+	var names []string
+	generateForEachTestProfiles().ForEachProfile(func(_ ResourceProfiles, _ ScopeProfiles, e ProfileContainer) bool {
+		names = append(names, e.OriginalPayloadFormat())
+		return true
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+
+	names = nil
+	generateForEachTestProfiles().ForEachProfile(func(_ ResourceProfiles, _ ScopeProfiles, e ProfileContainer) bool {
+		names = append(names, e.OriginalPayloadFormat())
+		return e.OriginalPayloadFormat() != "b"
+	})
+	assert.Equal(t, []string{"a", "b"}, names)
+}
+
+func TestRemoveProfilesIf(t *testing.T) {
+	data := generateForEachTestProfiles()
+	data.RemoveProfilesIf(func(r ResourceProfiles, _ ScopeProfiles, e ProfileContainer) bool {
+		return e.OriginalPayloadFormat() == "c" || r.Resource().Attributes().Len() == 0 && e.OriginalPayloadFormat() == "a"
+	})
+	require.Equal(t, 1, data.ResourceProfiles().Len())
+	profiles := data.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles()
+	require.Equal(t, 1, profiles.Len())
+	assert.Equal(t, "b", profiles.At(0).OriginalPayloadFormat())
+}
+
+func TestForEachResourceProfiles(t *testing.T) {
+	var count int
+	generateForEachTestProfiles().ForEachResourceProfiles(func(ResourceProfiles) bool {
+		count++
+		return true
+	})
+	assert.Equal(t, 2, count)
+
+	count = 0
+	generateForEachTestProfiles().ForEachResourceProfiles(func(ResourceProfiles) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 1, count)
+}
+
+func TestForEachScopeProfiles(t *testing.T) {
+	var names []string
+	generateForEachTestProfiles().ForEachScopeProfiles(func(_ ResourceProfiles, s ScopeProfiles) bool {
+		names = append(names, s.Profiles().At(0).OriginalPayloadFormat())
+		return true
+	})
+	assert.Equal(t, []string{"a", "c"}, names)
+
+	names = nil
+	generateForEachTestProfiles().ForEachScopeProfiles(func(_ ResourceProfiles, s ScopeProfiles) bool {
+		names = append(names, s.Profiles().At(0).OriginalPayloadFormat())
+		return false
+	})
+	assert.Equal(t, []string{"a"}, names)
+}
+
+func TestRemoveScopeProfilesIf(t *testing.T) {
+	data := generateForEachTestProfiles()
+	data.RemoveScopeProfilesIf(func(r ResourceProfiles, _ ScopeProfiles) bool {
+		return r.Resource().Attributes().Len() == 0
+	})
+	require.Equal(t, 1, data.ResourceProfiles().Len())
+	assert.Equal(t, "c", data.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(0).OriginalPayloadFormat())
+}
+
+func generateForEachTestProfiles() Profiles {
+	data := NewProfiles()
+	profiles := data.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles()
+	profiles.AppendEmpty().SetOriginalPayloadFormat("a")
+	profiles.AppendEmpty().SetOriginalPayloadFormat("b")
+	r := data.ResourceProfiles().AppendEmpty()
+	r.Resource().Attributes().PutStr("key", "value")
+	r.ScopeProfiles().AppendEmpty().Profiles().AppendEmpty().SetOriginalPayloadFormat("c")
+	return data
+}
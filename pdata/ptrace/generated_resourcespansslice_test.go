@@ -39,6 +39,7 @@ func TestResourceSpansSliceReadOnly(t *testing.T) {
 	es := newResourceSpansSlice(&[]*otlptrace.ResourceSpans{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewResourceSpansSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestResourceSpansSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestResourceSpansSlice(), es)
 }
 
+func TestResourceSpansSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestResourceSpansSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el ResourceSpans) {
+		indexes = append(indexes, i)
+		fillTestResourceSpans(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestResourceSpans(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewResourceSpans(), es.At(es.Len()-1))
+}
+
 func TestResourceSpansSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestResourceSpansSlice()
@@ -39,6 +39,7 @@ func TestScopeSpansSliceReadOnly(t *testing.T) {
 	es := newScopeSpansSlice(&[]*otlptrace.ScopeSpans{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewScopeSpansSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestScopeSpansSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestScopeSpansSlice(), es)
 }
 
+func TestScopeSpansSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestScopeSpansSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el ScopeSpans) {
+		indexes = append(indexes, i)
+		fillTestScopeSpans(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestScopeSpans(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewScopeSpans(), es.At(es.Len()-1))
+}
+
 func TestScopeSpansSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestScopeSpansSlice()
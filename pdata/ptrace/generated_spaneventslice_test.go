@@ -39,6 +39,7 @@ func TestSpanEventSliceReadOnly(t *testing.T) {
 	es := newSpanEventSlice(&[]*otlptrace.Span_Event{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewSpanEventSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestSpanEventSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestSpanEventSlice(), es)
 }
 
+func TestSpanEventSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestSpanEventSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el SpanEvent) {
+		indexes = append(indexes, i)
+		fillTestSpanEvent(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestSpanEvent(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewSpanEvent(), es.At(es.Len()-1))
+}
+
 func TestSpanEventSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestSpanEventSlice()
@@ -39,6 +39,7 @@ func TestSpanLinkSliceReadOnly(t *testing.T) {
 	es := newSpanLinkSlice(&[]*otlptrace.Span_Link{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewSpanLinkSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestSpanLinkSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestSpanLinkSlice(), es)
 }
 
+func TestSpanLinkSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestSpanLinkSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el SpanLink) {
+		indexes = append(indexes, i)
+		fillTestSpanLink(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestSpanLink(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewSpanLink(), es.At(es.Len()-1))
+}
+
 func TestSpanLinkSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestSpanLinkSlice()
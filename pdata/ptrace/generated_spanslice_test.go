@@ -39,6 +39,7 @@ func TestSpanSliceReadOnly(t *testing.T) {
 	es := newSpanSlice(&[]*otlptrace.Span{}, &sharedState)
 	assert.Equal(t, 0, es.Len())
 	assert.Panics(t, func() { es.AppendEmpty() })
+	assert.Panics(t, func() { es.AppendEmptyN(2, nil) })
 	assert.Panics(t, func() { es.EnsureCapacity(2) })
 	es2 := NewSpanSlice()
 	es.CopyTo(es2)
@@ -80,6 +81,25 @@ func TestSpanSlice_EnsureCapacity(t *testing.T) {
 	assert.Equal(t, generateTestSpanSlice(), es)
 }
 
+func TestSpanSlice_AppendEmptyN(t *testing.T) {
+	es := generateTestSpanSlice()
+	oldLen := es.Len()
+	var indexes []int
+	es.AppendEmptyN(3, func(i int, el Span) {
+		indexes = append(indexes, i)
+		fillTestSpan(el)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, oldLen+3, es.Len())
+	for i := oldLen; i < es.Len(); i++ {
+		assert.Equal(t, generateTestSpan(), es.At(i))
+	}
+
+	es.AppendEmptyN(2, nil)
+	assert.Equal(t, oldLen+5, es.Len())
+	assert.Equal(t, NewSpan(), es.At(es.Len()-1))
+}
+
 func TestSpanSlice_MoveAndAppendTo(t *testing.T) {
 	// Test MoveAndAppendTo to empty
 	expectedSlice := generateTestSpanSlice()
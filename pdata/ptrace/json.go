@@ -4,8 +4,8 @@
 package ptrace // import "go.opentelemetry.io/collector/pdata/ptrace"
 
 import (
-	"bytes"
 	"fmt"
+	"io"
 
 	jsoniter "github.com/json-iterator/go"
 
@@ -20,10 +20,14 @@ type JSONMarshaler struct{}
 
 // MarshalTraces to the OTLP/JSON format.
 func (*JSONMarshaler) MarshalTraces(td Traces) ([]byte, error) {
-	buf := bytes.Buffer{}
 	pb := internal.TracesToProto(internal.Traces(td))
-	err := json.Marshal(&buf, &pb)
-	return buf.Bytes(), err
+	return json.MarshalBytes(&pb)
+}
+
+// MarshalTracesTo writes td to w in the OTLP/JSON format, without buffering the whole payload in memory.
+func (*JSONMarshaler) MarshalTracesTo(w io.Writer, td Traces) error {
+	pb := internal.TracesToProto(internal.Traces(td))
+	return json.Marshal(w, &pb)
 }
 
 // JSONUnmarshaler unmarshals OTLP/JSON formatted-bytes to pdata.Traces.
@@ -33,6 +37,18 @@ type JSONUnmarshaler struct{}
 func (*JSONUnmarshaler) UnmarshalTraces(buf []byte) (Traces, error) {
 	iter := jsoniter.ConfigFastest.BorrowIterator(buf)
 	defer jsoniter.ConfigFastest.ReturnIterator(iter)
+	return unmarshalTraces(iter)
+}
+
+// UnmarshalTracesFrom reads OTLP/JSON formatted data from r into pdata.Traces, without
+// buffering the whole payload in memory.
+func (*JSONUnmarshaler) UnmarshalTracesFrom(r io.Reader) (Traces, error) {
+	iter := json.BorrowIterator(r)
+	defer json.ReturnIterator(iter)
+	return unmarshalTraces(iter)
+}
+
+func unmarshalTraces(iter *jsoniter.Iterator) (Traces, error) {
 	td := NewTraces()
 	td.unmarshalJsoniter(iter)
 	if iter.Error != nil {
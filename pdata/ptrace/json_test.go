@@ -4,10 +4,15 @@
 package ptrace
 
 import (
+	"bytes"
+	"io"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 )
@@ -104,11 +109,27 @@ func TestJSONMarshal(t *testing.T) {
 	assert.Equal(t, tracesJSON, string(jsonBuf))
 }
 
+func TestJSONMarshalTo(t *testing.T) {
+	encoder := &JSONMarshaler{}
+	var buf bytes.Buffer
+	require.NoError(t, encoder.MarshalTracesTo(&buf, tracesOTLP))
+	assert.Equal(t, tracesJSON, buf.String())
+}
+
+func TestJSONUnmarshalFrom(t *testing.T) {
+	decoder := &JSONUnmarshaler{}
+	got, err := decoder.UnmarshalTracesFrom(iotest.OneByteReader(strings.NewReader(tracesJSON)))
+	require.NoError(t, err)
+	assert.EqualValues(t, tracesOTLP, got)
+}
+
 func TestJSONUnmarshalInvalid(t *testing.T) {
 	jsonStr := `{"extra":"", "resourceSpans": "extra"}`
 	decoder := &JSONUnmarshaler{}
 	_, err := decoder.UnmarshalTraces([]byte(jsonStr))
 	assert.Error(t, err)
+	_, err = decoder.UnmarshalTracesFrom(strings.NewReader(jsonStr))
+	assert.Error(t, err)
 }
 
 func TestUnmarshalJsoniterTraceData(t *testing.T) {
@@ -247,3 +268,32 @@ func BenchmarkJSONUnmarshal(b *testing.B) {
 		}
 	})
 }
+
+// BenchmarkJSONUnmarshalFrom compares unmarshaling a large payload read from an io.Reader, as by the OTLP/HTTP
+// receiver, with reading it whole first.
+func BenchmarkJSONUnmarshalFrom(b *testing.B) {
+	td := NewTraces()
+	for i := 0; i < 1000; i++ {
+		tracesOTLP.ResourceSpans().At(0).CopyTo(td.ResourceSpans().AppendEmpty())
+	}
+	jsonBuf, err := (&JSONMarshaler{}).MarshalTraces(td)
+	require.NoError(b, err)
+	decoder := &JSONUnmarshaler{}
+
+	b.Run("reader", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, err := decoder.UnmarshalTracesFrom(iotest.HalfReader(bytes.NewReader(jsonBuf)))
+			require.NoError(b, err)
+		}
+	})
+	b.Run("read_all", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf, err := io.ReadAll(iotest.HalfReader(bytes.NewReader(jsonBuf)))
+			require.NoError(b, err)
+			_, err = decoder.UnmarshalTraces(buf)
+			require.NoError(b, err)
+		}
+	})
+}
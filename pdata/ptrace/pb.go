@@ -4,8 +4,12 @@
 package ptrace // import "go.opentelemetry.io/collector/pdata/ptrace"
 
 import (
+	"google.golang.org/protobuf/encoding/protowire"
+
 	"go.opentelemetry.io/collector/pdata/internal"
+	otlpcollectortrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/trace/v1"
 	otlptrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/trace/v1"
+	"go.opentelemetry.io/collector/pdata/internal/otlp"
 )
 
 var _ MarshalSizer = (*ProtoMarshaler)(nil)
@@ -13,13 +17,15 @@ var _ MarshalSizer = (*ProtoMarshaler)(nil)
 type ProtoMarshaler struct{}
 
 func (e *ProtoMarshaler) MarshalTraces(td Traces) ([]byte, error) {
+	if buf, ok := td.getEncoded(); ok {
+		return append([]byte(nil), buf...), nil
+	}
 	pb := internal.TracesToProto(internal.Traces(td))
 	return pb.Marshal()
 }
 
 func (e *ProtoMarshaler) TracesSize(td Traces) int {
-	pb := internal.TracesToProto(internal.Traces(td))
-	return pb.Size()
+	return td.ByteSize()
 }
 
 type ProtoUnmarshaler struct{}
@@ -29,3 +35,43 @@ func (d *ProtoUnmarshaler) UnmarshalTraces(buf []byte) (Traces, error) {
 	err := pb.Unmarshal(buf)
 	return Traces(internal.TracesFromProto(pb)), err
 }
+
+var _ Unmarshaler = (*LazyProtoUnmarshaler)(nil)
+
+// LazyProtoUnmarshaler unmarshals Traces from the OTLP protobuf format, deferring the decoding until the content
+// of the Traces is first accessed. Until the Traces is modified, the ProtoMarshaler reuses the received bytes instead
+// of encoding it again, so that pipelines only forwarding the data skip most of the decoding and encoding cost.
+//
+// UnmarshalTraces validates the whole encoding up front and returns an error if it is invalid, so that the content
+// is always decoded without error. The resources are decoded one at a time, reusing the validated buffer,
+// which must not be modified after calling UnmarshalTraces.
+type LazyProtoUnmarshaler struct{}
+
+func (d *LazyProtoUnmarshaler) UnmarshalTraces(buf []byte) (Traces, error) {
+	if err := internal.ValidateProto(buf, &otlpcollectortrace.ExportTraceServiceRequest{}); err != nil {
+		return NewTraces(), err
+	}
+	count, err := internal.CountResourceItems(buf, func(scope []byte) (int, error) {
+		return internal.CountMessages(scope, 2)
+	})
+	if err != nil {
+		return NewTraces(), err
+	}
+	orig := &otlpcollectortrace.ExportTraceServiceRequest{}
+	state := internal.StateMutable
+	return Traces(internal.NewLazyTraces(orig, &state, internal.NewLazyProto(buf, count, func(buf []byte) {
+		// The encoding was validated by UnmarshalTraces, so decoding its resources does not fail.
+		_ = internal.RangeMessages(buf, func(num protowire.Number, resource []byte) error {
+			if num != 1 {
+				return nil
+			}
+			rs := &otlptrace.ResourceSpans{}
+			if err := rs.Unmarshal(resource); err != nil {
+				return err
+			}
+			orig.ResourceSpans = append(orig.ResourceSpans, rs)
+			return nil
+		})
+		otlp.MigrateTraces(orig.ResourceSpans)
+	}))), nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 )
@@ -37,6 +38,64 @@ func TestProtoSizerEmptyTraces(t *testing.T) {
 	assert.Equal(t, 0, sizer.TracesSize(NewTraces()))
 }
 
+func TestLazyProtoUnmarshaler(t *testing.T) {
+	td := generateBenchmarkTraces(2)
+	buf, err := (&ProtoMarshaler{}).MarshalTraces(td)
+	require.NoError(t, err)
+	// Unknown fields are dropped when decoding, so they are only kept if the encoding is reused.
+	buf = protowire.AppendTag(buf, 99, protowire.VarintType)
+	buf = protowire.AppendVarint(buf, 1)
+
+	lazy, err := (&LazyProtoUnmarshaler{}).UnmarshalTraces(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, lazy.SpanCount())
+	assert.Equal(t, len(buf), lazy.ByteSize())
+	got, err := (&ProtoMarshaler{}).MarshalTraces(lazy)
+	require.NoError(t, err)
+	assert.Equal(t, buf, got)
+
+	// Accessing the content of a mutable Traces invalidates the encoding, since it may be modified.
+	lazy.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SetName("modified")
+	got, err = (&ProtoMarshaler{}).MarshalTraces(lazy)
+	require.NoError(t, err)
+	assert.NotEqual(t, buf, got)
+	assert.Equal(t, 2, lazy.SpanCount())
+	decoded, err := (&ProtoUnmarshaler{}).UnmarshalTraces(got)
+	require.NoError(t, err)
+	assert.Equal(t, "modified", decoded.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+}
+
+func TestLazyProtoUnmarshalerReadOnly(t *testing.T) {
+	buf, err := (&ProtoMarshaler{}).MarshalTraces(generateBenchmarkTraces(2))
+	require.NoError(t, err)
+	buf = protowire.AppendTag(buf, 99, protowire.VarintType)
+	buf = protowire.AppendVarint(buf, 1)
+
+	lazy, err := (&LazyProtoUnmarshaler{}).UnmarshalTraces(buf)
+	require.NoError(t, err)
+	lazy.MarkReadOnly()
+	assert.Equal(t, 2, lazy.ResourceSpans().At(0).ScopeSpans().At(0).Spans().Len())
+	got, err := (&ProtoMarshaler{}).MarshalTraces(lazy)
+	require.NoError(t, err)
+	assert.Equal(t, buf, got)
+}
+
+func TestLazyProtoUnmarshalerError(t *testing.T) {
+	p := &LazyProtoUnmarshaler{}
+	_, err := p.UnmarshalTraces([]byte("+$%"))
+	assert.Error(t, err)
+}
+
+func TestLazyProtoUnmarshalerInvalidContent(t *testing.T) {
+	// A span with a trace ID of 3 bytes, which is well framed but can not be decoded.
+	buf := protowire.AppendBytes(protowire.AppendTag(nil, 1, protowire.BytesType), []byte{1, 2, 3})
+	for _, num := range []protowire.Number{2, 2, 1} {
+		buf = protowire.AppendBytes(protowire.AppendTag(nil, num, protowire.BytesType), buf)
+	}
+	_, err := (&LazyProtoUnmarshaler{}).UnmarshalTraces(buf)
+	assert.Error(t, err)
+}
+
 func BenchmarkTracesToProto(b *testing.B) {
 	marshaler := &ProtoMarshaler{}
 	traces := generateBenchmarkTraces(128)
@@ -31,16 +31,27 @@ type GRPCClient interface {
 
 // NewGRPCClient returns a new GRPCClient connected using the given connection.
 func NewGRPCClient(cc *grpc.ClientConn) GRPCClient {
-	return &grpcClient{rawClient: otlpcollectortrace.NewTraceServiceClient(cc)}
+	return &grpcClient{cc: cc, rawClient: otlpcollectortrace.NewTraceServiceClient(cc)}
 }
 
 type grpcClient struct {
+	cc        *grpc.ClientConn
 	rawClient otlpcollectortrace.TraceServiceClient
 }
 
 // Export implements the Client interface.
 func (c *grpcClient) Export(ctx context.Context, request ExportRequest, opts ...grpc.CallOption) (ExportResponse, error) {
-	rsp, err := c.rawClient.Export(ctx, request.orig, opts...)
+	if buf, ok := request.lazy.Encoded(); ok {
+		// Send the retained encoding instead of decoding the request to encode it again.
+		rsp := &otlpcollectortrace.ExportTraceServiceResponse{}
+		err := c.cc.Invoke(ctx, "/opentelemetry.proto.collector.trace.v1.TraceService/Export", internal.EncodedProto(buf), rsp, append(opts, internal.EncodedProtoCodec)...)
+		if err != nil {
+			return ExportResponse{}, err
+		}
+		state := internal.StateMutable
+		return ExportResponse{orig: rsp, state: &state}, nil
+	}
+	rsp, err := c.rawClient.Export(ctx, request.getOrig(), opts...)
 	if err != nil {
 		return ExportResponse{}, err
 	}
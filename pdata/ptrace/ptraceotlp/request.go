@@ -4,7 +4,7 @@
 package ptraceotlp // import "go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 
 import (
-	"bytes"
+	"io"
 
 	"go.opentelemetry.io/collector/pdata/internal"
 	otlpcollectortrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/trace/v1"
@@ -20,6 +20,7 @@ var jsonUnmarshaler = &ptrace.JSONUnmarshaler{}
 type ExportRequest struct {
 	orig  *otlpcollectortrace.ExportTraceServiceRequest
 	state *internal.State
+	lazy  *internal.LazyProto
 }
 
 // NewExportRequest returns an empty ExportRequest.
@@ -35,20 +36,25 @@ func NewExportRequest() ExportRequest {
 // Because ExportRequest is a wrapper for ptrace.Traces,
 // any changes to the provided Traces struct will be reflected in the ExportRequest and vice versa.
 func NewExportRequestFromTraces(td ptrace.Traces) ExportRequest {
+	orig, lazy := internal.GetLazyTraces(internal.Traces(td))
 	return ExportRequest{
-		orig:  internal.GetOrigTraces(internal.Traces(td)),
+		orig:  orig,
 		state: internal.GetTracesState(internal.Traces(td)),
+		lazy:  lazy,
 	}
 }
 
 // MarshalProto marshals ExportRequest into proto bytes.
 func (ms ExportRequest) MarshalProto() ([]byte, error) {
-	return ms.orig.Marshal()
+	if buf, ok := ms.lazy.Encoded(); ok {
+		return append([]byte(nil), buf...), nil
+	}
+	return ms.getOrig().Marshal()
 }
 
 // UnmarshalProto unmarshalls ExportRequest from proto bytes.
 func (ms ExportRequest) UnmarshalProto(data []byte) error {
-	if err := ms.orig.Unmarshal(data); err != nil {
+	if err := ms.getOrig().Unmarshal(data); err != nil {
 		return err
 	}
 	otlp.MigrateTraces(ms.orig.ResourceSpans)
@@ -57,11 +63,7 @@ func (ms ExportRequest) UnmarshalProto(data []byte) error {
 
 // MarshalJSON marshals ExportRequest into JSON bytes.
 func (ms ExportRequest) MarshalJSON() ([]byte, error) {
-	var buf bytes.Buffer
-	if err := json.Marshal(&buf, ms.orig); err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
+	return json.MarshalBytes(ms.getOrig())
 }
 
 // UnmarshalJSON unmarshalls ExportRequest from JSON bytes.
@@ -70,10 +72,32 @@ func (ms ExportRequest) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		return err
 	}
-	*ms.orig = *internal.GetOrigTraces(internal.Traces(td))
+	*ms.getOrig() = *internal.GetOrigTraces(internal.Traces(td))
+	return nil
+}
+
+// MarshalJSONTo writes ExportRequest to w in the JSON format, without buffering the whole payload in memory.
+func (ms ExportRequest) MarshalJSONTo(w io.Writer) error {
+	return json.Marshal(w, ms.getOrig())
+}
+
+// UnmarshalJSONFrom unmarshalls ExportRequest from the JSON data read from r, without buffering the whole
+// payload in memory.
+func (ms ExportRequest) UnmarshalJSONFrom(r io.Reader) error {
+	td, err := jsonUnmarshaler.UnmarshalTracesFrom(r)
+	if err != nil {
+		return err
+	}
+	*ms.getOrig() = *internal.GetOrigTraces(internal.Traces(td))
 	return nil
 }
 
 func (ms ExportRequest) Traces() ptrace.Traces {
-	return ptrace.Traces(internal.NewTraces(ms.orig, ms.state))
+	return ptrace.Traces(internal.NewLazyTraces(ms.orig, ms.state, ms.lazy))
+}
+
+// getOrig returns the orig of the ExportRequest, decoding it first if it was lazily unmarshaled.
+func (ms ExportRequest) getOrig() *otlpcollectortrace.ExportTraceServiceRequest {
+	ms.lazy.Decode(ms.state)
+	return ms.orig
 }
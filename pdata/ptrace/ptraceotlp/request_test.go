@@ -4,6 +4,7 @@
 package ptraceotlp
 
 import (
+	"bytes"
 	"encoding/json"
 	"strings"
 	"testing"
@@ -53,3 +54,15 @@ func TestRequestJSON(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, strings.Join(strings.Fields(string(tracesRequestJSON)), ""), string(got))
 }
+
+func TestRequestJSONStreaming(t *testing.T) {
+	tr := NewExportRequest()
+	assert.NoError(t, tr.UnmarshalJSONFrom(bytes.NewReader(tracesRequestJSON)))
+	assert.Equal(t, "test_span", tr.Traces().ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+
+	var got bytes.Buffer
+	assert.NoError(t, tr.MarshalJSONTo(&got))
+	assert.Equal(t, strings.Join(strings.Fields(string(tracesRequestJSON)), ""), got.String())
+
+	assert.Error(t, NewExportRequest().UnmarshalJSONFrom(strings.NewReader(`{"resource`)))
+}
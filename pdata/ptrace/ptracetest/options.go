@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ptracetest // import "go.opentelemetry.io/collector/pdata/ptrace/ptracetest"
+
+// CompareOption configures the comparison done by CompareTraces.
+type CompareOption func(*compareOptions)
+
+type compareOptions struct {
+	ignoreOrder      bool
+	ignoreTimestamps bool
+}
+
+// IgnoreOrder makes the comparison ignore the order of the resources, scopes, spans, events and links.
+func IgnoreOrder() CompareOption {
+	return func(o *compareOptions) {
+		o.ignoreOrder = true
+	}
+}
+
+// IgnoreTimestamps makes the comparison ignore the timestamps of the spans and events.
+func IgnoreTimestamps() CompareOption {
+	return func(o *compareOptions) {
+		o.ignoreTimestamps = true
+	}
+}
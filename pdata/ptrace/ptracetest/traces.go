@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ptracetest provides helpers to compare ptrace.Traces, e.g. in component tests.
+package ptracetest // import "go.opentelemetry.io/collector/pdata/ptrace/ptracetest"
+
+import (
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/internal/pdatacmp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// CompareTraces compares expected and actual and returns an error listing all the differences, or nil if they are equal.
+// Resources are matched by attributes, scopes by name and version, spans and events by name and links by trace and span IDs.
+func CompareTraces(expected, actual ptrace.Traces, options ...CompareOption) error {
+	opts := compareOptions{}
+	for _, op := range options {
+		op(&opts)
+	}
+	return pdatacmp.Slices("", "resource", expected.ResourceSpans(), actual.ResourceSpans(),
+		func(rs ptrace.ResourceSpans) string { return pdatacmp.MapKey(rs.Resource().Attributes()) },
+		opts.ignoreOrder, opts.compareResourceSpans)
+}
+
+func (opts compareOptions) compareResourceSpans(path string, expected, actual ptrace.ResourceSpans) error {
+	return multierr.Combine(
+		pdatacmp.Resource(path, expected.Resource(), actual.Resource()),
+		pdatacmp.Field(path, "schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		pdatacmp.Slices(path, "scope", expected.ScopeSpans(), actual.ScopeSpans(),
+			func(ss ptrace.ScopeSpans) string { return pdatacmp.ScopeKey(ss.Scope()) },
+			opts.ignoreOrder, opts.compareScopeSpans),
+	)
+}
+
+func (opts compareOptions) compareScopeSpans(path string, expected, actual ptrace.ScopeSpans) error {
+	return multierr.Combine(
+		pdatacmp.Scope(path, expected.Scope(), actual.Scope()),
+		pdatacmp.Field(path, "schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		pdatacmp.Slices(path, "span", expected.Spans(), actual.Spans(),
+			ptrace.Span.Name, opts.ignoreOrder, opts.compareSpan),
+	)
+}
+
+func (opts compareOptions) compareSpan(path string, expected, actual ptrace.Span) error {
+	errs := multierr.Combine(
+		pdatacmp.Field(path, "trace id", expected.TraceID(), actual.TraceID()),
+		pdatacmp.Field(path, "span id", expected.SpanID(), actual.SpanID()),
+		pdatacmp.Field(path, "trace state", expected.TraceState().AsRaw(), actual.TraceState().AsRaw()),
+		pdatacmp.Field(path, "parent span id", expected.ParentSpanID(), actual.ParentSpanID()),
+		pdatacmp.Field(path, "kind", expected.Kind(), actual.Kind()),
+		pdatacmp.Map(path, "attributes", expected.Attributes(), actual.Attributes()),
+		pdatacmp.Field(path, "dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+		pdatacmp.Slices(path, "event", expected.Events(), actual.Events(),
+			ptrace.SpanEvent.Name, opts.ignoreOrder, opts.compareSpanEvent),
+		pdatacmp.Field(path, "dropped events count", expected.DroppedEventsCount(), actual.DroppedEventsCount()),
+		pdatacmp.Slices(path, "link", expected.Links(), actual.Links(),
+			linkKey, opts.ignoreOrder, compareSpanLink),
+		pdatacmp.Field(path, "dropped links count", expected.DroppedLinksCount(), actual.DroppedLinksCount()),
+		pdatacmp.Field(path, "status code", expected.Status().Code(), actual.Status().Code()),
+		pdatacmp.Field(path, "status message", expected.Status().Message(), actual.Status().Message()),
+	)
+	if !opts.ignoreTimestamps {
+		errs = multierr.Combine(errs,
+			pdatacmp.Field(path, "start timestamp", expected.StartTimestamp(), actual.StartTimestamp()),
+			pdatacmp.Field(path, "end timestamp", expected.EndTimestamp(), actual.EndTimestamp()),
+		)
+	}
+	return errs
+}
+
+func (opts compareOptions) compareSpanEvent(path string, expected, actual ptrace.SpanEvent) error {
+	errs := multierr.Combine(
+		pdatacmp.Map(path, "attributes", expected.Attributes(), actual.Attributes()),
+		pdatacmp.Field(path, "dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+	)
+	if !opts.ignoreTimestamps {
+		errs = multierr.Append(errs, pdatacmp.Field(path, "timestamp", expected.Timestamp(), actual.Timestamp()))
+	}
+	return errs
+}
+
+func compareSpanLink(path string, expected, actual ptrace.SpanLink) error {
+	return multierr.Combine(
+		pdatacmp.Field(path, "trace state", expected.TraceState().AsRaw(), actual.TraceState().AsRaw()),
+		pdatacmp.Map(path, "attributes", expected.Attributes(), actual.Attributes()),
+		pdatacmp.Field(path, "dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+	)
+}
+
+func linkKey(link ptrace.SpanLink) string {
+	return link.TraceID().String() + "/" + link.SpanID().String()
+}
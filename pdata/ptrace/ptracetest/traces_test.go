@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ptracetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestCompareTraces(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected ptrace.Traces
+		actual   ptrace.Traces
+		options  []CompareOption
+		errs     []string
+	}{
+		{
+			name:     "equal",
+			expected: generateTraces("span1", "span2"),
+			actual:   generateTraces("span1", "span2"),
+		},
+		{
+			name:     "span order",
+			expected: generateTraces("span1", "span2"),
+			actual:   generateTraces("span2", "span1"),
+			errs: []string{
+				`resource "host.name=host" > scope "scope@v1" > span "span1": expected at index 0, actual at index 1`,
+				`resource "host.name=host" > scope "scope@v1" > span "span2": expected at index 1, actual at index 0`,
+			},
+		},
+		{
+			name:     "ignore span order",
+			expected: generateTraces("span1", "span2"),
+			actual:   generateTraces("span2", "span1"),
+			options:  []CompareOption{IgnoreOrder()},
+		},
+		{
+			name:     "missing and unexpected spans",
+			expected: generateTraces("span1", "span2"),
+			actual:   generateTraces("span1", "span3"),
+			errs: []string{
+				`resource "host.name=host" > scope "scope@v1": missing expected span "span2"`,
+				`resource "host.name=host" > scope "scope@v1": unexpected span "span3"`,
+			},
+		},
+		{
+			name:     "different fields",
+			expected: generateTraces("span1"),
+			actual: func() ptrace.Traces {
+				td := generateTraces("span1")
+				span := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+				span.Attributes().PutInt("attr", 2)
+				span.Attributes().PutStr("extra", "value")
+				span.Status().SetCode(ptrace.StatusCodeError)
+				span.Events().At(0).SetTimestamp(3)
+				return td
+			}(),
+			errs: []string{
+				`resource "host.name=host" > scope "scope@v1" > span "span1": attributes: key "attr": expected Int(1), actual Int(2)`,
+				`resource "host.name=host" > scope "scope@v1" > span "span1": attributes: unexpected key "extra"`,
+				`resource "host.name=host" > scope "scope@v1" > span "span1" > event "event": timestamp: expected 1970-01-01 00:00:00.000000002 +0000 UTC, actual 1970-01-01 00:00:00.000000003 +0000 UTC`,
+				`resource "host.name=host" > scope "scope@v1" > span "span1": status code: expected Unset, actual Error`,
+			},
+		},
+		{
+			name:     "ignore timestamps",
+			expected: generateTraces("span1"),
+			actual: func() ptrace.Traces {
+				td := generateTraces("span1")
+				span := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+				span.SetStartTimestamp(10)
+				span.SetEndTimestamp(20)
+				span.Events().At(0).SetTimestamp(30)
+				return td
+			}(),
+			options: []CompareOption{IgnoreTimestamps()},
+		},
+		{
+			name:     "different resource",
+			expected: generateTraces("span1"),
+			actual: func() ptrace.Traces {
+				td := generateTraces("span1")
+				td.ResourceSpans().At(0).Resource().Attributes().PutStr("host.name", "other")
+				return td
+			}(),
+			errs: []string{
+				`missing expected resource "host.name=host"`,
+				`unexpected resource "host.name=other"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CompareTraces(tt.expected, tt.actual, tt.options...)
+			if len(tt.errs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var errs []string
+			for _, e := range multierr.Errors(err) {
+				errs = append(errs, e.Error())
+			}
+			assert.Equal(t, tt.errs, errs)
+		})
+	}
+}
+
+func generateTraces(spanNames ...string) ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("host.name", "host")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("scope")
+	ss.Scope().SetVersion("v1")
+	for _, name := range spanNames {
+		span := ss.Spans().AppendEmpty()
+		span.SetName(name)
+		span.SetTraceID(pcommon.TraceID([16]byte{1}))
+		span.SetSpanID(pcommon.SpanID([8]byte{name[len(name)-1]}))
+		span.SetStartTimestamp(1)
+		span.SetEndTimestamp(2)
+		span.Attributes().PutInt("attr", 1)
+		event := span.Events().AppendEmpty()
+		event.SetName("event")
+		event.SetTimestamp(2)
+	}
+	return td
+}
@@ -21,6 +21,12 @@ func (ms Traces) getOrig() *otlpcollectortrace.ExportTraceServiceRequest {
 	return internal.GetOrigTraces(internal.Traces(ms))
 }
 
+// getEncoded returns the OTLP protobuf encoding of the Traces if it was lazily unmarshaled and is still valid.
+func (ms Traces) getEncoded() ([]byte, bool) {
+	_, lazy := internal.GetLazyTraces(internal.Traces(ms))
+	return lazy.Encoded()
+}
+
 func (ms Traces) getState() *internal.State {
 	return internal.GetTracesState(internal.Traces(ms))
 }
@@ -40,8 +46,36 @@ func (ms Traces) CopyTo(dest Traces) {
 	ms.ResourceSpans().CopyTo(dest.ResourceSpans())
 }
 
+// Mutable returns the Traces itself if it is mutable, otherwise a mutable copy of it.
+// Consumers that need to modify data they may share with other consumers should
+// call it before doing any modification, so that the data is only copied when required.
+func (ms Traces) Mutable() Traces {
+	if !ms.IsReadOnly() {
+		return ms
+	}
+	dest := NewTraces()
+	ms.CopyTo(dest)
+	return dest
+}
+
+// ByteSize returns the size in bytes of the Traces marshaled in the OTLP protobuf format.
+// The size is computed once and cached after the Traces is marked as read-only.
+func (ms Traces) ByteSize() int {
+	if buf, ok := ms.getEncoded(); ok {
+		return len(buf)
+	}
+	return internal.GetTracesSizeCache(internal.Traces(ms)).Size(ms.getState(), func() int {
+		pb := internal.TracesToProto(internal.Traces(ms))
+		return pb.Size()
+	})
+}
+
 // SpanCount calculates the total number of spans.
 func (ms Traces) SpanCount() int {
+	_, lazy := internal.GetLazyTraces(internal.Traces(ms))
+	if count, ok := lazy.Count(); ok {
+		return count
+	}
 	spanCount := 0
 	rss := ms.ResourceSpans()
 	for i := 0; i < rss.Len(); i++ {
@@ -63,3 +97,73 @@ func (ms Traces) ResourceSpans() ResourceSpansSlice {
 func (ms Traces) MarkReadOnly() {
 	internal.SetTracesState(internal.Traces(ms), internal.StateReadOnly)
 }
+
+// ForEachResourceSpans calls fn for each ResourceSpans.
+// The iteration stops if fn returns false.
+func (ms Traces) ForEachResourceSpans(fn func(ResourceSpans) bool) {
+	rss := ms.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		if !fn(rss.At(i)) {
+			return
+		}
+	}
+}
+
+// ForEachScopeSpans calls fn for each ScopeSpans along with the ResourceSpans containing it.
+// The iteration stops if fn returns false.
+func (ms Traces) ForEachScopeSpans(fn func(ResourceSpans, ScopeSpans) bool) {
+	rss := ms.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			if !fn(rs, sss.At(j)) {
+				return
+			}
+		}
+	}
+}
+
+// RemoveScopeSpansIf removes the ScopeSpans for which fn returns true, then removes the ResourceSpans
+// left without any ScopeSpans.
+func (ms Traces) RemoveScopeSpansIf(fn func(ResourceSpans, ScopeSpans) bool) {
+	ms.ResourceSpans().RemoveIf(func(rs ResourceSpans) bool {
+		rs.ScopeSpans().RemoveIf(func(ss ScopeSpans) bool {
+			return fn(rs, ss)
+		})
+		return rs.ScopeSpans().Len() == 0
+	})
+}
+
+// ForEachSpan calls fn for each span along with the ResourceSpans and ScopeSpans containing it.
+// The iteration stops if fn returns false.
+func (ms Traces) ForEachSpan(fn func(ResourceSpans, ScopeSpans, Span) bool) {
+	rss := ms.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			ss := sss.At(j)
+			spans := ss.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				if !fn(rs, ss, spans.At(k)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// RemoveSpansIf removes the spans for which fn returns true, then removes the ScopeSpans
+// and ResourceSpans left without any span.
+func (ms Traces) RemoveSpansIf(fn func(ResourceSpans, ScopeSpans, Span) bool) {
+	ms.ResourceSpans().RemoveIf(func(rs ResourceSpans) bool {
+		rs.ScopeSpans().RemoveIf(func(ss ScopeSpans) bool {
+			ss.Spans().RemoveIf(func(span Span) bool {
+				return fn(rs, ss, span)
+			})
+			return ss.Spans().Len() == 0
+		})
+		return rs.ScopeSpans().Len() == 0
+	})
+}
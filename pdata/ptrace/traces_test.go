@@ -9,6 +9,7 @@ import (
 
 	gogoproto "github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	goproto "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -116,6 +117,35 @@ func TestTracesCopyTo(t *testing.T) {
 	assert.EqualValues(t, traces, tracesCopy)
 }
 
+func TestTracesMutable(t *testing.T) {
+	traces := NewTraces()
+	fillTestResourceSpansSlice(traces.ResourceSpans())
+	assert.Equal(t, traces, traces.Mutable())
+
+	traces.MarkReadOnly()
+	mutable := traces.Mutable()
+	assert.False(t, mutable.IsReadOnly())
+	assert.Equal(t, traces.ByteSize(), mutable.ByteSize())
+	mutable.ResourceSpans().AppendEmpty()
+	assert.Equal(t, traces.ResourceSpans().Len()+1, mutable.ResourceSpans().Len())
+}
+
+func TestTracesByteSize(t *testing.T) {
+	traces := NewTraces()
+	assert.Equal(t, 0, traces.ByteSize())
+
+	fillTestResourceSpansSlice(traces.ResourceSpans())
+	buf, err := (&ProtoMarshaler{}).MarshalTraces(traces)
+	require.NoError(t, err)
+	assert.Equal(t, len(buf), traces.ByteSize())
+
+	traces.MarkReadOnly()
+	assert.Equal(t, len(buf), traces.ByteSize())
+	// The size of read-only data is computed once.
+	traces.getOrig().ResourceSpans = nil
+	assert.Equal(t, len(buf), traces.ByteSize())
+}
+
 func TestReadOnlyTracesInvalidUsage(t *testing.T) {
 	traces := NewTraces()
 	assert.False(t, traces.IsReadOnly())
@@ -178,3 +208,82 @@ func BenchmarkTracesUsage(b *testing.B) {
 		}
 	}
 }
+
+func TestForEachSpan(t *testing.T) {
+	var names []string
+	generateForEachTestTraces().ForEachSpan(func(_ ResourceSpans, _ ScopeSpans, e Span) bool {
+		names = append(names, e.Name())
+		return true
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+
+	names = nil
+	generateForEachTestTraces().ForEachSpan(func(_ ResourceSpans, _ ScopeSpans, e Span) bool {
+		names = append(names, e.Name())
+		return e.Name() != "b"
+	})
+	assert.Equal(t, []string{"a", "b"}, names)
+}
+
+func TestRemoveSpansIf(t *testing.T) {
+	data := generateForEachTestTraces()
+	data.RemoveSpansIf(func(r ResourceSpans, _ ScopeSpans, e Span) bool {
+		return e.Name() == "c" || r.Resource().Attributes().Len() == 0 && e.Name() == "a"
+	})
+	require.Equal(t, 1, data.ResourceSpans().Len())
+	spans := data.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	require.Equal(t, 1, spans.Len())
+	assert.Equal(t, "b", spans.At(0).Name())
+}
+
+func TestForEachResourceSpans(t *testing.T) {
+	var count int
+	generateForEachTestTraces().ForEachResourceSpans(func(ResourceSpans) bool {
+		count++
+		return true
+	})
+	assert.Equal(t, 2, count)
+
+	count = 0
+	generateForEachTestTraces().ForEachResourceSpans(func(ResourceSpans) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 1, count)
+}
+
+func TestForEachScopeSpans(t *testing.T) {
+	var names []string
+	generateForEachTestTraces().ForEachScopeSpans(func(_ ResourceSpans, s ScopeSpans) bool {
+		names = append(names, s.Spans().At(0).Name())
+		return true
+	})
+	assert.Equal(t, []string{"a", "c"}, names)
+
+	names = nil
+	generateForEachTestTraces().ForEachScopeSpans(func(_ ResourceSpans, s ScopeSpans) bool {
+		names = append(names, s.Spans().At(0).Name())
+		return false
+	})
+	assert.Equal(t, []string{"a"}, names)
+}
+
+func TestRemoveScopeSpansIf(t *testing.T) {
+	data := generateForEachTestTraces()
+	data.RemoveScopeSpansIf(func(r ResourceSpans, _ ScopeSpans) bool {
+		return r.Resource().Attributes().Len() == 0
+	})
+	require.Equal(t, 1, data.ResourceSpans().Len())
+	assert.Equal(t, "c", data.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+}
+
+func generateForEachTestTraces() Traces {
+	data := NewTraces()
+	spans := data.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().SetName("a")
+	spans.AppendEmpty().SetName("b")
+	r := data.ResourceSpans().AppendEmpty()
+	r.Resource().Attributes().PutStr("key", "value")
+	r.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("c")
+	return data
+}
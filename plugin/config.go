@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin // import "go.opentelemetry.io/collector/plugin"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config is the configuration of a component served by a plugin binary.
+type Config struct {
+	// Path is the path of the plugin binary.
+	Path string `mapstructure:"path"`
+	// Args are the arguments the plugin binary is run with.
+	Args []string `mapstructure:"args"`
+	// Type is the type of the component in the plugin binary.
+	Type component.Type `mapstructure:"type"`
+	// ComponentConfig is the configuration of the component, unmarshaled and validated by the plugin binary.
+	ComponentConfig map[string]any `mapstructure:"config"`
+}
+
+// Validate checks if the configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Path == "" {
+		return errors.New("path must be specified")
+	}
+	if cfg.Type == "" {
+		return errors.New("type must be specified")
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	cfg := &Config{}
+	assert.EqualError(t, cfg.Validate(), "path must be specified")
+	cfg.Path = "/usr/local/bin/otelcol-plugin"
+	assert.EqualError(t, cfg.Validate(), "type must be specified")
+	cfg.Type = "custom"
+	assert.NoError(t, cfg.Validate())
+}
@@ -0,0 +1,10 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package plugin serves receivers and exporters from plugin binaries, so that custom components
+// can be shipped separately from the collector instead of requiring a build of the collector.
+//
+// A plugin binary calls Serve with the factories of its components. The collector runs the
+// plugin binaries declared in the configuration of the plugin receivers and exporters, see the
+// pluginreceiver and pluginexporter packages.
+package plugin // import "go.opentelemetry.io/collector/plugin"
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol // import "go.opentelemetry.io/collector/plugin/internal/protocol"
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+
+	"github.com/hashicorp/go-hclog"
+	goplugin "github.com/hashicorp/go-plugin"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapio"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+)
+
+// Client is the client of the component served by a plugin binary.
+type Client struct {
+	process *goplugin.Client
+	broker  *goplugin.GRPCBroker
+	conn    *grpc.ClientConn
+}
+
+// Launch runs the plugin binary with the given arguments. The lines written by the binary
+// on its standard error are logged with the logger.
+func Launch(path string, args []string, logger *zap.Logger) (*Client, error) {
+	process := goplugin.NewClient(&goplugin.ClientConfig{
+		HandshakeConfig:  Handshake,
+		Plugins:          goplugin.PluginSet{PluginName: &GRPCPlugin{}},
+		Cmd:              exec.Command(path, args...), //#nosec G204 -- the plugin binaries are set in the configuration
+		AllowedProtocols: []goplugin.Protocol{goplugin.ProtocolGRPC},
+		Logger:           hclog.NewNullLogger(),
+		Stderr:           &zapio.Writer{Log: logger, Level: zap.InfoLevel},
+	})
+	rpcClient, err := process.Client()
+	if err != nil {
+		process.Kill()
+		return nil, fmt.Errorf("failed to run plugin %q: %w", path, err)
+	}
+	raw, err := rpcClient.Dispense(PluginName)
+	if err != nil {
+		process.Kill()
+		return nil, fmt.Errorf("failed to connect to plugin %q: %w", path, err)
+	}
+	client := raw.(*Client)
+	client.process = process
+	return client, nil
+}
+
+// Start creates and starts the component in the plugin binary.
+func (c *Client) Start(ctx context.Context, req StartRequest) error {
+	in, err := req.marshal()
+	if err != nil {
+		return fmt.Errorf("invalid plugin configuration: %w", err)
+	}
+	return c.conn.Invoke(ctx, "/"+serviceName+"/Start", in, &emptypb.Empty{})
+}
+
+// Shutdown shuts down the component in the plugin binary, then stops the binary.
+func (c *Client) Shutdown(ctx context.Context) error {
+	defer c.process.Kill()
+	return c.conn.Invoke(ctx, "/"+serviceName+"/Shutdown", &emptypb.Empty{}, &emptypb.Empty{})
+}
+
+// ServeConsumer serves the consumer to the plugin binary, which sends the data received by its
+// receiver to the consumer. It returns the broker ID of the StartRequest of the receiver.
+func (c *Client) ServeConsumer(next any) uint32 {
+	id := c.broker.NextId()
+	go c.broker.AcceptAndServe(id, func(opts []grpc.ServerOption) *grpc.Server {
+		s := grpc.NewServer(opts...)
+		registerConsumer(s, next)
+		return s
+	})
+	return id
+}
+
+// ExportTraces sends the traces to the exporter of the plugin binary.
+func (c *Client) ExportTraces(ctx context.Context, td ptrace.Traces) error {
+	_, err := ptraceotlp.NewGRPCClient(c.conn).Export(ctx, ptraceotlp.NewExportRequestFromTraces(td))
+	return err
+}
+
+// ExportMetrics sends the metrics to the exporter of the plugin binary.
+func (c *Client) ExportMetrics(ctx context.Context, md pmetric.Metrics) error {
+	_, err := pmetricotlp.NewGRPCClient(c.conn).Export(ctx, pmetricotlp.NewExportRequestFromMetrics(md))
+	return err
+}
+
+// ExportLogs sends the logs to the exporter of the plugin binary.
+func (c *Client) ExportLogs(ctx context.Context, ld plog.Logs) error {
+	_, err := plogotlp.NewGRPCClient(c.conn).Export(ctx, plogotlp.NewExportRequestFromLogs(ld))
+	return err
+}
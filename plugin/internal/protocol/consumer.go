@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol // import "go.opentelemetry.io/collector/plugin/internal/protocol"
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+)
+
+// registerConsumer registers the OTLP services of the data types supported by the consumer,
+// sending the data they receive to the consumer.
+func registerConsumer(s *grpc.Server, next any) {
+	if next, ok := next.(consumer.Traces); ok {
+		ptraceotlp.RegisterGRPCServer(s, &tracesServer{next: next})
+	}
+	if next, ok := next.(consumer.Metrics); ok {
+		pmetricotlp.RegisterGRPCServer(s, &metricsServer{next: next})
+	}
+	if next, ok := next.(consumer.Logs); ok {
+		plogotlp.RegisterGRPCServer(s, &logsServer{next: next})
+	}
+}
+
+type tracesServer struct {
+	ptraceotlp.UnimplementedGRPCServer
+	next consumer.Traces
+}
+
+func (s *tracesServer) Export(ctx context.Context, req ptraceotlp.ExportRequest) (ptraceotlp.ExportResponse, error) {
+	return ptraceotlp.NewExportResponse(), s.next.ConsumeTraces(ctx, req.Traces())
+}
+
+type metricsServer struct {
+	pmetricotlp.UnimplementedGRPCServer
+	next consumer.Metrics
+}
+
+func (s *metricsServer) Export(ctx context.Context, req pmetricotlp.ExportRequest) (pmetricotlp.ExportResponse, error) {
+	return pmetricotlp.NewExportResponse(), s.next.ConsumeMetrics(ctx, req.Metrics())
+}
+
+type logsServer struct {
+	plogotlp.UnimplementedGRPCServer
+	next consumer.Logs
+}
+
+func (s *logsServer) Export(ctx context.Context, req plogotlp.ExportRequest) (plogotlp.ExportResponse, error) {
+	return plogotlp.NewExportResponse(), s.next.ConsumeLogs(ctx, req.Logs())
+}
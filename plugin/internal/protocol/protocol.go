@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package protocol implements the protocol between the collector and the plugin binaries
+// serving components, over gRPC with hashicorp/go-plugin.
+//
+// The collector runs a plugin binary for each exporter it creates, and for each receiver ID,
+// shared by the data types of the receiver, and starts the components with the Start method of
+// the Component service. The data is sent with the OTLP services:
+// the collector calls the ones of the plugin binary to export data, and the plugin binary
+// calls the ones served by the collector with the broker to send the data it receives.
+package protocol // import "go.opentelemetry.io/collector/plugin/internal/protocol"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+const (
+	// PluginName is the name of the plugin dispensed by the plugin binaries.
+	PluginName = "component"
+
+	serviceName = "opentelemetry.collector.plugin.v1.Component"
+
+	kindReceiver = "receiver"
+	kindExporter = "exporter"
+)
+
+// Handshake is the handshake between the collector and the plugin binaries, so that the
+// binaries are not run by mistake and that their protocol version is checked.
+var Handshake = goplugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "OTELCOL_PLUGIN",
+	MagicCookieValue: "opentelemetry-collector",
+}
+
+// StartRequest is the request to create and start a component in the plugin binary.
+type StartRequest struct {
+	// ID is the ID of the component in the collector.
+	ID component.ID
+	// Kind is either component.KindReceiver or component.KindExporter.
+	Kind component.Kind
+	// Type is the type of the component in the plugin binary.
+	Type component.Type
+	// DataType is the data type of the pipeline of the exporter.
+	DataType component.DataType
+	// Config is the configuration of the component, unmarshaled by the plugin binary.
+	Config map[string]any
+	// Consumers are the broker IDs of the consumers served to the receiver by the collector,
+	// by data type. A receiver is created for each of them, from the same configuration.
+	Consumers map[component.DataType]uint32
+}
+
+func (r StartRequest) marshal() (*structpb.Struct, error) {
+	var kind string
+	switch r.Kind {
+	case component.KindReceiver:
+		kind = kindReceiver
+	case component.KindExporter:
+		kind = kindExporter
+	default:
+		return nil, fmt.Errorf("unsupported component kind %v", r.Kind)
+	}
+	config := r.Config
+	if config == nil {
+		config = map[string]any{}
+	}
+	consumers := make(map[string]any, len(r.Consumers))
+	for dataType, brokerID := range r.Consumers {
+		consumers[string(dataType)] = brokerID
+	}
+	return structpb.NewStruct(map[string]any{
+		"id":        r.ID.String(),
+		"kind":      kind,
+		"type":      string(r.Type),
+		"data_type": string(r.DataType),
+		"config":    config,
+		"consumers": consumers,
+	})
+}
+
+func unmarshalStartRequest(s *structpb.Struct) (StartRequest, error) {
+	fields := s.AsMap()
+	var req StartRequest
+	if id, _ := fields["id"].(string); id != "" {
+		if err := req.ID.UnmarshalText([]byte(id)); err != nil {
+			return StartRequest{}, err
+		}
+	}
+	switch fields["kind"] {
+	case kindReceiver:
+		req.Kind = component.KindReceiver
+	case kindExporter:
+		req.Kind = component.KindExporter
+	default:
+		return StartRequest{}, fmt.Errorf("unsupported component kind %v", fields["kind"])
+	}
+	typ, _ := fields["type"].(string)
+	req.Type = component.Type(typ)
+	dataType, _ := fields["data_type"].(string)
+	req.DataType = component.DataType(dataType)
+	req.Config, _ = fields["config"].(map[string]any)
+	if consumers, _ := fields["consumers"].(map[string]any); len(consumers) > 0 {
+		req.Consumers = make(map[component.DataType]uint32, len(consumers))
+		for dataType, brokerID := range consumers {
+			id, _ := brokerID.(float64)
+			req.Consumers[component.DataType(dataType)] = uint32(id)
+		}
+	}
+	return req, nil
+}
+
+// componentService is implemented by the server of the plugin binaries.
+type componentService interface {
+	start(ctx context.Context, req StartRequest) error
+	shutdown(ctx context.Context) error
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*componentService)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Start",
+			Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+				in := &structpb.Struct{}
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				handler := func(ctx context.Context, req any) (any, error) {
+					startReq, err := unmarshalStartRequest(req.(*structpb.Struct))
+					if err != nil {
+						return nil, status.Error(codes.InvalidArgument, err.Error())
+					}
+					return &emptypb.Empty{}, srv.(componentService).start(ctx, startReq)
+				}
+				if interceptor == nil {
+					return handler(ctx, in)
+				}
+				return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + serviceName + "/Start"}, handler)
+			},
+		},
+		{
+			MethodName: "Shutdown",
+			Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+				in := &emptypb.Empty{}
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				handler := func(ctx context.Context, _ any) (any, error) {
+					return &emptypb.Empty{}, srv.(componentService).shutdown(ctx)
+				}
+				if interceptor == nil {
+					return handler(ctx, in)
+				}
+				return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + serviceName + "/Shutdown"}, handler)
+			},
+		},
+	},
+}
+
+// GRPCPlugin is the go-plugin plugin of the components, for both the collector and the plugin binaries.
+type GRPCPlugin struct {
+	goplugin.NetRPCUnsupportedPlugin
+
+	// Factories are the factories of the components served by the plugin binary.
+	Factories Factories
+	// BuildInfo is the build information of the plugin binary.
+	BuildInfo component.BuildInfo
+	// Logger is the logger of the components served by the plugin binary.
+	Logger *zap.Logger
+}
+
+var _ goplugin.GRPCPlugin = (*GRPCPlugin)(nil)
+
+// GRPCServer registers the services of the plugin binary.
+func (p *GRPCPlugin) GRPCServer(broker *goplugin.GRPCBroker, s *grpc.Server) error {
+	srv := &componentServer{
+		factories: p.Factories,
+		buildInfo: p.BuildInfo,
+		logger:    p.Logger,
+		broker:    broker,
+	}
+	s.RegisterService(&serviceDesc, srv)
+	registerConsumer(s, srv)
+	return nil
+}
+
+// GRPCClient returns the *Client of the plugin binary for the collector.
+func (p *GRPCPlugin) GRPCClient(_ context.Context, broker *goplugin.GRPCBroker, conn *grpc.ClientConn) (any, error) {
+	return &Client{broker: broker, conn: conn}, nil
+}
+
+// errNotStarted is returned when data is sent to a plugin binary whose exporter is not started.
+var errNotStarted = errors.New("the exporter of the plugin is not started")
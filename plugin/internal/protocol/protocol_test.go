@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+func TestStartRequestMarshal(t *testing.T) {
+	req := StartRequest{
+		ID:       component.NewIDWithName("plugin", "custom"),
+		Kind:     component.KindReceiver,
+		Type:     "custom",
+		DataType: component.DataTypeMetrics,
+		Config: map[string]any{
+			"endpoint": "localhost:1234",
+			"tags":     []any{"a", "b"},
+			"nested":   map[string]any{"enabled": true},
+		},
+		Consumers: map[component.DataType]uint32{
+			component.DataTypeTraces:  3,
+			component.DataTypeMetrics: 4,
+		},
+	}
+	s, err := req.marshal()
+	require.NoError(t, err)
+	got, err := unmarshalStartRequest(s)
+	require.NoError(t, err)
+	assert.Equal(t, req, got)
+
+	_, err = StartRequest{Kind: component.KindProcessor}.marshal()
+	assert.EqualError(t, err, "unsupported component kind Processor")
+	_, err = StartRequest{Kind: component.KindExporter, Config: map[string]any{"invalid": struct{}{}}}.marshal()
+	assert.Error(t, err)
+}
@@ -0,0 +1,277 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol // import "go.opentelemetry.io/collector/plugin/internal/protocol"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	noopmetric "go.opentelemetry.io/otel/metric/noop"
+	nooptrace "go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtelemetry"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"go.opentelemetry.io/collector/receiver"
+)
+
+// Factories are the factories of the components served by a plugin binary.
+type Factories struct {
+	Receivers map[component.Type]receiver.Factory
+	Exporters map[component.Type]exporter.Factory
+}
+
+// componentServer creates and runs the components requested by the collector in the plugin binary.
+// The collector runs a plugin binary for each exporter and for each receiver ID, so a single
+// exporter, or the receivers of the data types of a single configuration, are served.
+type componentServer struct {
+	factories Factories
+	buildInfo component.BuildInfo
+	logger    *zap.Logger
+	broker    *goplugin.GRPCBroker
+
+	mu sync.Mutex
+	// components are the started exporter, or receivers, empty if none.
+	components []component.Component
+	// conns are the connections to the consumers of the started receivers.
+	conns []*grpc.ClientConn
+}
+
+var _ componentService = (*componentServer)(nil)
+
+// receiverDataTypes are the data types of the receivers, in the order they are created.
+var receiverDataTypes = []component.DataType{component.DataTypeTraces, component.DataTypeMetrics, component.DataTypeLogs}
+
+func (s *componentServer) start(ctx context.Context, req StartRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.components) != 0 {
+		return errors.New("the component of the plugin is already started")
+	}
+
+	var err error
+	if s.components, err = s.create(ctx, req); err != nil {
+		return err
+	}
+	// As in the collector, the receivers created by a factory for the same configuration are all
+	// started, the shared ones ensuring that they start once.
+	for _, comp := range s.components {
+		if err = comp.Start(ctx, &host{logger: s.logger}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// create creates the requested components. The components created before an error are returned,
+// so that they are shut down.
+func (s *componentServer) create(ctx context.Context, req StartRequest) ([]component.Component, error) {
+	id := component.NewIDWithName(req.Type, req.ID.Name())
+	set := component.TelemetrySettings{
+		Logger:         s.logger.With(zap.String("plugin", req.ID.String())),
+		TracerProvider: nooptrace.NewTracerProvider(),
+		MeterProvider:  noopmetric.NewMeterProvider(),
+		MetricsLevel:   configtelemetry.LevelNone,
+		Resource:       pcommon.NewResource(),
+		ReportStatus:   func(*component.StatusEvent) {},
+	}
+
+	switch req.Kind {
+	case component.KindReceiver:
+		factory, ok := s.factories.Receivers[req.Type]
+		if !ok {
+			return nil, fmt.Errorf("the plugin does not serve a receiver of type %q", req.Type)
+		}
+		cfg := factory.CreateDefaultConfig()
+		if err := unmarshalConfig(req.Config, cfg); err != nil {
+			return nil, err
+		}
+		rset := receiver.CreateSettings{ID: id, TelemetrySettings: set, BuildInfo: s.buildInfo}
+		var comps []component.Component
+		for _, dataType := range receiverDataTypes {
+			brokerID, ok := req.Consumers[dataType]
+			if !ok {
+				continue
+			}
+			comp, err := s.createReceiver(ctx, factory, rset, cfg, dataType, brokerID)
+			if err != nil {
+				return comps, err
+			}
+			comps = append(comps, comp)
+		}
+		if len(comps) == 0 {
+			return nil, errors.New("no consumer to send the data of the receiver to")
+		}
+		return comps, nil
+	case component.KindExporter:
+		factory, ok := s.factories.Exporters[req.Type]
+		if !ok {
+			return nil, fmt.Errorf("the plugin does not serve an exporter of type %q", req.Type)
+		}
+		cfg := factory.CreateDefaultConfig()
+		if err := unmarshalConfig(req.Config, cfg); err != nil {
+			return nil, err
+		}
+		eset := exporter.CreateSettings{ID: id, TelemetrySettings: set, BuildInfo: s.buildInfo}
+		var exp component.Component
+		var err error
+		switch req.DataType {
+		case component.DataTypeTraces:
+			exp, err = factory.CreateTracesExporter(ctx, eset, cfg)
+		case component.DataTypeMetrics:
+			exp, err = factory.CreateMetricsExporter(ctx, eset, cfg)
+		case component.DataTypeLogs:
+			exp, err = factory.CreateLogsExporter(ctx, eset, cfg)
+		default:
+			return nil, component.ErrDataTypeIsNotSupported
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []component.Component{exp}, nil
+	}
+	return nil, component.ErrDataTypeIsNotSupported
+}
+
+// createReceiver creates the receiver of the data type, sending the data it receives to the
+// consumer served by the collector with the broker ID.
+func (s *componentServer) createReceiver(ctx context.Context, factory receiver.Factory, set receiver.CreateSettings, cfg component.Config, dataType component.DataType, brokerID uint32) (component.Component, error) {
+	conn, err := s.broker.Dial(brokerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the collector: %w", err)
+	}
+	s.conns = append(s.conns, conn)
+	switch dataType {
+	case component.DataTypeTraces:
+		next, _ := consumer.NewTraces(func(ctx context.Context, td ptrace.Traces) error {
+			_, err := ptraceotlp.NewGRPCClient(conn).Export(ctx, ptraceotlp.NewExportRequestFromTraces(td))
+			return err
+		})
+		return factory.CreateTracesReceiver(ctx, set, cfg, next)
+	case component.DataTypeMetrics:
+		next, _ := consumer.NewMetrics(func(ctx context.Context, md pmetric.Metrics) error {
+			_, err := pmetricotlp.NewGRPCClient(conn).Export(ctx, pmetricotlp.NewExportRequestFromMetrics(md))
+			return err
+		})
+		return factory.CreateMetricsReceiver(ctx, set, cfg, next)
+	default:
+		next, _ := consumer.NewLogs(func(ctx context.Context, ld plog.Logs) error {
+			_, err := plogotlp.NewGRPCClient(conn).Export(ctx, plogotlp.NewExportRequestFromLogs(ld))
+			return err
+		})
+		return factory.CreateLogsReceiver(ctx, set, cfg, next)
+	}
+}
+
+func unmarshalConfig(raw map[string]any, cfg component.Config) error {
+	if err := component.UnmarshalConfig(confmap.NewFromStringMap(raw), cfg); err != nil {
+		return fmt.Errorf("invalid plugin configuration: %w", err)
+	}
+	if err := component.ValidateConfig(cfg); err != nil {
+		return fmt.Errorf("invalid plugin configuration: %w", err)
+	}
+	return nil
+}
+
+func (s *componentServer) shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var err error
+	for _, comp := range s.components {
+		err = multierr.Append(err, comp.Shutdown(ctx))
+	}
+	s.components = nil
+	for _, conn := range s.conns {
+		err = multierr.Append(err, conn.Close())
+	}
+	s.conns = nil
+	return err
+}
+
+// started returns the started exporter, or errNotStarted.
+func (s *componentServer) started() (component.Component, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.components) == 0 {
+		return nil, errNotStarted
+	}
+	return s.components[0], nil
+}
+
+func (s *componentServer) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (s *componentServer) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	comp, err := s.started()
+	if err != nil {
+		return err
+	}
+	next, ok := comp.(consumer.Traces)
+	if !ok {
+		return component.ErrDataTypeIsNotSupported
+	}
+	return next.ConsumeTraces(ctx, td)
+}
+
+func (s *componentServer) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	comp, err := s.started()
+	if err != nil {
+		return err
+	}
+	next, ok := comp.(consumer.Metrics)
+	if !ok {
+		return component.ErrDataTypeIsNotSupported
+	}
+	return next.ConsumeMetrics(ctx, md)
+}
+
+func (s *componentServer) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	comp, err := s.started()
+	if err != nil {
+		return err
+	}
+	next, ok := comp.(consumer.Logs)
+	if !ok {
+		return component.ErrDataTypeIsNotSupported
+	}
+	return next.ConsumeLogs(ctx, ld)
+}
+
+// host is the component.Host of the components of the plugin binaries. No extensions
+// nor exporters of the collector are available to the components.
+type host struct {
+	logger *zap.Logger
+}
+
+func (h *host) ReportFatalError(err error) {
+	h.logger.Error("Fatal error reported by the component of the plugin", zap.Error(err))
+}
+
+func (h *host) GetFactory(component.Kind, component.Type) component.Factory {
+	return nil
+}
+
+func (h *host) GetExtensions() map[component.ID]component.Component {
+	return nil
+}
+
+func (h *host) GetExporters() map[component.DataType]map[component.ID]component.Component {
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin // import "go.opentelemetry.io/collector/plugin"
+
+import (
+	"github.com/hashicorp/go-hclog"
+	goplugin "github.com/hashicorp/go-plugin"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/plugin/internal/protocol"
+	"go.opentelemetry.io/collector/receiver"
+)
+
+// Settings are the settings of the components served by a plugin binary.
+type Settings struct {
+	// Receivers are the factories of the receivers served by the plugin binary.
+	Receivers []receiver.Factory
+	// Exporters are the factories of the exporters served by the plugin binary.
+	Exporters []exporter.Factory
+	// BuildInfo is the build information of the plugin binary.
+	BuildInfo component.BuildInfo
+	// Logger is the logger of the components. Its output must be the standard error,
+	// which is logged by the collector. If nil, a production logger is used.
+	Logger *zap.Logger
+}
+
+// Serve serves the components to the collector which runs the plugin binary. It must be called
+// by the main function of the binary, and returns once the collector stopped the binary.
+// The binary must not write to its standard output, which is used to connect to the collector.
+func Serve(set Settings) error {
+	logger := set.Logger
+	if logger == nil {
+		var err error
+		if logger, err = zap.NewProduction(); err != nil {
+			return err
+		}
+	}
+
+	factories := protocol.Factories{
+		Receivers: make(map[component.Type]receiver.Factory, len(set.Receivers)),
+		Exporters: make(map[component.Type]exporter.Factory, len(set.Exporters)),
+	}
+	for _, f := range set.Receivers {
+		factories.Receivers[f.Type()] = f
+	}
+	for _, f := range set.Exporters {
+		factories.Exporters[f.Type()] = f
+	}
+
+	goplugin.Serve(&goplugin.ServeConfig{
+		HandshakeConfig: protocol.Handshake,
+		Plugins: goplugin.PluginSet{protocol.PluginName: &protocol.GRPCPlugin{
+			Factories: factories,
+			BuildInfo: set.BuildInfo,
+			Logger:    logger,
+		}},
+		GRPCServer: goplugin.DefaultGRPCServer,
+		Logger:     hclog.NewNullLogger(),
+	})
+	return nil
+}
@@ -0,0 +1,12 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pluginexporter // import "go.opentelemetry.io/collector/plugin/pluginexporter"
+
+import (
+	"go.opentelemetry.io/collector/plugin"
+)
+
+// Config defines the configuration of the plugin exporter: the plugin binary to run,
+// and the type and the configuration of the exporter it serves.
+type Config = plugin.Config
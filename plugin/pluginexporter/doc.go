@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package pluginexporter exports data with an exporter served by a plugin binary.
+package pluginexporter // import "go.opentelemetry.io/collector/plugin/pluginexporter"
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pluginexporter // import "go.opentelemetry.io/collector/plugin/pluginexporter"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/plugin/internal/protocol"
+)
+
+// pluginExporter runs the plugin binary of its configuration, and sends the data
+// to the exporter served by the binary.
+type pluginExporter struct {
+	cfg      *Config
+	set      exporter.CreateSettings
+	dataType component.DataType
+
+	client *protocol.Client
+}
+
+func newPluginExporter(cfg *Config, set exporter.CreateSettings, dataType component.DataType) *pluginExporter {
+	return &pluginExporter{
+		cfg:      cfg,
+		set:      set,
+		dataType: dataType,
+	}
+}
+
+func (e *pluginExporter) start(ctx context.Context, _ component.Host) error {
+	client, err := protocol.Launch(e.cfg.Path, e.cfg.Args, e.set.Logger)
+	if err != nil {
+		return err
+	}
+	e.client = client
+	return client.Start(ctx, protocol.StartRequest{
+		ID:       e.set.ID,
+		Kind:     component.KindExporter,
+		Type:     e.cfg.Type,
+		DataType: e.dataType,
+		Config:   e.cfg.ComponentConfig,
+	})
+}
+
+func (e *pluginExporter) shutdown(ctx context.Context) error {
+	if e.client == nil {
+		return nil
+	}
+	return e.client.Shutdown(ctx)
+}
+
+func (e *pluginExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
+	return e.client.ExportTraces(ctx, td)
+}
+
+func (e *pluginExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return e.client.ExportMetrics(ctx, md)
+}
+
+func (e *pluginExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	return e.client.ExportLogs(ctx, ld)
+}
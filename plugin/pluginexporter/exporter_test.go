@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pluginexporter
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func newTestConfig(t *testing.T) *Config {
+	t.Setenv(servePluginEnv, "1")
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Path = os.Args[0]
+	cfg.Type = "test"
+	return cfg
+}
+
+func newTestTraces(spanName string) ptrace.Traces {
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(spanName)
+	return td
+}
+
+func TestPluginExporter(t *testing.T) {
+	cfg := newTestConfig(t)
+	cfg.ComponentConfig = map[string]any{"rejected_span_name": "rejected"}
+	exp, err := NewFactory().CreateTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, exp.ConsumeTraces(context.Background(), newTestTraces("accepted")))
+	assert.ErrorContains(t, exp.ConsumeTraces(context.Background(), newTestTraces("rejected")), "span rejected")
+	require.NoError(t, exp.Shutdown(context.Background()))
+}
+
+func TestPluginExporterStartErrors(t *testing.T) {
+	cfg := newTestConfig(t)
+	cfg.ComponentConfig = map[string]any{"unknown": true}
+	exp, err := NewFactory().CreateTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	assert.ErrorContains(t, exp.Start(context.Background(), componenttest.NewNopHost()), "invalid plugin configuration")
+	assert.NoError(t, exp.Shutdown(context.Background()))
+
+	cfg = newTestConfig(t)
+	cfg.Type = "unknown"
+	exp, err = NewFactory().CreateTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	assert.ErrorContains(t, exp.Start(context.Background(), componenttest.NewNopHost()), `the plugin does not serve an exporter of type "unknown"`)
+	assert.NoError(t, exp.Shutdown(context.Background()))
+
+	mexp, err := NewFactory().CreateMetricsExporter(context.Background(), exportertest.NewNopCreateSettings(), newTestConfig(t))
+	require.NoError(t, err)
+	assert.ErrorContains(t, mexp.Start(context.Background(), componenttest.NewNopHost()), "telemetry type is not supported")
+	assert.NoError(t, mexp.Shutdown(context.Background()))
+}
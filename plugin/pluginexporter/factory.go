@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pluginexporter // import "go.opentelemetry.io/collector/plugin/pluginexporter"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/plugin/pluginexporter/internal/metadata"
+)
+
+// NewFactory creates a factory for the plugin exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
+		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{}
+}
+
+func createTracesExporter(ctx context.Context, set exporter.CreateSettings, cfg component.Config) (exporter.Traces, error) {
+	pe := newPluginExporter(cfg.(*Config), set, component.DataTypeTraces)
+	return exporterhelper.NewTracesExporter(ctx, set, cfg, pe.pushTraces,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithStart(pe.start),
+		exporterhelper.WithShutdown(pe.shutdown))
+}
+
+func createMetricsExporter(ctx context.Context, set exporter.CreateSettings, cfg component.Config) (exporter.Metrics, error) {
+	pe := newPluginExporter(cfg.(*Config), set, component.DataTypeMetrics)
+	return exporterhelper.NewMetricsExporter(ctx, set, cfg, pe.pushMetrics,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithStart(pe.start),
+		exporterhelper.WithShutdown(pe.shutdown))
+}
+
+func createLogsExporter(ctx context.Context, set exporter.CreateSettings, cfg component.Config) (exporter.Logs, error) {
+	pe := newPluginExporter(cfg.(*Config), set, component.DataTypeLogs)
+	return exporterhelper.NewLogsExporter(ctx, set, cfg, pe.pushLogs,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithStart(pe.start),
+		exporterhelper.WithShutdown(pe.shutdown))
+}
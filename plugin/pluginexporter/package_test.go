@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pluginexporter
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"go.uber.org/goleak"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/plugin"
+)
+
+// servePluginEnv is set to run the test binary as the plugin binary of the tests.
+const servePluginEnv = "PLUGINEXPORTER_TEST_SERVE_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(servePluginEnv) != "" {
+		err := plugin.Serve(plugin.Settings{
+			Exporters: []exporter.Factory{newTestExporterFactory()},
+			Logger:    zap.NewNop(),
+		})
+		if err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	goleak.VerifyTestMain(m)
+}
+
+type testExporterConfig struct {
+	RejectedSpanName string `mapstructure:"rejected_span_name"`
+}
+
+// newTestExporterFactory returns the factory of an exporter rejecting the traces
+// whose first span has the configured name.
+func newTestExporterFactory() exporter.Factory {
+	return exporter.NewFactory("test",
+		func() component.Config { return &testExporterConfig{} },
+		exporter.WithTraces(func(_ context.Context, _ exporter.CreateSettings, cfg component.Config) (exporter.Traces, error) {
+			return &testExporter{cfg: cfg.(*testExporterConfig)}, nil
+		}, component.StabilityLevelDevelopment))
+}
+
+type testExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	cfg *testExporterConfig
+}
+
+func (e *testExporter) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{}
+}
+
+func (e *testExporter) ConsumeTraces(_ context.Context, td ptrace.Traces) error {
+	if td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name() == e.cfg.RejectedSpanName {
+		return errors.New("span rejected")
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pluginreceiver // import "go.opentelemetry.io/collector/plugin/pluginreceiver"
+
+import (
+	"go.opentelemetry.io/collector/plugin"
+)
+
+// Config defines the configuration of the plugin receiver: the plugin binary to run,
+// and the type and the configuration of the receiver it serves.
+type Config = plugin.Config
@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package pluginreceiver receives data with a receiver served by a plugin binary.
+package pluginreceiver // import "go.opentelemetry.io/collector/plugin/pluginreceiver"
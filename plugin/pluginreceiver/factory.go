@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pluginreceiver // import "go.opentelemetry.io/collector/plugin/pluginreceiver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/internal/sharedcomponent"
+	"go.opentelemetry.io/collector/plugin/pluginreceiver/internal/metadata"
+	"go.opentelemetry.io/collector/receiver"
+)
+
+// NewFactory creates a factory for the plugin receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithTraces(createTraces, metadata.TracesStability),
+		receiver.WithMetrics(createMetrics, metadata.MetricsStability),
+		receiver.WithLogs(createLogs, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{}
+}
+
+func createTraces(_ context.Context, set receiver.CreateSettings, cfg component.Config, next consumer.Traces) (receiver.Traces, error) {
+	return createReceiver(set, cfg.(*Config), component.DataTypeTraces, next), nil
+}
+
+func createMetrics(_ context.Context, set receiver.CreateSettings, cfg component.Config, next consumer.Metrics) (receiver.Metrics, error) {
+	return createReceiver(set, cfg.(*Config), component.DataTypeMetrics, next), nil
+}
+
+func createLogs(_ context.Context, set receiver.CreateSettings, cfg component.Config, next consumer.Logs) (receiver.Logs, error) {
+	return createReceiver(set, cfg.(*Config), component.DataTypeLogs, next), nil
+}
+
+// createReceiver returns the receiver shared by the data types of the configuration, with the
+// next consumer of the data type registered.
+func createReceiver(set receiver.CreateSettings, cfg *Config, dataType component.DataType, next any) *sharedcomponent.Component[*pluginReceiver] {
+	// The creation never fails.
+	r, _ := receivers.LoadOrStore(
+		cfg,
+		func() (*pluginReceiver, error) {
+			return newPluginReceiver(cfg, set), nil
+		},
+		&set.TelemetrySettings,
+	)
+	r.Unwrap().registerConsumer(dataType, next)
+	return r
+}
+
+// receivers are the receivers created for the configurations, so that a single plugin binary is
+// run for the data types a receiver is used for. A receiver is removed once it is shut down, so
+// that the same configuration can be created again.
+var receivers = sharedcomponent.NewMap[*Config, *pluginReceiver]()
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pluginreceiver
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"go.uber.org/goleak"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/plugin"
+	"go.opentelemetry.io/collector/receiver"
+)
+
+// servePluginEnv is set to run the test binary as the plugin binary of the tests.
+const servePluginEnv = "PLUGINRECEIVER_TEST_SERVE_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(servePluginEnv) != "" {
+		err := plugin.Serve(plugin.Settings{
+			Receivers: []receiver.Factory{newTestReceiverFactory()},
+			Logger:    zap.NewNop(),
+		})
+		if err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	goleak.VerifyTestMain(m)
+}
+
+type testReceiverConfig struct {
+	SpanName string `mapstructure:"span_name"`
+}
+
+// pidKey is the resource attribute set to the process ID of the plugin binary by the test receivers.
+const pidKey = "pid"
+
+// newTestReceiverFactory returns the factory of a receiver sending a span, or a metric, when started.
+func newTestReceiverFactory() receiver.Factory {
+	return receiver.NewFactory("test",
+		func() component.Config { return &testReceiverConfig{} },
+		receiver.WithTraces(func(_ context.Context, _ receiver.CreateSettings, cfg component.Config, next consumer.Traces) (receiver.Traces, error) {
+			return &testReceiver{cfg: cfg.(*testReceiverConfig), traces: next}, nil
+		}, component.StabilityLevelDevelopment),
+		receiver.WithMetrics(func(_ context.Context, _ receiver.CreateSettings, cfg component.Config, next consumer.Metrics) (receiver.Metrics, error) {
+			return &testReceiver{cfg: cfg.(*testReceiverConfig), metrics: next}, nil
+		}, component.StabilityLevelDevelopment))
+}
+
+type testReceiver struct {
+	component.ShutdownFunc
+	cfg     *testReceiverConfig
+	traces  consumer.Traces
+	metrics consumer.Metrics
+}
+
+func (r *testReceiver) Start(ctx context.Context, _ component.Host) error {
+	if r.metrics != nil {
+		md := pmetric.NewMetrics()
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutInt(pidKey, int64(os.Getpid()))
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName(r.cfg.SpanName)
+		return r.metrics.ConsumeMetrics(ctx, md)
+	}
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutInt(pidKey, int64(os.Getpid()))
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(r.cfg.SpanName)
+	return r.traces.ConsumeTraces(ctx, td)
+}
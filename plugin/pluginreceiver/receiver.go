@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pluginreceiver // import "go.opentelemetry.io/collector/plugin/pluginreceiver"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/plugin/internal/protocol"
+	"go.opentelemetry.io/collector/receiver"
+)
+
+// pluginReceiver runs the plugin binary of its configuration, and starts the receivers served
+// by the binary to send the data they receive to the next consumers. It is shared by the data
+// types the receiver is used for, so that a single binary is run.
+type pluginReceiver struct {
+	cfg *Config
+	set receiver.CreateSettings
+
+	mu sync.Mutex
+	// consumers are the consumer.Traces, consumer.Metrics or consumer.Logs by data type.
+	consumers map[component.DataType]any
+
+	client *protocol.Client
+}
+
+func newPluginReceiver(cfg *Config, set receiver.CreateSettings) *pluginReceiver {
+	return &pluginReceiver{
+		cfg:       cfg,
+		set:       set,
+		consumers: map[component.DataType]any{},
+	}
+}
+
+// registerConsumer registers the next consumer of the data type, before the receiver starts.
+func (r *pluginReceiver) registerConsumer(dataType component.DataType, next any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.consumers[dataType] = next
+}
+
+func (r *pluginReceiver) Start(ctx context.Context, _ component.Host) error {
+	client, err := protocol.Launch(r.cfg.Path, r.cfg.Args, r.set.Logger)
+	if err != nil {
+		return err
+	}
+	r.client = client
+
+	r.mu.Lock()
+	consumers := make(map[component.DataType]uint32, len(r.consumers))
+	for dataType, next := range r.consumers {
+		consumers[dataType] = client.ServeConsumer(next)
+	}
+	r.mu.Unlock()
+	return client.Start(ctx, protocol.StartRequest{
+		ID:        r.set.ID,
+		Kind:      component.KindReceiver,
+		Type:      r.cfg.Type,
+		Config:    r.cfg.ComponentConfig,
+		Consumers: consumers,
+	})
+}
+
+func (r *pluginReceiver) Shutdown(ctx context.Context) error {
+	if r.client == nil {
+		return nil
+	}
+	return r.client.Shutdown(ctx)
+}
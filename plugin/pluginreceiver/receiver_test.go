@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pluginreceiver
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func newTestConfig(t *testing.T) *Config {
+	t.Setenv(servePluginEnv, "1")
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Path = os.Args[0]
+	cfg.Type = "test"
+	return cfg
+}
+
+func TestPluginReceiver(t *testing.T) {
+	cfg := newTestConfig(t)
+	cfg.ComponentConfig = map[string]any{"span_name": "from plugin"}
+	sink := new(consumertest.TracesSink)
+	rcv, err := NewFactory().CreateTracesReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	require.NoError(t, rcv.Start(context.Background(), componenttest.NewNopHost()))
+	require.Len(t, sink.AllTraces(), 1)
+	assert.Equal(t, "from plugin", sink.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+	require.NoError(t, rcv.Shutdown(context.Background()))
+}
+
+func TestPluginReceiverSharedByDataTypes(t *testing.T) {
+	cfg := newTestConfig(t)
+	tracesSink := new(consumertest.TracesSink)
+	metricsSink := new(consumertest.MetricsSink)
+	factory := NewFactory()
+	traces, err := factory.CreateTracesReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, tracesSink)
+	require.NoError(t, err)
+	metrics, err := factory.CreateMetricsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, metricsSink)
+	require.NoError(t, err)
+	assert.Same(t, traces, metrics)
+
+	require.NoError(t, traces.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, metrics.Start(context.Background(), componenttest.NewNopHost()))
+	require.Len(t, tracesSink.AllTraces(), 1)
+	require.Len(t, metricsSink.AllMetrics(), 1)
+	tracesPID, _ := tracesSink.AllTraces()[0].ResourceSpans().At(0).Resource().Attributes().Get(pidKey)
+	metricsPID, _ := metricsSink.AllMetrics()[0].ResourceMetrics().At(0).Resource().Attributes().Get(pidKey)
+	assert.Equal(t, tracesPID.Int(), metricsPID.Int())
+	require.NoError(t, traces.Shutdown(context.Background()))
+	require.NoError(t, metrics.Shutdown(context.Background()))
+
+	// The receiver is created again once shut down.
+	recreated, err := factory.CreateTracesReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, tracesSink)
+	require.NoError(t, err)
+	assert.NotSame(t, traces, recreated)
+	require.NoError(t, recreated.Shutdown(context.Background()))
+}
+
+func TestPluginReceiverStartErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		wantErr string
+	}{
+		{
+			name:    "invalid config",
+			modify:  func(cfg *Config) { cfg.ComponentConfig = map[string]any{"unknown": true} },
+			wantErr: "invalid plugin configuration",
+		},
+		{
+			name:    "unknown type",
+			modify:  func(cfg *Config) { cfg.Type = "unknown" },
+			wantErr: `the plugin does not serve a receiver of type "unknown"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newTestConfig(t)
+			tt.modify(cfg)
+			rcv, err := NewFactory().CreateTracesReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+			require.NoError(t, err)
+			assert.ErrorContains(t, rcv.Start(context.Background(), componenttest.NewNopHost()), tt.wantErr)
+			assert.NoError(t, rcv.Shutdown(context.Background()))
+		})
+	}
+
+	rcv, err := NewFactory().CreateLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), newTestConfig(t), consumertest.NewNop())
+	require.NoError(t, err)
+	assert.ErrorContains(t, rcv.Start(context.Background(), componenttest.NewNopHost()), "telemetry type is not supported")
+	assert.NoError(t, rcv.Shutdown(context.Background()))
+
+	cfg := newTestConfig(t)
+	cfg.Path = "/nonexistent/plugin"
+	rcv, err = NewFactory().CreateLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.ErrorContains(t, rcv.Start(context.Background(), componenttest.NewNopHost()), `failed to run plugin "/nonexistent/plugin"`)
+	assert.NoError(t, rcv.Shutdown(context.Background()))
+}
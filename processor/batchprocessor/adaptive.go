@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package batchprocessor // import "go.opentelemetry.io/collector/processor/batchprocessor"
+
+import (
+	"time"
+)
+
+// adaptiveController adjusts the batch size and timeout of a shard based on the
+// latency and the errors of the exports, using additive increase and
+// multiplicative decrease. It is only used by the shard goroutine.
+// The latency is the one of the next consumer, so the adaptation only reflects
+// the backend when the next consumers are synchronous, e.g. an exporter
+// without a sending queue.
+type adaptiveController struct {
+	targetLatency time.Duration
+	minSize       int
+	maxSize       int
+	step          int
+
+	baseSize    int
+	baseTimeout time.Duration
+	minTimeout  time.Duration
+
+	// sendBatchSize and timeout are the current adjusted values.
+	sendBatchSize int
+	timeout       time.Duration
+}
+
+func newAdaptiveController(cfg *Config) *adaptiveController {
+	c := &adaptiveController{
+		targetLatency: cfg.AdaptiveSizing.TargetLatency,
+		minSize:       int(cfg.AdaptiveSizing.MinSendBatchSize),
+		maxSize:       int(cfg.AdaptiveSizing.MaxSendBatchSize),
+		baseSize:      int(cfg.SendBatchSize),
+		baseTimeout:   cfg.Timeout,
+		minTimeout:    cfg.AdaptiveSizing.MinTimeout,
+		sendBatchSize: int(cfg.SendBatchSize),
+		timeout:       cfg.Timeout,
+	}
+	// Grow by a tenth of the configured size, so that it takes about ten
+	// successful exports to double the batch size from its initial value.
+	c.step = c.baseSize / 10
+	if c.step < 1 {
+		c.step = 1
+	}
+	if c.minTimeout == 0 {
+		c.minTimeout = c.baseTimeout / 10
+	}
+	return c
+}
+
+// observe records the outcome of an export and adjusts the batch size and timeout.
+func (c *adaptiveController) observe(latency time.Duration, err error) {
+	switch {
+	case err != nil || latency > c.targetLatency:
+		c.sendBatchSize /= 2
+		if c.sendBatchSize < c.minSize {
+			c.sendBatchSize = c.minSize
+		}
+	case latency < c.targetLatency/2:
+		c.sendBatchSize += c.step
+		if c.sendBatchSize > c.maxSize {
+			c.sendBatchSize = c.maxSize
+		}
+	default:
+		return
+	}
+	// The timeout follows the batch size, so that the batches fill up at the same rate, but not below
+	// minTimeout, so that a shrunk batch size does not flush tiny batches in a tight loop.
+	c.timeout = time.Duration(int64(c.baseTimeout) * int64(c.sendBatchSize) / int64(c.baseSize))
+	if c.timeout < c.minTimeout {
+		c.timeout = c.minTimeout
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package batchprocessor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/internal/testdata"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+func newTestAdaptiveController() *adaptiveController {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SendBatchSize = 1000
+	cfg.Timeout = time.Second
+	cfg.AdaptiveSizing = AdaptiveSizingConfig{
+		Enabled:          true,
+		TargetLatency:    100 * time.Millisecond,
+		MinSendBatchSize: 100,
+		MaxSendBatchSize: 1200,
+	}
+	return newAdaptiveController(cfg)
+}
+
+func TestAdaptiveControllerGrows(t *testing.T) {
+	c := newTestAdaptiveController()
+
+	c.observe(10*time.Millisecond, nil)
+	assert.Equal(t, 1100, c.sendBatchSize)
+	assert.Equal(t, 1100*time.Millisecond, c.timeout)
+
+	c.observe(10*time.Millisecond, nil)
+	c.observe(10*time.Millisecond, nil)
+	assert.Equal(t, 1200, c.sendBatchSize)
+	assert.Equal(t, 1200*time.Millisecond, c.timeout)
+}
+
+func TestAdaptiveControllerSteady(t *testing.T) {
+	c := newTestAdaptiveController()
+
+	c.observe(80*time.Millisecond, nil)
+	assert.Equal(t, 1000, c.sendBatchSize)
+	assert.Equal(t, time.Second, c.timeout)
+}
+
+func TestAdaptiveControllerShrinks(t *testing.T) {
+	c := newTestAdaptiveController()
+
+	c.observe(200*time.Millisecond, nil)
+	assert.Equal(t, 500, c.sendBatchSize)
+	assert.Equal(t, 500*time.Millisecond, c.timeout)
+
+	c.observe(10*time.Millisecond, errors.New("unavailable"))
+	assert.Equal(t, 250, c.sendBatchSize)
+
+	c.observe(10*time.Millisecond, errors.New("unavailable"))
+	c.observe(10*time.Millisecond, errors.New("unavailable"))
+	assert.Equal(t, 100, c.sendBatchSize)
+	assert.Equal(t, 100*time.Millisecond, c.timeout)
+}
+
+func TestAdaptiveControllerMinTimeout(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SendBatchSize = 1000
+	cfg.Timeout = time.Second
+	cfg.AdaptiveSizing = AdaptiveSizingConfig{
+		Enabled:          true,
+		TargetLatency:    100 * time.Millisecond,
+		MinSendBatchSize: 10,
+		MaxSendBatchSize: 1200,
+	}
+
+	// The timeout is not lower than a tenth of the configured one by default.
+	c := newAdaptiveController(cfg)
+	for i := 0; i < 10; i++ {
+		c.observe(time.Second, nil)
+	}
+	assert.Equal(t, 10, c.sendBatchSize)
+	assert.Equal(t, 100*time.Millisecond, c.timeout)
+
+	cfg.AdaptiveSizing.MinTimeout = 300 * time.Millisecond
+	c = newAdaptiveController(cfg)
+	c.observe(time.Second, nil)
+	assert.Equal(t, 500*time.Millisecond, c.timeout)
+	c.observe(time.Second, nil)
+	assert.Equal(t, 300*time.Millisecond, c.timeout)
+}
+
+func TestBatchProcessorAdaptiveSizing(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SendBatchSize = 1000
+	cfg.Timeout = 10 * time.Minute
+	cfg.AdaptiveSizing = AdaptiveSizingConfig{
+		Enabled:          true,
+		TargetLatency:    time.Minute,
+		MinSendBatchSize: 100,
+		MaxSendBatchSize: 2000,
+	}
+	require.NoError(t, cfg.Validate())
+
+	sink := new(consumertest.TracesSink)
+	bp, err := newBatchTracesProcessor(processortest.NewNopCreateSettings(), sink, cfg)
+	require.NoError(t, err)
+	require.NoError(t, bp.Start(context.Background(), componenttest.NewNopHost()))
+
+	// Every export is fast, the batch size grows after each of them.
+	for i := 0; i < 22; i++ {
+		require.NoError(t, bp.ConsumeTraces(context.Background(), testdata.GenerateTraces(100)))
+	}
+	require.NoError(t, bp.Shutdown(context.Background()))
+
+	// The first batch is sent at 1000 spans, the second one at 1100 spans, the rest on shutdown.
+	require.Len(t, sink.AllTraces(), 3)
+	assert.Equal(t, 1000, sink.AllTraces()[0].SpanCount())
+	assert.Equal(t, 1100, sink.AllTraces()[1].SpanCount())
+	assert.Equal(t, 100, sink.AllTraces()[2].SpanCount())
+}
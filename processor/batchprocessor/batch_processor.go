@@ -11,9 +11,11 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 
 	"go.opentelemetry.io/collector/client"
@@ -29,6 +31,10 @@ import (
 // errTooManyBatchers is returned when the MetadataCardinalityLimit has been reached.
 var errTooManyBatchers = consumererror.NewPermanent(errors.New("too many batcher metadata-value combinations"))
 
+// errShardFull is returned when the MetadataShardMaxItems has been reached, it is not
+// permanent so that the data can be retried once the batcher sent its pending items.
+var errShardFull = errors.New("too many pending items for the batcher metadata-value combination")
+
 // batch_processor is a component that accepts spans and metrics, places them
 // into batches and sends downstream.
 //
@@ -36,6 +42,7 @@ var errTooManyBatchers = consumererror.NewPermanent(errors.New("too many batcher
 //
 // Batches are sent out with any of the following conditions:
 // - batch size reaches cfg.SendBatchSize
+// - batch size in bytes reaches cfg.SendBatchSizeBytes
 // - cfg.Timeout is elapsed since the timestamp when the previous batch was sent out.
 type batchProcessor struct {
 	logger           *zap.Logger
@@ -43,6 +50,9 @@ type batchProcessor struct {
 	sendBatchSize    int
 	sendBatchMaxSize int
 
+	sendBatchSizeBytes    int
+	sendBatchMaxSizeBytes int
+
 	// batchFunc is a factory for new batch objects corresponding
 	// with the appropriate signal.
 	batchFunc func() batch
@@ -56,9 +66,25 @@ type batchProcessor struct {
 	// metadataLimit is the limiting size of the batchers map.
 	metadataLimit int
 
+	// metadataEviction enables the eviction of the least recently
+	// used batcher when metadataLimit is reached.
+	metadataEviction bool
+
+	// shardMaxItems is the maximum number of pending items per batcher.
+	shardMaxItems int
+
+	// newController creates the adaptive sizing controller of a
+	// shard, nil when adaptive sizing is disabled.
+	newController func() *adaptiveController
+
 	shutdownC  chan struct{}
 	goroutines sync.WaitGroup
 
+	// shutdownCtx is the context passed to Shutdown, set before
+	// shutdownC is closed. The pending batches are flushed
+	// within its deadline.
+	shutdownCtx context.Context
+
 	telemetry *batchProcessorTelemetry
 
 	//  batcher will be either *singletonBatcher or *multiBatcher
@@ -85,22 +111,49 @@ type shard struct {
 	// timer informs the shard send a batch.
 	timer *time.Timer
 
+	// controller adjusts the batch size and timeout of the shard,
+	// nil when adaptive sizing is disabled.
+	controller *adaptiveController
+
 	// newItem is used to receive data items from producers.
 	newItem chan any
 
 	// batch is an in-flight data item containing one of the
 	// underlying data types.
 	batch batch
+
+	// tenantAttrs are the attributes of the per-tenant metrics,
+	// nil when metadata keys are not in use.
+	tenantAttrs metric.MeasurementOption
+
+	// pending is the number of items accepted and not sent yet.
+	pending atomic.Int64
+
+	// lastUsed is the time data was last received, in Unix
+	// nanoseconds, used to select the shard to evict.
+	lastUsed atomic.Int64
+
+	// stopC is closed when the shard is evicted.
+	stopC chan struct{}
+
+	// mu guards stopped, producers hold the read lock while
+	// sending to newItem so that eviction waits for them.
+	mu      sync.RWMutex
+	stopped bool
 }
 
 // batch is an interface generalizing the individual signal types.
 type batch interface {
 	// export the current batch
-	export(ctx context.Context, sendBatchMaxSize int, returnBytes bool) (sentBatchSize int, sentBatchBytes int, err error)
+	export(ctx context.Context, sendBatchMaxSize int, sendBatchMaxSizeBytes int, returnBytes bool) (sentBatchSize int, sentBatchBytes int, err error)
 
 	// itemCount returns the size of the current batch
 	itemCount() int
 
+	// byteCount returns the estimated size in bytes of the current batch,
+	// only tracked when byte limits are configured.
+	byteCount() int
+
 	// add item to the current batch
 	add(item any)
 }
@@ -120,23 +173,30 @@ func newBatchProcessor(set processor.CreateSettings, cfg *Config, batchFunc func
 	bp := &batchProcessor{
 		logger: set.Logger,
 
-		sendBatchSize:    int(cfg.SendBatchSize),
-		sendBatchMaxSize: int(cfg.SendBatchMaxSize),
-		timeout:          cfg.Timeout,
-		batchFunc:        batchFunc,
-		shutdownC:        make(chan struct{}, 1),
-		metadataKeys:     mks,
-		metadataLimit:    int(cfg.MetadataCardinalityLimit),
+		sendBatchSize:         int(cfg.SendBatchSize),
+		sendBatchMaxSize:      int(cfg.SendBatchMaxSize),
+		sendBatchSizeBytes:    int(cfg.SendBatchSizeBytes),
+		sendBatchMaxSizeBytes: int(cfg.SendBatchMaxSizeBytes),
+		timeout:               cfg.Timeout,
+		batchFunc:             batchFunc,
+		shutdownC:             make(chan struct{}, 1),
+		metadataKeys:          mks,
+		metadataLimit:         int(cfg.MetadataCardinalityLimit),
+		metadataEviction:      cfg.MetadataCardinalityEviction,
+		shardMaxItems:         int(cfg.MetadataShardMaxItems),
+	}
+	if cfg.AdaptiveSizing.Enabled {
+		bp.newController = func() *adaptiveController { return newAdaptiveController(cfg) }
 	}
 	if len(bp.metadataKeys) == 0 {
-		bp.batcher = &singleShardBatcher{batcher: bp.newShard(nil)}
+		bp.batcher = &singleShardBatcher{batcher: bp.newShard(nil, nil)}
 	} else {
 		bp.batcher = &multiShardBatcher{
 			batchProcessor: bp,
 		}
 	}
 
-	bpt, err := newBatchProcessorTelemetry(set, bp.batcher.currentMetadataCardinality)
+	bpt, err := newBatchProcessorTelemetry(set, bp.batcher.currentMetadataCardinality, int(cfg.MetadataMetricsCardinalityLimit))
 	if err != nil {
 		return nil, fmt.Errorf("error creating batch processor telemetry: %w", err)
 	}
@@ -145,8 +205,10 @@ func newBatchProcessor(set processor.CreateSettings, cfg *Config, batchFunc func
 	return bp, nil
 }
 
-// newShard gets or creates a batcher corresponding with attrs.
-func (bp *batchProcessor) newShard(md map[string][]string) *shard {
+// newShard creates a batcher corresponding with md. The attrs are
+// the metadata attributes of the shard, nil for the singleton shard
+// which is created before the telemetry.
+func (bp *batchProcessor) newShard(md map[string][]string, attrs *attribute.Set) *shard {
 	exportCtx := client.NewContext(context.Background(), client.Info{
 		Metadata: client.NewMetadata(md),
 	})
@@ -155,7 +217,15 @@ func (bp *batchProcessor) newShard(md map[string][]string) *shard {
 		newItem:   make(chan any, runtime.NumCPU()),
 		exportCtx: exportCtx,
 		batch:     bp.batchFunc(),
+		stopC:     make(chan struct{}),
 	}
+	if bp.newController != nil {
+		b.controller = bp.newController()
+	}
+	if attrs != nil {
+		b.tenantAttrs = bp.telemetry.tenantAttributes(*attrs)
+	}
+	b.lastUsed.Store(time.Now().UnixNano())
 	b.processor.goroutines.Add(1)
 	go b.start()
 	return b
@@ -170,13 +240,24 @@ func (bp *batchProcessor) Start(context.Context, component.Host) error {
 	return nil
 }
 
-// Shutdown is invoked during service shutdown.
-func (bp *batchProcessor) Shutdown(context.Context) error {
+// Shutdown is invoked during service shutdown. It flushes the pending
+// batches, the data not sent before ctx is done is dropped.
+func (bp *batchProcessor) Shutdown(ctx context.Context) error {
+	bp.shutdownCtx = ctx
 	close(bp.shutdownC)
 
 	// Wait until all goroutines are done.
-	bp.goroutines.Wait()
-	return nil
+	doneC := make(chan struct{})
+	go func() {
+		bp.goroutines.Wait()
+		close(doneC)
+	}()
+	select {
+	case <-doneC:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to flush the pending batches before shutdown: %w", ctx.Err())
+	}
 }
 
 func (b *shard) start() {
@@ -185,28 +266,17 @@ func (b *shard) start() {
 	// timerCh ensures we only block when there is a
 	// timer, since <- from a nil channel is blocking.
 	var timerCh <-chan time.Time
-	if b.processor.timeout != 0 && b.processor.sendBatchSize != 0 {
-		b.timer = time.NewTimer(b.processor.timeout)
+	if b.processor.timeout != 0 && (b.processor.sendBatchSize != 0 || b.processor.sendBatchSizeBytes != 0) {
+		b.timer = time.NewTimer(b.timeout())
 		timerCh = b.timer.C
 	}
 	for {
 		select {
 		case <-b.processor.shutdownC:
-		DONE:
-			for {
-				select {
-				case item := <-b.newItem:
-					b.processItem(item)
-				default:
-					break DONE
-				}
-			}
-			// This is the close of the channel
-			if b.batch.itemCount() > 0 {
-				// TODO: Set a timeout on sendTraces or
-				// make it cancellable using the context that Shutdown gets as a parameter
-				b.sendItems(triggerTimeout)
-			}
+			b.drain(shutdownContext{Context: b.processor.shutdownCtx, values: b.exportCtx})
+			return
+		case <-b.stopC:
+			b.drain(b.exportCtx)
 			return
 		case item := <-b.newItem:
 			if item == nil {
@@ -215,19 +285,45 @@ func (b *shard) start() {
 			b.processItem(item)
 		case <-timerCh:
 			if b.batch.itemCount() > 0 {
-				b.sendItems(triggerTimeout)
+				b.sendItems(b.exportCtx, triggerTimeout)
 			}
 			b.resetTimer()
 		}
 	}
 }
 
+// drain sends all the items received before the shard was shut down or
+// evicted, the items not sent before ctx is done are dropped.
+func (b *shard) drain(ctx context.Context) {
+DONE:
+	for {
+		select {
+		case item := <-b.newItem:
+			b.processItem(item)
+		default:
+			break DONE
+		}
+	}
+	// This is the close of the channel, the batch may need to be
+	// split in several requests to honor the maximum batch size.
+	for b.batch.itemCount() > 0 {
+		if ctx.Err() != nil {
+			dropped := b.batch.itemCount()
+			b.processor.logger.Warn("Shutdown deadline exceeded before sending the pending batch, dropping data",
+				zap.Int("dropped_items", dropped))
+			b.processor.telemetry.recordDropped(int64(dropped))
+			return
+		}
+		b.sendItems(ctx, triggerTimeout)
+	}
+}
+
 func (b *shard) processItem(item any) {
 	b.batch.add(item)
 	sent := false
-	for b.batch.itemCount() > 0 && (!b.hasTimer() || b.batch.itemCount() >= b.processor.sendBatchSize) {
+	for b.batch.itemCount() > 0 && (!b.hasTimer() || b.isFull()) {
 		sent = true
-		b.sendItems(triggerBatchSize)
+		b.sendItems(b.exportCtx, triggerBatchSize)
 	}
 
 	if sent {
@@ -236,6 +332,12 @@ func (b *shard) processItem(item any) {
 	}
 }
 
+// isFull returns whether the batch reached one of the size triggers.
+func (b *shard) isFull() bool {
+	return (b.sendBatchSize() > 0 && b.batch.itemCount() >= b.sendBatchSize()) ||
+		(b.processor.sendBatchSizeBytes > 0 && b.batch.byteCount() >= b.processor.sendBatchSizeBytes)
+}
+
 func (b *shard) hasTimer() bool {
 	return b.timer != nil
 }
@@ -248,19 +350,84 @@ func (b *shard) stopTimer() {
 
 func (b *shard) resetTimer() {
 	if b.hasTimer() {
-		b.timer.Reset(b.processor.timeout)
+		b.timer.Reset(b.timeout())
 	}
 }
 
-func (b *shard) sendItems(trigger trigger) {
-	sent, bytes, err := b.batch.export(b.exportCtx, b.processor.sendBatchMaxSize, b.processor.telemetry.detailed)
+// sendBatchSize returns the current size trigger, adjusted when adaptive sizing is enabled.
+func (b *shard) sendBatchSize() int {
+	if b.controller != nil {
+		return b.controller.sendBatchSize
+	}
+	return b.processor.sendBatchSize
+}
+
+// timeout returns the current timeout, adjusted when adaptive sizing is enabled.
+func (b *shard) timeout() time.Duration {
+	if b.controller != nil {
+		return b.controller.timeout
+	}
+	return b.processor.timeout
+}
+
+func (b *shard) sendItems(ctx context.Context, trigger trigger) {
+	start := time.Now()
+	sent, bytes, err := b.batch.export(ctx, b.processor.sendBatchMaxSize, b.processor.sendBatchMaxSizeBytes, b.processor.telemetry.detailed)
+	if b.controller != nil {
+		b.controller.observe(time.Since(start), err)
+	}
+	b.pending.Add(-int64(sent))
 	if err != nil {
 		b.processor.logger.Warn("Sender failed", zap.Error(err))
+		b.processor.telemetry.recordDropped(int64(sent))
 	} else {
 		b.processor.telemetry.record(trigger, int64(sent), int64(bytes))
+		b.processor.telemetry.recordTenantSent(b.tenantAttrs, int64(sent))
 	}
 }
 
+// send hands the data over to the shard goroutine. It returns false
+// if the shard was evicted, in which case the data was not accepted.
+func (b *shard) send(data any) (bool, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.stopped {
+		return false, nil
+	}
+	items := int64(countItems(data))
+	pending := b.pending.Add(items)
+	// A single request larger than the limit is accepted by an
+	// empty shard, otherwise it could never be sent.
+	if b.processor.shardMaxItems > 0 && pending > int64(b.processor.shardMaxItems) && pending != items {
+		b.pending.Add(-items)
+		b.processor.telemetry.recordTenantRefused(b.tenantAttrs, items)
+		return true, errShardFull
+	}
+	b.lastUsed.Store(time.Now().UnixNano())
+	b.newItem <- data
+	return true, nil
+}
+
+// stop evicts the shard, its goroutine sends the pending items and exits.
+func (b *shard) stop() {
+	b.mu.Lock()
+	b.stopped = true
+	b.mu.Unlock()
+	close(b.stopC)
+}
+
+// shutdownContext is the context used to flush the batches on shutdown,
+// it is done with the context passed to Shutdown and carries the values,
+// like the client metadata, of the shard context.
+type shutdownContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c shutdownContext) Value(key any) any {
+	return c.values.Value(key)
+}
+
 // singleShardBatcher is used when metadataKeys is empty, to avoid the
 // additional lock and map operations used in multiBatcher.
 type singleShardBatcher struct {
@@ -268,8 +435,8 @@ type singleShardBatcher struct {
 }
 
 func (sb *singleShardBatcher) consume(_ context.Context, data any) error {
-	sb.batcher.newItem <- data
-	return nil
+	_, err := sb.batcher.send(data)
+	return err
 }
 
 func (sb *singleShardBatcher) currentMetadataCardinality() int {
@@ -307,25 +474,62 @@ func (mb *multiShardBatcher) consume(ctx context.Context, data any) error {
 	}
 	aset := attribute.NewSet(attrs...)
 
-	b, ok := mb.batchers.Load(aset)
-	if !ok {
-		mb.lock.Lock()
-		if mb.metadataLimit != 0 && mb.size >= mb.metadataLimit {
+	for {
+		var evicted *shard
+		b, ok := mb.batchers.Load(aset)
+		if !ok {
+			mb.lock.Lock()
+			if b, ok = mb.batchers.Load(aset); !ok {
+				if mb.metadataLimit != 0 && mb.size >= mb.metadataLimit {
+					if !mb.metadataEviction {
+						mb.lock.Unlock()
+						return errTooManyBatchers
+					}
+					evicted = mb.removeLeastRecentlyUsed()
+				}
+
+				// aset.ToSlice() returns the sorted, deduplicated,
+				// and name-downcased list of attributes.
+				b = mb.newShard(md, &aset)
+				mb.batchers.Store(aset, b)
+				mb.size++
+			}
 			mb.lock.Unlock()
-			return errTooManyBatchers
 		}
+		if evicted != nil {
+			// The evicted shard is stopped without the lock, since it
+			// waits for the data being sent to the shard, so that the
+			// other combinations are not blocked meanwhile.
+			evicted.stop()
+			mb.telemetry.recordTenantEviction(evicted.tenantAttrs)
+		}
+		// The shard may have been evicted after the lookup,
+		// in which case a new one is created.
+		if accepted, err := b.(*shard).send(data); accepted {
+			return err
+		}
+	}
+}
 
-		// aset.ToSlice() returns the sorted, deduplicated,
-		// and name-downcased list of attributes.
-		var loaded bool
-		b, loaded = mb.batchers.LoadOrStore(aset, mb.newShard(md))
-		if !loaded {
-			mb.size++
+// removeLeastRecentlyUsed removes the shard that received data least
+// recently, and returns it so that it is stopped, which sends its
+// pending items, once mb.lock is released. It must be called with
+// mb.lock held.
+func (mb *multiShardBatcher) removeLeastRecentlyUsed() *shard {
+	var lruKey any
+	var lru *shard
+	mb.batchers.Range(func(k, v any) bool {
+		if s := v.(*shard); lru == nil || s.lastUsed.Load() < lru.lastUsed.Load() {
+			lruKey, lru = k, s
 		}
-		mb.lock.Unlock()
+		return true
+	})
+	if lru == nil {
+		return nil
 	}
-	b.(*shard).newItem <- data
-	return nil
+	mb.batchers.Delete(lruKey)
+	mb.size--
+	return lru
 }
 
 func (mb *multiShardBatcher) currentMetadataCardinality() int {
@@ -334,6 +538,19 @@ func (mb *multiShardBatcher) currentMetadataCardinality() int {
 	return mb.size
 }
 
+// countItems returns the number of spans, data points, or log records in data.
+func countItems(data any) int {
+	switch d := data.(type) {
+	case ptrace.Traces:
+		return d.SpanCount()
+	case pmetric.Metrics:
+		return d.DataPointCount()
+	case plog.Logs:
+		return d.LogRecordCount()
+	}
+	return 0
+}
+
 // ConsumeTraces implements TracesProcessor
 func (bp *batchProcessor) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
 	return bp.batcher.consume(ctx, td)
@@ -351,17 +568,17 @@ func (bp *batchProcessor) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 
 // newBatchTracesProcessor creates a new batch processor that batches traces by size or with timeout
 func newBatchTracesProcessor(set processor.CreateSettings, next consumer.Traces, cfg *Config) (*batchProcessor, error) {
-	return newBatchProcessor(set, cfg, func() batch { return newBatchTraces(next) })
+	return newBatchProcessor(set, cfg, func() batch { return newBatchTraces(next, cfg.tracksBytes()) })
 }
 
 // newBatchMetricsProcessor creates a new batch processor that batches metrics by size or with timeout
 func newBatchMetricsProcessor(set processor.CreateSettings, next consumer.Metrics, cfg *Config) (*batchProcessor, error) {
-	return newBatchProcessor(set, cfg, func() batch { return newBatchMetrics(next) })
+	return newBatchProcessor(set, cfg, func() batch { return newBatchMetrics(next, cfg.tracksBytes()) })
 }
 
 // newBatchLogsProcessor creates a new batch processor that batches logs by size or with timeout
 func newBatchLogsProcessor(set processor.CreateSettings, next consumer.Logs, cfg *Config) (*batchProcessor, error) {
-	return newBatchProcessor(set, cfg, func() batch { return newBatchLogs(next) })
+	return newBatchProcessor(set, cfg, func() batch { return newBatchLogs(next, cfg.tracksBytes()) })
 }
 
 type batchTraces struct {
@@ -369,10 +586,12 @@ type batchTraces struct {
 	traceData    ptrace.Traces
 	spanCount    int
 	sizer        ptrace.Sizer
+	trackBytes   bool
+	bytesCount   int
 }
 
-func newBatchTraces(nextConsumer consumer.Traces) *batchTraces {
-	return &batchTraces{nextConsumer: nextConsumer, traceData: ptrace.NewTraces(), sizer: &ptrace.ProtoMarshaler{}}
+func newBatchTraces(nextConsumer consumer.Traces, trackBytes bool) *batchTraces {
+	return &batchTraces{nextConsumer: nextConsumer, traceData: ptrace.NewTraces(), sizer: &ptrace.ProtoMarshaler{}, trackBytes: trackBytes}
 }
 
 // add updates current batchTraces by adding new TraceData object
@@ -384,68 +603,150 @@ func (bt *batchTraces) add(item any) {
 	}
 
 	bt.spanCount += newSpanCount
+	if bt.trackBytes {
+		bt.bytesCount += bt.sizer.TracesSize(td)
+	}
 	td.ResourceSpans().MoveAndAppendTo(bt.traceData.ResourceSpans())
 }
 
-func (bt *batchTraces) export(ctx context.Context, sendBatchMaxSize int, returnBytes bool) (int, int, error) {
+func (bt *batchTraces) export(ctx context.Context, sendBatchMaxSize int, sendBatchMaxSizeBytes int, returnBytes bool) (int, int, error) {
 	var req ptrace.Traces
 	var sent int
 	var bytes int
-	if sendBatchMaxSize > 0 && bt.itemCount() > sendBatchMaxSize {
-		req = splitTraces(sendBatchMaxSize, bt.traceData)
-		bt.spanCount -= sendBatchMaxSize
-		sent = sendBatchMaxSize
+	if maxItems := maxItemsToSend(bt.spanCount, bt.bytesCount, sendBatchMaxSize, sendBatchMaxSizeBytes); maxItems > 0 && bt.itemCount() > maxItems {
+		req = splitTraces(maxItems, bt.traceData)
+		sent = maxItems
 	} else {
 		req = bt.traceData
 		sent = bt.spanCount
 		bt.traceData = ptrace.NewTraces()
-		bt.spanCount = 0
 	}
-	if returnBytes {
+	if sendBatchMaxSizeBytes > 0 {
+		req = bt.shrinkToBytes(req, sendBatchMaxSizeBytes)
+		sent = req.SpanCount()
+	}
+	bt.spanCount -= sent
+	if returnBytes || bt.trackBytes {
 		bytes = bt.sizer.TracesSize(req)
 	}
+	if bt.trackBytes {
+		bt.bytesCount -= bytes
+		if bt.spanCount == 0 || bt.bytesCount < 0 {
+			bt.bytesCount = 0
+		}
+	}
 	return sent, bytes, bt.nextConsumer.ConsumeTraces(ctx, req)
 }
 
+// shrinkToBytes returns the first spans of req fitting in maxBytes, and moves the other spans back to the front
+// of the batch. The size of each resource is computed once, only a resource which does not fit alone is halved.
+func (bt *batchTraces) shrinkToBytes(req ptrace.Traces, maxBytes int) ptrace.Traces {
+	rss := req.ResourceSpans()
+	count, whole := itemsInBytes(rss.Len(), func(i int) (int, int) {
+		return resourceSpansSize(bt.sizer, rss.At(i)), resourceSC(rss.At(i))
+	}, maxBytes)
+	if whole == rss.Len() || count >= req.SpanCount() {
+		return req
+	}
+	rest := req
+	req = splitTraces(count, rest)
+	// The spans of a resource which does not fit alone are estimated from its average span size.
+	for count = req.SpanCount(); whole == 0 && count > 1 && bt.sizer.TracesSize(req) > maxBytes; count = req.SpanCount() {
+		tail := req
+		req = splitTraces(count/2, tail)
+		rest.ResourceSpans().MoveAndAppendTo(tail.ResourceSpans())
+		rest = tail
+	}
+	// The spans which do not fit are sent before the spans added to the batch since.
+	bt.traceData.ResourceSpans().MoveAndAppendTo(rest.ResourceSpans())
+	bt.traceData = rest
+	return req
+}
+
 func (bt *batchTraces) itemCount() int {
 	return bt.spanCount
 }
 
+func (bt *batchTraces) byteCount() int {
+	return bt.bytesCount
+}
+
 type batchMetrics struct {
 	nextConsumer   consumer.Metrics
 	metricData     pmetric.Metrics
 	dataPointCount int
 	sizer          pmetric.Sizer
+	trackBytes     bool
+	bytesCount     int
 }
 
-func newBatchMetrics(nextConsumer consumer.Metrics) *batchMetrics {
-	return &batchMetrics{nextConsumer: nextConsumer, metricData: pmetric.NewMetrics(), sizer: &pmetric.ProtoMarshaler{}}
+func newBatchMetrics(nextConsumer consumer.Metrics, trackBytes bool) *batchMetrics {
+	return &batchMetrics{nextConsumer: nextConsumer, metricData: pmetric.NewMetrics(), sizer: &pmetric.ProtoMarshaler{}, trackBytes: trackBytes}
 }
 
-func (bm *batchMetrics) export(ctx context.Context, sendBatchMaxSize int, returnBytes bool) (int, int, error) {
+func (bm *batchMetrics) export(ctx context.Context, sendBatchMaxSize int, sendBatchMaxSizeBytes int, returnBytes bool) (int, int, error) {
 	var req pmetric.Metrics
 	var sent int
 	var bytes int
-	if sendBatchMaxSize > 0 && bm.dataPointCount > sendBatchMaxSize {
-		req = splitMetrics(sendBatchMaxSize, bm.metricData)
-		bm.dataPointCount -= sendBatchMaxSize
-		sent = sendBatchMaxSize
+	if maxItems := maxItemsToSend(bm.dataPointCount, bm.bytesCount, sendBatchMaxSize, sendBatchMaxSizeBytes); maxItems > 0 && bm.dataPointCount > maxItems {
+		req = splitMetrics(maxItems, bm.metricData)
+		sent = maxItems
 	} else {
 		req = bm.metricData
 		sent = bm.dataPointCount
 		bm.metricData = pmetric.NewMetrics()
-		bm.dataPointCount = 0
 	}
-	if returnBytes {
+	if sendBatchMaxSizeBytes > 0 {
+		req = bm.shrinkToBytes(req, sendBatchMaxSizeBytes)
+		sent = req.DataPointCount()
+	}
+	bm.dataPointCount -= sent
+	if returnBytes || bm.trackBytes {
 		bytes = bm.sizer.MetricsSize(req)
 	}
+	if bm.trackBytes {
+		bm.bytesCount -= bytes
+		if bm.dataPointCount == 0 || bm.bytesCount < 0 {
+			bm.bytesCount = 0
+		}
+	}
 	return sent, bytes, bm.nextConsumer.ConsumeMetrics(ctx, req)
 }
 
+// shrinkToBytes returns the first data points of req fitting in maxBytes, and moves the other data points back to
+// the front of the batch. The size of each resource is computed once, only a resource which does not fit alone is
+// halved.
+func (bm *batchMetrics) shrinkToBytes(req pmetric.Metrics, maxBytes int) pmetric.Metrics {
+	rms := req.ResourceMetrics()
+	count, whole := itemsInBytes(rms.Len(), func(i int) (int, int) {
+		return resourceMetricsSize(bm.sizer, rms.At(i)), resourceMetricsDPC(rms.At(i))
+	}, maxBytes)
+	if whole == rms.Len() || count >= req.DataPointCount() {
+		return req
+	}
+	rest := req
+	req = splitMetrics(count, rest)
+	// The data points of a resource which does not fit alone are estimated from its average data point size.
+	for count = req.DataPointCount(); whole == 0 && count > 1 && bm.sizer.MetricsSize(req) > maxBytes; count = req.DataPointCount() {
+		tail := req
+		req = splitMetrics(count/2, tail)
+		rest.ResourceMetrics().MoveAndAppendTo(tail.ResourceMetrics())
+		rest = tail
+	}
+	// The data points which do not fit are sent before the data points added to the batch since.
+	bm.metricData.ResourceMetrics().MoveAndAppendTo(rest.ResourceMetrics())
+	bm.metricData = rest
+	return req
+}
+
 func (bm *batchMetrics) itemCount() int {
 	return bm.dataPointCount
 }
 
+func (bm *batchMetrics) byteCount() int {
+	return bm.bytesCount
+}
+
 func (bm *batchMetrics) add(item any) {
 	md := item.(pmetric.Metrics)
 
@@ -454,6 +755,9 @@ func (bm *batchMetrics) add(item any) {
 		return
 	}
 	bm.dataPointCount += newDataPointCount
+	if bm.trackBytes {
+		bm.bytesCount += bm.sizer.MetricsSize(md)
+	}
 	md.ResourceMetrics().MoveAndAppendTo(bm.metricData.ResourceMetrics())
 }
 
@@ -462,37 +766,78 @@ type batchLogs struct {
 	logData      plog.Logs
 	logCount     int
 	sizer        plog.Sizer
+	trackBytes   bool
+	bytesCount   int
 }
 
-func newBatchLogs(nextConsumer consumer.Logs) *batchLogs {
-	return &batchLogs{nextConsumer: nextConsumer, logData: plog.NewLogs(), sizer: &plog.ProtoMarshaler{}}
+func newBatchLogs(nextConsumer consumer.Logs, trackBytes bool) *batchLogs {
+	return &batchLogs{nextConsumer: nextConsumer, logData: plog.NewLogs(), sizer: &plog.ProtoMarshaler{}, trackBytes: trackBytes}
 }
 
-func (bl *batchLogs) export(ctx context.Context, sendBatchMaxSize int, returnBytes bool) (int, int, error) {
+func (bl *batchLogs) export(ctx context.Context, sendBatchMaxSize int, sendBatchMaxSizeBytes int, returnBytes bool) (int, int, error) {
 	var req plog.Logs
 	var sent int
 	var bytes int
 
-	if sendBatchMaxSize > 0 && bl.logCount > sendBatchMaxSize {
-		req = splitLogs(sendBatchMaxSize, bl.logData)
-		bl.logCount -= sendBatchMaxSize
-		sent = sendBatchMaxSize
+	if maxItems := maxItemsToSend(bl.logCount, bl.bytesCount, sendBatchMaxSize, sendBatchMaxSizeBytes); maxItems > 0 && bl.logCount > maxItems {
+		req = splitLogs(maxItems, bl.logData)
+		sent = maxItems
 	} else {
 		req = bl.logData
 		sent = bl.logCount
 		bl.logData = plog.NewLogs()
-		bl.logCount = 0
 	}
-	if returnBytes {
+	if sendBatchMaxSizeBytes > 0 {
+		req = bl.shrinkToBytes(req, sendBatchMaxSizeBytes)
+		sent = req.LogRecordCount()
+	}
+	bl.logCount -= sent
+	if returnBytes || bl.trackBytes {
 		bytes = bl.sizer.LogsSize(req)
 	}
+	if bl.trackBytes {
+		bl.bytesCount -= bytes
+		if bl.logCount == 0 || bl.bytesCount < 0 {
+			bl.bytesCount = 0
+		}
+	}
 	return sent, bytes, bl.nextConsumer.ConsumeLogs(ctx, req)
 }
 
+// shrinkToBytes returns the first log records of req fitting in maxBytes, and moves the other log records back to
+// the front of the batch. The size of each resource is computed once, only a resource which does not fit alone is
+// halved.
+func (bl *batchLogs) shrinkToBytes(req plog.Logs, maxBytes int) plog.Logs {
+	rls := req.ResourceLogs()
+	count, whole := itemsInBytes(rls.Len(), func(i int) (int, int) {
+		return resourceLogsSize(bl.sizer, rls.At(i)), resourceLRC(rls.At(i))
+	}, maxBytes)
+	if whole == rls.Len() || count >= req.LogRecordCount() {
+		return req
+	}
+	rest := req
+	req = splitLogs(count, rest)
+	// The log records of a resource which does not fit alone are estimated from its average log record size.
+	for count = req.LogRecordCount(); whole == 0 && count > 1 && bl.sizer.LogsSize(req) > maxBytes; count = req.LogRecordCount() {
+		tail := req
+		req = splitLogs(count/2, tail)
+		rest.ResourceLogs().MoveAndAppendTo(tail.ResourceLogs())
+		rest = tail
+	}
+	// The log records which do not fit are sent before the log records added to the batch since.
+	bl.logData.ResourceLogs().MoveAndAppendTo(rest.ResourceLogs())
+	bl.logData = rest
+	return req
+}
+
 func (bl *batchLogs) itemCount() int {
 	return bl.logCount
 }
 
+func (bl *batchLogs) byteCount() int {
+	return bl.bytesCount
+}
+
 func (bl *batchLogs) add(item any) {
 	ld := item.(plog.Logs)
 
@@ -501,5 +846,47 @@ func (bl *batchLogs) add(item any) {
 		return
 	}
 	bl.logCount += newLogsCount
+	if bl.trackBytes {
+		bl.bytesCount += bl.sizer.LogsSize(ld)
+	}
 	ld.ResourceLogs().MoveAndAppendTo(bl.logData.ResourceLogs())
 }
+
+// itemsInBytes returns the number of items of the first resources whose sizes add up to at most maxBytes, and the
+// number of these whole resources, given the size and the number of items of each of the n resources. If the
+// first resource does not fit alone, its number of items is scaled down to maxBytes, with at least one item.
+func itemsInBytes(n int, resource func(i int) (size int, items int), maxBytes int) (int, int) {
+	var items, bytes int
+	for i := 0; i < n; i++ {
+		size, count := resource(i)
+		if bytes+size > maxBytes {
+			if i > 0 {
+				return items, i
+			}
+			items = int(int64(count) * int64(maxBytes) / int64(size))
+			if items < 1 {
+				items = 1
+			}
+			return items, 0
+		}
+		items += count
+		bytes += size
+	}
+	return items, n
+}
+
+// maxItemsToSend returns the maximum number of items to send in a single request, or 0 if there is no limit.
+// The byte limit is converted to a number of items using the average item size of the batch.
+func maxItemsToSend(items int, bytes int, sendBatchMaxSize int, sendBatchMaxSizeBytes int) int {
+	maxItems := sendBatchMaxSize
+	if sendBatchMaxSizeBytes > 0 && bytes > sendBatchMaxSizeBytes {
+		byteItems := int(int64(items) * int64(sendBatchMaxSizeBytes) / int64(bytes))
+		if byteItems < 1 {
+			byteItems = 1
+		}
+		if maxItems == 0 || byteItems < maxItems {
+			maxItems = byteItems
+		}
+	}
+	return maxItems
+}
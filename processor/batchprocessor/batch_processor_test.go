@@ -13,6 +13,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -24,6 +27,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processorhelper"
 	"go.opentelemetry.io/collector/processor/processortest"
 )
 
@@ -267,6 +271,123 @@ func testBatchProcessorSentBySizeWithMaxSize(t *testing.T, tel testTelemetry) {
 	})
 }
 
+func TestBatchProcessorSentBySizeBytes(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	sizer := &ptrace.ProtoMarshaler{}
+	spanSize := sizer.TracesSize(testdata.GenerateTraces(1))
+	cfg := createDefaultConfig().(*Config)
+	cfg.SendBatchSize = 0
+	cfg.SendBatchSizeBytes = uint32(20 * spanSize)
+	cfg.SendBatchMaxSizeBytes = uint32(30 * spanSize)
+	cfg.Timeout = 10 * time.Second
+	batcher, err := newBatchTracesProcessor(processortest.NewNopCreateSettings(), sink, cfg)
+	require.NoError(t, err)
+	require.NoError(t, batcher.Start(context.Background(), componenttest.NewNopHost()))
+
+	// Each request is bigger than the byte trigger, and is split to fit in the max size.
+	for requestNum := 0; requestNum < 5; requestNum++ {
+		assert.NoError(t, batcher.ConsumeTraces(context.Background(), testdata.GenerateTraces(100)))
+	}
+	require.Eventually(t, func() bool {
+		return sink.SpanCount() >= 400
+	}, time.Second, 5*time.Millisecond, "batches must be sent without waiting for the timeout")
+	require.NoError(t, batcher.Shutdown(context.Background()))
+
+	require.Equal(t, 500, sink.SpanCount())
+	for _, td := range sink.AllTraces() {
+		assert.LessOrEqual(t, sizer.TracesSize(td), int(cfg.SendBatchMaxSizeBytes))
+	}
+}
+
+func TestBatchTracesShrinkToBytes(t *testing.T) {
+	sizer := &ptrace.ProtoMarshaler{}
+	batchTraces := newBatchTraces(new(consumertest.TracesSink), false)
+	var names []string
+	req := ptrace.NewTraces()
+	for i := 0; i < 3; i++ {
+		rs := testdata.GenerateTraces(10).ResourceSpans().At(0)
+		spans := rs.ScopeSpans().At(0).Spans()
+		for j := 0; j < spans.Len(); j++ {
+			spans.At(j).SetName(fmt.Sprintf("span-%02d", len(names)))
+			names = append(names, spans.At(j).Name())
+		}
+		rs.MoveTo(req.ResourceSpans().AppendEmpty())
+	}
+	resourceSize := sizer.TracesSize(req) / 3
+	newer := testdata.GenerateTraces(1)
+	newer.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SetName("newer")
+	names = append(names, "newer")
+	batchTraces.add(newer)
+
+	// The byte limit fits two whole resources, the spans which do not fit are put back before the newer ones.
+	req = batchTraces.shrinkToBytes(req, 2*resourceSize+resourceSize/2)
+	assert.Equal(t, 20, req.SpanCount())
+	assert.Equal(t, 2*resourceSize, sizer.TracesSize(req))
+	assert.Equal(t, names, append(spanNames(req), spanNames(batchTraces.traceData)...))
+
+	// A resource which does not fit alone is split.
+	req = batchTraces.traceData
+	batchTraces.traceData = ptrace.NewTraces()
+	req = batchTraces.shrinkToBytes(req, resourceSize/2)
+	assert.Less(t, req.SpanCount(), 10)
+	assert.LessOrEqual(t, sizer.TracesSize(req), resourceSize/2)
+	assert.Equal(t, names[20:], append(spanNames(req), spanNames(batchTraces.traceData)...))
+}
+
+func spanNames(td ptrace.Traces) []string {
+	var names []string
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		sss := rss.At(i).ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			spans := sss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				names = append(names, spans.At(k).Name())
+			}
+		}
+	}
+	return names
+}
+
+func TestBatchLogsMaxSizeBytes(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	sizer := &plog.ProtoMarshaler{}
+	batchLogs := newBatchLogs(sink, true)
+	ld := testdata.GenerateLogs(100)
+	size := sizer.LogsSize(ld)
+
+	batchLogs.add(ld)
+	require.Equal(t, size, batchLogs.byteCount())
+	for batchLogs.itemCount() > 0 {
+		_, bytes, err := batchLogs.export(context.Background(), 0, size/4, false)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, bytes, size/4)
+	}
+	assert.Equal(t, 0, batchLogs.byteCount())
+	assert.Equal(t, 100, sink.LogRecordCount())
+	assert.GreaterOrEqual(t, len(sink.AllLogs()), 4)
+}
+
+func TestBatchMetricsMaxSizeBytes(t *testing.T) {
+	sink := new(metricsSink)
+	sizer := &pmetric.ProtoMarshaler{}
+	batchMetrics := newBatchMetrics(sink, true)
+	md := testdata.GenerateMetrics(50)
+	size := sizer.MetricsSize(md)
+
+	batchMetrics.add(md)
+	require.Equal(t, size, batchMetrics.byteCount())
+	// The item limit is lower than the byte limit.
+	sent, _, err := batchMetrics.export(context.Background(), 10, size, false)
+	require.NoError(t, err)
+	assert.Equal(t, 10, sent)
+	// The byte limit is lower than the item limit.
+	sent, bytes, err := batchMetrics.export(context.Background(), 50, size/4, false)
+	require.NoError(t, err)
+	assert.Less(t, sent, 50)
+	assert.LessOrEqual(t, bytes, size/4)
+}
+
 func TestBatchProcessorSentByTimeout(t *testing.T) {
 	sink := new(consumertest.TracesSink)
 	cfg := createDefaultConfig().(*Config)
@@ -459,12 +580,12 @@ func TestBatchMetrics_UnevenBatchMaxSize(t *testing.T) {
 	dataPointsPerMetric := 2
 	sendBatchMaxSize := 99
 
-	batchMetrics := newBatchMetrics(sink)
+	batchMetrics := newBatchMetrics(sink, false)
 	md := testdata.GenerateMetrics(metricsCount)
 
 	batchMetrics.add(md)
 	require.Equal(t, dataPointsPerMetric*metricsCount, batchMetrics.dataPointCount)
-	sent, _, sendErr := batchMetrics.export(ctx, sendBatchMaxSize, false)
+	sent, _, sendErr := batchMetrics.export(ctx, sendBatchMaxSize, 0, false)
 	require.NoError(t, sendErr)
 	require.Equal(t, sendBatchMaxSize, sent)
 	remainingDataPointCount := metricsCount*dataPointsPerMetric - sendBatchMaxSize
@@ -1032,6 +1153,239 @@ func TestBatchProcessorMetadataCardinalityLimit(t *testing.T) {
 	require.NoError(t, batcher.Shutdown(context.Background()))
 }
 
+func TestBatchProcessorShutdownFlushesAllBatches(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.SendBatchSize = 1000
+	cfg.SendBatchMaxSize = 10
+	cfg.Timeout = 10 * time.Minute
+	batcher, err := newBatchTracesProcessor(processortest.NewNopCreateSettings(), sink, cfg)
+	require.NoError(t, err)
+	require.NoError(t, batcher.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, batcher.ConsumeTraces(context.Background(), testdata.GenerateTraces(35)))
+	require.NoError(t, batcher.Shutdown(context.Background()))
+
+	assert.Equal(t, 35, sink.SpanCount())
+	assert.Len(t, sink.AllTraces(), 4)
+}
+
+func TestBatchProcessorShutdownDeadline(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SendBatchSize = 1000
+	cfg.SendBatchMaxSize = 10
+	cfg.Timeout = 10 * time.Minute
+	reader := sdkmetric.NewManualReader()
+	creationSet := processortest.NewNopCreateSettings()
+	creationSet.MetricsLevel = configtelemetry.LevelNormal
+	creationSet.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	// The next consumer blocks until the shutdown deadline.
+	next, err := consumer.NewTraces(func(ctx context.Context, _ ptrace.Traces) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+	batcher, err := newBatchTracesProcessor(creationSet, next, cfg)
+	require.NoError(t, err)
+	require.NoError(t, batcher.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, batcher.ConsumeTraces(context.Background(), testdata.GenerateTraces(35)))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, batcher.Shutdown(ctx), context.DeadlineExceeded)
+
+	// The first request fails, the rest of the batch is never sent.
+	require.Eventually(t, func() bool {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(context.Background(), &rm))
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				if m.Name == processorhelper.BuildCustomMetricName(typeStr, "dropped_items") {
+					return m.Data.(metricdata.Sum[int64]).DataPoints[0].Value == 35
+				}
+			}
+		}
+		return false
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestBatchProcessorMetadataCardinalityEviction(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Timeout = 10 * time.Minute
+	cfg.MetadataKeys = []string{"token"}
+	cfg.MetadataCardinalityLimit = 2
+	cfg.MetadataCardinalityEviction = true
+	reader := sdkmetric.NewManualReader()
+	creationSet := processortest.NewNopCreateSettings()
+	creationSet.MetricsLevel = configtelemetry.LevelDetailed
+	creationSet.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	batcher, err := newBatchTracesProcessor(creationSet, sink, cfg)
+	require.NoError(t, err)
+	require.NoError(t, batcher.Start(context.Background(), componenttest.NewNopHost()))
+
+	for _, token := range []string{"a", "b", "a", "c"} {
+		ctx := client.NewContext(context.Background(), client.Info{
+			Metadata: client.NewMetadata(map[string][]string{"token": {token}}),
+		})
+		require.NoError(t, batcher.ConsumeTraces(ctx, testdata.GenerateTraces(1)))
+	}
+
+	// "b" is the least recently used, it is flushed when evicted.
+	require.Eventually(t, func() bool { return sink.SpanCount() == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2, batcher.batcher.currentMetadataCardinality())
+
+	require.NoError(t, batcher.Shutdown(context.Background()))
+	assert.Equal(t, 4, sink.SpanCount())
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	evictions := tenantCounter(t, rm, "metadata_evictions")
+	assert.Equal(t, map[string]int64{"b": 1}, evictions)
+	sent := tenantCounter(t, rm, "metadata_sent_items")
+	assert.Equal(t, map[string]int64{"a": 2, "b": 1, "c": 1}, sent)
+}
+
+func TestBatchProcessorMetadataEvictionWithoutLock(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Timeout = 10 * time.Minute
+	cfg.MetadataKeys = []string{"token"}
+	cfg.MetadataCardinalityLimit = 1
+	cfg.MetadataCardinalityEviction = true
+	creationSet := processortest.NewNopCreateSettings()
+	batcher, err := newBatchTracesProcessor(creationSet, sink, cfg)
+	require.NoError(t, err)
+	require.NoError(t, batcher.Start(context.Background(), componenttest.NewNopHost()))
+
+	tokenContext := func(token string) context.Context {
+		return client.NewContext(context.Background(), client.Info{
+			Metadata: client.NewMetadata(map[string][]string{"token": {token}}),
+		})
+	}
+	require.NoError(t, batcher.ConsumeTraces(tokenContext("a"), testdata.GenerateTraces(1)))
+
+	// Hold the shard of "a" as a send in progress does, so that its eviction waits.
+	mb := batcher.batcher.(*multiShardBatcher)
+	a, ok := mb.batchers.Load(attribute.NewSet(attribute.String("token", "a")))
+	require.True(t, ok)
+	a.(*shard).mu.RLock()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, batcher.ConsumeTraces(tokenContext("b"), testdata.GenerateTraces(1)))
+	}()
+
+	// The other metadata value combinations are not blocked while "a" is being evicted.
+	assert.Eventually(t, func() bool { return mb.currentMetadataCardinality() == 1 }, time.Second, 10*time.Millisecond)
+	select {
+	case <-done:
+		t.Fatal("the eviction did not wait for the send in progress")
+	default:
+	}
+	a.(*shard).mu.RUnlock()
+	<-done
+
+	require.NoError(t, batcher.Shutdown(context.Background()))
+	assert.Equal(t, 2, sink.SpanCount())
+}
+
+func TestBatchProcessorMetadataMetricsCardinalityLimit(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Timeout = 10 * time.Minute
+	cfg.MetadataKeys = []string{"token"}
+	cfg.MetadataCardinalityLimit = 1
+	cfg.MetadataCardinalityEviction = true
+	cfg.MetadataMetricsCardinalityLimit = 2
+	reader := sdkmetric.NewManualReader()
+	creationSet := processortest.NewNopCreateSettings()
+	creationSet.MetricsLevel = configtelemetry.LevelDetailed
+	creationSet.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	batcher, err := newBatchTracesProcessor(creationSet, sink, cfg)
+	require.NoError(t, err)
+	require.NoError(t, batcher.Start(context.Background(), componenttest.NewNopHost()))
+
+	for _, token := range []string{"a", "b", "c", "a", "d"} {
+		ctx := client.NewContext(context.Background(), client.Info{
+			Metadata: client.NewMetadata(map[string][]string{"token": {token}}),
+		})
+		require.NoError(t, batcher.ConsumeTraces(ctx, testdata.GenerateTraces(1)))
+	}
+	require.NoError(t, batcher.Shutdown(context.Background()))
+	assert.Equal(t, 5, sink.SpanCount())
+
+	// The combinations seen after the first two share the overflow attributes.
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	assert.Equal(t, map[string]int64{"a": 2, "b": 1, overflowKey: 2}, tenantCounter(t, rm, "metadata_sent_items"))
+	assert.Equal(t, map[string]int64{"a": 2, "b": 1, overflowKey: 1}, tenantCounter(t, rm, "metadata_evictions"))
+}
+
+func TestBatchProcessorMetadataShardMaxItems(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Timeout = 10 * time.Minute
+	cfg.MetadataKeys = []string{"token"}
+	cfg.MetadataShardMaxItems = 15
+	reader := sdkmetric.NewManualReader()
+	creationSet := processortest.NewNopCreateSettings()
+	creationSet.MetricsLevel = configtelemetry.LevelDetailed
+	creationSet.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	batcher, err := newBatchTracesProcessor(creationSet, sink, cfg)
+	require.NoError(t, err)
+	require.NoError(t, batcher.Start(context.Background(), componenttest.NewNopHost()))
+
+	ctxA := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"token": {"a"}}),
+	})
+	ctxB := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"token": {"b"}}),
+	})
+
+	// A single request larger than the limit is accepted by an empty batcher.
+	require.NoError(t, batcher.ConsumeTraces(ctxA, testdata.GenerateTraces(20)))
+	err = batcher.ConsumeTraces(ctxA, testdata.GenerateTraces(1))
+	assert.ErrorIs(t, err, errShardFull)
+	assert.False(t, consumererror.IsPermanent(err))
+
+	// Other metadata value combinations are not affected.
+	require.NoError(t, batcher.ConsumeTraces(ctxB, testdata.GenerateTraces(10)))
+	assert.ErrorIs(t, batcher.ConsumeTraces(ctxB, testdata.GenerateTraces(10)), errShardFull)
+
+	require.NoError(t, batcher.Shutdown(context.Background()))
+	assert.Equal(t, 30, sink.SpanCount())
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	assert.Equal(t, map[string]int64{"a": 1, "b": 10}, tenantCounter(t, rm, "metadata_refused_items"))
+}
+
+// tenantCounter returns the values of a per-tenant counter by the value of the "token" attribute.
+func tenantCounter(t *testing.T, rm metricdata.ResourceMetrics, name string) map[string]int64 {
+	values := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != processorhelper.BuildCustomMetricName(typeStr, name) {
+				continue
+			}
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			require.True(t, ok)
+			for _, dp := range sum.DataPoints {
+				if overflow, ok := dp.Attributes.Value(overflowKey); ok && overflow.AsBool() {
+					values[overflowKey] += dp.Value
+					continue
+				}
+				token, ok := dp.Attributes.Value("token")
+				require.True(t, ok)
+				values[token.AsString()] += dp.Value
+			}
+		}
+	}
+	return values
+}
+
 func TestBatchZeroConfig(t *testing.T) {
 	// This is a no-op configuration. No need for a timer, no
 	// minimum, no maximum, just a pass through.
@@ -28,6 +28,17 @@ type Config struct {
 	// Default value is 0, that means no maximum size.
 	SendBatchMaxSize uint32 `mapstructure:"send_batch_max_size"`
 
+	// SendBatchSizeBytes is the estimated size in bytes of a batch which after hit, will trigger it to be sent.
+	// The size is estimated with the OTLP protobuf encoding of the data.
+	// Default value is 0, that means the batch size in bytes is ignored.
+	SendBatchSizeBytes uint32 `mapstructure:"send_batch_size_bytes"`
+
+	// SendBatchMaxSizeBytes is the maximum estimated size in bytes of a batch. It must be larger than
+	// SendBatchSizeBytes. Larger batches are split into smaller units, a single item larger than
+	// the limit is sent on its own.
+	// Default value is 0, that means no maximum size in bytes.
+	SendBatchMaxSizeBytes uint32 `mapstructure:"send_batch_max_size_bytes"`
+
 	// MetadataKeys is a list of client.Metadata keys that will be
 	// used to form distinct batchers.  If this setting is empty,
 	// a single batcher instance will be used.  When this setting
@@ -44,6 +55,55 @@ type Config struct {
 	// batcher instances that will be created through a distinct
 	// combination of MetadataKeys.
 	MetadataCardinalityLimit uint32 `mapstructure:"metadata_cardinality_limit"`
+
+	// MetadataCardinalityEviction controls what happens when
+	// MetadataCardinalityLimit is reached.  When false, data with
+	// a new combination of MetadataKeys is refused.  When true,
+	// the least recently used batcher is flushed and evicted to
+	// make room for the new combination.
+	MetadataCardinalityEviction bool `mapstructure:"metadata_cardinality_eviction"`
+
+	// MetadataShardMaxItems is the maximum number of items pending
+	// in a single batcher.  Data that would exceed the limit is
+	// refused with a retryable error, so that a single combination
+	// of MetadataKeys cannot hold an unbounded amount of memory.
+	// Default value is 0, that means no limit.
+	MetadataShardMaxItems uint32 `mapstructure:"metadata_shard_max_items"`
+
+	// MetadataMetricsCardinalityLimit is the maximum number of
+	// combinations of MetadataKeys recorded as distinct attributes
+	// of the per-tenant metrics.  The combinations seen once the
+	// limit is reached are recorded together, with the
+	// "otel.metric.overflow" attribute, so that the evicted
+	// combinations do not grow the metrics without limit.
+	// Default value is 0, that means no limit.
+	MetadataMetricsCardinalityLimit uint32 `mapstructure:"metadata_metrics_cardinality_limit"`
+
+	// AdaptiveSizing adjusts SendBatchSize and Timeout based on the
+	// latency and the errors of the next consumer. The adaptation only
+	// works with synchronous next consumers, e.g. exporters without a
+	// sending queue, whose latency is the one of the backend.
+	AdaptiveSizing AdaptiveSizingConfig `mapstructure:"adaptive_sizing"`
+}
+
+// AdaptiveSizingConfig defines the configuration of the adaptive batch sizing.
+type AdaptiveSizingConfig struct {
+	// Enabled turns on the adaptive batch sizing.
+	Enabled bool `mapstructure:"enabled"`
+
+	// TargetLatency is the export latency the batch size is adjusted to.
+	// The batch size grows while the exports take less than half of it,
+	// and shrinks when an export takes longer or fails.
+	TargetLatency time.Duration `mapstructure:"target_latency"`
+
+	// MinSendBatchSize is the lower bound of the adjusted SendBatchSize.
+	MinSendBatchSize uint32 `mapstructure:"min_send_batch_size"`
+
+	// MaxSendBatchSize is the upper bound of the adjusted SendBatchSize.
+	MaxSendBatchSize uint32 `mapstructure:"max_send_batch_size"`
+
+	// MinTimeout is the lower bound of the adjusted Timeout. If zero, a tenth of Timeout is used.
+	MinTimeout time.Duration `mapstructure:"min_timeout"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -53,6 +113,9 @@ func (cfg *Config) Validate() error {
 	if cfg.SendBatchMaxSize > 0 && cfg.SendBatchMaxSize < cfg.SendBatchSize {
 		return errors.New("send_batch_max_size must be greater or equal to send_batch_size")
 	}
+	if cfg.SendBatchMaxSizeBytes > 0 && cfg.SendBatchMaxSizeBytes < cfg.SendBatchSizeBytes {
+		return errors.New("send_batch_max_size_bytes must be greater or equal to send_batch_size_bytes")
+	}
 	uniq := map[string]bool{}
 	for _, k := range cfg.MetadataKeys {
 		l := strings.ToLower(k)
@@ -61,8 +124,40 @@ func (cfg *Config) Validate() error {
 		}
 		uniq[l] = true
 	}
+	if cfg.MetadataCardinalityEviction && cfg.MetadataCardinalityLimit == 0 {
+		return errors.New("metadata_cardinality_eviction requires a metadata_cardinality_limit")
+	}
+	if cfg.AdaptiveSizing.Enabled {
+		if err := cfg.AdaptiveSizing.validate(cfg.SendBatchSize, cfg.SendBatchMaxSize, cfg.Timeout); err != nil {
+			return err
+		}
+	}
 	if cfg.Timeout < 0 {
 		return errors.New("timeout must be greater or equal to 0")
 	}
 	return nil
 }
+
+// tracksBytes returns whether the batches must track their size in bytes.
+func (cfg *Config) tracksBytes() bool {
+	return cfg.SendBatchSizeBytes > 0 || cfg.SendBatchMaxSizeBytes > 0
+}
+
+func (cfg *AdaptiveSizingConfig) validate(sendBatchSize, sendBatchMaxSize uint32, timeout time.Duration) error {
+	if cfg.TargetLatency <= 0 {
+		return errors.New("adaptive_sizing::target_latency must be greater than 0")
+	}
+	if cfg.MinSendBatchSize == 0 {
+		return errors.New("adaptive_sizing::min_send_batch_size must be greater than 0")
+	}
+	if sendBatchSize < cfg.MinSendBatchSize || sendBatchSize > cfg.MaxSendBatchSize {
+		return errors.New("send_batch_size must be between adaptive_sizing::min_send_batch_size and adaptive_sizing::max_send_batch_size")
+	}
+	if sendBatchMaxSize > 0 && sendBatchMaxSize < cfg.MaxSendBatchSize {
+		return errors.New("send_batch_max_size must be greater or equal to adaptive_sizing::max_send_batch_size")
+	}
+	if cfg.MinTimeout < 0 || cfg.MinTimeout > timeout {
+		return errors.New("adaptive_sizing::min_timeout must be between 0 and timeout")
+	}
+	return nil
+}
@@ -31,10 +31,24 @@ func TestUnmarshalConfig(t *testing.T) {
 	assert.NoError(t, component.UnmarshalConfig(cm, cfg))
 	assert.Equal(t,
 		&Config{
-			SendBatchSize:            uint32(10000),
-			SendBatchMaxSize:         uint32(11000),
-			Timeout:                  time.Second * 10,
-			MetadataCardinalityLimit: 1000,
+			SendBatchSize:               uint32(10000),
+			SendBatchMaxSize:            uint32(11000),
+			SendBatchSizeBytes:          uint32(1000000),
+			SendBatchMaxSizeBytes:       uint32(4000000),
+			Timeout:                     time.Second * 10,
+			MetadataKeys:                []string{"tenant_id"},
+			MetadataCardinalityLimit:    100,
+			MetadataCardinalityEviction: true,
+			MetadataShardMaxItems:       50000,
+
+			MetadataMetricsCardinalityLimit: 200,
+			AdaptiveSizing: AdaptiveSizingConfig{
+				Enabled:          true,
+				TargetLatency:    2 * time.Second,
+				MinSendBatchSize: 1000,
+				MaxSendBatchSize: 11000,
+				MinTimeout:       2 * time.Second,
+			},
 		}, cfg)
 }
 
@@ -63,6 +77,72 @@ func TestValidateConfig_InvalidBatchSize(t *testing.T) {
 	assert.Error(t, cfg.Validate())
 }
 
+func TestValidateConfig_InvalidBatchSizeBytes(t *testing.T) {
+	cfg := &Config{
+		SendBatchSizeBytes:    1000,
+		SendBatchMaxSizeBytes: 100,
+	}
+	assert.EqualError(t, cfg.Validate(), "send_batch_max_size_bytes must be greater or equal to send_batch_size_bytes")
+}
+
+func TestValidateConfig_EvictionWithoutLimit(t *testing.T) {
+	cfg := &Config{
+		MetadataKeys:                []string{"tenant_id"},
+		MetadataCardinalityEviction: true,
+	}
+	assert.EqualError(t, cfg.Validate(), "metadata_cardinality_eviction requires a metadata_cardinality_limit")
+}
+
+func TestValidateConfig_AdaptiveSizing(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(cfg *Config)
+		err    string
+	}{
+		{
+			name:   "valid",
+			mutate: func(*Config) {},
+		},
+		{
+			name:   "no target latency",
+			mutate: func(cfg *Config) { cfg.AdaptiveSizing.TargetLatency = 0 },
+			err:    "adaptive_sizing::target_latency must be greater than 0",
+		},
+		{
+			name:   "no min size",
+			mutate: func(cfg *Config) { cfg.AdaptiveSizing.MinSendBatchSize = 0 },
+			err:    "adaptive_sizing::min_send_batch_size must be greater than 0",
+		},
+		{
+			name:   "size out of bounds",
+			mutate: func(cfg *Config) { cfg.SendBatchSize = 100000 },
+			err:    "send_batch_size must be between adaptive_sizing::min_send_batch_size and adaptive_sizing::max_send_batch_size",
+		},
+		{
+			name:   "max size lower than adaptive max size",
+			mutate: func(cfg *Config) { cfg.SendBatchMaxSize = 10000 },
+			err:    "send_batch_max_size must be greater or equal to adaptive_sizing::max_send_batch_size",
+		},
+		{
+			name:   "min timeout greater than timeout",
+			mutate: func(cfg *Config) { cfg.AdaptiveSizing.MinTimeout = cfg.Timeout + time.Second },
+			err:    "adaptive_sizing::min_timeout must be between 0 and timeout",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.AdaptiveSizing.Enabled = true
+			tt.mutate(cfg)
+			if tt.err == "" {
+				assert.NoError(t, cfg.Validate())
+			} else {
+				assert.EqualError(t, cfg.Validate(), tt.err)
+			}
+		})
+	}
+}
+
 func TestValidateConfig_InvalidTimeout(t *testing.T) {
 	cfg := &Config{
 		Timeout: -time.Second,
@@ -23,6 +23,15 @@ const (
 	// of metadata configurations the user expects to submit to
 	// the collector.
 	defaultMetadataCardinalityLimit = 1000
+
+	// defaultMetadataMetricsCardinalityLimit bounds the attribute
+	// sets of the per-tenant metrics, which outlive the evicted
+	// batchers.
+	defaultMetadataMetricsCardinalityLimit = 1000
+
+	defaultAdaptiveTargetLatency    = time.Second
+	defaultAdaptiveMinSendBatchSize = uint32(512)
+	defaultAdaptiveMaxSendBatchSize = uint32(65536)
 )
 
 // NewFactory returns a new factory for the Batch processor.
@@ -37,9 +46,15 @@ func NewFactory() processor.Factory {
 
 func createDefaultConfig() component.Config {
 	return &Config{
-		SendBatchSize:            defaultSendBatchSize,
-		Timeout:                  defaultTimeout,
-		MetadataCardinalityLimit: defaultMetadataCardinalityLimit,
+		SendBatchSize:                   defaultSendBatchSize,
+		Timeout:                         defaultTimeout,
+		MetadataCardinalityLimit:        defaultMetadataCardinalityLimit,
+		MetadataMetricsCardinalityLimit: defaultMetadataMetricsCardinalityLimit,
+		AdaptiveSizing: AdaptiveSizingConfig{
+			TargetLatency:    defaultAdaptiveTargetLatency,
+			MinSendBatchSize: defaultAdaptiveMinSendBatchSize,
+			MaxSendBatchSize: defaultAdaptiveMaxSendBatchSize,
+		},
 	}
 }
 
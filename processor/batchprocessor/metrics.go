@@ -5,6 +5,7 @@ package batchprocessor // import "go.opentelemetry.io/collector/processor/batchp
 
 import (
 	"context"
+	"sync"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
@@ -18,6 +19,10 @@ import (
 
 const (
 	scopeName = "go.opentelemetry.io/collector/processor/batchprocessor"
+
+	// overflowKey is the attribute of the per-tenant metrics recorded for the metadata value
+	// combinations beyond the limit, as the OpenTelemetry SDKs do for the cardinality limits.
+	overflowKey = "otel.metric.overflow"
 )
 
 type trigger int
@@ -34,16 +39,29 @@ type batchProcessorTelemetry struct {
 
 	exportCtx context.Context
 
+	// tenantLimit is the maximum number of metadata value combinations
+	// recorded as distinct attributes of the per-tenant metrics, no
+	// limit if 0. The combinations are guarded by tenantsMu.
+	tenantLimit int
+	tenantsMu   sync.Mutex
+	tenants     map[attribute.Distinct]struct{}
+
 	processorAttr            []attribute.KeyValue
 	batchSizeTriggerSend     metric.Int64Counter
 	timeoutTriggerSend       metric.Int64Counter
 	batchSendSize            metric.Int64Histogram
 	batchSendSizeBytes       metric.Int64Histogram
 	batchMetadataCardinality metric.Int64ObservableUpDownCounter
+	metadataSentItems        metric.Int64Counter
+	metadataRefusedItems     metric.Int64Counter
+	metadataEvictions        metric.Int64Counter
+	droppedItems             metric.Int64Counter
 }
 
-func newBatchProcessorTelemetry(set processor.CreateSettings, currentMetadataCardinality func() int) (*batchProcessorTelemetry, error) {
+func newBatchProcessorTelemetry(set processor.CreateSettings, currentMetadataCardinality func() int, tenantLimit int) (*batchProcessorTelemetry, error) {
 	bpt := &batchProcessorTelemetry{
+		tenantLimit:   tenantLimit,
+		tenants:       map[attribute.Distinct]struct{}{},
 		processorAttr: []attribute.KeyValue{attribute.String(obsmetrics.ProcessorKey, set.ID.String())},
 		exportCtx:     context.Background(),
 		level:         set.MetricsLevel,
@@ -100,6 +118,34 @@ func (bpt *batchProcessorTelemetry) createOtelMetrics(mp metric.MeterProvider, c
 	)
 	errors = multierr.Append(errors, err)
 
+	bpt.metadataSentItems, err = meter.Int64Counter(
+		processorhelper.BuildCustomMetricName(typeStr, "metadata_sent_items"),
+		metric.WithDescription("Number of items sent per metadata value combination"),
+		metric.WithUnit("1"),
+	)
+	errors = multierr.Append(errors, err)
+
+	bpt.metadataRefusedItems, err = meter.Int64Counter(
+		processorhelper.BuildCustomMetricName(typeStr, "metadata_refused_items"),
+		metric.WithDescription("Number of items refused because the metadata value combination had too many pending items"),
+		metric.WithUnit("1"),
+	)
+	errors = multierr.Append(errors, err)
+
+	bpt.metadataEvictions, err = meter.Int64Counter(
+		processorhelper.BuildCustomMetricName(typeStr, "metadata_evictions"),
+		metric.WithDescription("Number of times the batcher of a metadata value combination was evicted"),
+		metric.WithUnit("1"),
+	)
+	errors = multierr.Append(errors, err)
+
+	bpt.droppedItems, err = meter.Int64Counter(
+		processorhelper.BuildCustomMetricName(typeStr, "dropped_items"),
+		metric.WithDescription("Number of items dropped because the export failed or the shutdown deadline was exceeded"),
+		metric.WithUnit("1"),
+	)
+	errors = multierr.Append(errors, err)
+
 	return errors
 }
 
@@ -116,3 +162,54 @@ func (bpt *batchProcessorTelemetry) record(trigger trigger, sent, bytes int64) {
 		bpt.batchSendSizeBytes.Record(bpt.exportCtx, bytes, metric.WithAttributes(bpt.processorAttr...))
 	}
 }
+
+// recordDropped records the dropped items when the metrics level is normal or detailed.
+func (bpt *batchProcessorTelemetry) recordDropped(dropped int64) {
+	if bpt.level < configtelemetry.LevelNormal {
+		return
+	}
+	bpt.droppedItems.Add(bpt.exportCtx, dropped, metric.WithAttributes(bpt.processorAttr...))
+}
+
+// tenantAttributes returns the attributes of the per-tenant metrics for a metadata value combination.
+// Once tenantLimit combinations were seen, the new ones share the overflow attributes. The per-tenant
+// metrics are only recorded when the metrics level is detailed, nil is returned otherwise.
+func (bpt *batchProcessorTelemetry) tenantAttributes(attrs attribute.Set) metric.MeasurementOption {
+	if !bpt.detailed {
+		return nil
+	}
+	bpt.tenantsMu.Lock()
+	_, seen := bpt.tenants[attrs.Equivalent()]
+	if !seen && bpt.tenantLimit > 0 && len(bpt.tenants) >= bpt.tenantLimit {
+		bpt.tenantsMu.Unlock()
+		kvs := make([]attribute.KeyValue, 0, len(bpt.processorAttr)+1)
+		kvs = append(kvs, bpt.processorAttr...)
+		kvs = append(kvs, attribute.Bool(overflowKey, true))
+		return metric.WithAttributes(kvs...)
+	}
+	bpt.tenants[attrs.Equivalent()] = struct{}{}
+	bpt.tenantsMu.Unlock()
+
+	kvs := make([]attribute.KeyValue, 0, len(bpt.processorAttr)+attrs.Len())
+	kvs = append(kvs, bpt.processorAttr...)
+	kvs = append(kvs, attrs.ToSlice()...)
+	return metric.WithAttributes(kvs...)
+}
+
+func (bpt *batchProcessorTelemetry) recordTenantSent(attrs metric.MeasurementOption, sent int64) {
+	if attrs != nil {
+		bpt.metadataSentItems.Add(bpt.exportCtx, sent, attrs)
+	}
+}
+
+func (bpt *batchProcessorTelemetry) recordTenantRefused(attrs metric.MeasurementOption, refused int64) {
+	if attrs != nil {
+		bpt.metadataRefusedItems.Add(bpt.exportCtx, refused, attrs)
+	}
+}
+
+func (bpt *batchProcessorTelemetry) recordTenantEviction(attrs metric.MeasurementOption) {
+	if attrs != nil {
+		bpt.metadataEvictions.Add(bpt.exportCtx, 1, attrs)
+	}
+}
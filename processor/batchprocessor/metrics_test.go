@@ -17,11 +17,14 @@ import (
 	"github.com/prometheus/common/expfmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
 	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/resource"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtelemetry"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processorhelper"
 	"go.opentelemetry.io/collector/processor/processortest"
@@ -203,3 +206,40 @@ func batchViews() []sdkmetric.View {
 		),
 	}
 }
+
+func TestBatchProcessorTelemetryLevels(t *testing.T) {
+	tests := []struct {
+		level   configtelemetry.Level
+		dropped bool
+		tenants bool
+	}{
+		{level: configtelemetry.LevelBasic},
+		{level: configtelemetry.LevelNormal, dropped: true},
+		{level: configtelemetry.LevelDetailed, dropped: true, tenants: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			reader := sdkmetric.NewManualReader()
+			set := processortest.NewNopCreateSettings()
+			set.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+			set.MetricsLevel = tt.level
+			bpt, err := newBatchProcessorTelemetry(set, func() int { return 0 }, 0)
+			require.NoError(t, err)
+
+			attrs := bpt.tenantAttributes(attribute.NewSet(attribute.String("token", "a")))
+			bpt.recordTenantSent(attrs, 1)
+			bpt.recordDropped(1)
+
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, reader.Collect(context.Background(), &rm))
+			names := map[string]bool{}
+			for _, sm := range rm.ScopeMetrics {
+				for _, m := range sm.Metrics {
+					names[m.Name] = true
+				}
+			}
+			assert.Equal(t, tt.dropped, names[processorhelper.BuildCustomMetricName(typeStr, "dropped_items")])
+			assert.Equal(t, tt.tenants, names[processorhelper.BuildCustomMetricName(typeStr, "metadata_sent_items")])
+		})
+	}
+}
@@ -64,6 +64,14 @@ func splitLogs(size int, src plog.Logs) plog.Logs {
 	return dest
 }
 
+// resourceLogsSize returns the size of the plog.Logs holding only rs.
+func resourceLogsSize(sizer plog.Sizer, rs plog.ResourceLogs) int {
+	ld := plog.NewLogs()
+	rs.MoveTo(ld.ResourceLogs().AppendEmpty())
+	defer ld.ResourceLogs().At(0).MoveTo(rs)
+	return sizer.LogsSize(ld)
+}
+
 // resourceLRC calculates the total number of log records in the plog.ResourceLogs.
 func resourceLRC(rs plog.ResourceLogs) (count int) {
 	for k := 0; k < rs.ScopeLogs().Len(); k++ {
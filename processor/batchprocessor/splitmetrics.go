@@ -75,6 +75,14 @@ func splitMetrics(size int, src pmetric.Metrics) pmetric.Metrics {
 	return dest
 }
 
+// resourceMetricsSize returns the size of the pmetric.Metrics holding only rs.
+func resourceMetricsSize(sizer pmetric.Sizer, rs pmetric.ResourceMetrics) int {
+	md := pmetric.NewMetrics()
+	rs.MoveTo(md.ResourceMetrics().AppendEmpty())
+	defer md.ResourceMetrics().At(0).MoveTo(rs)
+	return sizer.MetricsSize(md)
+}
+
 // resourceMetricsDPC calculates the total number of data points in the pmetric.ResourceMetrics.
 func resourceMetricsDPC(rs pmetric.ResourceMetrics) int {
 	dataPointCount := 0
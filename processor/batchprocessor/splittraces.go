@@ -64,6 +64,14 @@ func splitTraces(size int, src ptrace.Traces) ptrace.Traces {
 	return dest
 }
 
+// resourceSpansSize returns the size of the ptrace.Traces holding only rs.
+func resourceSpansSize(sizer ptrace.Sizer, rs ptrace.ResourceSpans) int {
+	td := ptrace.NewTraces()
+	rs.MoveTo(td.ResourceSpans().AppendEmpty())
+	defer td.ResourceSpans().At(0).MoveTo(rs)
+	return sizer.TracesSize(td)
+}
+
 // resourceSC calculates the total number of spans in the ptrace.ResourceSpans.
 func resourceSC(rs ptrace.ResourceSpans) (count int) {
 	for k := 0; k < rs.ScopeSpans().Len(); k++ {
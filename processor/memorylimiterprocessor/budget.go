@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package memorylimiterprocessor // import "go.opentelemetry.io/collector/processor/memorylimiterprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var (
+	tracesSizer  = &ptrace.ProtoMarshaler{}
+	metricsSizer = &pmetric.ProtoMarshaler{}
+	logsSizer    = &plog.ProtoMarshaler{}
+)
+
+// overBudget returns true if the data being consumed by the next components exceeds
+// the budget. A single request is always let through, even if larger than the budget.
+//
+// The data is accounted until the call of the next consumer returns, as the pipeline
+// does not tell when an asynchronous component, such as the batch processor or a
+// sending queue, releases the data it holds. The budget only bounds the data of the
+// synchronous part of the pipeline following the processor.
+func (p *memoryLimiterProcessor) overBudget() bool {
+	return p.budget > 0 && p.inFlight.Load() >= p.budget
+}
+
+// track accounts for size bytes of data until the returned func is called.
+func (p *memoryLimiterProcessor) track(size int) func() {
+	p.inFlight.Add(int64(size))
+	return func() { p.inFlight.Add(-int64(size)) }
+}
+
+// budgetTraces wraps next so that the data it consumes is accounted against the budget.
+func (p *memoryLimiterProcessor) budgetTraces(next consumer.Traces) consumer.Traces {
+	if p.budget == 0 {
+		return next
+	}
+	return &budgetTraces{Traces: next, p: p}
+}
+
+func (p *memoryLimiterProcessor) budgetMetrics(next consumer.Metrics) consumer.Metrics {
+	if p.budget == 0 {
+		return next
+	}
+	return &budgetMetrics{Metrics: next, p: p}
+}
+
+func (p *memoryLimiterProcessor) budgetLogs(next consumer.Logs) consumer.Logs {
+	if p.budget == 0 {
+		return next
+	}
+	return &budgetLogs{Logs: next, p: p}
+}
+
+type budgetTraces struct {
+	consumer.Traces
+	p *memoryLimiterProcessor
+}
+
+func (b *budgetTraces) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	defer b.p.track(tracesSizer.TracesSize(td))()
+	return b.Traces.ConsumeTraces(ctx, td)
+}
+
+type budgetMetrics struct {
+	consumer.Metrics
+	p *memoryLimiterProcessor
+}
+
+func (b *budgetMetrics) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	defer b.p.track(metricsSizer.MetricsSize(md))()
+	return b.Metrics.ConsumeMetrics(ctx, md)
+}
+
+type budgetLogs struct {
+	consumer.Logs
+	p *memoryLimiterProcessor
+}
+
+func (b *budgetLogs) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	defer b.p.track(logsSizer.LogsSize(ld))()
+	return b.Logs.ConsumeLogs(ctx, ld)
+}
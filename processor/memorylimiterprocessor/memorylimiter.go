@@ -5,6 +5,9 @@ package memorylimiterprocessor // import "go.opentelemetry.io/collector/processo
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/internal/memorylimiter"
@@ -18,6 +21,23 @@ import (
 type memoryLimiterProcessor struct {
 	memlimiter *memorylimiter.MemoryLimiter
 	obsrep     *processorhelper.ObsReport
+
+	tracesActions  memorylimiter.LimitActions
+	metricsActions memorylimiter.LimitActions
+	logsActions    memorylimiter.LimitActions
+	// maxPause is the maximum time the data is held by the pause and drop_oldest actions.
+	maxPause time.Duration
+
+	// tracesHeld, metricsHeld and logsHeld hold the newest data of each signal for the drop_oldest action.
+	tracesHeld  heldData
+	metricsHeld heldData
+	logsHeld    heldData
+
+	// budget is the maximum number of bytes consumed by the next components at the
+	// same time, zero if unlimited. inFlight is the number of bytes being consumed,
+	// until the call of the next consumer returns.
+	budget   int64
+	inFlight atomic.Int64
 }
 
 // newMemoryLimiter returns a new memorylimiter processor.
@@ -35,8 +55,13 @@ func newMemoryLimiterProcessor(set processor.CreateSettings, cfg *Config) (*memo
 	}
 
 	p := &memoryLimiterProcessor{
-		memlimiter: ml,
-		obsrep:     obsrep,
+		memlimiter:     ml,
+		obsrep:         obsrep,
+		tracesActions:  cfg.Actions.For(component.DataTypeTraces),
+		metricsActions: cfg.Actions.For(component.DataTypeMetrics),
+		logsActions:    cfg.Actions.For(component.DataTypeLogs),
+		maxPause:       cfg.Actions.PauseTimeout(),
+		budget:         int64(cfg.BudgetMiB) * 1024 * 1024,
 	}
 
 	return p, nil
@@ -50,9 +75,95 @@ func (p *memoryLimiterProcessor) shutdown(ctx context.Context) error {
 	return p.memlimiter.Shutdown(ctx)
 }
 
+// heldData tracks the data held by the drop_oldest action, only the newest data is held at a time.
+type heldData struct {
+	mu sync.Mutex
+	// drop is closed to drop the data currently held, nil if none is held.
+	drop chan struct{}
+}
+
+// hold drops the data currently held, if any, and returns a channel closed when the new data is dropped in turn.
+func (h *heldData) hold() chan struct{} {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.drop != nil {
+		close(h.drop)
+	}
+	h.drop = make(chan struct{})
+	return h.drop
+}
+
+// release stops holding the data of the given channel, unless it was already dropped.
+func (h *heldData) release(drop chan struct{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.drop == drop {
+		h.drop = nil
+	}
+}
+
+// limitAction returns the action to take on the incoming data, empty when the memory
+// usage is below the limits. When the action is to pause or to drop the oldest data,
+// it waits for the memory usage to go down, and returns the refuse action if ctx is
+// done first, so that the data is never held forever. After maxPause, the paused data
+// is refused and the data held by the drop_oldest action is dropped, as is the data
+// held when newer data arrives.
+func (p *memoryLimiterProcessor) limitAction(ctx context.Context, actions memorylimiter.LimitActions, held *heldData) memorylimiter.LimitAction {
+	action := p.currentLimitAction(actions)
+	if !isHoldAction(action) {
+		return action
+	}
+	ticker := time.NewTicker(p.memlimiter.CheckInterval())
+	defer ticker.Stop()
+	timeout := time.NewTimer(p.maxPause)
+	defer timeout.Stop()
+	// dropped is closed when newer data is held by the drop_oldest action, nil until this data is held.
+	var dropped chan struct{}
+	defer func() {
+		if dropped != nil {
+			held.release(dropped)
+		}
+	}()
+	for isHoldAction(action) {
+		if action == memorylimiter.LimitActionDropOldest && dropped == nil {
+			dropped = held.hold()
+		}
+		select {
+		case <-ctx.Done():
+			return memorylimiter.LimitActionRefuse
+		case <-dropped:
+			return memorylimiter.LimitActionDropOldest
+		case <-timeout.C:
+			if action == memorylimiter.LimitActionDropOldest {
+				return memorylimiter.LimitActionDropOldest
+			}
+			return memorylimiter.LimitActionRefuse
+		case <-ticker.C:
+		}
+		action = p.currentLimitAction(actions)
+	}
+	return action
+}
+
+// isHoldAction returns true if the action holds the data until the memory usage goes down.
+func isHoldAction(action memorylimiter.LimitAction) bool {
+	return action == memorylimiter.LimitActionPause || action == memorylimiter.LimitActionDropOldest
+}
+
+func (p *memoryLimiterProcessor) currentLimitAction(actions memorylimiter.LimitActions) memorylimiter.LimitAction {
+	switch {
+	case p.memlimiter.AboveHardLimit():
+		return actions.HardLimit
+	case p.memlimiter.MustRefuse(), p.overBudget():
+		return actions.SoftLimit
+	}
+	return ""
+}
+
 func (p *memoryLimiterProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
 	numSpans := td.SpanCount()
-	if p.memlimiter.MustRefuse() {
+	switch p.limitAction(ctx, p.tracesActions, &p.tracesHeld) {
+	case memorylimiter.LimitActionRefuse:
 		// TODO: actually to be 100% sure that this is "refused" and not "dropped"
 		// 	it is necessary to check the pipeline to see if this is directly connected
 		// 	to a receiver (ie.: a receiver is on the call stack). For now it
@@ -60,6 +171,11 @@ func (p *memoryLimiterProcessor) processTraces(ctx context.Context, td ptrace.Tr
 		// 	callstack and that the receiver will correctly retry the refused data again.
 		p.obsrep.TracesRefused(ctx, numSpans)
 		return td, memorylimiter.ErrDataRefused
+	case memorylimiter.LimitActionDropNew, memorylimiter.LimitActionDropOldest:
+		p.obsrep.TracesDropped(ctx, numSpans)
+		return td, processorhelper.ErrSkipProcessingData
+	case memorylimiter.LimitActionGC:
+		p.memlimiter.ForceGC()
 	}
 
 	// Even if the next consumer returns error record the data as accepted by
@@ -70,7 +186,8 @@ func (p *memoryLimiterProcessor) processTraces(ctx context.Context, td ptrace.Tr
 
 func (p *memoryLimiterProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
 	numDataPoints := md.DataPointCount()
-	if p.memlimiter.MustRefuse() {
+	switch p.limitAction(ctx, p.metricsActions, &p.metricsHeld) {
+	case memorylimiter.LimitActionRefuse:
 		// TODO: actually to be 100% sure that this is "refused" and not "dropped"
 		// 	it is necessary to check the pipeline to see if this is directly connected
 		// 	to a receiver (ie.: a receiver is on the call stack). For now it
@@ -78,6 +195,11 @@ func (p *memoryLimiterProcessor) processMetrics(ctx context.Context, md pmetric.
 		// 	callstack.
 		p.obsrep.MetricsRefused(ctx, numDataPoints)
 		return md, memorylimiter.ErrDataRefused
+	case memorylimiter.LimitActionDropNew, memorylimiter.LimitActionDropOldest:
+		p.obsrep.MetricsDropped(ctx, numDataPoints)
+		return md, processorhelper.ErrSkipProcessingData
+	case memorylimiter.LimitActionGC:
+		p.memlimiter.ForceGC()
 	}
 
 	// Even if the next consumer returns error record the data as accepted by
@@ -88,7 +210,8 @@ func (p *memoryLimiterProcessor) processMetrics(ctx context.Context, md pmetric.
 
 func (p *memoryLimiterProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
 	numRecords := ld.LogRecordCount()
-	if p.memlimiter.MustRefuse() {
+	switch p.limitAction(ctx, p.logsActions, &p.logsHeld) {
+	case memorylimiter.LimitActionRefuse:
 		// TODO: actually to be 100% sure that this is "refused" and not "dropped"
 		// 	it is necessary to check the pipeline to see if this is directly connected
 		// 	to a receiver (ie.: a receiver is on the call stack). For now it
@@ -96,6 +219,11 @@ func (p *memoryLimiterProcessor) processLogs(ctx context.Context, ld plog.Logs)
 		// 	callstack.
 		p.obsrep.LogsRefused(ctx, numRecords)
 		return ld, memorylimiter.ErrDataRefused
+	case memorylimiter.LimitActionDropNew, memorylimiter.LimitActionDropOldest:
+		p.obsrep.LogsDropped(ctx, numRecords)
+		return ld, processorhelper.ErrSkipProcessingData
+	case memorylimiter.LimitActionGC:
+		p.memlimiter.ForceGC()
 	}
 
 	// Even if the next consumer returns error record the data as accepted by
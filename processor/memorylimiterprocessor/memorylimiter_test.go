@@ -6,6 +6,7 @@ package memorylimiterprocessor
 import (
 	"context"
 	"runtime"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/internal/iruntime"
 	"go.opentelemetry.io/collector/internal/memorylimiter"
@@ -221,7 +223,7 @@ func TestMetricsMemoryPressureResponse(t *testing.T) {
 		})
 	}
 	t.Cleanup(func() {
-		memorylimiter.GetMemoryFn = iruntime.TotalMemory
+		memorylimiter.GetMemoryFn = iruntime.MemoryLimit
 		memorylimiter.ReadMemStatsFn = runtime.ReadMemStats
 	})
 }
@@ -338,7 +340,7 @@ func TestTraceMemoryPressureResponse(t *testing.T) {
 		})
 	}
 	t.Cleanup(func() {
-		memorylimiter.GetMemoryFn = iruntime.TotalMemory
+		memorylimiter.GetMemoryFn = iruntime.MemoryLimit
 		memorylimiter.ReadMemStatsFn = runtime.ReadMemStats
 	})
 }
@@ -455,11 +457,294 @@ func TestLogMemoryPressureResponse(t *testing.T) {
 		})
 	}
 	t.Cleanup(func() {
-		memorylimiter.GetMemoryFn = iruntime.TotalMemory
+		memorylimiter.GetMemoryFn = iruntime.MemoryLimit
 		memorylimiter.ReadMemStatsFn = runtime.ReadMemStats
 	})
 }
 
+func TestLimitActions(t *testing.T) {
+	tests := []struct {
+		name        string
+		actions     memorylimiter.ActionsConfig
+		memAlloc    uint64
+		expectError error
+		expectSpans int
+		// noDeadline is true if the data is consumed without deadline.
+		noDeadline bool
+	}{
+		{
+			name:        "soft limit drop",
+			actions:     memorylimiter.ActionsConfig{Traces: memorylimiter.LimitActions{SoftLimit: memorylimiter.LimitActionDropNew}},
+			memAlloc:    900,
+			expectSpans: 0,
+		},
+		{
+			name:        "soft limit gc",
+			actions:     memorylimiter.ActionsConfig{LimitActions: memorylimiter.LimitActions{SoftLimit: memorylimiter.LimitActionGC}},
+			memAlloc:    900,
+			expectSpans: 1,
+		},
+		{
+			name:        "hard limit drop",
+			actions:     memorylimiter.ActionsConfig{LimitActions: memorylimiter.LimitActions{HardLimit: memorylimiter.LimitActionDropNew}},
+			memAlloc:    1800,
+			expectSpans: 0,
+		},
+		{
+			name:        "hard limit refuse",
+			actions:     memorylimiter.ActionsConfig{LimitActions: memorylimiter.LimitActions{SoftLimit: memorylimiter.LimitActionDropNew}},
+			memAlloc:    1800,
+			expectError: memorylimiter.ErrDataRefused,
+		},
+		{
+			name:        "other signal override",
+			actions:     memorylimiter.ActionsConfig{Logs: memorylimiter.LimitActions{SoftLimit: memorylimiter.LimitActionDropNew}},
+			memAlloc:    900,
+			expectError: memorylimiter.ErrDataRefused,
+		},
+		{
+			name:        "pause until the caller gives up",
+			actions:     memorylimiter.ActionsConfig{LimitActions: memorylimiter.LimitActions{SoftLimit: memorylimiter.LimitActionPause}},
+			memAlloc:    900,
+			expectError: memorylimiter.ErrDataRefused,
+		},
+		{
+			name: "pause until max pause",
+			actions: memorylimiter.ActionsConfig{
+				LimitActions: memorylimiter.LimitActions{SoftLimit: memorylimiter.LimitActionPause},
+				MaxPause:     20 * time.Millisecond,
+			},
+			memAlloc:    900,
+			noDeadline:  true,
+			expectError: memorylimiter.ErrDataRefused,
+		},
+		{
+			name:        "drop oldest until the caller gives up",
+			actions:     memorylimiter.ActionsConfig{LimitActions: memorylimiter.LimitActions{SoftLimit: memorylimiter.LimitActionDropOldest}},
+			memAlloc:    900,
+			expectError: memorylimiter.ErrDataRefused,
+		},
+		{
+			name: "drop oldest until max pause",
+			actions: memorylimiter.ActionsConfig{
+				LimitActions: memorylimiter.LimitActions{SoftLimit: memorylimiter.LimitActionDropOldest},
+				MaxPause:     20 * time.Millisecond,
+			},
+			memAlloc:    900,
+			noDeadline:  true,
+			expectSpans: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			memorylimiter.GetMemoryFn = totalMemory
+			memorylimiter.ReadMemStatsFn = func(ms *runtime.MemStats) {
+				ms.Alloc = tt.memAlloc
+			}
+			cfg := &Config{
+				CheckInterval:         10 * time.Millisecond,
+				MemoryLimitPercentage: 50,
+				MemorySpikePercentage: 10,
+				Actions:               tt.actions,
+			}
+			require.NoError(t, cfg.Validate())
+
+			sink := new(consumertest.TracesSink)
+			ml, err := newMemoryLimiterProcessor(processortest.NewNopCreateSettings(), cfg)
+			require.NoError(t, err)
+			tp, err := processorhelper.NewTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, sink,
+				ml.processTraces,
+				processorhelper.WithCapabilities(processorCapabilities),
+				processorhelper.WithStart(ml.start),
+				processorhelper.WithShutdown(ml.shutdown))
+			require.NoError(t, err)
+			require.NoError(t, tp.Start(context.Background(), &host{}))
+			ml.memlimiter.CheckMemLimits()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			if tt.noDeadline {
+				ctx = context.Background()
+			}
+			td := ptrace.NewTraces()
+			td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+			assert.Equal(t, tt.expectError, tp.ConsumeTraces(ctx, td))
+			assert.Equal(t, tt.expectSpans, sink.SpanCount())
+			assert.NoError(t, tp.Shutdown(context.Background()))
+		})
+	}
+	t.Cleanup(func() {
+		memorylimiter.GetMemoryFn = iruntime.MemoryLimit
+		memorylimiter.ReadMemStatsFn = runtime.ReadMemStats
+	})
+}
+
+func TestLimitActionPauseResumes(t *testing.T) {
+	var memAlloc atomic.Uint64
+	memAlloc.Store(900)
+	memorylimiter.GetMemoryFn = totalMemory
+	memorylimiter.ReadMemStatsFn = func(ms *runtime.MemStats) {
+		ms.Alloc = memAlloc.Load()
+	}
+	t.Cleanup(func() {
+		memorylimiter.GetMemoryFn = iruntime.MemoryLimit
+		memorylimiter.ReadMemStatsFn = runtime.ReadMemStats
+	})
+	cfg := &Config{
+		CheckInterval:         10 * time.Millisecond,
+		MemoryLimitPercentage: 50,
+		MemorySpikePercentage: 10,
+		Actions: memorylimiter.ActionsConfig{
+			LimitActions: memorylimiter.LimitActions{SoftLimit: memorylimiter.LimitActionPause},
+		},
+	}
+
+	sink := new(consumertest.LogsSink)
+	ml, err := newMemoryLimiterProcessor(processortest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	lp, err := processorhelper.NewLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, sink,
+		ml.processLogs,
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(ml.start),
+		processorhelper.WithShutdown(ml.shutdown))
+	require.NoError(t, err)
+	require.NoError(t, lp.Start(context.Background(), &host{}))
+	ml.memlimiter.CheckMemLimits()
+
+	// The memory usage goes down while the data is held.
+	time.AfterFunc(50*time.Millisecond, func() { memAlloc.Store(100) })
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	assert.NoError(t, lp.ConsumeLogs(context.Background(), ld))
+	assert.Equal(t, 1, sink.LogRecordCount())
+	assert.NoError(t, lp.Shutdown(context.Background()))
+}
+
+func TestLimitActionDropOldest(t *testing.T) {
+	var memAlloc atomic.Uint64
+	memAlloc.Store(900)
+	memorylimiter.GetMemoryFn = totalMemory
+	memorylimiter.ReadMemStatsFn = func(ms *runtime.MemStats) {
+		ms.Alloc = memAlloc.Load()
+	}
+	t.Cleanup(func() {
+		memorylimiter.GetMemoryFn = iruntime.MemoryLimit
+		memorylimiter.ReadMemStatsFn = runtime.ReadMemStats
+	})
+	cfg := &Config{
+		CheckInterval:         10 * time.Millisecond,
+		MemoryLimitPercentage: 50,
+		MemorySpikePercentage: 10,
+		Actions: memorylimiter.ActionsConfig{
+			LimitActions: memorylimiter.LimitActions{SoftLimit: memorylimiter.LimitActionDropOldest},
+		},
+	}
+
+	sink := new(consumertest.LogsSink)
+	ml, err := newMemoryLimiterProcessor(processortest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	lp, err := processorhelper.NewLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, sink,
+		ml.processLogs,
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(ml.start),
+		processorhelper.WithShutdown(ml.shutdown))
+	require.NoError(t, err)
+	require.NoError(t, lp.Start(context.Background(), &host{}))
+	ml.memlimiter.CheckMemLimits()
+
+	// The oldest data is dropped once newer data is held.
+	oldest := make(chan error, 1)
+	go func() {
+		ld := plog.NewLogs()
+		ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		oldest <- lp.ConsumeLogs(context.Background(), ld)
+	}()
+	assert.Eventually(t, func() bool {
+		ml.logsHeld.mu.Lock()
+		defer ml.logsHeld.mu.Unlock()
+		return ml.logsHeld.drop != nil
+	}, time.Second, 5*time.Millisecond)
+	newest := make(chan error, 1)
+	go func() {
+		ld := plog.NewLogs()
+		records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+		records.AppendEmpty()
+		records.AppendEmpty()
+		newest <- lp.ConsumeLogs(context.Background(), ld)
+	}()
+	assert.NoError(t, <-oldest)
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	// The newest data is let through once the memory usage goes down.
+	memAlloc.Store(100)
+	assert.NoError(t, <-newest)
+	assert.Equal(t, 2, sink.LogRecordCount())
+	assert.NoError(t, lp.Shutdown(context.Background()))
+}
+
+func TestBudgetPerProcessor(t *testing.T) {
+	memorylimiter.GetMemoryFn = totalMemory
+	memorylimiter.ReadMemStatsFn = func(ms *runtime.MemStats) {
+		ms.Alloc = 100
+	}
+	t.Cleanup(func() {
+		memorylimiter.GetMemoryFn = iruntime.MemoryLimit
+		memorylimiter.ReadMemStatsFn = runtime.ReadMemStats
+	})
+	newCfg := func() *Config {
+		return &Config{
+			CheckInterval:         10 * time.Millisecond,
+			MemoryLimitPercentage: 50,
+			MemorySpikePercentage: 10,
+			BudgetMiB:             1,
+		}
+	}
+
+	// The logs pipeline is held by its next consumer with more data than its budget.
+	factory := NewFactory()
+	unblock := make(chan struct{})
+	logsSink := new(consumertest.LogsSink)
+	blocking, err := consumer.NewLogs(func(ctx context.Context, ld plog.Logs) error {
+		<-unblock
+		return logsSink.ConsumeLogs(ctx, ld)
+	})
+	require.NoError(t, err)
+	lp, err := factory.CreateLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), newCfg(), blocking)
+	require.NoError(t, err)
+	require.NoError(t, lp.Start(context.Background(), &host{}))
+
+	tracesSink := new(consumertest.TracesSink)
+	tp, err := factory.CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), newCfg(), tracesSink)
+	require.NoError(t, err)
+	require.NoError(t, tp.Start(context.Background(), &host{}))
+
+	large := plog.NewLogs()
+	large.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(string(make([]byte, 2*1024*1024)))
+	done := make(chan error)
+	go func() { done <- lp.ConsumeLogs(context.Background(), large) }()
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	assert.Eventually(t, func() bool {
+		return lp.ConsumeLogs(context.Background(), ld) == memorylimiter.ErrDataRefused
+	}, time.Second, 10*time.Millisecond)
+
+	// The traces pipeline has its own budget, and is not throttled.
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	assert.NoError(t, tp.ConsumeTraces(context.Background(), td))
+	assert.Equal(t, 1, tracesSink.SpanCount())
+
+	// Once the data is consumed, the logs pipeline accepts data again.
+	close(unblock)
+	assert.NoError(t, <-done)
+	assert.NoError(t, lp.ConsumeLogs(context.Background(), ld))
+	assert.Equal(t, 2, logsSink.LogRecordCount())
+
+	assert.NoError(t, lp.Shutdown(context.Background()))
+	assert.NoError(t, tp.Shutdown(context.Background()))
+}
+
 type host struct {
 	ballastSize uint64
 	component.Host
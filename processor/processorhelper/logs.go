@@ -48,8 +48,15 @@ func NewLogsProcessor(
 	logsConsumer, err := consumer.NewLogs(func(ctx context.Context, ld plog.Logs) error {
 		span := trace.SpanFromContext(ctx)
 		span.AddEvent("Start processing.", eventOptions)
-		var err error
-		ld, err = logsFunc(ctx, ld)
+		if bs.capabilities.MutatesData {
+			// Copy the data only if it is shared with other consumers.
+			ld = ld.Mutable()
+		}
+		err := recoverReadOnly(set.ID, func() error {
+			var err error
+			ld, err = logsFunc(ctx, ld)
+			return err
+		})
 		span.AddEvent("End processing.", eventOptions)
 		if err != nil {
 			if errors.Is(err, ErrSkipProcessingData) {
@@ -14,7 +14,9 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/processor/processortest"
 )
@@ -26,6 +28,7 @@ func TestNewLogsProcessor(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.True(t, lp.Capabilities().MutatesData)
+	assert.True(t, lp.Capabilities().CopiesReadOnlyData)
 	assert.NoError(t, lp.Start(context.Background(), componenttest.NewNopHost()))
 	assert.NoError(t, lp.ConsumeLogs(context.Background(), plog.NewLogs()))
 	assert.NoError(t, lp.Shutdown(context.Background()))
@@ -42,6 +45,7 @@ func TestNewLogsProcessor_WithOptions(t *testing.T) {
 	assert.Equal(t, want, lp.Start(context.Background(), componenttest.NewNopHost()))
 	assert.Equal(t, want, lp.Shutdown(context.Background()))
 	assert.False(t, lp.Capabilities().MutatesData)
+	assert.False(t, lp.Capabilities().CopiesReadOnlyData)
 }
 
 func TestNewLogsProcessor_NilRequiredFields(t *testing.T) {
@@ -65,6 +69,32 @@ func TestNewLogsProcessor_ProcessLogsErrSkipProcessingData(t *testing.T) {
 	assert.Equal(t, nil, lp.ConsumeLogs(context.Background(), plog.NewLogs()))
 }
 
+func TestNewLogsProcessor_ReadOnlyData(t *testing.T) {
+	mutate := func(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+		ld.ResourceLogs().AppendEmpty()
+		return ld, nil
+	}
+	sink := new(consumertest.LogsSink)
+	ld := plog.NewLogs()
+	ld.MarkReadOnly()
+
+	// A mutating processor gets a copy of the read-only data.
+	p, err := NewLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), &testLogsCfg, sink, mutate)
+	require.NoError(t, err)
+	assert.NoError(t, p.ConsumeLogs(context.Background(), ld))
+	assert.Equal(t, 0, ld.ResourceLogs().Len())
+	require.Len(t, sink.AllLogs(), 1)
+	assert.Equal(t, 1, sink.AllLogs()[0].ResourceLogs().Len())
+
+	// A processor that declares not mutating the data returns an error instead of panicking.
+	p, err = NewLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), &testLogsCfg, sink, mutate,
+		WithCapabilities(consumer.Capabilities{MutatesData: false}))
+	require.NoError(t, err)
+	err = p.ConsumeLogs(context.Background(), ld)
+	assert.ErrorIs(t, err, pcommon.ErrReadOnly)
+	assert.True(t, consumererror.IsPermanent(err))
+}
+
 func newTestLProcessor(retError error) ProcessLogsFunc {
 	return func(_ context.Context, ld plog.Logs) (plog.Logs, error) {
 		return ld, retError
@@ -48,8 +48,15 @@ func NewMetricsProcessor(
 	metricsConsumer, err := consumer.NewMetrics(func(ctx context.Context, md pmetric.Metrics) error {
 		span := trace.SpanFromContext(ctx)
 		span.AddEvent("Start processing.", eventOptions)
-		var err error
-		md, err = metricsFunc(ctx, md)
+		if bs.capabilities.MutatesData {
+			// Copy the data only if it is shared with other consumers.
+			md = md.Mutable()
+		}
+		err := recoverReadOnly(set.ID, func() error {
+			var err error
+			md, err = metricsFunc(ctx, md)
+			return err
+		})
 		span.AddEvent("End processing.", eventOptions)
 		if err != nil {
 			if errors.Is(err, ErrSkipProcessingData) {
@@ -14,7 +14,9 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/processor/processortest"
 )
@@ -26,6 +28,7 @@ func TestNewMetricsProcessor(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.True(t, mp.Capabilities().MutatesData)
+	assert.True(t, mp.Capabilities().CopiesReadOnlyData)
 	assert.NoError(t, mp.Start(context.Background(), componenttest.NewNopHost()))
 	assert.NoError(t, mp.ConsumeMetrics(context.Background(), pmetric.NewMetrics()))
 	assert.NoError(t, mp.Shutdown(context.Background()))
@@ -42,6 +45,7 @@ func TestNewMetricsProcessor_WithOptions(t *testing.T) {
 	assert.Equal(t, want, mp.Start(context.Background(), componenttest.NewNopHost()))
 	assert.Equal(t, want, mp.Shutdown(context.Background()))
 	assert.False(t, mp.Capabilities().MutatesData)
+	assert.False(t, mp.Capabilities().CopiesReadOnlyData)
 }
 
 func TestNewMetricsProcessor_NilRequiredFields(t *testing.T) {
@@ -65,6 +69,32 @@ func TestNewMetricsProcessor_ProcessMetricsErrSkipProcessingData(t *testing.T) {
 	assert.Equal(t, nil, mp.ConsumeMetrics(context.Background(), pmetric.NewMetrics()))
 }
 
+func TestNewMetricsProcessor_ReadOnlyData(t *testing.T) {
+	mutate := func(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+		md.ResourceMetrics().AppendEmpty()
+		return md, nil
+	}
+	sink := new(consumertest.MetricsSink)
+	md := pmetric.NewMetrics()
+	md.MarkReadOnly()
+
+	// A mutating processor gets a copy of the read-only data.
+	p, err := NewMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), &testMetricsCfg, sink, mutate)
+	require.NoError(t, err)
+	assert.NoError(t, p.ConsumeMetrics(context.Background(), md))
+	assert.Equal(t, 0, md.ResourceMetrics().Len())
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.Equal(t, 1, sink.AllMetrics()[0].ResourceMetrics().Len())
+
+	// A processor that declares not mutating the data returns an error instead of panicking.
+	p, err = NewMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), &testMetricsCfg, sink, mutate,
+		WithCapabilities(consumer.Capabilities{MutatesData: false}))
+	require.NoError(t, err)
+	err = p.ConsumeMetrics(context.Background(), md)
+	assert.ErrorIs(t, err, pcommon.ErrReadOnly)
+	assert.True(t, consumererror.IsPermanent(err))
+}
+
 func newTestMProcessor(retError error) ProcessMetricsFunc {
 	return func(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
 		return md, retError
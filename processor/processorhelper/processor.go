@@ -5,13 +5,16 @@ package processorhelper // import "go.opentelemetry.io/collector/processor/proce
 
 import (
 	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/internal/obsreportconfig/obsmetrics"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
 // ErrSkipProcessingData is a sentinel value to indicate when traces or metrics should intentionally be dropped
@@ -39,24 +42,31 @@ func WithShutdown(shutdown component.ShutdownFunc) Option {
 }
 
 // WithCapabilities overrides the default GetCapabilities function for an processor.
-// The default GetCapabilities function returns mutable capabilities.
+// The default GetCapabilities function returns mutable capabilities. Since the data marked
+// as read-only is copied before being passed to a processor that mutates data, the processor
+// always reports CopiesReadOnlyData along with MutatesData.
 func WithCapabilities(capabilities consumer.Capabilities) Option {
 	return func(o *baseSettings) {
-		o.consumerOptions = append(o.consumerOptions, consumer.WithCapabilities(capabilities))
+		o.capabilities = capabilities
+		o.capabilities.CopiesReadOnlyData = capabilities.MutatesData
+		o.consumerOptions = append(o.consumerOptions, consumer.WithCapabilities(o.capabilities))
 	}
 }
 
 type baseSettings struct {
 	component.StartFunc
 	component.ShutdownFunc
+	capabilities    consumer.Capabilities
 	consumerOptions []consumer.Option
 }
 
 // fromOptions returns the internal settings starting from the default and applying all options.
 func fromOptions(options []Option) *baseSettings {
 	// Start from the default options:
+	capabilities := consumer.Capabilities{MutatesData: true, CopiesReadOnlyData: true}
 	opts := &baseSettings{
-		consumerOptions: []consumer.Option{consumer.WithCapabilities(consumer.Capabilities{MutatesData: true})},
+		capabilities:    capabilities,
+		consumerOptions: []consumer.Option{consumer.WithCapabilities(capabilities)},
 	}
 
 	for _, op := range options {
@@ -66,6 +76,21 @@ func fromOptions(options []Option) *baseSettings {
 	return opts
 }
 
+// recoverReadOnly calls process and returns a permanent error instead of panicking
+// if it modifies data marked as read-only.
+func recoverReadOnly(id component.ID, process func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			rErr, ok := r.(error)
+			if !ok || !errors.Is(rErr, pcommon.ErrReadOnly) {
+				panic(r)
+			}
+			err = consumererror.NewPermanent(fmt.Errorf("processor %q modified read-only data: %w", id, rErr))
+		}
+	}()
+	return process()
+}
+
 func spanAttributes(id component.ID) trace.EventOption {
 	return trace.WithAttributes(attribute.String(obsmetrics.ProcessorKey, id.String()))
 }
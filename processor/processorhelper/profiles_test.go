@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package processorhelper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+var testProfilesCfg = struct{}{}
+
+func TestNewProfilesProcessor(t *testing.T) {
+	pp, err := NewProfilesProcessor(context.Background(), processortest.NewNopCreateSettings(), &testProfilesCfg, consumertest.NewNop(), newTestPProcessor(nil))
+	require.NoError(t, err)
+
+	assert.True(t, pp.Capabilities().MutatesData)
+	assert.True(t, pp.Capabilities().CopiesReadOnlyData)
+	assert.NoError(t, pp.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, pp.ConsumeProfiles(context.Background(), pprofile.NewProfiles()))
+	assert.NoError(t, pp.Shutdown(context.Background()))
+}
+
+func TestNewProfilesProcessor_WithOptions(t *testing.T) {
+	want := errors.New("my_error")
+	pp, err := NewProfilesProcessor(context.Background(), processortest.NewNopCreateSettings(), &testProfilesCfg, consumertest.NewNop(), newTestPProcessor(nil),
+		WithStart(func(context.Context, component.Host) error { return want }),
+		WithShutdown(func(context.Context) error { return want }),
+		WithCapabilities(consumer.Capabilities{MutatesData: false}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, want, pp.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Equal(t, want, pp.Shutdown(context.Background()))
+	assert.False(t, pp.Capabilities().MutatesData)
+	assert.False(t, pp.Capabilities().CopiesReadOnlyData)
+}
+
+func TestNewProfilesProcessor_NilRequiredFields(t *testing.T) {
+	_, err := NewProfilesProcessor(context.Background(), processortest.NewNopCreateSettings(), &testProfilesCfg, consumertest.NewNop(), nil)
+	assert.Error(t, err)
+
+	_, err = NewProfilesProcessor(context.Background(), processortest.NewNopCreateSettings(), &testProfilesCfg, nil, newTestPProcessor(nil))
+	assert.Equal(t, component.ErrNilNextConsumer, err)
+}
+
+func TestNewProfilesProcessor_ProcessProfileError(t *testing.T) {
+	want := errors.New("my_error")
+	pp, err := NewProfilesProcessor(context.Background(), processortest.NewNopCreateSettings(), &testProfilesCfg, consumertest.NewNop(), newTestPProcessor(want))
+	require.NoError(t, err)
+	assert.Equal(t, want, pp.ConsumeProfiles(context.Background(), pprofile.NewProfiles()))
+}
+
+func TestNewProfilesProcessor_ProcessProfilesErrSkipProcessingData(t *testing.T) {
+	pp, err := NewProfilesProcessor(context.Background(), processortest.NewNopCreateSettings(), &testProfilesCfg, consumertest.NewNop(), newTestPProcessor(ErrSkipProcessingData))
+	require.NoError(t, err)
+	assert.Equal(t, nil, pp.ConsumeProfiles(context.Background(), pprofile.NewProfiles()))
+}
+
+func newTestPProcessor(retError error) ProcessProfilesFunc {
+	return func(_ context.Context, pd pprofile.Profiles) (pprofile.Profiles, error) {
+		return pd, retError
+	}
+}
@@ -48,8 +48,15 @@ func NewTracesProcessor(
 	traceConsumer, err := consumer.NewTraces(func(ctx context.Context, td ptrace.Traces) error {
 		span := trace.SpanFromContext(ctx)
 		span.AddEvent("Start processing.", eventOptions)
-		var err error
-		td, err = tracesFunc(ctx, td)
+		if bs.capabilities.MutatesData {
+			// Copy the data only if it is shared with other consumers.
+			td = td.Mutable()
+		}
+		err := recoverReadOnly(set.ID, func() error {
+			var err error
+			td, err = tracesFunc(ctx, td)
+			return err
+		})
 		span.AddEvent("End processing.", eventOptions)
 		if err != nil {
 			if errors.Is(err, ErrSkipProcessingData) {
@@ -14,7 +14,9 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor/processortest"
 )
@@ -26,6 +28,7 @@ func TestNewTracesProcessor(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.True(t, tp.Capabilities().MutatesData)
+	assert.True(t, tp.Capabilities().CopiesReadOnlyData)
 	assert.NoError(t, tp.Start(context.Background(), componenttest.NewNopHost()))
 	assert.NoError(t, tp.ConsumeTraces(context.Background(), ptrace.NewTraces()))
 	assert.NoError(t, tp.Shutdown(context.Background()))
@@ -42,6 +45,7 @@ func TestNewTracesProcessor_WithOptions(t *testing.T) {
 	assert.Equal(t, want, tp.Start(context.Background(), componenttest.NewNopHost()))
 	assert.Equal(t, want, tp.Shutdown(context.Background()))
 	assert.False(t, tp.Capabilities().MutatesData)
+	assert.False(t, tp.Capabilities().CopiesReadOnlyData)
 }
 
 func TestNewTracesProcessor_NilRequiredFields(t *testing.T) {
@@ -65,6 +69,32 @@ func TestNewTracesProcessor_ProcessTracesErrSkipProcessingData(t *testing.T) {
 	assert.Equal(t, nil, tp.ConsumeTraces(context.Background(), ptrace.NewTraces()))
 }
 
+func TestNewTracesProcessor_ReadOnlyData(t *testing.T) {
+	mutate := func(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+		td.ResourceSpans().AppendEmpty()
+		return td, nil
+	}
+	sink := new(consumertest.TracesSink)
+	td := ptrace.NewTraces()
+	td.MarkReadOnly()
+
+	// A mutating processor gets a copy of the read-only data.
+	p, err := NewTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), &testTracesCfg, sink, mutate)
+	require.NoError(t, err)
+	assert.NoError(t, p.ConsumeTraces(context.Background(), td))
+	assert.Equal(t, 0, td.ResourceSpans().Len())
+	require.Len(t, sink.AllTraces(), 1)
+	assert.Equal(t, 1, sink.AllTraces()[0].ResourceSpans().Len())
+
+	// A processor that declares not mutating the data returns an error instead of panicking.
+	p, err = NewTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), &testTracesCfg, sink, mutate,
+		WithCapabilities(consumer.Capabilities{MutatesData: false}))
+	require.NoError(t, err)
+	err = p.ConsumeTraces(context.Background(), td)
+	assert.ErrorIs(t, err, pcommon.ErrReadOnly)
+	assert.True(t, consumererror.IsPermanent(err))
+}
+
 func newTestTProcessor(retError error) ProcessTracesFunc {
 	return func(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
 		return td, retError
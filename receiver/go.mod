@@ -8,6 +8,7 @@ require (
 	go.opentelemetry.io/collector/component v0.93.0
 	go.opentelemetry.io/collector/config/configtelemetry v0.93.0
 	go.opentelemetry.io/collector/consumer v0.93.0
+	go.opentelemetry.io/collector/extension v0.93.0
 	go.opentelemetry.io/collector/pdata v1.0.1
 	go.opentelemetry.io/otel v1.22.0
 	go.opentelemetry.io/otel/metric v1.22.0
@@ -67,3 +68,5 @@ replace go.opentelemetry.io/collector/pdata => ../pdata
 retract v0.76.0 // Depends on retracted pdata v1.0.0-rc10 module
 
 replace go.opentelemetry.io/collector/config/configtelemetry => ../config/configtelemetry
+
+replace go.opentelemetry.io/collector/extension => ../extension
@@ -5,13 +5,19 @@ package otlpreceiver // import "go.opentelemetry.io/collector/receiver/otlprecei
 
 import (
 	"bytes"
+	"io"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
 	spb "google.golang.org/genproto/googleapis/rpc/status"
 
+	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 )
 
@@ -20,6 +26,13 @@ const (
 	jsonContentType = "application/json"
 )
 
+// lazyProtoDecodingFeatureGate controls whether the OTLP/HTTP protobuf requests are decoded on first access.
+var lazyProtoDecodingFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"receiver.otlp.lazyProtoDecoding",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("When enabled, the OTLP receiver decodes the OTLP/HTTP protobuf requests on first access, "+
+		"so that pipelines only forwarding the data do not decode and encode it again."))
+
 var (
 	pbEncoder       = &protoEncoder{}
 	jsEncoder       = &jsonEncoder{}
@@ -27,13 +40,15 @@ var (
 )
 
 type encoder interface {
-	unmarshalTracesRequest(buf []byte) (ptraceotlp.ExportRequest, error)
-	unmarshalMetricsRequest(buf []byte) (pmetricotlp.ExportRequest, error)
-	unmarshalLogsRequest(buf []byte) (plogotlp.ExportRequest, error)
+	unmarshalTracesRequest(body io.Reader) (ptraceotlp.ExportRequest, error)
+	unmarshalMetricsRequest(body io.Reader) (pmetricotlp.ExportRequest, error)
+	unmarshalLogsRequest(body io.Reader) (plogotlp.ExportRequest, error)
+	unmarshalProfilesRequest(body io.Reader) (pprofileotlp.ExportRequest, error)
 
 	marshalTracesResponse(ptraceotlp.ExportResponse) ([]byte, error)
 	marshalMetricsResponse(pmetricotlp.ExportResponse) ([]byte, error)
 	marshalLogsResponse(plogotlp.ExportResponse) ([]byte, error)
+	marshalProfilesResponse(pprofileotlp.ExportResponse) ([]byte, error)
 
 	marshalStatus(rsp *spb.Status) ([]byte, error)
 
@@ -42,21 +57,55 @@ type encoder interface {
 
 type protoEncoder struct{}
 
-func (protoEncoder) unmarshalTracesRequest(buf []byte) (ptraceotlp.ExportRequest, error) {
+func (protoEncoder) unmarshalTracesRequest(body io.Reader) (ptraceotlp.ExportRequest, error) {
+	buf, err := io.ReadAll(body)
+	if err != nil {
+		return ptraceotlp.NewExportRequest(), err
+	}
+	if lazyProtoDecodingFeatureGate.IsEnabled() {
+		data, err := (&ptrace.LazyProtoUnmarshaler{}).UnmarshalTraces(buf)
+		return ptraceotlp.NewExportRequestFromTraces(data), err
+	}
 	req := ptraceotlp.NewExportRequest()
-	err := req.UnmarshalProto(buf)
+	err = req.UnmarshalProto(buf)
 	return req, err
 }
 
-func (protoEncoder) unmarshalMetricsRequest(buf []byte) (pmetricotlp.ExportRequest, error) {
+func (protoEncoder) unmarshalMetricsRequest(body io.Reader) (pmetricotlp.ExportRequest, error) {
+	buf, err := io.ReadAll(body)
+	if err != nil {
+		return pmetricotlp.NewExportRequest(), err
+	}
+	if lazyProtoDecodingFeatureGate.IsEnabled() {
+		data, err := (&pmetric.LazyProtoUnmarshaler{}).UnmarshalMetrics(buf)
+		return pmetricotlp.NewExportRequestFromMetrics(data), err
+	}
 	req := pmetricotlp.NewExportRequest()
-	err := req.UnmarshalProto(buf)
+	err = req.UnmarshalProto(buf)
 	return req, err
 }
 
-func (protoEncoder) unmarshalLogsRequest(buf []byte) (plogotlp.ExportRequest, error) {
+func (protoEncoder) unmarshalLogsRequest(body io.Reader) (plogotlp.ExportRequest, error) {
+	buf, err := io.ReadAll(body)
+	if err != nil {
+		return plogotlp.NewExportRequest(), err
+	}
+	if lazyProtoDecodingFeatureGate.IsEnabled() {
+		data, err := (&plog.LazyProtoUnmarshaler{}).UnmarshalLogs(buf)
+		return plogotlp.NewExportRequestFromLogs(data), err
+	}
 	req := plogotlp.NewExportRequest()
-	err := req.UnmarshalProto(buf)
+	err = req.UnmarshalProto(buf)
+	return req, err
+}
+
+func (protoEncoder) unmarshalProfilesRequest(body io.Reader) (pprofileotlp.ExportRequest, error) {
+	buf, err := io.ReadAll(body)
+	if err != nil {
+		return pprofileotlp.NewExportRequest(), err
+	}
+	req := pprofileotlp.NewExportRequest()
+	err = req.UnmarshalProto(buf)
 	return req, err
 }
 
@@ -72,6 +121,10 @@ func (protoEncoder) marshalLogsResponse(resp plogotlp.ExportResponse) ([]byte, e
 	return resp.MarshalProto()
 }
 
+func (protoEncoder) marshalProfilesResponse(resp pprofileotlp.ExportResponse) ([]byte, error) {
+	return resp.MarshalProto()
+}
+
 func (protoEncoder) marshalStatus(resp *spb.Status) ([]byte, error) {
 	return proto.Marshal(resp)
 }
@@ -82,21 +135,27 @@ func (protoEncoder) contentType() string {
 
 type jsonEncoder struct{}
 
-func (jsonEncoder) unmarshalTracesRequest(buf []byte) (ptraceotlp.ExportRequest, error) {
+func (jsonEncoder) unmarshalTracesRequest(body io.Reader) (ptraceotlp.ExportRequest, error) {
 	req := ptraceotlp.NewExportRequest()
-	err := req.UnmarshalJSON(buf)
+	err := req.UnmarshalJSONFrom(body)
 	return req, err
 }
 
-func (jsonEncoder) unmarshalMetricsRequest(buf []byte) (pmetricotlp.ExportRequest, error) {
+func (jsonEncoder) unmarshalMetricsRequest(body io.Reader) (pmetricotlp.ExportRequest, error) {
 	req := pmetricotlp.NewExportRequest()
-	err := req.UnmarshalJSON(buf)
+	err := req.UnmarshalJSONFrom(body)
 	return req, err
 }
 
-func (jsonEncoder) unmarshalLogsRequest(buf []byte) (plogotlp.ExportRequest, error) {
+func (jsonEncoder) unmarshalLogsRequest(body io.Reader) (plogotlp.ExportRequest, error) {
 	req := plogotlp.NewExportRequest()
-	err := req.UnmarshalJSON(buf)
+	err := req.UnmarshalJSONFrom(body)
+	return req, err
+}
+
+func (jsonEncoder) unmarshalProfilesRequest(body io.Reader) (pprofileotlp.ExportRequest, error) {
+	req := pprofileotlp.NewExportRequest()
+	err := req.UnmarshalJSONFrom(body)
 	return req, err
 }
 
@@ -112,6 +171,10 @@ func (jsonEncoder) marshalLogsResponse(resp plogotlp.ExportResponse) ([]byte, er
 	return resp.MarshalJSON()
 }
 
+func (jsonEncoder) marshalProfilesResponse(resp pprofileotlp.ExportResponse) ([]byte, error) {
+	return resp.MarshalJSON()
+}
+
 func (jsonEncoder) marshalStatus(resp *spb.Status) ([]byte, error) {
 	buf := new(bytes.Buffer)
 	err := jsonPbMarshaler.Marshal(buf, resp)
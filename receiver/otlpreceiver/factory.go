@@ -23,9 +23,10 @@ const (
 	defaultGRPCEndpoint = "0.0.0.0:4317"
 	defaultHTTPEndpoint = "0.0.0.0:4318"
 
-	defaultTracesURLPath  = "/v1/traces"
-	defaultMetricsURLPath = "/v1/metrics"
-	defaultLogsURLPath    = "/v1/logs"
+	defaultTracesURLPath   = "/v1/traces"
+	defaultMetricsURLPath  = "/v1/metrics"
+	defaultLogsURLPath     = "/v1/logs"
+	defaultProfilesURLPath = "/v1experimental/profiles"
 )
 
 // NewFactory creates a new OTLP receiver factory.
@@ -36,6 +37,7 @@ func NewFactory() receiver.Factory {
 		receiver.WithTraces(createTraces, metadata.TracesStability),
 		receiver.WithMetrics(createMetrics, metadata.MetricsStability),
 		receiver.WithLogs(createLog, metadata.LogsStability),
+		receiver.WithProfiles(createProfiles, metadata.ProfilesStability),
 	)
 }
 
@@ -55,9 +57,10 @@ func createDefaultConfig() component.Config {
 				HTTPServerConfig: &confighttp.HTTPServerConfig{
 					Endpoint: localhostgate.EndpointForPort(httpPort),
 				},
-				TracesURLPath:  defaultTracesURLPath,
-				MetricsURLPath: defaultMetricsURLPath,
-				LogsURLPath:    defaultLogsURLPath,
+				TracesURLPath:   defaultTracesURLPath,
+				MetricsURLPath:  defaultMetricsURLPath,
+				LogsURLPath:     defaultLogsURLPath,
+				ProfilesURLPath: defaultProfilesURLPath,
 			},
 		},
 	}
@@ -138,6 +141,31 @@ func createLog(
 	return r, nil
 }
 
+// createProfiles creates a profiles receiver based on provided config.
+func createProfiles(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	consumer consumer.Profiles,
+) (receiver.Profiles, error) {
+	oCfg := cfg.(*Config)
+	r, err := receivers.LoadOrStore(
+		oCfg,
+		func() (*otlpReceiver, error) {
+			return newOtlpReceiver(oCfg, &set)
+		},
+		&set.TelemetrySettings,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.Unwrap().registerProfilesConsumer(consumer); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
 // This is the map of already created OTLP receivers for particular configurations.
 // We maintain this map because the Factory is asked trace and metric receivers separately
 // when it gets CreateTracesReceiver() and CreateMetricsReceiver() but they must not
@@ -378,3 +378,83 @@ func TestCreateLogReceiver(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateProfilesReceiver(t *testing.T) {
+	factory := NewFactory()
+	defaultGRPCSettings := &configgrpc.GRPCServerSettings{
+		NetAddr: confignet.NetAddr{
+			Endpoint:  testutil.GetAvailableLocalAddress(t),
+			Transport: "tcp",
+		},
+	}
+	defaultHTTPSettings := &HTTPConfig{
+		HTTPServerConfig: &confighttp.HTTPServerConfig{
+			Endpoint: testutil.GetAvailableLocalAddress(t),
+		},
+		ProfilesURLPath: defaultProfilesURLPath,
+	}
+
+	tests := []struct {
+		name         string
+		cfg          *Config
+		wantStartErr bool
+		wantErr      bool
+		sink         consumer.Profiles
+	}{
+		{
+			name: "default",
+			cfg: &Config{
+				Protocols: Protocols{
+					GRPC: defaultGRPCSettings,
+					HTTP: defaultHTTPSettings,
+				},
+			},
+			sink: consumertest.NewNop(),
+		},
+		{
+			name: "invalid_http_address",
+			cfg: &Config{
+				Protocols: Protocols{
+					GRPC: defaultGRPCSettings,
+					HTTP: &HTTPConfig{
+						HTTPServerConfig: &confighttp.HTTPServerConfig{
+							Endpoint: "327.0.0.1:1122",
+						},
+						ProfilesURLPath: defaultProfilesURLPath,
+					},
+				},
+			},
+			wantStartErr: true,
+			sink:         consumertest.NewNop(),
+		},
+		{
+			name: "no_next_consumer",
+			cfg: &Config{
+				Protocols: Protocols{
+					GRPC: defaultGRPCSettings,
+				},
+			},
+			wantErr: true,
+			sink:    nil,
+		},
+	}
+	ctx := context.Background()
+	creationSet := receivertest.NewNopCreateSettings()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr, err := factory.CreateProfilesReceiver(ctx, creationSet, tt.cfg, tt.sink)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if tt.wantStartErr {
+				assert.Error(t, pr.Start(context.Background(), componenttest.NewNopHost()))
+				assert.NoError(t, pr.Shutdown(context.Background()))
+			} else {
+				require.NoError(t, pr.Start(context.Background(), componenttest.NewNopHost()))
+				assert.NoError(t, pr.Shutdown(context.Background()))
+			}
+		})
+	}
+}
@@ -4,10 +4,13 @@
 package errors // import "go.opentelemetry.io/collector/receiver/otlpreceiver/internal/errors"
 
 import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
 )
 
 func GetStatusFromError(err error) error {
@@ -20,6 +23,24 @@ func GetStatusFromError(err error) error {
 			code = codes.InvalidArgument
 		}
 		s = status.New(code, err.Error())
+		// The throttled requests are retried by the clients after the delay of the RetryInfo.
+		// https://github.com/open-telemetry/opentelemetry-proto/blob/main/docs/specification.md#otlpgrpc-throttling
+		if retryAfter, ok := receiverhelper.RetryAfter(err); ok {
+			if withDetails, detailsErr := status.New(codes.ResourceExhausted, err.Error()).WithDetails(
+				&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); detailsErr == nil {
+				s = withDetails
+			}
+		}
 	}
 	return s.Err()
 }
+
+// GetRetryInfo returns the RetryInfo of the status, if any.
+func GetRetryInfo(s *status.Status) *errdetails.RetryInfo {
+	for _, detail := range s.Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok {
+			return retryInfo
+		}
+	}
+	return nil
+}
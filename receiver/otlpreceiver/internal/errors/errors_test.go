@@ -6,12 +6,15 @@ package errors // import "go.opentelemetry.io/collector/receiver/otlpreceiver/in
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
 )
 
 func Test_GetStatusFromError(t *testing.T) {
@@ -43,3 +46,16 @@ func Test_GetStatusFromError(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetStatusFromRetryableError(t *testing.T) {
+	err := receiverhelper.NewRetryableError(fmt.Errorf("test"), 2*time.Second)
+	s, ok := status.FromError(GetStatusFromError(err))
+	require.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, s.Code())
+	assert.Equal(t, err.Error(), s.Message())
+	retryInfo := GetRetryInfo(s)
+	require.NotNil(t, retryInfo)
+	assert.Equal(t, 2*time.Second, retryInfo.GetRetryDelay().AsDuration())
+
+	assert.Nil(t, GetRetryInfo(status.New(codes.Unavailable, "test")))
+}
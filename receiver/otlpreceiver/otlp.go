@@ -18,10 +18,12 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/logs"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/metrics"
+	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/profiles"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/trace"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
 )
@@ -32,10 +34,11 @@ type otlpReceiver struct {
 	serverGRPC *grpc.Server
 	serverHTTP *http.Server
 
-	nextTraces  consumer.Traces
-	nextMetrics consumer.Metrics
-	nextLogs    consumer.Logs
-	shutdownWG  sync.WaitGroup
+	nextTraces   consumer.Traces
+	nextMetrics  consumer.Metrics
+	nextLogs     consumer.Logs
+	nextProfiles consumer.Profiles
+	shutdownWG   sync.WaitGroup
 
 	obsrepGRPC *receiverhelper.ObsReport
 	obsrepHTTP *receiverhelper.ObsReport
@@ -48,11 +51,12 @@ type otlpReceiver struct {
 // as the various Stop*Reception methods to end it.
 func newOtlpReceiver(cfg *Config, set *receiver.CreateSettings) (*otlpReceiver, error) {
 	r := &otlpReceiver{
-		cfg:         cfg,
-		nextTraces:  nil,
-		nextMetrics: nil,
-		nextLogs:    nil,
-		settings:    set,
+		cfg:          cfg,
+		nextTraces:   nil,
+		nextMetrics:  nil,
+		nextLogs:     nil,
+		nextProfiles: nil,
+		settings:     set,
 	}
 
 	var err error
@@ -99,6 +103,10 @@ func (r *otlpReceiver) startGRPCServer(host component.Host) error {
 		plogotlp.RegisterGRPCServer(r.serverGRPC, logs.New(r.nextLogs, r.obsrepGRPC))
 	}
 
+	if r.nextProfiles != nil {
+		pprofileotlp.RegisterGRPCServer(r.serverGRPC, profiles.New(r.nextProfiles, r.obsrepGRPC))
+	}
+
 	r.settings.Logger.Info("Starting GRPC server", zap.String("endpoint", r.cfg.GRPC.NetAddr.Endpoint))
 	var gln net.Listener
 	if gln, err = r.cfg.GRPC.ToListenerContext(context.Background()); err != nil {
@@ -144,6 +152,13 @@ func (r *otlpReceiver) startHTTPServer(host component.Host) error {
 		})
 	}
 
+	if r.nextProfiles != nil {
+		httpProfilesReceiver := profiles.New(r.nextProfiles, r.obsrepHTTP)
+		httpMux.HandleFunc(r.cfg.HTTP.ProfilesURLPath, func(resp http.ResponseWriter, req *http.Request) {
+			handleProfiles(resp, req, httpProfilesReceiver)
+		})
+	}
+
 	var err error
 	if r.serverHTTP, err = r.cfg.HTTP.ToServer(host, r.settings.TelemetrySettings, httpMux, confighttp.WithErrorHandler(errorHandler)); err != nil {
 		return err
@@ -218,3 +233,11 @@ func (r *otlpReceiver) registerLogsConsumer(lc consumer.Logs) error {
 	r.nextLogs = lc
 	return nil
 }
+
+func (r *otlpReceiver) registerProfilesConsumer(pc consumer.Profiles) error {
+	if pc == nil {
+		return component.ErrNilNextConsumer
+	}
+	r.nextProfiles = pc
+	return nil
+}
@@ -37,12 +37,17 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/internal/testdata"
 	"go.opentelemetry.io/collector/internal/testutil"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogtest"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetrictest"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptracetest"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
@@ -365,6 +370,35 @@ func TestProtoHttp(t *testing.T) {
 	}
 }
 
+func TestProtoHttpLazyDecoding(t *testing.T) {
+	require.NoError(t, featuregate.GlobalRegistry().Set(lazyProtoDecodingFeatureGate.ID(), true))
+	t.Cleanup(func() {
+		require.NoError(t, featuregate.GlobalRegistry().Set(lazyProtoDecodingFeatureGate.ID(), false))
+	})
+	addr := testutil.GetAvailableLocalAddress(t)
+	sink := newErrOrSinkConsumer()
+	recv := newHTTPReceiver(t, componenttest.NewNopTelemetrySettings(), addr, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+	for _, dr := range generateDataRequests(t) {
+		sink.Reset()
+		doHTTPRequest(t, "http://"+addr+dr.path, "", "application/x-protobuf", dr.protoBytes, false)
+		// The received data holds its lazy decoding state, so it is compared by content.
+		switch data := dr.data.(type) {
+		case ptrace.Traces:
+			require.Len(t, sink.AllTraces(), 1)
+			assert.NoError(t, ptracetest.CompareTraces(data, sink.AllTraces()[0]))
+		case pmetric.Metrics:
+			require.Len(t, sink.AllMetrics(), 1)
+			assert.NoError(t, pmetrictest.CompareMetrics(data, sink.AllMetrics()[0]))
+		case plog.Logs:
+			require.Len(t, sink.AllLogs(), 1)
+			assert.NoError(t, plogtest.CompareLogs(data, sink.AllLogs()[0]))
+		}
+	}
+}
+
 func TestOTLPReceiverInvalidContentEncoding(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -630,6 +664,36 @@ func TestOTLPReceiverHTTPTracesIngestTest(t *testing.T) {
 	require.NoError(t, tt.CheckReceiverTraces("http", int64(expectedReceivedBatches), int64(expectedIngestionBlockedRPCs)))
 }
 
+// TestOTLPReceiverHTTPTracesThrottled checks that the retryable errors of the next consumer are
+// returned with the Retry-After header.
+func TestOTLPReceiverHTTPTracesThrottled(t *testing.T) {
+	addr := testutil.GetAvailableLocalAddress(t)
+	sink := &errOrSinkConsumer{TracesSink: new(consumertest.TracesSink)}
+	sink.SetConsumeError(receiverhelper.NewRetryableError(errors.New("overloaded"), 1500*time.Millisecond))
+
+	recv := newHTTPReceiver(t, componenttest.NewNopTelemetrySettings(), addr, sink)
+	require.NotNil(t, recv)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+	pbBytes, err := (&ptrace.ProtoMarshaler{}).MarshalTraces(testdata.GenerateTraces(1))
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, "http://"+addr+defaultTracesURLPath, bytes.NewReader(pbBytes))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", pbContentType)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	respBytes, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "2", resp.Header.Get("Retry-After"))
+	errStatus := &spb.Status{}
+	require.NoError(t, proto.Unmarshal(respBytes, errStatus))
+	assert.Equal(t, codes.ResourceExhausted, codes.Code(errStatus.Code))
+}
+
 func TestGRPCInvalidTLSCredentials(t *testing.T) {
 	cfg := &Config{
 		Protocols: Protocols{
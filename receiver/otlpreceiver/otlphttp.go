@@ -5,16 +5,19 @@ package otlpreceiver // import "go.opentelemetry.io/collector/receiver/otlprecei
 
 import (
 	"fmt"
-	"io"
+	"math"
 	"mime"
 	"net/http"
+	"strconv"
 
 	spb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/errors"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/logs"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/metrics"
+	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/profiles"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/trace"
 )
 
@@ -29,13 +32,8 @@ func handleTraces(resp http.ResponseWriter, req *http.Request, tracesReceiver *t
 		return
 	}
 
-	body, ok := readAndCloseBody(resp, req, enc)
-	if !ok {
-		return
-	}
-
-	otlpReq, err := enc.unmarshalTracesRequest(body)
-	if err != nil {
+	otlpReq, err := enc.unmarshalTracesRequest(req.Body)
+	if err = closeBody(req, err); err != nil {
 		writeError(resp, enc, err, http.StatusBadRequest)
 		return
 	}
@@ -60,13 +58,8 @@ func handleMetrics(resp http.ResponseWriter, req *http.Request, metricsReceiver
 		return
 	}
 
-	body, ok := readAndCloseBody(resp, req, enc)
-	if !ok {
-		return
-	}
-
-	otlpReq, err := enc.unmarshalMetricsRequest(body)
-	if err != nil {
+	otlpReq, err := enc.unmarshalMetricsRequest(req.Body)
+	if err = closeBody(req, err); err != nil {
 		writeError(resp, enc, err, http.StatusBadRequest)
 		return
 	}
@@ -91,24 +84,45 @@ func handleLogs(resp http.ResponseWriter, req *http.Request, logsReceiver *logs.
 		return
 	}
 
-	body, ok := readAndCloseBody(resp, req, enc)
-	if !ok {
+	otlpReq, err := enc.unmarshalLogsRequest(req.Body)
+	if err = closeBody(req, err); err != nil {
+		writeError(resp, enc, err, http.StatusBadRequest)
 		return
 	}
 
-	otlpReq, err := enc.unmarshalLogsRequest(body)
+	otlpResp, err := logsReceiver.Export(req.Context(), otlpReq)
+	if err != nil {
+		writeError(resp, enc, err, http.StatusInternalServerError)
+		return
+	}
+
+	msg, err := enc.marshalLogsResponse(otlpResp)
 	if err != nil {
+		writeError(resp, enc, err, http.StatusInternalServerError)
+		return
+	}
+	writeResponse(resp, enc.contentType(), http.StatusOK, msg)
+}
+
+func handleProfiles(resp http.ResponseWriter, req *http.Request, profilesReceiver *profiles.Receiver) {
+	enc, ok := readContentType(resp, req)
+	if !ok {
+		return
+	}
+
+	otlpReq, err := enc.unmarshalProfilesRequest(req.Body)
+	if err = closeBody(req, err); err != nil {
 		writeError(resp, enc, err, http.StatusBadRequest)
 		return
 	}
 
-	otlpResp, err := logsReceiver.Export(req.Context(), otlpReq)
+	otlpResp, err := profilesReceiver.Export(req.Context(), otlpReq)
 	if err != nil {
 		writeError(resp, enc, err, http.StatusInternalServerError)
 		return
 	}
 
-	msg, err := enc.marshalLogsResponse(otlpResp)
+	msg, err := enc.marshalProfilesResponse(otlpResp)
 	if err != nil {
 		writeError(resp, enc, err, http.StatusInternalServerError)
 		return
@@ -133,17 +147,13 @@ func readContentType(resp http.ResponseWriter, req *http.Request) (encoder, bool
 	}
 }
 
-func readAndCloseBody(resp http.ResponseWriter, req *http.Request, enc encoder) ([]byte, bool) {
-	body, err := io.ReadAll(req.Body)
-	if err != nil {
-		writeError(resp, enc, err, http.StatusBadRequest)
-		return nil, false
-	}
-	if err = req.Body.Close(); err != nil {
-		writeError(resp, enc, err, http.StatusBadRequest)
-		return nil, false
+// closeBody closes the body of the request once it was read and unmarshaled with the given error. The body is
+// unmarshaled while it is read, without reading it whole first, if the encoding allows it.
+func closeBody(req *http.Request, err error) error {
+	if closeErr := req.Body.Close(); err == nil {
+		err = closeErr
 	}
-	return body, true
+	return err
 }
 
 // writeError encodes the HTTP error inside a rpc.Status message as required by the OTLP protocol.
@@ -151,6 +161,11 @@ func writeError(w http.ResponseWriter, encoder encoder, err error, statusCode in
 	s, ok := status.FromError(err)
 	if !ok {
 		s = errorMsgToStatus(err.Error(), statusCode)
+	} else if retryInfo := errors.GetRetryInfo(s); retryInfo != nil {
+		// The throttled requests are retried by the clients after the delay of the Retry-After header.
+		// https://github.com/open-telemetry/opentelemetry-proto/blob/main/docs/specification.md#otlphttp-throttling
+		statusCode = http.StatusTooManyRequests
+		w.Header().Set("Retry-After", strconv.FormatInt(int64(math.Ceil(retryInfo.GetRetryDelay().AsDuration().Seconds())), 10))
 	}
 	writeStatusResponse(w, encoder, statusCode, s.Proto())
 }
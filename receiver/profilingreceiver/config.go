@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profilingreceiver // import "go.opentelemetry.io/collector/receiver/profilingreceiver"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config has the configuration of the profiling receiver.
+type Config struct {
+	// Extension is the ID of the profiling extension the captured profiles are received from.
+	Extension component.ID `mapstructure:"extension"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Extension.Type() == "" {
+		return errors.New("extension must be set")
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profilingreceiver // import "go.opentelemetry.io/collector/receiver/profilingreceiver"
+
+//go:generate mdatagen metadata.yaml
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/profilingreceiver/internal/metadata"
+)
+
+// NewFactory creates a factory for the profiling receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithProfiles(createProfiles, metadata.ProfilesStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{}
+}
+
+func createProfiles(_ context.Context, set receiver.CreateSettings, cfg component.Config, next consumer.Profiles) (receiver.Profiles, error) {
+	return newProfilingReceiver(cfg.(*Config), set, next)
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profilingreceiver // import "go.opentelemetry.io/collector/receiver/profilingreceiver"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/profiling"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+)
+
+const (
+	transport = "http"
+	format    = "pprof"
+)
+
+// profilingReceiver receives the profiles captured by a profiling extension, by registering
+// itself as a consumer of the extension.
+type profilingReceiver struct {
+	config *Config
+	id     component.ID
+	next   consumer.Profiles
+	obsrep *receiverhelper.ObsReport
+
+	unregister func()
+}
+
+var _ consumer.Profiles = (*profilingReceiver)(nil)
+
+func newProfilingReceiver(config *Config, set receiver.CreateSettings, next consumer.Profiles) (*profilingReceiver, error) {
+	obsrep, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &profilingReceiver{
+		config: config,
+		id:     set.ID,
+		next:   next,
+		obsrep: obsrep,
+	}, nil
+}
+
+func (r *profilingReceiver) Start(_ context.Context, host component.Host) error {
+	ext, ok := host.GetExtensions()[r.config.Extension]
+	if !ok {
+		return fmt.Errorf("profiling extension %q not found", r.config.Extension)
+	}
+	pe, ok := ext.(profiling.Extension)
+	if !ok {
+		return fmt.Errorf("extension %q is not a profiling extension", r.config.Extension)
+	}
+	r.unregister = pe.RegisterConsumer(r.id, r)
+	return nil
+}
+
+func (r *profilingReceiver) Shutdown(context.Context) error {
+	if r.unregister != nil {
+		r.unregister()
+	}
+	return nil
+}
+
+func (r *profilingReceiver) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeProfiles sends the profiles captured by the extension through the pipeline.
+func (r *profilingReceiver) ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	ctx = r.obsrep.StartProfilesOp(ctx)
+	err := r.next.ConsumeProfiles(ctx, pd)
+	r.obsrep.EndProfilesOp(ctx, format, pd.SampleCount(), err)
+	return err
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profilingreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+// fakeProfiling is a profiling extension recording the registered consumers.
+type fakeProfiling struct {
+	component.StartFunc
+	component.ShutdownFunc
+	consumers map[component.ID]consumer.Profiles
+}
+
+func (f *fakeProfiling) RegisterConsumer(id component.ID, next consumer.Profiles) func() {
+	f.consumers[id] = next
+	return func() { delete(f.consumers, id) }
+}
+
+type nopExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+// extensionsHost is a host providing extensions.
+type extensionsHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h extensionsHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func TestReceiveProfiles(t *testing.T) {
+	ext := &fakeProfiling{consumers: map[component.ID]consumer.Profiles{}}
+	extID := component.NewIDWithName("profiling", "debug")
+	host := extensionsHost{Host: componenttest.NewNopHost(), extensions: map[component.ID]component.Component{extID: ext}}
+
+	sink := new(consumertest.ProfilesSink)
+	set := receivertest.NewNopCreateSettings()
+	set.ID = component.NewID("profiling")
+	rcv, err := NewFactory().CreateProfilesReceiver(context.Background(), set, &Config{Extension: extID}, sink)
+	require.NoError(t, err)
+	require.NoError(t, rcv.Start(context.Background(), host))
+	require.Contains(t, ext.consumers, set.ID)
+
+	pd := pprofile.NewProfiles()
+	pd.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty().Profile().Sample().AppendEmpty()
+	require.NoError(t, ext.consumers[set.ID].ConsumeProfiles(context.Background(), pd))
+	require.Len(t, sink.AllProfiles(), 1)
+	assert.Equal(t, 1, sink.AllProfiles()[0].SampleCount())
+
+	require.NoError(t, rcv.Shutdown(context.Background()))
+	assert.Empty(t, ext.consumers)
+}
+
+func TestExtensionNotFound(t *testing.T) {
+	extID := component.NewID("profiling")
+	rcv, err := newProfilingReceiver(&Config{Extension: extID}, receivertest.NewNopCreateSettings(), consumertest.NewNop())
+	require.NoError(t, err)
+	assert.EqualError(t, rcv.Start(context.Background(), componenttest.NewNopHost()), `profiling extension "profiling" not found`)
+	assert.NoError(t, rcv.Shutdown(context.Background()))
+
+	host := extensionsHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{extID: &nopExtension{}},
+	}
+	assert.EqualError(t, rcv.Start(context.Background(), host), `extension "profiling" is not a profiling extension`)
+}
@@ -0,0 +1,209 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receiverhelper // import "go.opentelemetry.io/collector/receiver/receiverhelper"
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/config/configtelemetry"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/internal/obsreportconfig/obsmetrics"
+)
+
+const (
+	reasonTooLarge   = "too_large"
+	reasonOverloaded = "overloaded"
+)
+
+var errTooManyWaiters = errors.New("too many requests waiting for admission")
+
+// AdmissionSettings defines the settings of the admission of the requests of a push receiver,
+// which bounds the memory used by the requests being processed.
+type AdmissionSettings struct {
+	// MaxInFlightBytes is the maximum number of bytes of the requests being processed at the
+	// same time. The requests are admitted in the order they arrive when enough bytes are
+	// released. No limit if 0.
+	MaxInFlightBytes int64 `mapstructure:"max_inflight_bytes"`
+
+	// MaxWaiters is the maximum number of requests waiting for admission. The requests which
+	// arrive when MaxWaiters requests are waiting are rejected immediately.
+	MaxWaiters int `mapstructure:"max_waiters"`
+
+	// RetryAfter is the delay after which the clients are told to retry the rejected requests.
+	RetryAfter time.Duration `mapstructure:"retry_after"`
+}
+
+// NewDefaultAdmissionSettings returns the default settings for AdmissionSettings.
+func NewDefaultAdmissionSettings() AdmissionSettings {
+	return AdmissionSettings{
+		MaxInFlightBytes: 0,
+		MaxWaiters:       100,
+		RetryAfter:       time.Second,
+	}
+}
+
+// Validate checks if the AdmissionSettings configuration is valid.
+func (as *AdmissionSettings) Validate() error {
+	if as.MaxInFlightBytes < 0 {
+		return errors.New("max_inflight_bytes must not be negative")
+	}
+	if as.MaxWaiters < 0 {
+		return errors.New("max_waiters must not be negative")
+	}
+	if as.RetryAfter < 0 {
+		return errors.New("retry_after must not be negative")
+	}
+	return nil
+}
+
+// AdmissionController admits the requests of a push receiver within a budget of in-flight bytes,
+// so that the receiver applies backpressure to its clients instead of buffering an unbounded
+// amount of data. The rejected requests are retryable, with the RetryAfter delay as hint, except
+// the ones which could never be admitted.
+type AdmissionController struct {
+	set    AdmissionSettings
+	obsrep *ObsReport
+
+	inFlightBytes    metric.Int64UpDownCounter
+	rejectedRequests metric.Int64Counter
+
+	mu       sync.Mutex
+	inFlight int64
+	// waiters are the *admissionWaiter waiting for admission, in their order of arrival.
+	waiters list.List
+}
+
+type admissionWaiter struct {
+	size int64
+	// ready is closed once the bytes of the waiter are acquired.
+	ready chan struct{}
+}
+
+// NewAdmissionController returns an AdmissionController with the given settings, which records
+// its metrics with the attributes of the ObsReport of the receiver.
+func NewAdmissionController(set AdmissionSettings, obsrep *ObsReport) (*AdmissionController, error) {
+	ac := &AdmissionController{set: set, obsrep: obsrep}
+	var errs, err error
+	ac.inFlightBytes, err = obsrep.meter.Int64UpDownCounter(
+		obsmetrics.ReceiverPrefix+obsmetrics.InFlightBytesKey,
+		metric.WithDescription("Number of bytes of the requests being processed by the receiver."),
+		metric.WithUnit("By"),
+	)
+	errs = multierr.Append(errs, err)
+
+	ac.rejectedRequests, err = obsrep.meter.Int64Counter(
+		obsmetrics.ReceiverPrefix+obsmetrics.RejectedRequestsKey,
+		metric.WithDescription("Number of requests rejected by the receiver before being processed, by reason."),
+		metric.WithUnit("1"),
+	)
+	errs = multierr.Append(errs, err)
+	if errs != nil {
+		return nil, errs
+	}
+	return ac, nil
+}
+
+// Acquire waits until the request of the given size in bytes is admitted, and returns the function
+// releasing its bytes, which must be called once the request is processed. It returns an error
+// built with NewRetryableError if the request is rejected because too many requests are waiting,
+// or if ctx is done before the request is admitted, and a permanent error if the request is
+// larger than MaxInFlightBytes.
+func (ac *AdmissionController) Acquire(ctx context.Context, size int64) (func(), error) {
+	if ac.set.MaxInFlightBytes > 0 && size > ac.set.MaxInFlightBytes {
+		ac.reject(ctx, reasonTooLarge)
+		return nil, consumererror.NewPermanent(fmt.Errorf("request of %d bytes exceeds the limit of %d in-flight bytes", size, ac.set.MaxInFlightBytes))
+	}
+
+	ac.mu.Lock()
+	if ac.set.MaxInFlightBytes == 0 || (ac.waiters.Len() == 0 && ac.inFlight+size <= ac.set.MaxInFlightBytes) {
+		ac.inFlight += size
+		ac.mu.Unlock()
+		return ac.admitted(ctx, size), nil
+	}
+	if ac.waiters.Len() >= ac.set.MaxWaiters {
+		ac.mu.Unlock()
+		ac.reject(ctx, reasonOverloaded)
+		return nil, NewRetryableError(errTooManyWaiters, ac.set.RetryAfter)
+	}
+	waiter := &admissionWaiter{size: size, ready: make(chan struct{})}
+	elem := ac.waiters.PushBack(waiter)
+	ac.mu.Unlock()
+
+	select {
+	case <-waiter.ready:
+		return ac.admitted(ctx, size), nil
+	case <-ctx.Done():
+	}
+
+	ac.mu.Lock()
+	select {
+	case <-waiter.ready:
+		// The request was admitted while ctx was done, release its bytes.
+		ac.inFlight -= size
+	default:
+		ac.waiters.Remove(elem)
+	}
+	// The next waiters may be admitted without this one.
+	ac.notifyWaiters()
+	ac.mu.Unlock()
+	ac.reject(ctx, reasonOverloaded)
+	return nil, NewRetryableError(fmt.Errorf("request not admitted: %w", ctx.Err()), ac.set.RetryAfter)
+}
+
+// admitted records the admission of the bytes of a request, and returns the function releasing them.
+func (ac *AdmissionController) admitted(ctx context.Context, size int64) func() {
+	ac.recordInFlight(ctx, size)
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ac.mu.Lock()
+			ac.inFlight -= size
+			ac.notifyWaiters()
+			ac.mu.Unlock()
+			ac.recordInFlight(context.Background(), -size)
+		})
+	}
+}
+
+// notifyWaiters admits the waiters, in their order of arrival, while their bytes fit in the budget.
+// It must be called with mu held.
+func (ac *AdmissionController) notifyWaiters() {
+	for {
+		front := ac.waiters.Front()
+		if front == nil {
+			return
+		}
+		waiter := front.Value.(*admissionWaiter)
+		if ac.inFlight+waiter.size > ac.set.MaxInFlightBytes {
+			return
+		}
+		ac.inFlight += waiter.size
+		ac.waiters.Remove(front)
+		close(waiter.ready)
+	}
+}
+
+func (ac *AdmissionController) recordInFlight(ctx context.Context, size int64) {
+	if ac.obsrep.level == configtelemetry.LevelNone {
+		return
+	}
+	ac.inFlightBytes.Add(ctx, size, metric.WithAttributes(ac.obsrep.otelAttrs...))
+}
+
+func (ac *AdmissionController) reject(ctx context.Context, reason string) {
+	if ac.obsrep.level == configtelemetry.LevelNone {
+		return
+	}
+	ac.rejectedRequests.Add(ctx, 1, metric.WithAttributes(
+		append([]attribute.KeyValue{attribute.String(obsmetrics.ReasonKey, reason)}, ac.obsrep.otelAttrs...)...))
+}
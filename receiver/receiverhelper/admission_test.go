@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receiverhelper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/receiver"
+)
+
+func TestAdmissionSettingsValidate(t *testing.T) {
+	set := NewDefaultAdmissionSettings()
+	assert.NoError(t, set.Validate())
+
+	set.MaxInFlightBytes = -1
+	assert.EqualError(t, set.Validate(), "max_inflight_bytes must not be negative")
+
+	set = NewDefaultAdmissionSettings()
+	set.MaxWaiters = -1
+	assert.EqualError(t, set.Validate(), "max_waiters must not be negative")
+
+	set = NewDefaultAdmissionSettings()
+	set.RetryAfter = -time.Second
+	assert.EqualError(t, set.Validate(), "retry_after must not be negative")
+}
+
+func TestAdmissionControllerNoLimit(t *testing.T) {
+	tt, ac := newTestAdmissionController(t, NewDefaultAdmissionSettings())
+
+	release1, err := ac.Acquire(context.Background(), 1<<30)
+	require.NoError(t, err)
+	release2, err := ac.Acquire(context.Background(), 1<<30)
+	require.NoError(t, err)
+	require.NoError(t, tt.CheckReceiverAdmission(transport, 2<<30, 0, 0))
+
+	release1()
+	release2()
+	// Releasing twice has no effect.
+	release2()
+	require.NoError(t, tt.CheckReceiverAdmission(transport, 0, 0, 0))
+}
+
+func TestAdmissionControllerTooLarge(t *testing.T) {
+	set := NewDefaultAdmissionSettings()
+	set.MaxInFlightBytes = 100
+	tt, ac := newTestAdmissionController(t, set)
+
+	_, err := ac.Acquire(context.Background(), 101)
+	assert.True(t, consumererror.IsPermanent(err))
+	_, ok := RetryAfter(err)
+	assert.False(t, ok)
+	require.NoError(t, tt.CheckReceiverAdmission(transport, 0, 1, 0))
+}
+
+func TestAdmissionControllerWaitsInOrder(t *testing.T) {
+	set := NewDefaultAdmissionSettings()
+	set.MaxInFlightBytes = 100
+	tt, ac := newTestAdmissionController(t, set)
+
+	release, err := ac.Acquire(context.Background(), 80)
+	require.NoError(t, err)
+
+	// The second request waits for the first one, and the third one for the second one,
+	// even though it fits in the remaining budget.
+	admitted := make(chan int64, 2)
+	releases := make(chan func(), 2)
+	for _, size := range []int64{50, 10} {
+		size := size
+		go func() {
+			r, acquireErr := ac.Acquire(context.Background(), size)
+			assert.NoError(t, acquireErr)
+			admitted <- size
+			releases <- r
+		}()
+		assert.Eventually(t, func() bool {
+			ac.mu.Lock()
+			defer ac.mu.Unlock()
+			return ac.waiters.Len() > 0 && ac.waiters.Back().Value.(*admissionWaiter).size == size
+		}, time.Second, time.Millisecond)
+	}
+	select {
+	case <-admitted:
+		t.Fatal("a request was admitted over the budget")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	release()
+	assert.ElementsMatch(t, []int64{50, 10}, []int64{<-admitted, <-admitted})
+	require.NoError(t, tt.CheckReceiverAdmission(transport, 60, 0, 0))
+	(<-releases)()
+	(<-releases)()
+	require.NoError(t, tt.CheckReceiverAdmission(transport, 0, 0, 0))
+}
+
+func TestAdmissionControllerTooManyWaiters(t *testing.T) {
+	set := NewDefaultAdmissionSettings()
+	set.MaxInFlightBytes = 100
+	set.MaxWaiters = 0
+	set.RetryAfter = 3 * time.Second
+	tt, ac := newTestAdmissionController(t, set)
+
+	release, err := ac.Acquire(context.Background(), 100)
+	require.NoError(t, err)
+	defer release()
+
+	_, err = ac.Acquire(context.Background(), 1)
+	assert.ErrorIs(t, err, errTooManyWaiters)
+	assert.False(t, consumererror.IsPermanent(err))
+	retryAfter, ok := RetryAfter(err)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, retryAfter)
+	require.NoError(t, tt.CheckReceiverAdmission(transport, 100, 0, 1))
+}
+
+func TestAdmissionControllerContextDone(t *testing.T) {
+	set := NewDefaultAdmissionSettings()
+	set.MaxInFlightBytes = 100
+	tt, ac := newTestAdmissionController(t, set)
+
+	release, err := ac.Acquire(context.Background(), 90)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = ac.Acquire(ctx, 20)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	_, ok := RetryAfter(err)
+	assert.True(t, ok)
+	require.NoError(t, tt.CheckReceiverAdmission(transport, 90, 0, 1))
+
+	ac.mu.Lock()
+	assert.Equal(t, 0, ac.waiters.Len())
+	ac.mu.Unlock()
+
+	// The abandoned request does not hold any bytes.
+	release()
+	release, err = ac.Acquire(context.Background(), 100)
+	require.NoError(t, err)
+	release()
+}
+
+func newTestAdmissionController(t *testing.T, set AdmissionSettings) (componenttest.TestTelemetry, *AdmissionController) {
+	tt, err := componenttest.SetupTelemetry(receiverID)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, tt.Shutdown(context.Background())) })
+
+	obsrep, err := NewObsReport(ObsReportSettings{
+		ReceiverID:             receiverID,
+		Transport:              transport,
+		ReceiverCreateSettings: receiver.CreateSettings{ID: receiverID, TelemetrySettings: tt.TelemetrySettings(), BuildInfo: component.NewDefaultBuildInfo()},
+	})
+	require.NoError(t, err)
+	ac, err := NewAdmissionController(set, obsrep)
+	require.NoError(t, err)
+	return tt, ac
+}
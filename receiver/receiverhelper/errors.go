@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receiverhelper // import "go.opentelemetry.io/collector/receiver/receiverhelper"
+
+import (
+	"errors"
+	"time"
+)
+
+// retryableError is an error which the client can retry after a delay.
+type retryableError struct {
+	err        error
+	retryAfter time.Duration
+}
+
+func (e retryableError) Error() string {
+	return "retryable after " + e.retryAfter.String() + ": " + e.err.Error()
+}
+
+func (e retryableError) Unwrap() error {
+	return e.err
+}
+
+// NewRetryableError returns an error, wrapping err, which tells the client of the receiver that
+// the request can be retried after the given delay, e.g. when the receiver is overloaded.
+// The receivers translate the delay to the hint of their protocol, such as the RetryInfo of
+// gRPC or the Retry-After header of HTTP, with RetryAfter.
+func NewRetryableError(err error, retryAfter time.Duration) error {
+	return retryableError{err: err, retryAfter: retryAfter}
+}
+
+// RetryAfter returns the delay after which the request can be retried if err, or any error
+// it wraps, was returned by NewRetryableError.
+func RetryAfter(err error) (time.Duration, bool) {
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.retryAfter, true
+	}
+	return 0, false
+}
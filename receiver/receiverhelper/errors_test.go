@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receiverhelper
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryableError(t *testing.T) {
+	err := NewRetryableError(errFake, 5*time.Second)
+	assert.ErrorIs(t, err, errFake)
+	assert.Equal(t, "retryable after 5s: errFake", err.Error())
+
+	retryAfter, ok := RetryAfter(fmt.Errorf("wrapped: %w", err))
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, retryAfter)
+
+	_, ok = RetryAfter(errors.New("not retryable"))
+	assert.False(t, ok)
+	_, ok = RetryAfter(nil)
+	assert.False(t, ok)
+}
@@ -41,6 +41,8 @@ type ObsReport struct {
 	refusedMetricPointsCounter  metric.Int64Counter
 	acceptedLogRecordsCounter   metric.Int64Counter
 	refusedLogRecordsCounter    metric.Int64Counter
+	acceptedSamplesCounter      metric.Int64Counter
+	refusedSamplesCounter       metric.Int64Counter
 }
 
 // ObsReportSettings are settings for creating an ObsReport.
@@ -129,6 +131,20 @@ func (rec *ObsReport) createOtelMetrics() error {
 	)
 	errors = multierr.Append(errors, err)
 
+	rec.acceptedSamplesCounter, err = rec.meter.Int64Counter(
+		obsmetrics.ReceiverPrefix+obsmetrics.AcceptedSamplesKey,
+		metric.WithDescription("Number of profile samples successfully pushed into the pipeline."),
+		metric.WithUnit("1"),
+	)
+	errors = multierr.Append(errors, err)
+
+	rec.refusedSamplesCounter, err = rec.meter.Int64Counter(
+		obsmetrics.ReceiverPrefix+obsmetrics.RefusedSamplesKey,
+		metric.WithDescription("Number of profile samples that could not be pushed into the pipeline."),
+		metric.WithUnit("1"),
+	)
+	errors = multierr.Append(errors, err)
+
 	return errors
 }
 
@@ -186,6 +202,24 @@ func (rec *ObsReport) EndMetricsOp(
 	rec.endOp(receiverCtx, format, numReceivedPoints, err, component.DataTypeMetrics)
 }
 
+// StartProfilesOp is called when a request is received from a client.
+// The returned context should be used in other calls to the obsreport functions
+// dealing with the same receive operation.
+func (rec *ObsReport) StartProfilesOp(operationCtx context.Context) context.Context {
+	return rec.startOp(operationCtx, obsmetrics.ReceiverProfilesOperationSuffix)
+}
+
+// EndProfilesOp completes the receive operation that was started with
+// StartProfilesOp.
+func (rec *ObsReport) EndProfilesOp(
+	receiverCtx context.Context,
+	format string,
+	numReceivedSamples int,
+	err error,
+) {
+	rec.endOp(receiverCtx, format, numReceivedSamples, err, component.DataTypeProfiles)
+}
+
 // startOp creates the span used to trace the operation. Returning
 // the updated context with the created span.
 func (rec *ObsReport) startOp(receiverCtx context.Context, operationSuffix string) context.Context {
@@ -245,6 +279,9 @@ func (rec *ObsReport) endOp(
 		case component.DataTypeLogs:
 			acceptedItemsKey = obsmetrics.AcceptedLogRecordsKey
 			refusedItemsKey = obsmetrics.RefusedLogRecordsKey
+		case component.DataTypeProfiles:
+			acceptedItemsKey = obsmetrics.AcceptedSamplesKey
+			refusedItemsKey = obsmetrics.RefusedSamplesKey
 		}
 
 		span.SetAttributes(
@@ -271,6 +308,9 @@ func (rec *ObsReport) recordMetrics(receiverCtx context.Context, dataType compon
 	case component.DataTypeLogs:
 		acceptedMeasure = rec.acceptedLogRecordsCounter
 		refusedMeasure = rec.refusedLogRecordsCounter
+	case component.DataTypeProfiles:
+		acceptedMeasure = rec.acceptedSamplesCounter
+		refusedMeasure = rec.refusedSamplesCounter
 	}
 
 	acceptedMeasure.Add(receiverCtx, int64(numAccepted), metric.WithAttributes(rec.otelAttrs...))
@@ -29,7 +29,8 @@ func NewNopFactory() receiver.Factory {
 		func() component.Config { return &nopConfig{} },
 		receiver.WithTraces(createTraces, component.StabilityLevelStable),
 		receiver.WithMetrics(createMetrics, component.StabilityLevelStable),
-		receiver.WithLogs(createLogs, component.StabilityLevelStable))
+		receiver.WithLogs(createLogs, component.StabilityLevelStable),
+		receiver.WithProfiles(createProfiles, component.StabilityLevelStable))
 }
 
 func createTraces(context.Context, receiver.CreateSettings, component.Config, consumer.Traces) (receiver.Traces, error) {
@@ -44,6 +45,10 @@ func createLogs(context.Context, receiver.CreateSettings, component.Config, cons
 	return nopInstance, nil
 }
 
+func createProfiles(context.Context, receiver.CreateSettings, component.Config, consumer.Profiles) (receiver.Profiles, error) {
+	return nopInstance, nil
+}
+
 type nopConfig struct{}
 
 var nopInstance = &nopReceiver{}
@@ -37,8 +37,19 @@ type ObsReport struct {
 	otelAttrs            []attribute.KeyValue
 	scrapedMetricsPoints metric.Int64Counter
 	erroredMetricsPoints metric.Int64Counter
+	scrapeErrors         metric.Int64Counter
 }
 
+// The categories of the errors of the scrapes, recorded by the scrape_errors metric.
+const (
+	// errorCategoryTimeout is the category of the scrapes which exceeded their timeout.
+	errorCategoryTimeout = "timeout"
+	// errorCategoryPartial is the category of the scrapes which returned a PartialScrapeError.
+	errorCategoryPartial = "partial"
+	// errorCategoryPermanent is the category of the scrapes which failed for any other reason.
+	errorCategoryPermanent = "permanent"
+)
+
 // ObsReportSettings are settings for creating an ObsReport.
 type ObsReportSettings struct {
 	ReceiverID             component.ID
@@ -91,6 +102,13 @@ func (s *ObsReport) createOtelMetrics(cfg ObsReportSettings) error {
 	)
 	errors = multierr.Append(errors, err)
 
+	s.scrapeErrors, err = meter.Int64Counter(
+		obsmetrics.ScraperPrefix+obsmetrics.ScrapeErrorsKey,
+		metric.WithDescription("Number of scrapes which failed, by category of error: timeout, partial or permanent."),
+		metric.WithUnit("1"),
+	)
+	errors = multierr.Append(errors, err)
+
 	return errors
 }
 
@@ -104,20 +122,29 @@ func (s *ObsReport) StartMetricsOp(ctx context.Context) context.Context {
 }
 
 // EndMetricsOp completes the scrape operation that was started with
-// StartMetricsOp.
+// StartMetricsOp. The errors are categorized as timeouts if the deadline of
+// scraperCtx was exceeded, unless they are a PartialScrapeError.
 func (s *ObsReport) EndMetricsOp(
 	scraperCtx context.Context,
 	numScrapedMetrics int,
 	err error,
 ) {
 	numErroredMetrics := 0
+	var category string
 	if err != nil {
 		var partialErr scrapererror.PartialScrapeError
-		if errors.As(err, &partialErr) {
+		switch {
+		case errors.As(err, &partialErr):
 			numErroredMetrics = partialErr.Failed
-		} else {
+			category = errorCategoryPartial
+		case errors.Is(err, context.DeadlineExceeded) || errors.Is(scraperCtx.Err(), context.DeadlineExceeded):
+			numErroredMetrics = numScrapedMetrics
+			numScrapedMetrics = 0
+			category = errorCategoryTimeout
+		default:
 			numErroredMetrics = numScrapedMetrics
 			numScrapedMetrics = 0
+			category = errorCategoryPermanent
 		}
 	}
 
@@ -125,6 +152,12 @@ func (s *ObsReport) EndMetricsOp(
 
 	if s.level != configtelemetry.LevelNone {
 		s.recordMetrics(scraperCtx, numScrapedMetrics, numErroredMetrics)
+		if category != "" {
+			attrs := make([]attribute.KeyValue, 0, len(s.otelAttrs)+1)
+			attrs = append(attrs, s.otelAttrs...)
+			attrs = append(attrs, attribute.String(obsmetrics.ErrorCategoryKey, category))
+			s.scrapeErrors.Add(scraperCtx, 1, metric.WithAttributes(attrs...))
+		}
 	}
 
 	// end span according to errors
@@ -136,6 +169,7 @@ func (s *ObsReport) EndMetricsOp(
 		)
 
 		if err != nil {
+			span.SetAttributes(attribute.String(obsmetrics.ErrorCategoryKey, category))
 			span.SetStatus(codes.Error, err.Error())
 		}
 	}
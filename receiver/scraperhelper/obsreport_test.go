@@ -6,7 +6,9 @@ package scraperhelper
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -87,6 +89,40 @@ func TestScrapeMetricsDataOp(t *testing.T) {
 		}
 
 		require.NoError(t, tt.CheckScraperMetrics(receiverID, scraperID, int64(scrapedMetricPoints), int64(erroredMetricPoints)))
+		require.NoError(t, tt.CheckScraperErrors(receiverID, scraperID, 0, 1, 1))
+	})
+}
+
+func TestScrapeErrorCategories(t *testing.T) {
+	testTelemetry(t, receiverID, func(t *testing.T, tt componenttest.TestTelemetry) {
+		scrp, err := newScraper(ObsReportSettings{
+			ReceiverID:             receiverID,
+			Scraper:                scraperID,
+			ReceiverCreateSettings: receiver.CreateSettings{ID: receiverID, TelemetrySettings: tt.TelemetrySettings(), BuildInfo: component.NewDefaultBuildInfo()},
+		})
+		require.NoError(t, err)
+
+		// The error returned when the deadline is exceeded.
+		scrp.EndMetricsOp(scrp.StartMetricsOp(context.Background()), 0, fmt.Errorf("scrape failed: %w", context.DeadlineExceeded))
+
+		// Any error returned once the deadline of the scrape is exceeded.
+		expiredCtx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		defer cancel()
+		scrp.EndMetricsOp(scrp.StartMetricsOp(expiredCtx), 0, errFake)
+
+		// The partial errors are not timeouts, even if the deadline is exceeded.
+		scrp.EndMetricsOp(scrp.StartMetricsOp(expiredCtx), 3, partialErrFake)
+
+		scrp.EndMetricsOp(scrp.StartMetricsOp(context.Background()), 0, errFake)
+		scrp.EndMetricsOp(scrp.StartMetricsOp(context.Background()), 2, nil)
+
+		require.NoError(t, tt.CheckScraperErrors(receiverID, scraperID, 2, 1, 1))
+		spans := tt.SpanRecorder.Ended()
+		require.Len(t, spans, 5)
+		for i, category := range []string{"timeout", "timeout", "partial", "permanent"} {
+			assert.Contains(t, spans[i].Attributes(), attribute.String(obsmetrics.ErrorCategoryKey, category))
+		}
+		assert.NotContains(t, spans[4].Attributes(), attribute.String(obsmetrics.ErrorCategoryKey, ""))
 	})
 }
 
@@ -6,6 +6,9 @@ package scraperhelper // import "go.opentelemetry.io/collector/receiver/scraperh
 import (
 	"context"
 	"errors"
+	"time"
+
+	"go.uber.org/multierr"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -46,19 +49,49 @@ func WithShutdown(shutdown component.ShutdownFunc) ScraperOption {
 	}
 }
 
+// WithTimeout sets the timeout of the scrapes, which is the deadline of the context passed to
+// the scrape function, so that a slow scraper does not delay the other scrapers of the controller.
+// The deadline is never later than the one set by the Timeout of the ScraperControllerSettings.
+func WithTimeout(timeout time.Duration) ScraperOption {
+	return func(o *baseScraper) {
+		o.timeout = timeout
+	}
+}
+
+// WithStateTracker tracks the series of the scraped sums with the StateTracker, which is started
+// and shut down with the scraper: the start timestamps of the cumulative points are set, and the
+// delta points are converted to cumulative points. See StateTracker.
+func WithStateTracker(tracker *StateTracker) ScraperOption {
+	return func(o *baseScraper) {
+		o.tracker = tracker
+	}
+}
+
 var _ Scraper = (*baseScraper)(nil)
 
 type baseScraper struct {
 	component.StartFunc
 	component.ShutdownFunc
 	ScrapeFunc
-	id component.ID
+	id      component.ID
+	timeout time.Duration
+	tracker *StateTracker
 }
 
 func (b *baseScraper) ID() component.ID {
 	return b.id
 }
 
+// Timeout returns the timeout of the scrapes, set by WithTimeout.
+func (b *baseScraper) Timeout() time.Duration {
+	return b.timeout
+}
+
+// scraperWithTimeout is implemented by the scrapers which have their own timeout.
+type scraperWithTimeout interface {
+	Timeout() time.Duration
+}
+
 // NewScraper creates a Scraper that calls Scrape at the specified collection interval,
 // reports observability information, and passes the scraped metrics to the next consumer.
 func NewScraper(name string, scrape ScrapeFunc, options ...ScraperOption) (Scraper, error) {
@@ -72,6 +105,31 @@ func NewScraper(name string, scrape ScrapeFunc, options ...ScraperOption) (Scrap
 	for _, op := range options {
 		op(bs)
 	}
+	if bs.tracker != nil {
+		bs.withTracker()
+	}
 
 	return bs, nil
 }
+
+// withTracker wraps the functions of the scraper with the ones of its StateTracker.
+func (b *baseScraper) withTracker() {
+	start, shutdown, scrape := b.StartFunc, b.ShutdownFunc, b.ScrapeFunc
+	b.StartFunc = func(ctx context.Context, host component.Host) error {
+		if err := b.tracker.Start(ctx, host); err != nil {
+			return err
+		}
+		return start.Start(ctx, host)
+	}
+	b.ShutdownFunc = func(ctx context.Context) error {
+		return multierr.Append(shutdown.Shutdown(ctx), b.tracker.Shutdown(ctx))
+	}
+	b.ScrapeFunc = func(ctx context.Context) (pmetric.Metrics, error) {
+		md, err := scrape(ctx)
+		// The metrics of a partial scrape are tracked too.
+		if md.ResourceMetrics().Len() > 0 {
+			b.tracker.Track(ctx, md)
+		}
+		return md, err
+	}
+}
@@ -6,6 +6,9 @@ package scraperhelper // import "go.opentelemetry.io/collector/receiver/scraperh
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
 	"time"
 
 	"go.uber.org/multierr"
@@ -13,6 +16,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/leaderelection"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
@@ -47,8 +51,15 @@ type controller struct {
 	logger             *zap.Logger
 	collectionInterval time.Duration
 	initialDelay       time.Duration
-	timeout            time.Duration
-	nextConsumer       consumer.Metrics
+	// jitter is the random delay added to initialDelay, or the offset of the aligned ticks.
+	jitter       time.Duration
+	alignTicks   bool
+	timeout      time.Duration
+	concurrency  int
+	nextConsumer consumer.Metrics
+
+	leaderElection *component.ID
+	elector        leaderelection.Extension
 
 	scrapers    []Scraper
 	obsScrapers []*ObsReport
@@ -92,8 +103,12 @@ func NewScraperControllerReceiver(
 		logger:             set.Logger,
 		collectionInterval: cfg.CollectionInterval,
 		initialDelay:       cfg.InitialDelay,
+		jitter:             randomJitter(cfg.InitialDelayJitter),
+		alignTicks:         cfg.AlignTicks,
 		timeout:            cfg.Timeout,
+		concurrency:        cfg.Concurrency,
 		nextConsumer:       nextConsumer,
+		leaderElection:     cfg.LeaderElection,
 		done:               make(chan struct{}),
 		terminated:         make(chan struct{}),
 		obsrecv:            obsrecv,
@@ -124,6 +139,18 @@ func NewScraperControllerReceiver(
 
 // Start the receiver, invoked during service start.
 func (sc *controller) Start(ctx context.Context, host component.Host) error {
+	if sc.leaderElection != nil {
+		ext, found := host.GetExtensions()[*sc.leaderElection]
+		if !found {
+			return fmt.Errorf("leader election extension %q not found", sc.leaderElection)
+		}
+		elector, ok := ext.(leaderelection.Extension)
+		if !ok {
+			return fmt.Errorf("extension %q is not a leader election extension", sc.leaderElection)
+		}
+		sc.elector = elector
+	}
+
 	for _, scraper := range sc.scrapers {
 		if err := scraper.Start(ctx, host); err != nil {
 			return err
@@ -156,20 +183,39 @@ func (sc *controller) Shutdown(ctx context.Context) error {
 // collection interval.
 func (sc *controller) startScraping() {
 	go func() {
-		if sc.initialDelay > 0 {
-			<-time.After(sc.initialDelay)
+		delay := sc.initialDelay
+		if !sc.alignTicks {
+			delay += sc.jitter
+		}
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-sc.done:
+				sc.terminated <- struct{}{}
+				return
+			}
 		}
 
 		if sc.tickerCh == nil {
-			ticker := time.NewTicker(sc.collectionInterval)
-			defer ticker.Stop()
+			if sc.alignTicks {
+				ticker := newAlignedTicker(sc.collectionInterval, sc.jitter)
+				defer ticker.stop()
+
+				sc.tickerCh = ticker.c
+			} else {
+				ticker := time.NewTicker(sc.collectionInterval)
+				defer ticker.Stop()
 
-			sc.tickerCh = ticker.C
+				sc.tickerCh = ticker.C
+			}
 		}
 		// Call scrape method on initialision to ensure
 		// that scrapers start from when the component starts
 		// instead of waiting for the full duration to start.
-		sc.scrapeMetricsAndReport()
+		// The aligned scrapes only happen at the aligned times.
+		if !sc.alignTicks {
+			sc.scrapeMetricsAndReport()
+		}
 		for {
 			select {
 			case <-sc.tickerCh:
@@ -184,26 +230,18 @@ func (sc *controller) startScraping() {
 
 // scrapeMetricsAndReport calls the Scrape function for each of the configured
 // Scrapers, records observability information, and passes the scraped metrics
-// to the next component.
+// to the next component. Nothing is scraped while the collector is not the leader,
+// if a leader election extension is configured.
 func (sc *controller) scrapeMetricsAndReport() {
+	if sc.elector != nil && !sc.elector.IsLeader() {
+		return
+	}
+
 	ctx, done := withScrapeContext(sc.timeout)
 	defer done()
 
 	metrics := pmetric.NewMetrics()
-
-	for i, scraper := range sc.scrapers {
-		scrp := sc.obsScrapers[i]
-		ctx = scrp.StartMetricsOp(ctx)
-		md, err := scraper.Scrape(ctx)
-
-		if err != nil {
-			sc.logger.Error("Error scraping metrics", zap.Error(err), zap.Stringer("scraper", scraper.ID()))
-			if !scrapererror.IsPartialScrapeError(err) {
-				scrp.EndMetricsOp(ctx, 0, err)
-				continue
-			}
-		}
-		scrp.EndMetricsOp(ctx, md.MetricCount(), err)
+	for _, md := range sc.scrapeAll(ctx) {
 		md.ResourceMetrics().MoveAndAppendTo(metrics.ResourceMetrics())
 	}
 
@@ -213,11 +251,124 @@ func (sc *controller) scrapeMetricsAndReport() {
 	sc.obsrecv.EndMetricsOp(ctx, "", dataPointCount, err)
 }
 
+// scrapeAll calls the scrapers, up to concurrency of them in parallel, and returns the metrics
+// they scraped, in their order. The metrics of the scrapers which failed are empty, unless
+// the scrape failed partially.
+func (sc *controller) scrapeAll(ctx context.Context) []pmetric.Metrics {
+	results := make([]pmetric.Metrics, len(sc.scrapers))
+	scrapeOne := func(i int) {
+		md, err := sc.scrape(ctx, sc.scrapers[i], sc.obsScrapers[i])
+		if err != nil && !scrapererror.IsPartialScrapeError(err) {
+			md = pmetric.NewMetrics()
+		}
+		results[i] = md
+	}
+
+	workers := sc.concurrency
+	if workers > len(sc.scrapers) {
+		workers = len(sc.scrapers)
+	}
+	if workers < 2 {
+		for i := range sc.scrapers {
+			scrapeOne(i)
+		}
+		return results
+	}
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				scrapeOne(i)
+			}
+		}()
+	}
+	for i := range sc.scrapers {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+	return results
+}
+
+// scrape calls the scraper with its own timeout, if any, and records observability information.
+func (sc *controller) scrape(ctx context.Context, scraper Scraper, scrp *ObsReport) (pmetric.Metrics, error) {
+	if s, ok := scraper.(scraperWithTimeout); ok && s.Timeout() > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.Timeout())
+		defer cancel()
+	}
+	ctx = scrp.StartMetricsOp(ctx)
+	md, err := scraper.Scrape(ctx)
+	if err != nil {
+		sc.logger.Error("Error scraping metrics", zap.Error(err), zap.Stringer("scraper", scraper.ID()))
+		if !scrapererror.IsPartialScrapeError(err) {
+			scrp.EndMetricsOp(ctx, 0, err)
+			return md, err
+		}
+	}
+	scrp.EndMetricsOp(ctx, md.MetricCount(), err)
+	return md, err
+}
+
 // stopScraping stops the ticker
 func (sc *controller) stopScraping() {
 	close(sc.done)
 }
 
+// randomJitter returns a random duration in [0, max).
+func randomJitter(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max))) //#nosec G404 -- the jitter does not need a secure random number
+}
+
+// alignedTicker sends the time on c at the multiples of interval since the Unix epoch, plus offset.
+// Unlike a time.Ticker, it does not drift from the wall clock, as the next tick is computed
+// from the current time.
+type alignedTicker struct {
+	c    chan time.Time
+	done chan struct{}
+}
+
+func newAlignedTicker(interval, offset time.Duration) *alignedTicker {
+	t := &alignedTicker{c: make(chan time.Time, 1), done: make(chan struct{})}
+	go func() {
+		for {
+			timer := time.NewTimer(time.Until(nextAlignedTick(time.Now(), interval, offset)))
+			select {
+			case now := <-timer.C:
+				// Drop the tick if the previous one was not consumed yet, as a time.Ticker does.
+				select {
+				case t.c <- now:
+				default:
+				}
+			case <-t.done:
+				timer.Stop()
+				return
+			}
+		}
+	}()
+	return t
+}
+
+func (t *alignedTicker) stop() {
+	close(t.done)
+}
+
+// nextAlignedTick returns the first multiple of interval since the Unix epoch, plus offset, after now.
+func nextAlignedTick(now time.Time, interval, offset time.Duration) time.Time {
+	next := time.Unix(0, now.UnixNano()/int64(interval)*int64(interval)).Add(offset)
+	for !next.After(now) {
+		next = next.Add(interval)
+	}
+	return next
+}
+
 // withScrapeContext will return a context that has no deadline if timeout is 0
 // which implies no explicit timeout had occurred, otherwise, a context
 // with a deadline of the provided timeout is returned.
@@ -6,6 +6,8 @@ package scraperhelper
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -437,3 +439,321 @@ func TestScrapeControllerInitialDelay(t *testing.T) {
 
 	assert.NoError(t, r.Shutdown(context.Background()), "Must not error closing down")
 }
+
+func TestScrapeControllerAlignTicks(t *testing.T) {
+	tsm := &testScrapeMetrics{ch: make(chan int, 10)}
+	scp, err := NewScraper("", tsm.scrape)
+	require.NoError(t, err)
+
+	tickerCh := make(chan time.Time)
+	r, err := NewScraperControllerReceiver(
+		&ScraperControllerSettings{
+			CollectionInterval: time.Second,
+			AlignTicks:         true,
+		},
+		receivertest.NewNopCreateSettings(),
+		new(consumertest.MetricsSink),
+		AddScraper(scp),
+		WithTickerChannel(tickerCh),
+	)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, r.Shutdown(context.Background())) }()
+
+	select {
+	case <-tsm.ch:
+		assert.Fail(t, "Must not scrape on start when the ticks are aligned")
+	case <-time.After(100 * time.Millisecond):
+	}
+	tickerCh <- time.Now()
+	assert.Equal(t, 1, <-tsm.ch)
+}
+
+func TestScrapeControllerAlignedScrapes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("This requires real time to pass, skipping")
+		return
+	}
+
+	const interval = 200 * time.Millisecond
+	scraped := make(chan time.Time, 10)
+	scp, err := NewScraper("timed", func(ctx context.Context) (pmetric.Metrics, error) {
+		scraped <- time.Now()
+		return pmetric.NewMetrics(), nil
+	})
+	require.NoError(t, err)
+
+	r, err := NewScraperControllerReceiver(
+		&ScraperControllerSettings{
+			CollectionInterval: interval,
+			InitialDelayJitter: 50 * time.Millisecond,
+			AlignTicks:         true,
+		},
+		receivertest.NewNopCreateSettings(),
+		new(consumertest.MetricsSink),
+		AddScraper(scp),
+	)
+	require.NoError(t, err)
+	offset := r.(*controller).jitter
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+
+	for i := 0; i < 2; i++ {
+		// The scrapes happen at the aligned times plus the jitter, give or take the scheduling latency.
+		late := time.Duration((<-scraped).UnixNano()%int64(interval)) - offset
+		if late < 0 {
+			late += interval
+		}
+		assert.Less(t, late, 50*time.Millisecond)
+	}
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestScrapeControllerScraperTimeout(t *testing.T) {
+	tt, err := componenttest.SetupTelemetry(component.NewID("receiver"))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, tt.Shutdown(context.Background())) })
+
+	// The slow scraper times out without delaying the other scraper past its own deadline.
+	slow, err := NewScraper("slow", func(ctx context.Context) (pmetric.Metrics, error) {
+		<-ctx.Done()
+		return pmetric.NewMetrics(), ctx.Err()
+	}, WithTimeout(50*time.Millisecond))
+	require.NoError(t, err)
+	var deadline time.Time
+	fast, err := NewScraper("fast", func(ctx context.Context) (pmetric.Metrics, error) {
+		deadline, _ = ctx.Deadline()
+		md := pmetric.NewMetrics()
+		md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+		return md, nil
+	})
+	require.NoError(t, err)
+
+	sink := new(consumertest.MetricsSink)
+	tickerCh := make(chan time.Time)
+	r, err := NewScraperControllerReceiver(
+		&ScraperControllerSettings{CollectionInterval: time.Second, Timeout: time.Hour},
+		receiver.CreateSettings{ID: component.NewID("receiver"), TelemetrySettings: tt.TelemetrySettings(), BuildInfo: component.NewDefaultBuildInfo()},
+		sink,
+		AddScraper(slow),
+		AddScraper(fast),
+		WithTickerChannel(tickerCh),
+	)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool { return sink.DataPointCount() == 1 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	// The collective deadline still applies to the scrapers without their own timeout.
+	assert.WithinDuration(t, time.Now().Add(time.Hour), deadline, time.Minute)
+	require.NoError(t, tt.CheckScraperErrors(component.NewID("receiver"), component.NewID("slow"), 1, 0, 0))
+	require.NoError(t, tt.CheckScraperErrors(component.NewID("receiver"), component.NewID("fast"), 0, 0, 0))
+	require.NoError(t, tt.CheckScraperMetrics(component.NewID("receiver"), component.NewID("fast"), 1, 0))
+}
+
+func TestScrapeControllerConcurrency(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		concurrency int
+		expectedMax int32
+	}{
+		{name: "sequential", concurrency: 0, expectedMax: 1},
+		{name: "two workers", concurrency: 2, expectedMax: 2},
+		{name: "more workers than scrapers", concurrency: 10, expectedMax: 4},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var running, maxRunning atomic.Int32
+			var options []ScraperControllerOption
+			for i := 0; i < 4; i++ {
+				name := fmt.Sprintf("scraper%d", i)
+				scp, err := NewScraper(name, func(context.Context) (pmetric.Metrics, error) {
+					current := running.Add(1)
+					defer running.Add(-1)
+					for {
+						previous := maxRunning.Load()
+						if current <= previous || maxRunning.CompareAndSwap(previous, current) {
+							break
+						}
+					}
+					time.Sleep(50 * time.Millisecond)
+					md := pmetric.NewMetrics()
+					md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName(name)
+					return md, nil
+				})
+				require.NoError(t, err)
+				options = append(options, AddScraper(scp))
+			}
+
+			sink := new(consumertest.MetricsSink)
+			r, err := NewScraperControllerReceiver(
+				&ScraperControllerSettings{CollectionInterval: time.Hour, Concurrency: tc.concurrency},
+				receivertest.NewNopCreateSettings(),
+				sink,
+				options...,
+			)
+			require.NoError(t, err)
+			require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+			require.Eventually(t, func() bool { return len(sink.AllMetrics()) == 1 }, 5*time.Second, 10*time.Millisecond)
+			require.NoError(t, r.Shutdown(context.Background()))
+
+			assert.Equal(t, tc.expectedMax, maxRunning.Load())
+			// The metrics are in the order of the scrapers, whatever the order the scrapes ended.
+			rms := sink.AllMetrics()[0].ResourceMetrics()
+			require.Equal(t, 4, rms.Len())
+			for i := 0; i < rms.Len(); i++ {
+				assert.Equal(t, fmt.Sprintf("scraper%d", i), rms.At(i).ScopeMetrics().At(0).Metrics().At(0).Name())
+			}
+		})
+	}
+}
+
+func TestNextAlignedTick(t *testing.T) {
+	base := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name     string
+		now      time.Time
+		interval time.Duration
+		offset   time.Duration
+		expected time.Time
+	}{
+		{
+			name:     "next minute",
+			now:      base.Add(10 * time.Second),
+			interval: time.Minute,
+			expected: base.Add(time.Minute),
+		},
+		{
+			name:     "on a tick",
+			now:      base,
+			interval: time.Minute,
+			expected: base.Add(time.Minute),
+		},
+		{
+			name:     "with offset in the current interval",
+			now:      base.Add(10 * time.Second),
+			interval: time.Minute,
+			offset:   15 * time.Second,
+			expected: base.Add(15 * time.Second),
+		},
+		{
+			name:     "with offset in the next interval",
+			now:      base.Add(20 * time.Second),
+			interval: time.Minute,
+			offset:   15 * time.Second,
+			expected: base.Add(75 * time.Second),
+		},
+		{
+			name:     "hourly",
+			now:      base.Add(59 * time.Minute),
+			interval: time.Hour,
+			expected: base.Add(time.Hour),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, nextAlignedTick(tc.now, tc.interval, tc.offset).UTC())
+		})
+	}
+}
+
+func TestRandomJitter(t *testing.T) {
+	assert.Equal(t, time.Duration(0), randomJitter(0))
+	for i := 0; i < 100; i++ {
+		jitter := randomJitter(time.Second)
+		assert.GreaterOrEqual(t, jitter, time.Duration(0))
+		assert.Less(t, jitter, time.Second)
+	}
+}
+
+// fakeElector is a leader election extension whose leadership is set by the tests.
+// The leadership returned by IsLeader is sent to checks.
+type fakeElector struct {
+	component.StartFunc
+	component.ShutdownFunc
+	isLeader atomic.Bool
+	checks   chan bool
+}
+
+func (e *fakeElector) IsLeader() bool {
+	isLeader := e.isLeader.Load()
+	e.checks <- isLeader
+	return isLeader
+}
+
+func (e *fakeElector) Subscribe(callback func(bool)) func() {
+	callback(e.IsLeader())
+	return func() {}
+}
+
+type extensionsHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *extensionsHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func TestScrapeControllerLeaderElection(t *testing.T) {
+	electorID := component.NewID("leader_election")
+	elector := &fakeElector{checks: make(chan bool, 1)}
+	host := &extensionsHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{electorID: elector},
+	}
+	tickerCh := make(chan time.Time)
+	tsm := &testScrapeMetrics{ch: make(chan int, 10)}
+	scp, err := NewScraper("", tsm.scrape)
+	require.NoError(t, err)
+	sink := new(consumertest.MetricsSink)
+	r, err := NewScraperControllerReceiver(
+		&ScraperControllerSettings{CollectionInterval: time.Hour, LeaderElection: &electorID},
+		receivertest.NewNopCreateSettings(),
+		sink,
+		AddScraper(scp),
+		WithTickerChannel(tickerCh),
+	)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), host))
+
+	// The standby collector does not scrape.
+	assert.False(t, <-elector.checks)
+	tickerCh <- time.Now()
+	assert.False(t, <-elector.checks)
+	assert.Empty(t, tsm.ch)
+	assert.Empty(t, sink.AllMetrics())
+
+	elector.isLeader.Store(true)
+	tickerCh <- time.Now()
+	assert.True(t, <-elector.checks)
+	assert.Equal(t, 1, <-tsm.ch)
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.Len(t, sink.AllMetrics(), 1)
+}
+
+func TestScrapeControllerLeaderElectionNotFound(t *testing.T) {
+	electorID := component.NewID("leader_election")
+	zpagesID := component.NewID("zpages")
+	zpages := &struct {
+		component.StartFunc
+		component.ShutdownFunc
+	}{}
+	host := &extensionsHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{zpagesID: zpages},
+	}
+	for _, tt := range []struct {
+		id     component.ID
+		errMsg string
+	}{
+		{id: electorID, errMsg: "leader election extension \"leader_election\" not found"},
+		{id: zpagesID, errMsg: "extension \"zpages\" is not a leader election extension"},
+	} {
+		id := tt.id
+		r, err := NewScraperControllerReceiver(
+			&ScraperControllerSettings{CollectionInterval: time.Hour, LeaderElection: &id},
+			receivertest.NewNopCreateSettings(),
+			new(consumertest.MetricsSink),
+		)
+		require.NoError(t, err)
+		assert.EqualError(t, r.Start(context.Background(), host), tt.errMsg)
+	}
+}
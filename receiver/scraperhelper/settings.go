@@ -28,8 +28,25 @@ type ScraperControllerSettings struct {
 	// InitialDelay sets the initial start delay for the scraper,
 	// any non positive value is assumed to be immediately.
 	InitialDelay time.Duration `mapstructure:"initial_delay"`
+	// InitialDelayJitter is the maximum of a random delay added to InitialDelay, so that the
+	// receivers of a large fleet of collectors started at the same time do not scrape in lockstep.
+	// When AlignTicks is set, the random delay is the offset of the scrapes from the aligned times.
+	InitialDelayJitter time.Duration `mapstructure:"initial_delay_jitter"`
+	// AlignTicks aligns the scrapes on the wall clock: they happen at the multiples of
+	// CollectionInterval since the Unix epoch, e.g. at the start of each minute with an interval
+	// of one minute, instead of relative to the start of the receiver. There is no scrape when the
+	// receiver starts, the first one happens at the first aligned time after InitialDelay.
+	AlignTicks bool `mapstructure:"align_ticks"`
 	// Timeout is an optional value used to set scraper's context deadline.
 	Timeout time.Duration `mapstructure:"timeout"`
+	// Concurrency is the maximum number of scrapers of the receiver called in parallel at each
+	// collection interval. Any value lower than 2 calls the scrapers one after the other.
+	// The scraped metrics are passed to the next consumer in the order of the scrapers.
+	Concurrency int `mapstructure:"concurrency"`
+	// LeaderElection is the optional ID of a leader election extension. When it is set,
+	// the scrapers are only called while the collector is the leader, so the replicas
+	// of an active/standby pair do not scrape the same targets.
+	LeaderElection *component.ID `mapstructure:"leader_election"`
 }
 
 // NewDefaultScraperControllerSettings returns default scraper controller
@@ -39,6 +56,7 @@ func NewDefaultScraperControllerSettings(component.Type) ScraperControllerSettin
 		CollectionInterval: time.Minute,
 		InitialDelay:       time.Second,
 		Timeout:            0,
+		Concurrency:        1,
 	}
 }
 
@@ -49,5 +67,14 @@ func (set *ScraperControllerSettings) Validate() (errs error) {
 	if set.Timeout < 0 {
 		errs = multierr.Append(errs, fmt.Errorf(`"timeout": %w`, errNonPositiveInterval))
 	}
+	if set.Concurrency < 0 {
+		errs = multierr.Append(errs, errors.New(`"concurrency" must not be negative`))
+	}
+	if set.InitialDelayJitter < 0 {
+		errs = multierr.Append(errs, fmt.Errorf(`"initial_delay_jitter": %w`, errNonPositiveInterval))
+	}
+	if set.AlignTicks && set.InitialDelayJitter >= set.CollectionInterval && set.CollectionInterval > 0 {
+		errs = multierr.Append(errs, errors.New(`"initial_delay_jitter" must be less than "collection_interval" when "align_ticks" is set`))
+	}
 	return errs
 }
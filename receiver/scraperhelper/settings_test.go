@@ -36,6 +36,40 @@ func TestScrapeControllerSettings(t *testing.T) {
 			},
 			errVal: `"timeout": requires positive value`,
 		},
+		{
+			name: "invalid concurrency",
+			set: ScraperControllerSettings{
+				CollectionInterval: time.Minute,
+				Concurrency:        -1,
+			},
+			errVal: `"concurrency" must not be negative`,
+		},
+		{
+			name: "invalid initial delay jitter",
+			set: ScraperControllerSettings{
+				CollectionInterval: time.Minute,
+				InitialDelayJitter: -1 * time.Second,
+			},
+			errVal: `"initial_delay_jitter": requires positive value`,
+		},
+		{
+			name: "aligned ticks with jitter",
+			set: ScraperControllerSettings{
+				CollectionInterval: time.Minute,
+				InitialDelayJitter: 10 * time.Second,
+				AlignTicks:         true,
+			},
+			errVal: "",
+		},
+		{
+			name: "aligned ticks with jitter longer than interval",
+			set: ScraperControllerSettings{
+				CollectionInterval: time.Minute,
+				InitialDelayJitter: time.Minute,
+				AlignTicks:         true,
+			},
+			errVal: `"initial_delay_jitter" must be less than "collection_interval" when "align_ticks" is set`,
+		},
 	} {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,310 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scraperhelper // import "go.opentelemetry.io/collector/receiver/scraperhelper"
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+)
+
+const (
+	// stateKey is the key of the state of the series in the storage client.
+	stateKey = "series_state"
+
+	// defaultStaleIntervals is the number of collection intervals after which a series which is
+	// not scraped anymore is forgotten, when StaleAfter is not set.
+	defaultStaleIntervals = 5
+)
+
+// StateSettings defines the settings of the StateTracker of a scraper. Scraper controller
+// receivers can embed this struct in their configuration.
+type StateSettings struct {
+	// StaleAfter is the duration after which the state of a series which is not scraped anymore
+	// is forgotten, so that the state does not grow without limit. It is 5 times the collection
+	// interval of the scraper if 0, so that the series are not forgotten between two scrapes.
+	StaleAfter time.Duration `mapstructure:"stale_after"`
+	// StorageID is the optional ID of a storage extension, which persists the state of the series,
+	// so that it is kept when the collector restarts.
+	StorageID *component.ID `mapstructure:"storage"`
+	// PersistInterval is the minimum duration between two writes of the state in the storage
+	// extension, which are done after the scrapes. The state is always written on shutdown.
+	PersistInterval time.Duration `mapstructure:"persist_interval"`
+}
+
+// NewDefaultStateSettings returns the default settings for StateSettings.
+func NewDefaultStateSettings() StateSettings {
+	return StateSettings{
+		PersistInterval: time.Minute,
+	}
+}
+
+// Validate checks if the StateSettings configuration is valid.
+func (set *StateSettings) Validate() error {
+	if set.StaleAfter < 0 {
+		return errors.New(`"stale_after" must not be negative`)
+	}
+	if set.PersistInterval < 0 {
+		return errors.New(`"persist_interval" must not be negative`)
+	}
+	return nil
+}
+
+// seriesState is the state of a series of the number data points of a sum.
+type seriesState struct {
+	// Start is the start timestamp of the series set on the cumulative points.
+	Start pcommon.Timestamp `json:"start"`
+	// Timestamp is the timestamp of the last point of the series.
+	Timestamp pcommon.Timestamp `json:"timestamp"`
+	// The last cumulative value of the series, or the sum of its deltas.
+	Int    int64   `json:"int,omitempty"`
+	Double float64 `json:"double,omitempty"`
+	// LastSeen is the time when the series was last scraped, to expire the stale series.
+	LastSeen time.Time `json:"last_seen"`
+}
+
+// StateTracker tracks the state of the series of the sums scraped by a scraper, so that their
+// points are consistent from one scrape to the next:
+//   - The cumulative points without start timestamp are given the timestamp of the first point of
+//     their series, or the timestamp of the previous point when the value of a monotonic sum
+//     decreases, which means that the scraped counter was reset.
+//   - The delta points are converted to cumulative points, by summing the deltas of their series.
+//
+// The state is persisted with the storage extension of the StateSettings, if any, so that the
+// series are not reset when the collector restarts, which would break the rate calculations.
+type StateTracker struct {
+	set        StateSettings
+	settings   receiver.CreateSettings
+	scraperID  component.ID
+	staleAfter time.Duration
+	now        func() time.Time
+
+	mu     sync.Mutex
+	series map[string]*seriesState
+	client storage.Client
+	// lastPersist is the time when the state was last written in the storage extension.
+	lastPersist time.Time
+}
+
+// NewStateTracker returns a StateTracker of the series scraped every collectionInterval by the
+// scraper with the given ID in the receiver created with the given settings. Its state is
+// persisted under the ID of the receiver and the scraper in the storage extension, if any.
+func NewStateTracker(settings receiver.CreateSettings, scraperID component.ID, collectionInterval time.Duration, set StateSettings) *StateTracker {
+	staleAfter := set.StaleAfter
+	if staleAfter == 0 {
+		staleAfter = defaultStaleIntervals * collectionInterval
+	}
+	return &StateTracker{
+		set:        set,
+		settings:   settings,
+		scraperID:  scraperID,
+		staleAfter: staleAfter,
+		now:        time.Now,
+		series:     map[string]*seriesState{},
+	}
+}
+
+// Start loads the persisted state from the storage extension, if any.
+func (st *StateTracker) Start(ctx context.Context, host component.Host) error {
+	if st.set.StorageID == nil {
+		return nil
+	}
+	ext, found := host.GetExtensions()[*st.set.StorageID]
+	if !found {
+		return fmt.Errorf("storage extension %q not found", st.set.StorageID)
+	}
+	storageExt, ok := ext.(storage.Extension)
+	if !ok {
+		return fmt.Errorf("extension %q is not a storage extension", st.set.StorageID)
+	}
+	client, err := storageExt.GetClient(ctx, component.KindReceiver, st.settings.ID, st.scraperID.String())
+	if err != nil {
+		return err
+	}
+	data, err := client.Get(ctx, stateKey)
+	if err != nil {
+		return multierr.Append(err, client.Close(ctx))
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.client = client
+	st.lastPersist = st.now()
+	if data == nil {
+		return nil
+	}
+	series := map[string]*seriesState{}
+	if err = json.Unmarshal(data, &series); err != nil {
+		// The series are reset, as if the state was not persisted.
+		st.settings.Logger.Warn("Failed to load the state of the series, starting without state",
+			zap.String("scraper", st.scraperID.String()), zap.Error(err))
+		return nil
+	}
+	// The series are not expired for the time the collector was stopped, they are given StaleAfter
+	// from now to be scraped again.
+	for _, state := range series {
+		state.LastSeen = st.lastPersist
+	}
+	st.series = series
+	return nil
+}
+
+// Shutdown persists the state with the storage extension, if any.
+func (st *StateTracker) Shutdown(ctx context.Context) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.client == nil {
+		return nil
+	}
+	err := multierr.Append(st.persist(ctx), st.client.Close(ctx))
+	st.client = nil
+	return err
+}
+
+// Track updates the points of the sums of the scraped metrics with the state of their series,
+// and persists the state with the storage extension, if any, at most every PersistInterval.
+// The series which were not scraped during StaleAfter are forgotten first.
+func (st *StateTracker) Track(ctx context.Context, md pmetric.Metrics) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	now := st.now()
+	if st.staleAfter > 0 {
+		for key, state := range st.series {
+			if now.Sub(state.LastSeen) > st.staleAfter {
+				delete(st.series, key)
+			}
+		}
+	}
+
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sm := sms.At(j)
+			ms := sm.Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				m := ms.At(k)
+				if m.Type() != pmetric.MetricTypeSum {
+					continue
+				}
+				sum := m.Sum()
+				dps := sum.DataPoints()
+				for l := 0; l < dps.Len(); l++ {
+					dp := dps.At(l)
+					key := seriesKey(rm.Resource(), sm.Scope(), m, dp)
+					state, found := st.series[key]
+					if !found {
+						state = &seriesState{}
+						st.series[key] = state
+					}
+					if sum.AggregationTemporality() == pmetric.AggregationTemporalityDelta {
+						accumulate(state, dp, found)
+					} else {
+						adjustStart(state, dp, found, sum.IsMonotonic())
+					}
+					state.Timestamp = dp.Timestamp()
+					state.LastSeen = now
+				}
+				if sum.AggregationTemporality() == pmetric.AggregationTemporalityDelta {
+					sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+				}
+			}
+		}
+	}
+
+	if st.client != nil && now.Sub(st.lastPersist) >= st.set.PersistInterval {
+		if err := st.persist(ctx); err != nil {
+			st.settings.Logger.Warn("Failed to persist the state of the series",
+				zap.String("scraper", st.scraperID.String()), zap.Error(err))
+		}
+	}
+}
+
+// persist writes the state with the storage client. It must be called with mu held.
+func (st *StateTracker) persist(ctx context.Context) error {
+	st.lastPersist = st.now()
+	data, err := json.Marshal(st.series)
+	if err != nil {
+		return err
+	}
+	return st.client.Set(ctx, stateKey, data)
+}
+
+// accumulate replaces the delta value of the point by the sum of the deltas of its series.
+func accumulate(state *seriesState, dp pmetric.NumberDataPoint, found bool) {
+	if !found {
+		state.Start = dp.StartTimestamp()
+		if state.Start == 0 {
+			state.Start = dp.Timestamp()
+		}
+	}
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeInt:
+		state.Int += dp.IntValue()
+		dp.SetIntValue(state.Int)
+	case pmetric.NumberDataPointValueTypeDouble:
+		state.Double += dp.DoubleValue()
+		dp.SetDoubleValue(state.Double)
+	}
+	dp.SetStartTimestamp(state.Start)
+}
+
+// adjustStart sets the start timestamp of the cumulative point if it has none.
+func adjustStart(state *seriesState, dp pmetric.NumberDataPoint, found bool, monotonic bool) {
+	var reset bool
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeInt:
+		reset = dp.IntValue() < state.Int
+		state.Int = dp.IntValue()
+	case pmetric.NumberDataPointValueTypeDouble:
+		reset = dp.DoubleValue() < state.Double
+		state.Double = dp.DoubleValue()
+	}
+	switch {
+	case !found:
+		state.Start = dp.Timestamp()
+	case monotonic && reset:
+		// The counter was reset after the previous point.
+		state.Start = state.Timestamp
+	}
+	if dp.StartTimestamp() == 0 {
+		dp.SetStartTimestamp(state.Start)
+	}
+}
+
+// seriesKey returns the key identifying the series of the point, from the attributes of its
+// resource, its scope, its metric and its own attributes.
+func seriesKey(res pcommon.Resource, scope pcommon.InstrumentationScope, m pmetric.Metric, dp pmetric.NumberDataPoint) string {
+	identity := []any{
+		res.Attributes().AsRaw(),
+		scope.Name(),
+		scope.Version(),
+		m.Name(),
+		dp.Attributes().AsRaw(),
+	}
+	// The keys of the maps are sorted when encoded in JSON, as when printed by fmt, which is only
+	// used for the values which can not be encoded in JSON, such as NaN.
+	data, err := json.Marshal(identity)
+	if err != nil {
+		data = fmt.Append(nil, identity...)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
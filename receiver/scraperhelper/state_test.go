@@ -0,0 +1,358 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scraperhelper
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestStateSettingsValidate(t *testing.T) {
+	set := NewDefaultStateSettings()
+	assert.NoError(t, set.Validate())
+	set.StaleAfter = -time.Second
+	assert.EqualError(t, set.Validate(), `"stale_after" must not be negative`)
+
+	set = NewDefaultStateSettings()
+	set.PersistInterval = -time.Second
+	assert.EqualError(t, set.Validate(), `"persist_interval" must not be negative`)
+}
+
+func TestStateTrackerCumulativeStart(t *testing.T) {
+	st := NewStateTracker(receivertest.NewNopCreateSettings(), component.NewID("scraper"), time.Minute, NewDefaultStateSettings())
+
+	for _, tc := range []struct {
+		timestamp     pcommon.Timestamp
+		value         int64
+		expectedStart pcommon.Timestamp
+	}{
+		{timestamp: 10, value: 5, expectedStart: 10},
+		{timestamp: 20, value: 8, expectedStart: 10},
+		// The counter was reset between the previous point and this one.
+		{timestamp: 30, value: 2, expectedStart: 20},
+		{timestamp: 40, value: 4, expectedStart: 20},
+	} {
+		md := newTestSum(pmetric.AggregationTemporalityCumulative, true, 0, tc.timestamp, tc.value)
+		st.Track(context.Background(), md)
+		dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+		assert.Equal(t, tc.expectedStart, dp.StartTimestamp())
+		assert.Equal(t, tc.value, dp.IntValue())
+	}
+
+	// The start timestamps set by the scrapers are kept.
+	md := newTestSum(pmetric.AggregationTemporalityCumulative, true, 35, 50, 1)
+	st.Track(context.Background(), md)
+	assert.Equal(t, pcommon.Timestamp(35), md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).StartTimestamp())
+}
+
+func TestStateTrackerNonMonotonicCumulative(t *testing.T) {
+	st := NewStateTracker(receivertest.NewNopCreateSettings(), component.NewID("scraper"), time.Minute, NewDefaultStateSettings())
+
+	st.Track(context.Background(), newTestSum(pmetric.AggregationTemporalityCumulative, false, 0, 10, 5))
+	md := newTestSum(pmetric.AggregationTemporalityCumulative, false, 0, 20, 2)
+	st.Track(context.Background(), md)
+	// A non-monotonic sum can decrease without being reset.
+	assert.Equal(t, pcommon.Timestamp(10), md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).StartTimestamp())
+}
+
+func TestStateTrackerDeltaToCumulative(t *testing.T) {
+	st := NewStateTracker(receivertest.NewNopCreateSettings(), component.NewID("scraper"), time.Minute, NewDefaultStateSettings())
+
+	for _, tc := range []struct {
+		start    pcommon.Timestamp
+		delta    int64
+		expected int64
+	}{
+		{start: 5, delta: 3, expected: 3},
+		{start: 10, delta: 4, expected: 7},
+		{start: 20, delta: 0, expected: 7},
+	} {
+		md := newTestSum(pmetric.AggregationTemporalityDelta, true, tc.start, tc.start+10, tc.delta)
+		st.Track(context.Background(), md)
+		sum := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum()
+		assert.Equal(t, pmetric.AggregationTemporalityCumulative, sum.AggregationTemporality())
+		assert.Equal(t, pcommon.Timestamp(5), sum.DataPoints().At(0).StartTimestamp())
+		assert.Equal(t, tc.expected, sum.DataPoints().At(0).IntValue())
+	}
+
+	var dp pmetric.NumberDataPoint
+	for _, timestamp := range []pcommon.Timestamp{10, 20} {
+		md := pmetric.NewMetrics()
+		sum := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptySum()
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		dp = sum.DataPoints().AppendEmpty()
+		dp.SetTimestamp(timestamp)
+		dp.SetDoubleValue(1.5)
+		st.Track(context.Background(), md)
+	}
+	assert.Equal(t, 3.0, dp.DoubleValue())
+	// The points without start timestamp start at the timestamp of the first point.
+	assert.Equal(t, pcommon.Timestamp(10), dp.StartTimestamp())
+}
+
+func TestStateTrackerSeriesIdentity(t *testing.T) {
+	st := NewStateTracker(receivertest.NewNopCreateSettings(), component.NewID("scraper"), time.Minute, NewDefaultStateSettings())
+
+	md := newTestSum(pmetric.AggregationTemporalityDelta, true, 0, 10, 1)
+	md.ResourceMetrics().At(0).Resource().Attributes().PutStr("host", "a")
+	st.Track(context.Background(), md)
+
+	// A point of another resource is another series.
+	md = newTestSum(pmetric.AggregationTemporalityDelta, true, 0, 20, 1)
+	md.ResourceMetrics().At(0).Resource().Attributes().PutStr("host", "b")
+	st.Track(context.Background(), md)
+	assert.Equal(t, int64(1), md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).IntValue())
+
+	// The attributes which can not be encoded in JSON identify the series too.
+	for _, timestamp := range []pcommon.Timestamp{20, 30} {
+		md = newTestSum(pmetric.AggregationTemporalityDelta, true, 0, timestamp, 1)
+		md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).Attributes().PutDouble("ratio", math.NaN())
+		st.Track(context.Background(), md)
+	}
+	assert.Equal(t, int64(2), md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).IntValue())
+	assert.Len(t, st.series, 3)
+}
+
+func TestStateTrackerStaleSeries(t *testing.T) {
+	set := NewDefaultStateSettings()
+	set.StaleAfter = time.Minute
+	st := NewStateTracker(receivertest.NewNopCreateSettings(), component.NewID("scraper"), time.Minute, set)
+	now := time.Now()
+	st.now = func() time.Time { return now }
+
+	st.Track(context.Background(), newTestSum(pmetric.AggregationTemporalityDelta, true, 0, 10, 1))
+	now = now.Add(time.Minute)
+	md := newTestSum(pmetric.AggregationTemporalityDelta, true, 0, 20, 1)
+	st.Track(context.Background(), md)
+	assert.Equal(t, int64(2), md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).IntValue())
+
+	// The series was not scraped for more than StaleAfter, it starts again.
+	now = now.Add(time.Minute + time.Second)
+	md = newTestSum(pmetric.AggregationTemporalityDelta, true, 30, 40, 1)
+	st.Track(context.Background(), md)
+	dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, int64(1), dp.IntValue())
+	assert.Equal(t, pcommon.Timestamp(30), dp.StartTimestamp())
+}
+
+func TestStateTrackerDefaultStaleAfter(t *testing.T) {
+	st := NewStateTracker(receivertest.NewNopCreateSettings(), component.NewID("scraper"), 10*time.Minute, NewDefaultStateSettings())
+	now := time.Now()
+	st.now = func() time.Time { return now }
+
+	// The series are kept between scrapes longer than 5 minutes apart.
+	for i, expected := range []int64{1, 2, 3} {
+		now = now.Add(10 * time.Minute)
+		md := newTestSum(pmetric.AggregationTemporalityDelta, true, 0, pcommon.Timestamp(i+1), 1)
+		st.Track(context.Background(), md)
+		assert.Equal(t, expected, md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).IntValue())
+	}
+
+	now = now.Add(50*time.Minute + time.Second)
+	md := newTestSum(pmetric.AggregationTemporalityDelta, true, 0, 10, 1)
+	st.Track(context.Background(), md)
+	assert.Equal(t, int64(1), md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).IntValue())
+}
+
+func TestStateTrackerPersistence(t *testing.T) {
+	storageID := component.NewID("storage")
+	host := &extensionsHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{storageID: &memStorage{}},
+	}
+	set := NewDefaultStateSettings()
+	set.StorageID = &storageID
+
+	st := NewStateTracker(receivertest.NewNopCreateSettings(), component.NewID("scraper"), time.Minute, set)
+	require.NoError(t, st.Start(context.Background(), host))
+	st.Track(context.Background(), newTestSum(pmetric.AggregationTemporalityDelta, true, 5, 10, 3))
+	require.NoError(t, st.Shutdown(context.Background()))
+
+	// The series continue after a restart, even if the collector was stopped longer than StaleAfter.
+	st = NewStateTracker(receivertest.NewNopCreateSettings(), component.NewID("scraper"), time.Minute, set)
+	st.now = func() time.Time { return time.Now().Add(time.Hour) }
+	require.NoError(t, st.Start(context.Background(), host))
+	md := newTestSum(pmetric.AggregationTemporalityDelta, true, 10, 20, 4)
+	st.Track(context.Background(), md)
+	dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, int64(7), dp.IntValue())
+	assert.Equal(t, pcommon.Timestamp(5), dp.StartTimestamp())
+	require.NoError(t, st.Shutdown(context.Background()))
+
+	// The state of another scraper is stored apart.
+	st = NewStateTracker(receivertest.NewNopCreateSettings(), component.NewID("other"), time.Minute, set)
+	require.NoError(t, st.Start(context.Background(), host))
+	assert.Empty(t, st.series)
+	require.NoError(t, st.Shutdown(context.Background()))
+}
+
+func TestStateTrackerPersistInterval(t *testing.T) {
+	storageID := component.NewID("storage")
+	mem := &memStorage{}
+	host := &extensionsHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{storageID: mem},
+	}
+	set := NewDefaultStateSettings()
+	set.StorageID = &storageID
+	set.PersistInterval = time.Minute
+
+	st := NewStateTracker(receivertest.NewNopCreateSettings(), component.NewID("scraper"), 10*time.Second, set)
+	now := time.Now()
+	st.now = func() time.Time { return now }
+	require.NoError(t, st.Start(context.Background(), host))
+	key := "Receiver//scraper/" + stateKey
+
+	now = now.Add(10 * time.Second)
+	st.Track(context.Background(), newTestSum(pmetric.AggregationTemporalityDelta, true, 0, 10, 1))
+	assert.Nil(t, mem.data[key])
+
+	now = now.Add(time.Minute)
+	st.Track(context.Background(), newTestSum(pmetric.AggregationTemporalityDelta, true, 0, 20, 1))
+	persisted := mem.data[key]
+	assert.NotNil(t, persisted)
+
+	now = now.Add(10 * time.Second)
+	st.Track(context.Background(), newTestSum(pmetric.AggregationTemporalityDelta, true, 0, 30, 1))
+	assert.Equal(t, persisted, mem.data[key])
+
+	// The state is always written on shutdown.
+	require.NoError(t, st.Shutdown(context.Background()))
+	assert.NotEqual(t, persisted, mem.data[key])
+}
+
+func TestStateTrackerStorageErrors(t *testing.T) {
+	storageID := component.NewID("storage")
+	set := NewDefaultStateSettings()
+	set.StorageID = &storageID
+
+	st := NewStateTracker(receivertest.NewNopCreateSettings(), component.NewID("scraper"), time.Minute, set)
+	assert.EqualError(t, st.Start(context.Background(), componenttest.NewNopHost()), `storage extension "storage" not found`)
+
+	host := &extensionsHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{storageID: &testExtension{}},
+	}
+	assert.EqualError(t, st.Start(context.Background(), host), `extension "storage" is not a storage extension`)
+
+	host.extensions[storageID] = &memStorage{getClientErr: errors.New("no client")}
+	assert.EqualError(t, st.Start(context.Background(), host), "no client")
+
+	// The state which can not be loaded is reset.
+	createSettings := receivertest.NewNopCreateSettings()
+	createSettings.ID = component.NewID("nop")
+	st = NewStateTracker(createSettings, component.NewID("scraper"), time.Minute, set)
+	mem := &memStorage{data: map[string][]byte{"Receiver/nop/scraper/" + stateKey: []byte("invalid")}}
+	host.extensions[storageID] = mem
+	require.NoError(t, st.Start(context.Background(), host))
+	assert.Empty(t, st.series)
+	require.NoError(t, st.Shutdown(context.Background()))
+	assert.Equal(t, "{}", string(mem.data["Receiver/nop/scraper/"+stateKey]))
+}
+
+func TestScraperWithStateTracker(t *testing.T) {
+	st := NewStateTracker(receivertest.NewNopCreateSettings(), component.NewID("scraper"), time.Minute, NewDefaultStateSettings())
+	var started, shutdown bool
+	scp, err := NewScraper("scraper",
+		func(context.Context) (pmetric.Metrics, error) {
+			return newTestSum(pmetric.AggregationTemporalityDelta, true, 5, 10, 2), errors.New("partial")
+		},
+		WithStateTracker(st),
+		WithStart(func(context.Context, component.Host) error {
+			started = true
+			return nil
+		}),
+		WithShutdown(func(context.Context) error {
+			shutdown = true
+			return nil
+		}))
+	require.NoError(t, err)
+
+	require.NoError(t, scp.Start(context.Background(), componenttest.NewNopHost()))
+	assert.True(t, started)
+	_, err = scp.Scrape(context.Background())
+	assert.Error(t, err)
+	md, err := scp.Scrape(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, int64(4), md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).IntValue())
+	require.NoError(t, scp.Shutdown(context.Background()))
+	assert.True(t, shutdown)
+}
+
+func newTestSum(temporality pmetric.AggregationTemporality, monotonic bool, start, timestamp pcommon.Timestamp, value int64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("requests")
+	sum := m.SetEmptySum()
+	sum.SetAggregationTemporality(temporality)
+	sum.SetIsMonotonic(monotonic)
+	dp := sum.DataPoints().AppendEmpty()
+	dp.Attributes().PutStr("method", "GET")
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(timestamp)
+	dp.SetIntValue(value)
+	return md
+}
+
+type testExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+// memStorage is a storage extension keeping the data in memory, by component and storage name.
+type memStorage struct {
+	testExtension
+	data         map[string][]byte
+	getClientErr error
+}
+
+func (m *memStorage) GetClient(_ context.Context, kind component.Kind, id component.ID, name string) (storage.Client, error) {
+	if m.getClientErr != nil {
+		return nil, m.getClientErr
+	}
+	if m.data == nil {
+		m.data = map[string][]byte{}
+	}
+	return &memClient{data: m.data, prefix: kind.String() + "/" + id.String() + "/" + name + "/"}, nil
+}
+
+type memClient struct {
+	data   map[string][]byte
+	prefix string
+}
+
+func (c *memClient) Get(_ context.Context, key string) ([]byte, error) {
+	return c.data[c.prefix+key], nil
+}
+
+func (c *memClient) Set(_ context.Context, key string, value []byte) error {
+	c.data[c.prefix+key] = value
+	return nil
+}
+
+func (c *memClient) Delete(_ context.Context, key string) error {
+	delete(c.data, c.prefix+key)
+	return nil
+}
+
+func (c *memClient) Batch(context.Context, ...storage.Operation) error {
+	return errors.New("not implemented")
+}
+
+func (c *memClient) Close(context.Context) error {
+	return nil
+}
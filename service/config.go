@@ -4,8 +4,10 @@
 package service // import "go.opentelemetry.io/collector/service"
 
 import (
+	"errors"
 	"fmt"
 
+	"go.opentelemetry.io/collector/connector"
 	"go.opentelemetry.io/collector/service/extensions"
 	"go.opentelemetry.io/collector/service/pipelines"
 	"go.opentelemetry.io/collector/service/telemetry"
@@ -21,9 +23,32 @@ type Config struct {
 
 	// Pipelines are the set of data pipelines configured for the service.
 	Pipelines pipelines.Config `mapstructure:"pipelines"`
+
+	// FeatureGates is the list of feature gate identifiers to enable or disable, using the syntax
+	// of the --feature-gates flag: prefix with '-' to disable the feature, '+' or no prefix to enable it.
+	FeatureGates []string `mapstructure:"feature_gates"`
+
+	// RuntimeFeatureGates allows enabling and disabling the runtime safe feature gates from the
+	// featurez zPages page while the collector is running. It is disabled by default, since the
+	// zPages are not authenticated: only enable it when the zPages endpoint is not exposed.
+	RuntimeFeatureGates bool `mapstructure:"runtime_feature_gates"`
+
+	// ConnectorBuffer configures the buffering of the data consumed by the connectors. The connectors
+	// consume the data synchronously when its queue_size is 0, the default.
+	ConnectorBuffer connector.BufferSettings `mapstructure:"connector_buffer"`
 }
 
 func (cfg *Config) Validate() error {
+	for _, id := range cfg.FeatureGates {
+		if id == "" || id == "-" || id == "+" {
+			return fmt.Errorf("service::feature_gates config validation failed: %w", errors.New("empty feature gate identifier"))
+		}
+	}
+
+	if err := cfg.ConnectorBuffer.Validate(); err != nil {
+		return fmt.Errorf("service::connector_buffer config validation failed: %w", err)
+	}
+
 	if err := cfg.Pipelines.Validate(); err != nil {
 		return fmt.Errorf("service::pipelines config validation failed: %w", err)
 	}
@@ -13,6 +13,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configtelemetry"
+	"go.opentelemetry.io/collector/connector"
 	"go.opentelemetry.io/collector/service/extensions"
 	"go.opentelemetry.io/collector/service/pipelines"
 	"go.opentelemetry.io/collector/service/telemetry"
@@ -61,6 +62,42 @@ func TestConfigValidate(t *testing.T) {
 			},
 			expected: fmt.Errorf(`service::pipelines config validation failed: %w`, errors.New(`pipeline "wrongtype": unknown datatype "wrongtype"`)),
 		},
+		{
+			name: "feature-gates",
+			cfgFn: func() *Config {
+				cfg := generateConfig()
+				cfg.FeatureGates = []string{"alpha", "-beta", "+gamma"}
+				return cfg
+			},
+			expected: nil,
+		},
+		{
+			name: "empty-feature-gate",
+			cfgFn: func() *Config {
+				cfg := generateConfig()
+				cfg.FeatureGates = []string{"alpha", "-"}
+				return cfg
+			},
+			expected: fmt.Errorf(`service::feature_gates config validation failed: %w`, errors.New("empty feature gate identifier")),
+		},
+		{
+			name: "connector-buffer",
+			cfgFn: func() *Config {
+				cfg := generateConfig()
+				cfg.ConnectorBuffer = connector.BufferSettings{QueueSize: 100, NumShards: 4}
+				return cfg
+			},
+			expected: nil,
+		},
+		{
+			name: "invalid-connector-buffer",
+			cfgFn: func() *Config {
+				cfg := generateConfig()
+				cfg.ConnectorBuffer.QueueSize = -1
+				return cfg
+			},
+			expected: fmt.Errorf(`service::connector_buffer config validation failed: %w`, errors.New("queue_size must not be negative")),
+		},
 		{
 			name: "invalid-telemetry-metric-config",
 			cfgFn: func() *Config {
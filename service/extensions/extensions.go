@@ -41,7 +41,7 @@ func (bes *Extensions) Start(ctx context.Context, host component.Host) error {
 			instanceID,
 			component.NewStatusEvent(component.StatusStarting),
 		)
-		if err := ext.Start(ctx, host); err != nil {
+		if err := bes.telemetry.Panics.Call(ctx, instanceID, "Start", func() error { return ext.Start(ctx, host) }); err != nil {
 			bes.telemetry.Status.ReportStatus(
 				instanceID,
 				component.NewPermanentErrorEvent(err),
@@ -66,7 +66,7 @@ func (bes *Extensions) Shutdown(ctx context.Context) error {
 			instanceID,
 			component.NewStatusEvent(component.StatusStopping),
 		)
-		if err := ext.Shutdown(ctx); err != nil {
+		if err := bes.telemetry.Panics.Call(ctx, instanceID, "Shutdown", func() error { return ext.Shutdown(ctx) }); err != nil {
 			bes.telemetry.Status.ReportStatus(
 				instanceID,
 				component.NewPermanentErrorEvent(err),
@@ -5,6 +5,7 @@ package service // import "go.opentelemetry.io/collector/service"
 
 import (
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/connector"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/extension"
@@ -26,6 +27,12 @@ type serviceHost struct {
 
 	buildInfo component.BuildInfo
 
+	effectiveConf func() (*confmap.Conf, error)
+	confOrigins   map[string]string
+
+	// runtimeFeatureGates is true if the feature gates can be toggled from the featurez page.
+	runtimeFeatureGates bool
+
 	pipelines         *graph.Graph
 	serviceExtensions *extensions.Extensions
 }
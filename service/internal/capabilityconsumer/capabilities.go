@@ -54,3 +54,19 @@ type capTraces struct {
 func (mts capTraces) Capabilities() consumer.Capabilities {
 	return mts.cap
 }
+
+func NewProfiles(profiles consumer.Profiles, cap consumer.Capabilities) consumer.Profiles {
+	if profiles.Capabilities() == cap {
+		return profiles
+	}
+	return capProfiles{Profiles: profiles, cap: cap}
+}
+
+type capProfiles struct {
+	consumer.Profiles
+	cap consumer.Capabilities
+}
+
+func (mts capProfiles) Capabilities() consumer.Capabilities {
+	return mts.cap
+}
@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package crashreport records the panics of the components recovered at their call boundaries,
+// so that a faulty component does not take down all the pipelines with an unattributed stack trace.
+package crashreport // import "go.opentelemetry.io/collector/service/internal/crashreport"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+const (
+	scopeName = "go.opentelemetry.io/collector/service/crashreport"
+
+	kindKey = "component_kind"
+	idKey   = "component_id"
+)
+
+// Settings are the settings of a Reporter.
+type Settings struct {
+	// Logger logs the recovered panics.
+	Logger *zap.Logger
+
+	// MeterProvider provides the component_panics counter.
+	MeterProvider otelmetric.MeterProvider
+
+	// BuildInfo is the build information of the collector, written in the bundles.
+	BuildInfo component.BuildInfo
+
+	// Directory is the directory where a diagnostic bundle is written for each panic.
+	// No bundle is written if empty.
+	Directory string
+
+	// ConfigHash is the hash of the effective configuration, written in the bundles
+	// instead of the configuration, which may hold sensitive values.
+	ConfigHash string
+
+	// Logs holds the recent internal logs written in the bundles, if not nil.
+	Logs *LogBuffer
+}
+
+// Reporter records the panics of the components: it logs them, counts them with the
+// component_panics counter and writes a diagnostic bundle for each of them.
+// A nil *Reporter does not recover the panics in Call, and Recover only converts them to errors.
+type Reporter struct {
+	set    Settings
+	panics otelmetric.Int64Counter
+	// seq makes the names of the bundles written at the same time unique.
+	seq atomic.Int64
+}
+
+// NewReporter returns a Reporter with the given settings.
+func NewReporter(set Settings) (*Reporter, error) {
+	panics, err := set.MeterProvider.Meter(scopeName).Int64Counter(
+		"component_panics",
+		otelmetric.WithDescription("Number of panics of the components recovered by the collector"),
+		otelmetric.WithUnit("1"))
+	if err != nil {
+		return nil, err
+	}
+	return &Reporter{set: set, panics: panics}, nil
+}
+
+// Bundle is the diagnostic bundle written as JSON for a panic of a component.
+type Bundle struct {
+	Time      time.Time `json:"time"`
+	Version   string    `json:"version"`
+	Kind      string    `json:"component_kind"`
+	ID        string    `json:"component_id"`
+	Pipelines []string  `json:"pipelines,omitempty"`
+	// Operation is the method of the component which panicked, e.g. "ConsumeTraces".
+	Operation  string   `json:"operation"`
+	Panic      string   `json:"panic"`
+	Stack      string   `json:"stack"`
+	ConfigHash string   `json:"config_hash,omitempty"`
+	Logs       []string `json:"logs,omitempty"`
+}
+
+// Recover records the panic of the component during the given operation, and returns it as an
+// error attributed to the component. It must be called with the value returned by recover() in
+// the deferred function.
+func (r *Reporter) Recover(ctx context.Context, instanceID *component.InstanceID, operation string, recovered any) error {
+	kind := strings.ToLower(instanceID.Kind.String())
+	err := fmt.Errorf("%s %q panicked during %s: %v", kind, instanceID.ID, operation, recovered)
+	if r == nil {
+		return err
+	}
+
+	stack := string(debug.Stack())
+	r.set.Logger.Error("Recovered from a panic of a component",
+		zap.String(kindKey, kind),
+		zap.String(idKey, instanceID.ID.String()),
+		zap.String("operation", operation),
+		zap.Any("panic", recovered),
+		zap.String("stack", stack))
+	r.panics.Add(ctx, 1, otelmetric.WithAttributes(
+		attribute.String(kindKey, kind),
+		attribute.String(idKey, instanceID.ID.String())))
+
+	if r.set.Directory == "" {
+		return err
+	}
+	bundle := Bundle{
+		Time:       time.Now(),
+		Version:    r.set.BuildInfo.Version,
+		Kind:       kind,
+		ID:         instanceID.ID.String(),
+		Operation:  operation,
+		Panic:      fmt.Sprint(recovered),
+		Stack:      stack,
+		ConfigHash: r.set.ConfigHash,
+		Logs:       r.set.Logs.Entries(),
+	}
+	for pipelineID := range instanceID.PipelineIDs {
+		bundle.Pipelines = append(bundle.Pipelines, pipelineID.String())
+	}
+	sort.Strings(bundle.Pipelines)
+	path, writeErr := r.write(bundle)
+	if writeErr != nil {
+		r.set.Logger.Error("Failed to write the diagnostic bundle of the panic", zap.Error(writeErr))
+		return err
+	}
+	r.set.Logger.Info("Wrote the diagnostic bundle of the panic", zap.String("path", path))
+	return err
+}
+
+// Call calls f, and returns the panic of the component during the given operation as an error
+// with Recover if f panics. If r is nil, the panic is not recovered.
+func (r *Reporter) Call(ctx context.Context, instanceID *component.InstanceID, operation string, f func() error) (err error) {
+	if r == nil {
+		return f()
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = r.Recover(ctx, instanceID, operation, recovered)
+		}
+	}()
+	return f()
+}
+
+func (r *Reporter) write(bundle Bundle) (string, error) {
+	if err := os.MkdirAll(r.set.Directory, 0o700); err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	// The ID of the component may contain characters which are not allowed in file names.
+	name := fmt.Sprintf("panic-%s-%d-%s-%s.json", bundle.Time.UTC().Format("20060102T150405Z"), r.seq.Add(1),
+		bundle.Kind, strings.NewReplacer("/", "_", "\\", "_", ":", "_").Replace(bundle.ID))
+	path := filepath.Join(r.set.Directory, name)
+	return path, os.WriteFile(path, data, 0o600)
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package crashreport
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+func newTestReporter(t *testing.T, set Settings) (*Reporter, *sdkmetric.ManualReader, *observer.ObservedLogs) {
+	core, logs := observer.New(zap.InfoLevel)
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { assert.NoError(t, mp.Shutdown(context.Background())) })
+	set.Logger = zap.New(core)
+	set.MeterProvider = mp
+	r, err := NewReporter(set)
+	require.NoError(t, err)
+	return r, reader, logs
+}
+
+func collectPanics(t *testing.T, reader *sdkmetric.ManualReader) map[string]int64 {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	panics := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "component_panics" {
+				continue
+			}
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				kind, _ := dp.Attributes.Value(kindKey)
+				id, _ := dp.Attributes.Value(idKey)
+				panics[kind.AsString()+"/"+id.AsString()] = dp.Value
+			}
+		}
+	}
+	return panics
+}
+
+func TestRecover(t *testing.T) {
+	dir := t.TempDir()
+	buf := NewLogBuffer(2)
+	buf.add(`{"msg":"first"}`)
+	r, reader, logs := newTestReporter(t, Settings{
+		BuildInfo:  component.BuildInfo{Version: "1.2.3"},
+		Directory:  dir,
+		ConfigHash: "abc",
+		Logs:       buf,
+	})
+	instanceID := &component.InstanceID{
+		ID:   component.NewIDWithName("otlp", "in"),
+		Kind: component.KindReceiver,
+		PipelineIDs: map[component.ID]struct{}{
+			component.NewID(component.DataTypeTraces):  {},
+			component.NewID(component.DataTypeMetrics): {},
+		},
+	}
+
+	err := r.Call(context.Background(), instanceID, "Start", func() error { panic("boom") })
+	assert.EqualError(t, err, `receiver "otlp/in" panicked during Start: boom`)
+	err = r.Call(context.Background(), instanceID, "Shutdown", func() error { return nil })
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]int64{"receiver/otlp/in": 1}, collectPanics(t, reader))
+	assert.Equal(t, 1, logs.FilterMessage("Recovered from a panic of a component").Len())
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Regexp(t, `^panic-\d{8}T\d{6}Z-1-receiver-otlp_in\.json$`, files[0].Name())
+	data, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	var bundle Bundle
+	require.NoError(t, json.Unmarshal(data, &bundle))
+	assert.Equal(t, "1.2.3", bundle.Version)
+	assert.Equal(t, "receiver", bundle.Kind)
+	assert.Equal(t, "otlp/in", bundle.ID)
+	assert.Equal(t, []string{"metrics", "traces"}, bundle.Pipelines)
+	assert.Equal(t, "Start", bundle.Operation)
+	assert.Equal(t, "boom", bundle.Panic)
+	assert.Contains(t, bundle.Stack, "TestRecover")
+	assert.Equal(t, "abc", bundle.ConfigHash)
+	assert.Equal(t, []string{`{"msg":"first"}`}, bundle.Logs)
+}
+
+func TestRecoverWithoutDirectory(t *testing.T) {
+	r, reader, _ := newTestReporter(t, Settings{})
+	instanceID := &component.InstanceID{ID: component.NewID("debug"), Kind: component.KindExporter}
+	err := r.Recover(context.Background(), instanceID, "ConsumeLogs", "boom")
+	assert.EqualError(t, err, `exporter "debug" panicked during ConsumeLogs: boom`)
+	assert.Equal(t, map[string]int64{"exporter/debug": 1}, collectPanics(t, reader))
+}
+
+func TestRecoverWriteError(t *testing.T) {
+	// The directory can not be created, as its parent is a file.
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+	r, _, logs := newTestReporter(t, Settings{Directory: filepath.Join(file, "bundles")})
+	instanceID := &component.InstanceID{ID: component.NewID("batch"), Kind: component.KindProcessor}
+	err := r.Recover(context.Background(), instanceID, "ConsumeTraces", "boom")
+	assert.EqualError(t, err, `processor "batch" panicked during ConsumeTraces: boom`)
+	assert.Equal(t, 1, logs.FilterMessage("Failed to write the diagnostic bundle of the panic").Len())
+}
+
+func TestNilReporter(t *testing.T) {
+	var r *Reporter
+	instanceID := &component.InstanceID{ID: component.NewID("health_check"), Kind: component.KindExtension}
+	assert.PanicsWithValue(t, "boom", func() {
+		_ = r.Call(context.Background(), instanceID, "Start", func() error { panic("boom") })
+	})
+	err := r.Recover(context.Background(), instanceID, "Start", "boom")
+	assert.EqualError(t, err, `extension "health_check" panicked during Start: boom`)
+}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package crashreport // import "go.opentelemetry.io/collector/service/internal/crashreport"
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// LogBuffer keeps the most recent internal log entries, encoded as JSON, to write them in
+// the diagnostic bundles. Its Core is meant to be teed with the core of the service logger.
+// As for the configuration, the values of the fields of the entries, which may hold sensitive
+// values, are replaced by their SHA-256 hash.
+type LogBuffer struct {
+	mu      sync.Mutex
+	entries []string
+	// next is the index of the next entry to write, the entries form a ring once full.
+	next int
+	full bool
+}
+
+// NewLogBuffer returns a LogBuffer keeping the given number of entries.
+func NewLogBuffer(size int) *LogBuffer {
+	return &LogBuffer{entries: make([]string, size)}
+}
+
+// Entries returns the buffered entries, from the oldest to the most recent.
+func (b *LogBuffer) Entries() []string {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.full {
+		return append([]string(nil), b.entries[:b.next]...)
+	}
+	return append(append([]string(nil), b.entries[b.next:]...), b.entries[:b.next]...)
+}
+
+func (b *LogBuffer) add(entry string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.entries) == 0 {
+		return
+	}
+	b.entries[b.next] = entry
+	b.next = (b.next + 1) % len(b.entries)
+	b.full = b.full || b.next == 0
+}
+
+// Core returns a zapcore.Core writing the entries of the given level and above to the buffer.
+func (b *LogBuffer) Core(level zapcore.LevelEnabler) zapcore.Core {
+	return &bufferCore{
+		LevelEnabler: level,
+		enc: zapcore.NewJSONEncoder(zapcore.EncoderConfig{
+			TimeKey:        "ts",
+			LevelKey:       "level",
+			NameKey:        "logger",
+			MessageKey:     "msg",
+			EncodeLevel:    zapcore.LowercaseLevelEncoder,
+			EncodeTime:     zapcore.ISO8601TimeEncoder,
+			EncodeDuration: zapcore.StringDurationEncoder,
+		}),
+		buffer: b,
+	}
+}
+
+type bufferCore struct {
+	zapcore.LevelEnabler
+	enc    zapcore.Encoder
+	buffer *LogBuffer
+}
+
+func (c *bufferCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := &bufferCore{LevelEnabler: c.LevelEnabler, enc: c.enc.Clone(), buffer: c.buffer}
+	for _, f := range redact(fields) {
+		f.AddTo(clone.enc)
+	}
+	return clone
+}
+
+func (c *bufferCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *bufferCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	// The stack traces are not kept, the one of the panic is written in the bundle.
+	ent.Stack = ""
+	ent.Time = ent.Time.UTC().Truncate(time.Millisecond)
+	buf, err := c.enc.EncodeEntry(ent, redact(fields))
+	if err != nil {
+		return err
+	}
+	c.buffer.add(string(buf.Bytes()[:buf.Len()-1]))
+	buf.Free()
+	return nil
+}
+
+func (c *bufferCore) Sync() error {
+	return nil
+}
+
+// redact returns the fields with their values replaced by the hex encoded SHA-256 hash of their JSON encoding.
+func redact(fields []zapcore.Field) []zapcore.Field {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+	keys := make([]string, 0, len(enc.Fields))
+	for key := range enc.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	redacted := make([]zapcore.Field, 0, len(keys))
+	for _, key := range keys {
+		data, err := json.Marshal(enc.Fields[key])
+		if err != nil {
+			data = []byte(fmt.Sprint(enc.Fields[key]))
+		}
+		sum := sha256.Sum256(data)
+		redacted = append(redacted, zap.String(key, hex.EncodeToString(sum[:])))
+	}
+	return redacted
+}
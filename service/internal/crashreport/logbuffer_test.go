@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package crashreport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLogBuffer(t *testing.T) {
+	buf := NewLogBuffer(2)
+	assert.Empty(t, buf.Entries())
+
+	logger := zap.New(buf.Core(zapcore.InfoLevel)).With(zap.String("kind", "receiver"))
+	logger.Debug("not kept")
+	logger.Info("first", zap.Int("n", 1))
+	assert.Len(t, buf.Entries(), 1)
+	// The values of the fields are redacted.
+	assert.Contains(t, buf.Entries()[0], `"msg":"first","kind":"`+hash(`"receiver"`)+`","n":"`+hash(`1`)+`"`)
+	assert.NotContains(t, buf.Entries()[0], `receiver`)
+
+	logger.Warn("second")
+	logger.Error("third")
+	entries := buf.Entries()
+	assert.Len(t, entries, 2)
+	assert.Contains(t, entries[0], `"msg":"second"`)
+	assert.Contains(t, entries[1], `"msg":"third"`)
+}
+
+func TestNilLogBuffer(t *testing.T) {
+	var buf *LogBuffer
+	assert.Nil(t, buf.Entries())
+}
+
+func hash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
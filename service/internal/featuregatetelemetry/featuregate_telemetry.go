@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package featuregatetelemetry reports the state of the feature gates as internal telemetry,
+// so the experimental behaviors active in a fleet of collectors can be audited.
+package featuregatetelemetry // import "go.opentelemetry.io/collector/service/internal/featuregatetelemetry"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+const (
+	scopeName = "go.opentelemetry.io/collector/service/featuregate_telemetry"
+
+	idKey      = "feature_gate_id"
+	stageKey   = "feature_gate_stage"
+	enabledKey = "feature_gate_enabled"
+)
+
+// RegisterMetrics registers the feature_gate_info gauge, which has a data point of value 1 for
+// each gate of the registry, with the id, the stage and the effective state of the gate as attributes.
+// The state is observed on each collection, so the gates toggled at runtime are reported.
+func RegisterMetrics(mp otelmetric.MeterProvider, reg *featuregate.Registry) error {
+	_, err := mp.Meter(scopeName).Int64ObservableGauge(
+		"feature_gate_info",
+		otelmetric.WithDescription("Feature gates registered in the collector, with their stage and whether they are enabled"),
+		otelmetric.WithUnit("1"),
+		otelmetric.WithInt64Callback(func(_ context.Context, o otelmetric.Int64Observer) error {
+			reg.VisitAll(func(g *featuregate.Gate) {
+				o.Observe(1, otelmetric.WithAttributes(
+					attribute.String(idKey, g.ID()),
+					attribute.String(stageKey, g.Stage().String()),
+					attribute.Bool(enabledKey, g.IsEnabled()),
+				))
+			})
+			return nil
+		}))
+	return err
+}
+
+// LogGates logs the id, the stage and the effective state of each gate of the registry.
+func LogGates(logger *zap.Logger, reg *featuregate.Registry) {
+	var gates gateList
+	reg.VisitAll(func(g *featuregate.Gate) {
+		gates = append(gates, g)
+	})
+	logger.Info("Feature gates", zap.Array("feature_gates", gates))
+}
+
+type gateList []*featuregate.Gate
+
+func (gl gateList) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, g := range gl {
+		if err := enc.AppendObject(gate{g}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type gate struct {
+	*featuregate.Gate
+}
+
+func (g gate) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("id", g.ID())
+	enc.AddString("stage", g.Stage().String())
+	enc.AddBool("enabled", g.IsEnabled())
+	return nil
+}
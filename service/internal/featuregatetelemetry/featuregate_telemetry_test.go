@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package featuregatetelemetry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+func newTestRegistry() *featuregate.Registry {
+	reg := featuregate.NewRegistry()
+	reg.MustRegister("alpha", featuregate.StageAlpha)
+	reg.MustRegister("beta", featuregate.StageBeta, featuregate.WithRegisterRuntimeSafe())
+	return reg
+}
+
+// collectGates returns the stage and enabled attributes of the data points of the feature_gate_info gauge, keyed by gate id.
+func collectGates(t *testing.T, reader sdkmetric.Reader) map[string]string {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	assert.Equal(t, scopeName, rm.ScopeMetrics[0].Scope.Name)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	m := rm.ScopeMetrics[0].Metrics[0]
+	assert.Equal(t, "feature_gate_info", m.Name)
+
+	gates := map[string]string{}
+	for _, dp := range m.Data.(metricdata.Gauge[int64]).DataPoints {
+		assert.Equal(t, int64(1), dp.Value)
+		id, _ := dp.Attributes.Value(idKey)
+		stage, _ := dp.Attributes.Value(stageKey)
+		enabled, _ := dp.Attributes.Value(enabledKey)
+		gates[id.AsString()] = stage.AsString() + "," + enabled.Emit()
+	}
+	return gates
+}
+
+func TestRegisterMetrics(t *testing.T) {
+	reg := newTestRegistry()
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { assert.NoError(t, mp.Shutdown(context.Background())) })
+	require.NoError(t, RegisterMetrics(mp, reg))
+
+	assert.Equal(t, map[string]string{"alpha": "Alpha,false", "beta": "Beta,true"}, collectGates(t, reader))
+
+	// The gates toggled at runtime are reported on the next collection.
+	require.NoError(t, reg.SetAtRuntime("beta", false))
+	assert.Equal(t, map[string]string{"alpha": "Alpha,false", "beta": "Beta,false"}, collectGates(t, reader))
+}
+
+func TestLogGates(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	LogGates(zap.New(core), newTestRegistry())
+
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, "Feature gates", entry.Message)
+	assert.Equal(t, map[string]any{
+		"feature_gates": []any{
+			map[string]any{"id": "alpha", "stage": "Alpha", "enabled": false},
+			map[string]any{"id": "beta", "stage": "Beta", "enabled": true},
+		},
+	}, entry.ContextMap())
+}
@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graph // import "go.opentelemetry.io/collector/service/internal/graph"
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gonum.org/v1/gonum/graph"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// edgeConsumer passes the data sent over an edge of the graph to the consumer of the node it points to,
+// keeping track of the amount of data and of the calls that have not returned yet once counting is set.
+type edgeConsumer struct {
+	from, to graph.Node
+	next     baseConsumer
+
+	// counting is set once the data sent over the edges is counted, that is once the graph is rendered.
+	// It is shared by the edges of the graph, so that nothing is counted unless the graph is looked at.
+	counting *atomic.Bool
+
+	// recoverPanic converts the panic of the consumer during the given operation to an error.
+	// If nil, the panics are not recovered.
+	recoverPanic func(ctx context.Context, operation string, recovered any) error
+
+	// items is the number of spans, data points, log records or profile samples sent over the edge.
+	// inFlight is the number of calls to the consumer which have not returned yet.
+	items    atomic.Int64
+	batches  atomic.Int64
+	inFlight atomic.Int64
+
+	// The throughput is computed from the items sent since the previous time it was observed.
+	mu             sync.Mutex
+	lastItems      int64
+	lastTime       time.Time
+	itemsPerSecond float64
+}
+
+// minRateWindow is the minimum duration over which the throughput of an edge is computed,
+// so that the throughput observed by frequent requests is not skewed by a few batches.
+const minRateWindow = time.Second
+
+func newEdgeConsumer(from, to graph.Node, next baseConsumer, counting *atomic.Bool) *edgeConsumer {
+	return &edgeConsumer{from: from, to: to, next: next, counting: counting, lastTime: time.Now()}
+}
+
+func (e *edgeConsumer) Capabilities() consumer.Capabilities {
+	return e.next.Capabilities()
+}
+
+func (e *edgeConsumer) ConsumeTraces(ctx context.Context, td ptrace.Traces) (err error) {
+	counted := e.counting.Load()
+	if counted {
+		e.begin(td.SpanCount())
+	}
+	defer e.end(ctx, "ConsumeTraces", counted, &err)
+	return e.next.(consumer.Traces).ConsumeTraces(ctx, td)
+}
+
+func (e *edgeConsumer) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) (err error) {
+	counted := e.counting.Load()
+	if counted {
+		e.begin(md.DataPointCount())
+	}
+	defer e.end(ctx, "ConsumeMetrics", counted, &err)
+	return e.next.(consumer.Metrics).ConsumeMetrics(ctx, md)
+}
+
+func (e *edgeConsumer) ConsumeLogs(ctx context.Context, ld plog.Logs) (err error) {
+	counted := e.counting.Load()
+	if counted {
+		e.begin(ld.LogRecordCount())
+	}
+	defer e.end(ctx, "ConsumeLogs", counted, &err)
+	return e.next.(consumer.Logs).ConsumeLogs(ctx, ld)
+}
+
+func (e *edgeConsumer) ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) (err error) {
+	counted := e.counting.Load()
+	if counted {
+		e.begin(pd.SampleCount())
+	}
+	defer e.end(ctx, "ConsumeProfiles", counted, &err)
+	return e.next.(consumer.Profiles).ConsumeProfiles(ctx, pd)
+}
+
+func (e *edgeConsumer) begin(items int) {
+	e.inFlight.Add(1)
+	e.batches.Add(1)
+	e.items.Add(int64(items))
+}
+
+// end marks the end of a call to the consumer, if counted when it began, and recovers its panic
+// if recoverPanic is set. It must be deferred.
+func (e *edgeConsumer) end(ctx context.Context, operation string, counted bool, err *error) {
+	if counted {
+		e.inFlight.Add(-1)
+	}
+	if e.recoverPanic == nil {
+		return
+	}
+	if recovered := recover(); recovered != nil {
+		*err = e.recoverPanic(ctx, operation, recovered)
+	}
+}
+
+// edgeStats is a snapshot of the data sent over an edge.
+type edgeStats struct {
+	items          int64
+	batches        int64
+	inFlight       int64
+	itemsPerSecond float64
+}
+
+// stats returns the current stats of the edge. The throughput is updated
+// if at least minRateWindow elapsed since it was last updated.
+func (e *edgeConsumer) stats(now time.Time) edgeStats {
+	items := e.items.Load()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if elapsed := now.Sub(e.lastTime); elapsed >= minRateWindow {
+		e.itemsPerSecond = float64(items-e.lastItems) / elapsed.Seconds()
+		e.lastItems = items
+		e.lastTime = now
+	}
+	return edgeStats{
+		items:          items,
+		batches:        e.batches.Load(),
+		inFlight:       e.inFlight.Load(),
+		itemsPerSecond: e.itemsPerSecond,
+	}
+}
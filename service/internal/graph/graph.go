@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
 
 	"go.uber.org/multierr"
 	"gonum.org/v1/gonum/graph"
@@ -17,6 +18,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/connector"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/internal/fanoutconsumer"
 	"go.opentelemetry.io/collector/processor"
@@ -50,6 +52,11 @@ type Graph struct {
 	// Keep track of status source per node
 	instanceIDs map[int64]*component.InstanceID
 
+	// The consumers of the edges of the graph, keeping track of the data flowing through them
+	// once edgeCounting is set, when the graph is first rendered.
+	edges        []*edgeConsumer
+	edgeCounting atomic.Bool
+
 	telemetry servicetelemetry.TelemetrySettings
 }
 
@@ -288,13 +295,16 @@ func (g *Graph) buildComponents(ctx context.Context, set Settings) error {
 		case *connectorNode:
 			err = n.buildComponent(ctx, telemetrySettings, set.BuildInfo, set.ConnectorBuilder, g.nextConsumers(n.ID()))
 		case *capabilitiesNode:
-			capability := consumer.Capabilities{
-				// The fanOutNode represents the aggregate capabilities of the exporters in the pipeline.
-				MutatesData: g.pipelines[n.pipelineID].fanOutNode.getConsumer().Capabilities().MutatesData,
-			}
+			// The fanOutNode represents the aggregate capabilities of the exporters in the pipeline.
+			capability := g.pipelines[n.pipelineID].fanOutNode.getConsumer().Capabilities()
+			capability.CopiesReadOnlyData = capability.CopiesReadOnlyData || !capability.MutatesData
 			for _, proc := range g.pipelines[n.pipelineID].processors {
-				capability.MutatesData = capability.MutatesData || proc.getConsumer().Capabilities().MutatesData
+				procCapability := proc.getConsumer().Capabilities()
+				capability.MutatesData = capability.MutatesData || procCapability.MutatesData
+				// The read-only data can only be shared with the pipeline if all the components which mutate it copy it first.
+				capability.CopiesReadOnlyData = capability.CopiesReadOnlyData && (procCapability.CopiesReadOnlyData || !procCapability.MutatesData)
 			}
+			capability.CopiesReadOnlyData = capability.CopiesReadOnlyData && capability.MutatesData
 			next := g.nextConsumers(n.ID())[0]
 			switch n.pipelineID.Type() {
 			case component.DataTypeTraces:
@@ -309,6 +319,10 @@ func (g *Graph) buildComponents(ctx context.Context, set Settings) error {
 				cc := capabilityconsumer.NewLogs(next.(consumer.Logs), capability)
 				n.baseConsumer = cc
 				n.ConsumeLogsFunc = cc.ConsumeLogs
+			case component.DataTypeProfiles:
+				cc := capabilityconsumer.NewProfiles(next.(consumer.Profiles), capability)
+				n.baseConsumer = cc
+				n.ConsumeProfilesFunc = cc.ConsumeProfiles
 			}
 		case *fanOutNode:
 			nexts := g.nextConsumers(n.ID())
@@ -331,6 +345,12 @@ func (g *Graph) buildComponents(ctx context.Context, set Settings) error {
 					consumers = append(consumers, next.(consumer.Logs))
 				}
 				n.baseConsumer = fanoutconsumer.NewLogs(consumers)
+			case component.DataTypeProfiles:
+				consumers := make([]consumer.Profiles, 0, len(nexts))
+				for _, next := range nexts {
+					consumers = append(consumers, next.(consumer.Profiles))
+				}
+				n.baseConsumer = fanoutconsumer.NewProfiles(consumers)
 			}
 		}
 		if err != nil {
@@ -340,12 +360,25 @@ func (g *Graph) buildComponents(ctx context.Context, set Settings) error {
 	return nil
 }
 
-// Find all nodes
+// Find all nodes the node emits to, and return their consumers wrapped in the consumers of the edges to them.
 func (g *Graph) nextConsumers(nodeID int64) []baseConsumer {
+	from := g.componentGraph.Node(nodeID)
 	nextNodes := g.componentGraph.From(nodeID)
 	nexts := make([]baseConsumer, 0, nextNodes.Len())
 	for nextNodes.Next() {
-		nexts = append(nexts, nextNodes.Node().(consumerNode).getConsumer())
+		to := nextNodes.Node()
+		edge := newEdgeConsumer(from, to, to.(consumerNode).getConsumer(), &g.edgeCounting)
+		if instanceID, ok := g.instanceIDs[to.ID()]; ok && g.telemetry.Panics != nil {
+			// Recover the panics of the component the edge points to, so that they do not take down all the pipelines.
+			edge.recoverPanic = func(ctx context.Context, operation string, recovered any) error {
+				err := g.telemetry.Panics.Recover(ctx, instanceID, operation, recovered)
+				g.telemetry.Status.ReportStatus(instanceID, component.NewRecoverableErrorEvent(err))
+				// The data is not retried, as it would likely cause the same panic.
+				return consumererror.NewPermanent(err)
+			}
+		}
+		g.edges = append(g.edges, edge)
+		nexts = append(nexts, edge)
 	}
 	return nexts
 }
@@ -393,7 +426,7 @@ func (g *Graph) StartAll(ctx context.Context, host component.Host) error {
 			component.NewStatusEvent(component.StatusStarting),
 		)
 
-		if compErr := comp.Start(ctx, host); compErr != nil {
+		if compErr := g.telemetry.Panics.Call(ctx, instanceID, "Start", func() error { return comp.Start(ctx, host) }); compErr != nil {
 			g.telemetry.Status.ReportStatus(
 				instanceID,
 				component.NewPermanentErrorEvent(compErr),
@@ -432,7 +465,7 @@ func (g *Graph) ShutdownAll(ctx context.Context) error {
 			component.NewStatusEvent(component.StatusStopping),
 		)
 
-		if compErr := comp.Shutdown(ctx); compErr != nil {
+		if compErr := g.telemetry.Panics.Call(ctx, instanceID, "Shutdown", func() error { return comp.Shutdown(ctx) }); compErr != nil {
 			errs = multierr.Append(errs, compErr)
 			g.telemetry.Status.ReportStatus(
 				instanceID,
@@ -465,6 +498,9 @@ func (g *Graph) GetExporters() map[component.DataType]map[component.ID]component
 		for _, expNode := range pg.exporters {
 			// Skip connectors, otherwise individual components can introduce cycles
 			if expNode, ok := g.componentGraph.Node(expNode.ID()).(*exporterNode); ok {
+				if _, ok := exportersMap[expNode.pipelineType]; !ok {
+					exportersMap[expNode.pipelineType] = make(map[component.ID]component.Component)
+				}
 				exportersMap[expNode.pipelineType][expNode.componentID] = expNode.Component
 			}
 		}
@@ -13,6 +13,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	noopmetric "go.opentelemetry.io/otel/metric/noop"
+	"go.uber.org/zap"
 	"gonum.org/v1/gonum/graph/simple"
 
 	"go.opentelemetry.io/collector/component"
@@ -20,14 +22,17 @@ import (
 	"go.opentelemetry.io/collector/connector"
 	"go.opentelemetry.io/collector/connector/connectortest"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/internal/testdata"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processortest"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/service/internal/crashreport"
 	"go.opentelemetry.io/collector/service/internal/servicetelemetry"
 	"go.opentelemetry.io/collector/service/internal/status"
 	"go.opentelemetry.io/collector/service/internal/testcomponents"
@@ -39,6 +44,7 @@ var _ component.Component = &testNode{}
 type testNode struct {
 	id          component.ID
 	startErr    error
+	startPanic  any
 	shutdownErr error
 }
 
@@ -49,6 +55,9 @@ func (n *testNode) ID() int64 {
 }
 
 func (n *testNode) Start(ctx context.Context, _ component.Host) error {
+	if n.startPanic != nil {
+		panic(n.startPanic)
+	}
 	if n.startErr != nil {
 		return n.startErr
 	}
@@ -244,6 +253,11 @@ func TestConnectorPipelinesGraph(t *testing.T) {
 					Processors: []component.ID{component.NewID("exampleprocessor")},
 					Exporters:  []component.ID{component.NewID("exampleexporter")},
 				},
+				component.NewID("profiles"): {
+					Receivers:  []component.ID{component.NewID("examplereceiver")},
+					Processors: []component.ID{component.NewID("exampleprocessor")},
+					Exporters:  []component.ID{component.NewID("exampleexporter")},
+				},
 			},
 			expectedPerExporter: 1,
 		},
@@ -785,12 +799,16 @@ func TestConnectorPipelinesGraph(t *testing.T) {
 						expectMutatesData = true
 					}
 				}
+				// The fanout to the exporters copies the read-only data, unlike the test processors.
+				expectCopiesReadOnlyData := expectMutatesData
 				for _, proc := range pipelineCfg.Processors {
 					if proc.Name() == "mutate" {
 						expectMutatesData = true
+						expectCopiesReadOnlyData = false
 					}
 				}
 				assert.Equal(t, expectMutatesData, pipeline.capabilitiesNode.getConsumer().Capabilities().MutatesData)
+				assert.Equal(t, expectCopiesReadOnlyData, pipeline.capabilitiesNode.getConsumer().Capabilities().CopiesReadOnlyData)
 				mutatingPipelines[pipelineID] = expectMutatesData
 
 				expectedReceivers, expectedExporters := expectedInstances(test.pipelineConfigs, pipelineID)
@@ -890,6 +908,10 @@ func TestConnectorPipelinesGraph(t *testing.T) {
 				logsReceiver := c.(*testcomponents.ExampleReceiver)
 				assert.NoError(t, logsReceiver.ConsumeLogs(context.Background(), testdata.GenerateLogs(1)))
 			}
+			for _, c := range allReceivers[component.DataTypeProfiles] {
+				profilesReceiver := c.(*testcomponents.ExampleReceiver)
+				assert.NoError(t, profilesReceiver.ConsumeProfiles(context.Background(), testdata.GenerateProfiles(1)))
+			}
 
 			// Shut down the entire component graph
 			assert.NoError(t, pg.ShutdownAll(context.Background()))
@@ -949,34 +971,53 @@ func TestConnectorPipelinesGraph(t *testing.T) {
 			for _, e := range allExporters[component.DataTypeTraces] {
 				tracesExporter := e.(*testcomponents.ExampleExporter)
 				assert.Equal(t, test.expectedPerExporter, len(tracesExporter.Traces))
-				expected := testdata.GenerateTraces(1)
-				if len(allExporters[component.DataTypeTraces]) > 1 {
-					expected.MarkReadOnly() // multiple read-only exporters should get read-only pdata
-				}
 				for i := 0; i < test.expectedPerExporter; i++ {
-					assert.EqualValues(t, expected, tracesExporter.Traces[0])
+					expected := testdata.GenerateTraces(1)
+					// Multiple read-only exporters should get read-only pdata, as well as the pipelines sharing
+					// the data of a connector with the pipelines copying the read-only data before mutating it.
+					if len(allExporters[component.DataTypeTraces]) > 1 || tracesExporter.Traces[i].IsReadOnly() {
+						expected.MarkReadOnly()
+					}
+					assert.EqualValues(t, expected, tracesExporter.Traces[i])
 				}
 			}
 			for _, e := range allExporters[component.DataTypeMetrics] {
 				metricsExporter := e.(*testcomponents.ExampleExporter)
 				assert.Equal(t, test.expectedPerExporter, len(metricsExporter.Metrics))
-				expected := testdata.GenerateMetrics(1)
-				if len(allExporters[component.DataTypeMetrics]) > 1 {
-					expected.MarkReadOnly() // multiple read-only exporters should get read-only pdata
-				}
 				for i := 0; i < test.expectedPerExporter; i++ {
-					assert.EqualValues(t, expected, metricsExporter.Metrics[0])
+					expected := testdata.GenerateMetrics(1)
+					// Multiple read-only exporters should get read-only pdata, as well as the pipelines sharing
+					// the data of a connector with the pipelines copying the read-only data before mutating it.
+					if len(allExporters[component.DataTypeMetrics]) > 1 || metricsExporter.Metrics[i].IsReadOnly() {
+						expected.MarkReadOnly()
+					}
+					assert.EqualValues(t, expected, metricsExporter.Metrics[i])
 				}
 			}
 			for _, e := range allExporters[component.DataTypeLogs] {
 				logsExporter := e.(*testcomponents.ExampleExporter)
 				assert.Equal(t, test.expectedPerExporter, len(logsExporter.Logs))
-				expected := testdata.GenerateLogs(1)
-				if len(allExporters[component.DataTypeLogs]) > 1 {
-					expected.MarkReadOnly() // multiple read-only exporters should get read-only pdata
+				for i := 0; i < test.expectedPerExporter; i++ {
+					expected := testdata.GenerateLogs(1)
+					// Multiple read-only exporters should get read-only pdata, as well as the pipelines sharing
+					// the data of a connector with the pipelines copying the read-only data before mutating it.
+					if len(allExporters[component.DataTypeLogs]) > 1 || logsExporter.Logs[i].IsReadOnly() {
+						expected.MarkReadOnly()
+					}
+					assert.EqualValues(t, expected, logsExporter.Logs[i])
 				}
+			}
+			for _, e := range allExporters[component.DataTypeProfiles] {
+				profilesExporter := e.(*testcomponents.ExampleExporter)
+				assert.Equal(t, test.expectedPerExporter, len(profilesExporter.Profiles))
 				for i := 0; i < test.expectedPerExporter; i++ {
-					assert.EqualValues(t, expected, logsExporter.Logs[0])
+					expected := testdata.GenerateProfiles(1)
+					// Multiple read-only exporters should get read-only pdata, as well as the pipelines sharing
+					// the data of a connector with the pipelines copying the read-only data before mutating it.
+					if len(allExporters[component.DataTypeProfiles]) > 1 || profilesExporter.Profiles[i].IsReadOnly() {
+						expected.MarkReadOnly()
+					}
+					assert.EqualValues(t, expected, profilesExporter.Profiles[i])
 				}
 			}
 		})
@@ -1201,6 +1242,22 @@ func TestGraphBuildErrors(t *testing.T) {
 			},
 			expected: "failed to create \"bf\" exporter for data type \"logs\": telemetry type is not supported",
 		},
+		{
+			name: "not_supported_exporter_profiles",
+			receiverCfgs: map[component.ID]component.Config{
+				component.NewID("nop"): nopReceiverFactory.CreateDefaultConfig(),
+			},
+			exporterCfgs: map[component.ID]component.Config{
+				component.NewID("bf"): badExporterFactory.CreateDefaultConfig(),
+			},
+			pipelineCfgs: pipelines.Config{
+				component.NewID("profiles"): {
+					Receivers: []component.ID{component.NewID("nop")},
+					Exporters: []component.ID{component.NewID("bf")},
+				},
+			},
+			expected: "failed to create \"bf\" exporter for data type \"profiles\": telemetry type is not supported",
+		},
 		{
 			name: "not_supported_exporter_metrics",
 			receiverCfgs: map[component.ID]component.Config{
@@ -1567,6 +1624,29 @@ func TestGraphBuildErrors(t *testing.T) {
 			},
 			expected: `connector "nop/conn" used as exporter in metrics pipeline but not used in any supported receiver pipeline`,
 		},
+		{
+			name: "not_supported_connector_profiles",
+			receiverCfgs: map[component.ID]component.Config{
+				component.NewID("nop"): nopReceiverFactory.CreateDefaultConfig(),
+			},
+			exporterCfgs: map[component.ID]component.Config{
+				component.NewID("nop"): nopExporterFactory.CreateDefaultConfig(),
+			},
+			connectorCfgs: map[component.ID]component.Config{
+				component.NewIDWithName("nop", "conn"): nopConnectorFactory.CreateDefaultConfig(),
+			},
+			pipelineCfgs: pipelines.Config{
+				component.NewIDWithName("profiles", "in"): {
+					Receivers: []component.ID{component.NewID("nop")},
+					Exporters: []component.ID{component.NewIDWithName("nop", "conn")},
+				},
+				component.NewIDWithName("profiles", "out"): {
+					Receivers: []component.ID{component.NewIDWithName("nop", "conn")},
+					Exporters: []component.ID{component.NewID("nop")},
+				},
+			},
+			expected: `connector "nop/conn" used as exporter in profiles pipeline but not used in any supported receiver pipeline`,
+		},
 		{
 			name: "orphaned-connector-use-as-receiver",
 			receiverCfgs: map[component.ID]component.Config{
@@ -2357,11 +2437,85 @@ func TestStatusReportedOnStartupShutdown(t *testing.T) {
 	}
 }
 
+func TestGraphRecoversStartPanic(t *testing.T) {
+	r := &testNode{id: component.NewIDWithName("r", "1")}
+	e := &testNode{id: component.NewIDWithName("e", "1"), startPanic: "boom"}
+
+	pg := &Graph{componentGraph: simple.NewDirectedGraph()}
+	pg.telemetry = servicetelemetry.NewNopTelemetrySettings()
+	pg.instanceIDs = map[int64]*component.InstanceID{
+		r.ID(): {ID: r.id, Kind: component.KindReceiver},
+		e.ID(): {ID: e.id, Kind: component.KindExporter},
+	}
+	pg.componentGraph.SetEdge(simple.Edge{F: r, T: e})
+
+	// The panics are only recovered when crash reports are enabled.
+	assert.PanicsWithValue(t, "boom", func() { _ = pg.StartAll(context.Background(), componenttest.NewNopHost()) })
+
+	pg.telemetry.Panics = newNopPanicReporter(t)
+	err := pg.StartAll(context.Background(), componenttest.NewNopHost())
+	assert.EqualError(t, err, `exporter "e/1" panicked during Start: boom`)
+	assert.NoError(t, pg.ShutdownAll(context.Background()))
+}
+
+func newNopPanicReporter(t *testing.T) *crashreport.Reporter {
+	r, err := crashreport.NewReporter(crashreport.Settings{Logger: zap.NewNop(), MeterProvider: noopmetric.NewMeterProvider()})
+	require.NoError(t, err)
+	return r
+}
+
+// panicTracesExporter is an exporter panicking when it consumes traces.
+type panicTracesExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+func (panicTracesExporter) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{}
+}
+
+func (panicTracesExporter) ConsumeTraces(context.Context, ptrace.Traces) error {
+	panic("boom")
+}
+
+func TestGraphRecoversConsumePanic(t *testing.T) {
+	rcvr := newReceiverNode(component.DataTypeTraces, component.NewID("r"))
+	rcvr.Component = &testNode{id: rcvr.componentID}
+	exp := newExporterNode(component.DataTypeTraces, component.NewID("e"))
+	exp.Component = panicTracesExporter{}
+	expID := &component.InstanceID{ID: exp.componentID, Kind: component.KindExporter}
+
+	pg := &Graph{componentGraph: simple.NewDirectedGraph()}
+	pg.telemetry = servicetelemetry.NewNopTelemetrySettings()
+	pg.telemetry.Panics = newNopPanicReporter(t)
+	var statuses []component.Status
+	pg.telemetry.Status = status.NewReporter(func(id *component.InstanceID, ev *component.StatusEvent) {
+		if id == expID {
+			statuses = append(statuses, ev.Status())
+		}
+	}, func(error) {})
+	pg.telemetry.Status.Ready()
+	pg.instanceIDs = map[int64]*component.InstanceID{
+		rcvr.ID(): {ID: rcvr.componentID, Kind: component.KindReceiver},
+		exp.ID():  expID,
+	}
+	pg.componentGraph.SetEdge(simple.Edge{F: rcvr, T: exp})
+	require.NoError(t, pg.StartAll(context.Background(), componenttest.NewNopHost()))
+
+	next := pg.nextConsumers(rcvr.ID())[0].(consumer.Traces)
+	err := next.ConsumeTraces(context.Background(), testdata.GenerateTraces(1))
+	assert.EqualError(t, err, `Permanent error: exporter "e" panicked during ConsumeTraces: boom`)
+	assert.True(t, consumererror.IsPermanent(err))
+	assert.Equal(t, int64(0), pg.edges[0].inFlight.Load())
+	assert.Equal(t, []component.Status{component.StatusStarting, component.StatusOK, component.StatusRecoverableError}, statuses)
+}
+
 func (g *Graph) getReceivers() map[component.DataType]map[component.ID]component.Component {
 	receiversMap := make(map[component.DataType]map[component.ID]component.Component)
 	receiversMap[component.DataTypeTraces] = make(map[component.ID]component.Component)
 	receiversMap[component.DataTypeMetrics] = make(map[component.ID]component.Component)
 	receiversMap[component.DataTypeLogs] = make(map[component.ID]component.Component)
+	receiversMap[component.DataTypeProfiles] = make(map[component.ID]component.Component)
 
 	for _, pg := range g.pipelines {
 		for _, rcvrNode := range pg.receivers {
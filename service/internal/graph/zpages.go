@@ -6,6 +6,10 @@ package graph // import "go.opentelemetry.io/collector/service/internal/graph"
 import (
 	"net/http"
 	"sort"
+	"time"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/topo"
 
 	"go.opentelemetry.io/collector/service/internal/zpages"
 )
@@ -16,9 +20,19 @@ const (
 	zPipelineName  = "pipelinenamez"
 	zComponentName = "componentnamez"
 	zComponentKind = "componentkindz"
+
+	// Layout of the graph
+	graphMargin      = 10
+	graphNodeWidth   = 240
+	graphNodeHeight  = 24
+	graphColumnWidth = 340
+	graphRowHeight   = 48
 )
 
 func (g *Graph) HandleZPages(w http.ResponseWriter, r *http.Request) {
+	// The data sent over the edges is only counted once the graph is looked at, so that the
+	// pipelines do not pay for the counting when the page is not used.
+	g.edgeCounting.Store(true)
 	qValues := r.URL.Query()
 	pipelineName := qValues.Get(zPipelineName)
 	componentName := qValues.Get(zComponentName)
@@ -66,6 +80,7 @@ func (g *Graph) HandleZPages(w http.ResponseWriter, r *http.Request) {
 		return sumData.Rows[i].FullName < sumData.Rows[j].FullName
 	})
 	zpages.WriteHTMLPipelinesSummaryTable(w, sumData)
+	zpages.WriteHTMLPipelinesGraph(w, g.graphData(time.Now()))
 
 	if pipelineName != "" && componentName != "" && componentKind != "" {
 		fullName := componentName
@@ -79,3 +94,103 @@ func (g *Graph) HandleZPages(w http.ResponseWriter, r *http.Request) {
 	}
 	zpages.WriteHTMLPageFooter(w)
 }
+
+// graphData lays out the nodes of the graph in columns, each node being in the column after its furthest predecessor.
+func (g *Graph) graphData(now time.Time) zpages.PipelinesGraphData {
+	// The graph was checked to be acyclic when it was built.
+	nodes, _ := topo.Sort(g.componentGraph)
+	columns := map[int64]int{}
+	var columnNodes [][]graph.Node
+	for _, node := range nodes {
+		column := 0
+		prevs := g.componentGraph.To(node.ID())
+		for prevs.Next() {
+			if c := columns[prevs.Node().ID()] + 1; c > column {
+				column = c
+			}
+		}
+		columns[node.ID()] = column
+		for len(columnNodes) <= column {
+			columnNodes = append(columnNodes, nil)
+		}
+		columnNodes[column] = append(columnNodes[column], node)
+	}
+
+	data := zpages.PipelinesGraphData{}
+	positions := map[int64]zpages.PipelinesGraphNodeData{}
+	for column, cns := range columnNodes {
+		sort.Slice(cns, func(i, j int) bool { return nodeLabel(cns[i]) < nodeLabel(cns[j]) })
+		for row, node := range cns {
+			nd := zpages.PipelinesGraphNodeData{
+				Label:  nodeLabel(node),
+				Kind:   nodeKind(node),
+				X:      graphMargin + column*graphColumnWidth,
+				Y:      graphMargin + row*graphRowHeight,
+				Width:  graphNodeWidth,
+				Height: graphNodeHeight,
+			}
+			positions[node.ID()] = nd
+			data.Nodes = append(data.Nodes, nd)
+			if h := nd.Y + nd.Height + graphMargin; h > data.Height {
+				data.Height = h
+			}
+		}
+		data.Width = graphMargin + column*graphColumnWidth + graphNodeWidth + graphMargin
+	}
+
+	for _, edge := range g.edges {
+		from, to := positions[edge.from.ID()], positions[edge.to.ID()]
+		stats := edge.stats(now)
+		data.Edges = append(data.Edges, zpages.PipelinesGraphEdgeData{
+			From:           from.Label,
+			To:             to.Label,
+			X1:             from.X + from.Width,
+			Y1:             from.Y + from.Height/2,
+			X2:             to.X,
+			Y2:             to.Y + to.Height/2,
+			Items:          stats.items,
+			Batches:        stats.batches,
+			ItemsPerSecond: stats.itemsPerSecond,
+			InFlight:       stats.inFlight,
+		})
+	}
+	sort.Slice(data.Edges, func(i, j int) bool {
+		if data.Edges[i].From != data.Edges[j].From {
+			return data.Edges[i].From < data.Edges[j].From
+		}
+		return data.Edges[i].To < data.Edges[j].To
+	})
+	return data
+}
+
+func nodeLabel(node graph.Node) string {
+	switch n := node.(type) {
+	case *receiverNode:
+		return n.componentID.String() + " (" + string(n.pipelineType) + ")"
+	case *processorNode:
+		return n.componentID.String() + " (" + n.pipelineID.String() + ")"
+	case *exporterNode:
+		return n.componentID.String() + " (" + string(n.pipelineType) + ")"
+	case *connectorNode:
+		return n.componentID.String() + " (" + string(n.exprPipelineType) + " to " + string(n.rcvrPipelineType) + ")"
+	case *capabilitiesNode:
+		return "pipeline " + n.pipelineID.String()
+	case *fanOutNode:
+		return "fan-out " + n.pipelineID.String()
+	}
+	return ""
+}
+
+func nodeKind(node graph.Node) string {
+	switch node.(type) {
+	case *receiverNode:
+		return "receiver"
+	case *processorNode:
+		return "processor"
+	case *exporterNode:
+		return "exporter"
+	case *connectorNode:
+		return "connector"
+	}
+	return "pipeline"
+}
@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/internal/testdata"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/service/internal/servicetelemetry"
+	"go.opentelemetry.io/collector/service/internal/testcomponents"
+	"go.opentelemetry.io/collector/service/internal/zpages"
+	"go.opentelemetry.io/collector/service/pipelines"
+)
+
+func TestGraphData(t *testing.T) {
+	rcvrID := component.NewID("examplereceiver")
+	procID := component.NewID("exampleprocessor")
+	connID := component.NewID("exampleconnector")
+	expID := component.NewID("exampleexporter")
+	tracesInID := component.NewIDWithName("traces", "in")
+	tracesOutID := component.NewIDWithName("traces", "out")
+
+	set := Settings{
+		Telemetry: servicetelemetry.NewNopTelemetrySettings(),
+		BuildInfo: component.NewDefaultBuildInfo(),
+		ReceiverBuilder: receiver.NewBuilder(
+			map[component.ID]component.Config{rcvrID: testcomponents.ExampleReceiverFactory.CreateDefaultConfig()},
+			map[component.Type]receiver.Factory{testcomponents.ExampleReceiverFactory.Type(): testcomponents.ExampleReceiverFactory},
+		),
+		ProcessorBuilder: processor.NewBuilder(
+			map[component.ID]component.Config{procID: testcomponents.ExampleProcessorFactory.CreateDefaultConfig()},
+			map[component.Type]processor.Factory{testcomponents.ExampleProcessorFactory.Type(): testcomponents.ExampleProcessorFactory},
+		),
+		ExporterBuilder: exporter.NewBuilder(
+			map[component.ID]component.Config{expID: testcomponents.ExampleExporterFactory.CreateDefaultConfig()},
+			map[component.Type]exporter.Factory{testcomponents.ExampleExporterFactory.Type(): testcomponents.ExampleExporterFactory},
+		),
+		ConnectorBuilder: connector.NewBuilder(
+			map[component.ID]component.Config{connID: testcomponents.ExampleConnectorFactory.CreateDefaultConfig()},
+			map[component.Type]connector.Factory{testcomponents.ExampleConnectorFactory.Type(): testcomponents.ExampleConnectorFactory},
+		),
+		PipelineConfigs: pipelines.Config{
+			tracesInID: {
+				Receivers:  []component.ID{rcvrID},
+				Processors: []component.ID{procID},
+				Exporters:  []component.ID{connID},
+			},
+			tracesOutID: {
+				Receivers: []component.ID{connID},
+				Exporters: []component.ID{expID},
+			},
+		},
+	}
+	pg, err := Build(context.Background(), set)
+	require.NoError(t, err)
+
+	tracesReceiver := pg.getReceivers()[component.DataTypeTraces][rcvrID].(*testcomponents.ExampleReceiver)
+	// Nothing is counted before the graph is first rendered.
+	require.NoError(t, tracesReceiver.ConsumeTraces(context.Background(), testdata.GenerateTraces(2)))
+	for _, edge := range pg.graphData(time.Now()).Edges {
+		assert.Zero(t, edge.Items, "%s to %s", edge.From, edge.To)
+	}
+	pg.HandleZPages(httptest.NewRecorder(), httptest.NewRequest("GET", "/debug/pipelinez", nil))
+	require.NoError(t, tracesReceiver.ConsumeTraces(context.Background(), testdata.GenerateTraces(2)))
+	require.NoError(t, tracesReceiver.ConsumeTraces(context.Background(), testdata.GenerateTraces(2)))
+
+	data := pg.graphData(time.Now())
+	nodes := [][2]string{
+		{"examplereceiver (traces)", "receiver"},
+		{"pipeline traces/in", "pipeline"},
+		{"exampleprocessor (traces/in)", "processor"},
+		{"fan-out traces/in", "pipeline"},
+		{"exampleconnector (traces to traces)", "connector"},
+		{"pipeline traces/out", "pipeline"},
+		{"fan-out traces/out", "pipeline"},
+		{"exampleexporter (traces)", "exporter"},
+	}
+	require.Len(t, data.Nodes, len(nodes))
+	for i, node := range nodes {
+		// The nodes are laid out in a single row, one column after the other.
+		assert.Equal(t, zpages.PipelinesGraphNodeData{
+			Label:  node[0],
+			Kind:   node[1],
+			X:      graphMargin + i*graphColumnWidth,
+			Y:      graphMargin,
+			Width:  graphNodeWidth,
+			Height: graphNodeHeight,
+		}, data.Nodes[i])
+	}
+	assert.Equal(t, graphMargin+7*graphColumnWidth+graphNodeWidth+graphMargin, data.Width)
+	assert.Equal(t, graphMargin+graphNodeHeight+graphMargin, data.Height)
+
+	require.Len(t, data.Edges, len(nodes)-1)
+	for _, edge := range data.Edges {
+		assert.Equal(t, int64(4), edge.Items, "%s to %s", edge.From, edge.To)
+		assert.Equal(t, int64(2), edge.Batches, "%s to %s", edge.From, edge.To)
+		assert.Equal(t, int64(0), edge.InFlight, "%s to %s", edge.From, edge.To)
+		assert.Equal(t, edge.Y1, edge.Y2)
+		assert.Less(t, edge.X1, edge.X2)
+	}
+
+	rec := httptest.NewRecorder()
+	pg.HandleZPages(rec, httptest.NewRequest("GET", "/debug/pipelinez", nil))
+	assert.Contains(t, rec.Body.String(), "<td>exampleconnector (traces to traces)</td>")
+}
+
+func TestEdgeConsumerStats(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	counting := &atomic.Bool{}
+	counting.Store(true)
+	edge := newEdgeConsumer(nil, nil, sink, counting)
+	start := edge.lastTime
+	require.NoError(t, edge.ConsumeTraces(context.Background(), testdata.GenerateTraces(3)))
+	assert.Equal(t, 3, sink.SpanCount())
+
+	// The throughput is not updated before a second elapsed.
+	stats := edge.stats(start.Add(500 * time.Millisecond))
+	assert.Equal(t, edgeStats{items: 3, batches: 1}, stats)
+
+	stats = edge.stats(start.Add(2 * time.Second))
+	assert.Equal(t, edgeStats{items: 3, batches: 1, itemsPerSecond: 1.5}, stats)
+
+	require.NoError(t, edge.ConsumeTraces(context.Background(), testdata.GenerateTraces(2)))
+	stats = edge.stats(start.Add(3 * time.Second))
+	assert.Equal(t, edgeStats{items: 5, batches: 2, itemsPerSecond: 2}, stats)
+}
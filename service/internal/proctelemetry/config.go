@@ -31,6 +31,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
+	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/processor/processorhelper"
 	semconv "go.opentelemetry.io/collector/semconv/v1.18.0"
 )
@@ -66,7 +67,7 @@ var (
 	errNoValidSpanExporter   = errors.New("no valid span exporter")
 )
 
-func InitMetricReader(ctx context.Context, reader config.MetricReader, asyncErrorChannel chan error) (sdkmetric.Reader, *http.Server, error) {
+func InitMetricReader(ctx context.Context, reader config.MetricReader, asyncErrorChannel chan error) (sdkmetric.Reader, *PrometheusServer, error) {
 	if reader.Pull != nil {
 		return initPullExporter(reader.Pull.Exporter, asyncErrorChannel)
 	}
@@ -139,19 +140,44 @@ func InitOpenTelemetry(res *resource.Resource, options []sdkmetric.Option, disab
 	), nil
 }
 
-func InitPrometheusServer(registry *prometheus.Registry, address string, asyncErrorChannel chan error) *http.Server {
+// PrometheusServer serves the internal metrics on a listener bound when it is created.
+type PrometheusServer struct {
+	*http.Server
+	// served is closed once the server stopped serving and its listener is closed.
+	served chan struct{}
+}
+
+// Close closes the server and waits until its listener is closed, so that its address can be bound again.
+func (s *PrometheusServer) Close() error {
+	err := s.Server.Close()
+	<-s.served
+	return err
+}
+
+// InitPrometheusServer serves the metrics of the registry on the address. The address is bound before
+// returning, with confignet.Listen so that the listener is handed off to the process started by a restart.
+func InitPrometheusServer(registry *prometheus.Registry, address string, asyncErrorChannel chan error) (*PrometheusServer, error) {
+	ln, err := confignet.Listen(context.Background(), "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %q: %w", address, err)
+	}
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
-	server := &http.Server{
-		Addr:    address,
-		Handler: mux,
+	server := &PrometheusServer{
+		Server: &http.Server{
+			Addr:    address,
+			Handler: mux,
+		},
+		served: make(chan struct{}),
 	}
 	go func() {
-		if serveErr := server.ListenAndServe(); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+		serveErr := server.Serve(ln)
+		close(server.served)
+		if serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
 			asyncErrorChannel <- serveErr
 		}
 	}()
-	return server
+	return server, nil
 }
 
 func batchViews(disableHighCardinality bool) []sdkmetric.View {
@@ -197,7 +223,7 @@ func cardinalityFilter(kvs ...attribute.KeyValue) attribute.Filter {
 	}
 }
 
-func initPrometheusExporter(prometheusConfig *config.Prometheus, asyncErrorChannel chan error) (sdkmetric.Reader, *http.Server, error) {
+func initPrometheusExporter(prometheusConfig *config.Prometheus, asyncErrorChannel chan error) (sdkmetric.Reader, *PrometheusServer, error) {
 	promRegistry := prometheus.NewRegistry()
 	if prometheusConfig.Host == nil {
 		return nil, nil, fmt.Errorf("host must be specified")
@@ -221,17 +247,21 @@ func initPrometheusExporter(prometheusConfig *config.Prometheus, asyncErrorChann
 		return nil, nil, fmt.Errorf("error creating otel prometheus exporter: %w", err)
 	}
 
-	return exporter, InitPrometheusServer(promRegistry, fmt.Sprintf("%s:%d", *prometheusConfig.Host, *prometheusConfig.Port), asyncErrorChannel), nil
+	server, err := InitPrometheusServer(promRegistry, fmt.Sprintf("%s:%d", *prometheusConfig.Host, *prometheusConfig.Port), asyncErrorChannel)
+	if err != nil {
+		return nil, nil, err
+	}
+	return exporter, server, nil
 }
 
-func initPullExporter(exporter config.MetricExporter, asyncErrorChannel chan error) (sdkmetric.Reader, *http.Server, error) {
+func initPullExporter(exporter config.MetricExporter, asyncErrorChannel chan error) (sdkmetric.Reader, *PrometheusServer, error) {
 	if exporter.Prometheus != nil {
 		return initPrometheusExporter(exporter.Prometheus, asyncErrorChannel)
 	}
 	return nil, nil, errNoValidMetricExporter
 }
 
-func initPeriodicExporter(ctx context.Context, exporter config.MetricExporter, opts ...sdkmetric.PeriodicReaderOption) (sdkmetric.Reader, *http.Server, error) {
+func initPeriodicExporter(ctx context.Context, exporter config.MetricExporter, opts ...sdkmetric.PeriodicReaderOption) (sdkmetric.Reader, *PrometheusServer, error) {
 	if exporter.Console != nil {
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
@@ -6,10 +6,13 @@ package proctelemetry
 import (
 	"context"
 	"errors"
+	"net"
 	"net/url"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/contrib/config"
 )
 
@@ -68,12 +71,12 @@ func TestMetricReader(t *testing.T) {
 			err: errors.New("port must be specified"),
 		},
 		{
-			name: "pull/prometheus-invalid-config-no-port",
+			name: "pull/prometheus",
 			reader: config.MetricReader{
 				Pull: &config.PullMetricReader{
 					Exporter: config.MetricExporter{
 						Prometheus: &config.Prometheus{
-							Host: strPtr("locahost"),
+							Host: strPtr("localhost"),
 							Port: intPtr(8080),
 						},
 					},
@@ -353,6 +356,19 @@ func TestMetricReader(t *testing.T) {
 	}
 }
 
+func TestInitPrometheusServerAddressInUse(t *testing.T) {
+	ln, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	// The address is bound before returning, so that the error is not reported asynchronously.
+	asyncErrorChannel := make(chan error, 1)
+	server, err := InitPrometheusServer(prometheus.NewRegistry(), ln.Addr().String(), asyncErrorChannel)
+	assert.Error(t, err)
+	assert.Nil(t, server)
+	assert.Empty(t, asyncErrorChannel)
+}
+
 func TestSpanProcessor(t *testing.T) {
 	testCases := []struct {
 		name      string
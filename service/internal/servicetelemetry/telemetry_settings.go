@@ -11,6 +11,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configtelemetry"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/service/internal/crashreport"
 	"go.opentelemetry.io/collector/service/internal/status"
 )
 
@@ -38,6 +39,10 @@ type TelemetrySettings struct {
 	// Status contains a Reporter that allows the service to report status on behalf of a
 	// component.
 	Status *status.Reporter
+
+	// Panics records the panics of the components recovered by the service. If nil, the
+	// panics are not recovered.
+	Panics *crashreport.Reporter
 }
 
 // ToComponentTelemetrySettings returns a TelemetrySettings for a specific component derived from
@@ -11,6 +11,7 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 )
 
@@ -26,6 +27,7 @@ var ExampleExporterFactory = exporter.NewFactory(
 	exporter.WithTraces(createTracesExporter, stability),
 	exporter.WithMetrics(createMetricsExporter, stability),
 	exporter.WithLogs(createLogsExporter, stability),
+	exporter.WithProfiles(createProfilesExporter, stability),
 )
 
 func createExporterDefaultConfig() component.Config {
@@ -44,12 +46,17 @@ func createLogsExporter(context.Context, exporter.CreateSettings, component.Conf
 	return &ExampleExporter{}, nil
 }
 
+func createProfilesExporter(context.Context, exporter.CreateSettings, component.Config) (exporter.Profiles, error) {
+	return &ExampleExporter{}, nil
+}
+
 // ExampleExporter stores consumed traces and metrics for testing purposes.
 type ExampleExporter struct {
 	componentState
-	Traces  []ptrace.Traces
-	Metrics []pmetric.Metrics
-	Logs    []plog.Logs
+	Traces   []ptrace.Traces
+	Metrics  []pmetric.Metrics
+	Logs     []plog.Logs
+	Profiles []pprofile.Profiles
 }
 
 // ConsumeTraces receives ptrace.Traces for processing by the consumer.Traces.
@@ -70,6 +77,12 @@ func (exp *ExampleExporter) ConsumeLogs(_ context.Context, ld plog.Logs) error {
 	return nil
 }
 
+// ConsumeProfiles receives pprofile.Profiles for processing by the Profiles.
+func (exp *ExampleExporter) ConsumeProfiles(_ context.Context, pd pprofile.Profiles) error {
+	exp.Profiles = append(exp.Profiles, pd)
+	return nil
+}
+
 func (exp *ExampleExporter) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
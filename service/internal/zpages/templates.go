@@ -12,15 +12,22 @@ import (
 
 var (
 	templateFunctions = template.FuncMap{
-		"even":     even,
-		"getKey":   getKey,
-		"getValue": getValue,
+		"even":      even,
+		"getKey":    getKey,
+		"getValue":  getValue,
+		"add":       add,
+		"midpoint":  midpoint,
+		"nodeColor": nodeColor,
 	}
 
 	//go:embed templates/component_header.html
 	componentHeaderBytes    []byte
 	componentHeaderTemplate = parseTemplate("component_header", componentHeaderBytes)
 
+	//go:embed templates/config_table.html
+	configTableBytes    []byte
+	configTableTemplate = parseTemplate("config_table", configTableBytes)
+
 	//go:embed templates/extensions_table.html
 	extensionsTableBytes    []byte
 	extensionsTableTemplate = parseTemplate("extensions_table", extensionsTableBytes)
@@ -33,6 +40,10 @@ var (
 	footerBytes    []byte
 	footerTemplate = parseTemplate("footer", footerBytes)
 
+	//go:embed templates/pipelines_graph.html
+	pipelinesGraphBytes    []byte
+	pipelinesGraphTemplate = parseTemplate("pipelines_graph", pipelinesGraphBytes)
+
 	//go:embed templates/pipelines_table.html
 	pipelinesTableBytes    []byte
 	pipelinesTableTemplate = parseTemplate("pipelines_table", pipelinesTableBytes)
@@ -104,6 +115,48 @@ func WriteHTMLPipelinesSummaryTable(w io.Writer, spd SummaryPipelinesTableData)
 	}
 }
 
+// PipelinesGraphData contains data for the pipelines graph template.
+type PipelinesGraphData struct {
+	Width  int
+	Height int
+	Nodes  []PipelinesGraphNodeData
+	Edges  []PipelinesGraphEdgeData
+}
+
+// PipelinesGraphNodeData contains data for one node of the pipelines graph template.
+type PipelinesGraphNodeData struct {
+	Label string
+	// Kind is the kind of the component, or "pipeline" for the nodes internal to the pipelines.
+	Kind   string
+	X      int
+	Y      int
+	Width  int
+	Height int
+}
+
+// PipelinesGraphEdgeData contains data for one edge of the pipelines graph template.
+type PipelinesGraphEdgeData struct {
+	From           string
+	To             string
+	X1             int
+	Y1             int
+	X2             int
+	Y2             int
+	Items          int64
+	Batches        int64
+	ItemsPerSecond float64
+	// InFlight is the number of calls to the next component which have not returned yet.
+	InFlight int64
+}
+
+// WriteHTMLPipelinesGraph writes the graph of the components of the pipelines, and the table of its edges.
+// It does not write the header or footer.
+func WriteHTMLPipelinesGraph(w io.Writer, pgd PipelinesGraphData) {
+	if err := pipelinesGraphTemplate.Execute(w, pgd); err != nil {
+		log.Printf("zpages: executing template: %v", err)
+	}
+}
+
 // ComponentHeaderData contains data for component header template.
 type ComponentHeaderData struct {
 	Name              string
@@ -131,6 +184,28 @@ func WriteHTMLPropertiesTable(w io.Writer, chd PropertiesTableData) {
 	}
 }
 
+// ConfigTableData contains data for the configuration table template.
+type ConfigTableData struct {
+	Name string
+	// Error is shown instead of the table when the configuration is not available.
+	Error string
+	Rows  []ConfigTableRowData
+}
+
+// ConfigTableRowData contains data for one key of the configuration table template.
+type ConfigTableRowData struct {
+	Key    string
+	Value  string
+	Origin string
+}
+
+// WriteHTMLConfigTable writes the table of the keys of a configuration, their values and where they come from.
+func WriteHTMLConfigTable(w io.Writer, ctd ConfigTableData) {
+	if err := configTableTemplate.Execute(w, ctd); err != nil {
+		log.Printf("zpages: executing template: %v", err)
+	}
+}
+
 // WriteHTMLPageFooter writes the footer.
 func WriteHTMLPageFooter(w io.Writer) {
 	if err := footerTemplate.Execute(w, nil); err != nil {
@@ -142,6 +217,28 @@ func even(x int) bool {
 	return x%2 == 0
 }
 
+func add(x, y int) int {
+	return x + y
+}
+
+func midpoint(x, y int) int {
+	return (x + y) / 2
+}
+
+func nodeColor(kind string) string {
+	switch kind {
+	case "receiver":
+		return "#c8e6c9"
+	case "processor":
+		return "#bbdefb"
+	case "exporter":
+		return "#ffe0b2"
+	case "connector":
+		return "#e1bee7"
+	}
+	return "#eeeeee"
+}
+
 func getKey(row [2]string) string {
 	return row[0]
 }
@@ -153,6 +250,8 @@ func getValue(row [2]string) string {
 // FeatureGateTableData contains data for feature gate table template.
 type FeatureGateTableData struct {
 	Rows []FeatureGateTableRowData
+	// Toggle is true if the runtime safe gates can be toggled from the table.
+	Toggle bool
 }
 
 // FeatureGateTableRowData contains data for one row in feature gate table template.
@@ -164,6 +263,8 @@ type FeatureGateTableRowData struct {
 	FromVersion  string
 	ToVersion    string
 	ReferenceURL string
+	// RuntimeSafe is true if the gate can be toggled while the collector is running.
+	RuntimeSafe bool
 }
 
 // WriteHTMLFeaturesTable writes a table summarizing registered feature gates.
@@ -6,6 +6,7 @@ package zpages
 import (
 	"bytes"
 	"html/template"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -59,6 +60,28 @@ func TestNoCrash(t *testing.T) {
 			}},
 		})
 	})
+	assert.NotPanics(t, func() {
+		WriteHTMLPipelinesGraph(buf, PipelinesGraphData{
+			Width:  100,
+			Height: 100,
+			Nodes: []PipelinesGraphNodeData{
+				{Label: "otlp (traces)", Kind: "receiver", Width: 20, Height: 10},
+				{Label: "traces", Kind: "pipeline", X: 50, Width: 20, Height: 10},
+			},
+			Edges: []PipelinesGraphEdgeData{{
+				From:           "otlp (traces)",
+				To:             "traces",
+				X1:             20,
+				Y1:             5,
+				X2:             50,
+				Y2:             5,
+				Items:          10,
+				Batches:        2,
+				ItemsPerSecond: 1.5,
+				InFlight:       1,
+			}},
+		})
+	})
 	assert.NotPanics(t, func() {
 		WriteHTMLExtensionsSummaryTable(buf, SummaryExtensionsTableData{
 			Rows: []SummaryExtensionsTableRowData{{
@@ -81,3 +104,65 @@ func TestNoCrash(t *testing.T) {
 	assert.NotPanics(t, func() { WriteHTMLPageFooter(buf) })
 	assert.NotPanics(t, func() { WriteHTMLPageFooter(buf) })
 }
+
+func TestWriteHTMLConfigTable(t *testing.T) {
+	buf := new(bytes.Buffer)
+	WriteHTMLConfigTable(buf, ConfigTableData{
+		Name: "Effective Configuration",
+		Rows: []ConfigTableRowData{{Key: "receivers::otlp::endpoint", Value: "localhost:4317", Origin: "file:config.yaml"}},
+	})
+	assert.Contains(t, buf.String(), "<td>receivers::otlp::endpoint</td>")
+	assert.Contains(t, buf.String(), "<td>file:config.yaml</td>")
+
+	buf.Reset()
+	WriteHTMLConfigTable(buf, ConfigTableData{Name: "Effective Configuration", Error: "not available"})
+	assert.Contains(t, buf.String(), "<p>not available</p>")
+	assert.NotContains(t, buf.String(), "<table")
+}
+
+func TestWriteHTMLPipelinesGraph(t *testing.T) {
+	buf := new(bytes.Buffer)
+	WriteHTMLPipelinesGraph(buf, PipelinesGraphData{
+		Width:  300,
+		Height: 40,
+		Nodes: []PipelinesGraphNodeData{
+			{Label: "otlp (traces)", Kind: "receiver", X: 10, Y: 10, Width: 100, Height: 20},
+			{Label: "pipeline traces", Kind: "pipeline", X: 190, Y: 10, Width: 100, Height: 20},
+		},
+		Edges: []PipelinesGraphEdgeData{{
+			From:           "otlp (traces)",
+			To:             "pipeline traces",
+			X1:             110,
+			Y1:             20,
+			X2:             190,
+			Y2:             20,
+			Items:          10,
+			Batches:        2,
+			ItemsPerSecond: 1.5,
+			InFlight:       1,
+		}},
+	})
+	out := buf.String()
+	assert.Contains(t, out, `<rect x="10" y="10" width="100" height="20" rx="4" fill="#c8e6c9" stroke="#666"/>`)
+	assert.Contains(t, out, `<text x="60" y="20" text-anchor="middle" dominant-baseline="middle">otlp (traces)</text>`)
+	assert.Contains(t, out, `<line x1="110" y1="20" x2="190" y2="20"`)
+	assert.Contains(t, out, `1.5/s (1 calls in flight)`)
+	assert.Contains(t, out, `<td>pipeline traces</td>`)
+}
+
+func TestWriteHTMLFeaturesTableRuntimeSafe(t *testing.T) {
+	buf := new(bytes.Buffer)
+	rows := []FeatureGateTableRowData{
+		{ID: "runtime.safe", Enabled: true, RuntimeSafe: true},
+		{ID: "startup.only"},
+	}
+	WriteHTMLFeaturesTable(buf, FeatureGateTableData{Rows: rows})
+	assert.NotContains(t, buf.String(), "<form")
+
+	buf.Reset()
+	WriteHTMLFeaturesTable(buf, FeatureGateTableData{Rows: rows, Toggle: true})
+	assert.Contains(t, buf.String(), `<input type="hidden" name="id" value="runtime.safe">`)
+	assert.Contains(t, buf.String(), `<input type="hidden" name="enabled" value="false">`)
+	assert.Contains(t, buf.String(), `<input type="submit" value="Disable">`)
+	assert.Equal(t, 1, strings.Count(buf.String(), "<form"))
+}
@@ -8,8 +8,16 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/featuregate"
 )
 
+// profilesSupportGate controls whether pipelines of the experimental profiles data type can be configured.
+var profilesSupportGate = featuregate.GlobalRegistry().MustRegister(
+	"service.profilesSupport",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterFromVersion("v0.94.0"),
+	featuregate.WithRegisterDescription("Controls whether profiles pipelines can be configured."))
+
 var (
 	errMissingServicePipelines         = errors.New("service must have at least one pipeline")
 	errMissingServicePipelineReceivers = errors.New("must have at least one receiver")
@@ -28,7 +36,13 @@ func (cfg Config) Validate() error {
 	// Check that all pipelines have at least one receiver and one exporter, and they reference
 	// only configured components.
 	for pipelineID, pipeline := range cfg {
-		if pipelineID.Type() != component.DataTypeTraces && pipelineID.Type() != component.DataTypeMetrics && pipelineID.Type() != component.DataTypeLogs {
+		switch pipelineID.Type() {
+		case component.DataTypeTraces, component.DataTypeMetrics, component.DataTypeLogs:
+		case component.DataTypeProfiles:
+			if !profilesSupportGate.IsEnabled() {
+				return fmt.Errorf("pipeline %q: profiling signal support is at alpha level, gated under the %q feature gate", pipelineID, profilesSupportGate.ID())
+			}
+		default:
 			return fmt.Errorf("pipeline %q: unknown datatype %q", pipelineID, pipelineID.Type())
 		}
 
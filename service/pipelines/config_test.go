@@ -9,8 +9,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/featuregate"
 )
 
 func TestConfigValidate(t *testing.T) {
@@ -72,6 +74,19 @@ func TestConfigValidate(t *testing.T) {
 			},
 			expected: errors.New(`pipeline "wrongtype": unknown datatype "wrongtype"`),
 		},
+		{
+			name: "disabled-feature-gate-profiles",
+			cfgFn: func() Config {
+				cfg := generateConfig()
+				cfg[component.NewID("profiles")] = &PipelineConfig{
+					Receivers:  []component.ID{component.NewID("nop")},
+					Processors: []component.ID{component.NewID("nop")},
+					Exporters:  []component.ID{component.NewID("nop")},
+				}
+				return cfg
+			},
+			expected: errors.New(`pipeline "profiles": profiling signal support is at alpha level, gated under the "service.profilesSupport" feature gate`),
+		},
 	}
 
 	for _, test := range testCases {
@@ -91,3 +106,18 @@ func generateConfig() Config {
 		},
 	}
 }
+
+func TestConfigValidateProfilesEnabled(t *testing.T) {
+	require.NoError(t, featuregate.GlobalRegistry().Set(profilesSupportGate.ID(), true))
+	defer func() {
+		require.NoError(t, featuregate.GlobalRegistry().Set(profilesSupportGate.ID(), false))
+	}()
+
+	cfg := generateConfig()
+	cfg[component.NewID("profiles")] = &PipelineConfig{
+		Receivers:  []component.ID{component.NewID("nop")},
+		Processors: []component.ID{component.NewID("nop")},
+		Exporters:  []component.ID{component.NewID("nop")},
+	}
+	assert.NoError(t, cfg.Validate())
+}
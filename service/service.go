@@ -5,6 +5,9 @@ package service // import "go.opentelemetry.io/collector/service"
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"runtime"
@@ -12,6 +15,7 @@ import (
 	sdkresource "go.opentelemetry.io/otel/sdk/resource"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configtelemetry"
@@ -19,12 +23,15 @@ import (
 	"go.opentelemetry.io/collector/connector"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/internal/localhostgate"
 	"go.opentelemetry.io/collector/internal/obsreportconfig"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/service/extensions"
+	"go.opentelemetry.io/collector/service/internal/crashreport"
+	"go.opentelemetry.io/collector/service/internal/featuregatetelemetry"
 	"go.opentelemetry.io/collector/service/internal/graph"
 	"go.opentelemetry.io/collector/service/internal/proctelemetry"
 	"go.opentelemetry.io/collector/service/internal/resource"
@@ -41,6 +48,13 @@ type Settings struct {
 	// CollectorConf contains the Collector's current configuration
 	CollectorConf *confmap.Conf
 
+	// EffectiveConf returns the Collector's effective configuration, including the default values of the
+	// components, with their opaque values redacted. It is shown in the configz zPage.
+	EffectiveConf func() (*confmap.Conf, error)
+
+	// ConfOrigins maps the keys of the Collector's configuration to the URI they were retrieved from.
+	ConfOrigins map[string]string
+
 	// Receivers builder for receivers.
 	Receivers *receiver.Builder
 
@@ -86,12 +100,25 @@ func New(ctx context.Context, set Settings, cfg Config) (*Service, error) {
 			extensions:        set.Extensions,
 			buildInfo:         set.BuildInfo,
 			asyncErrorChannel: set.AsyncErrorChannel,
+			effectiveConf:     set.EffectiveConf,
+			confOrigins:       set.ConfOrigins,
+
+			runtimeFeatureGates: cfg.RuntimeFeatureGates,
 		},
 		telemetryInitializer: newColTelemetry(disableHighCard, extendedConfig),
 		collectorConf:        set.CollectorConf,
 	}
 	var err error
-	srv.telemetry, err = telemetry.New(ctx, telemetry.Settings{BuildInfo: set.BuildInfo, ZapOptions: set.LoggingOptions}, cfg.Telemetry)
+	zapOptions := set.LoggingOptions
+	var logs *crashreport.LogBuffer
+	if cfg.Telemetry.CrashReports.Enabled && cfg.Telemetry.CrashReports.Directory != "" && cfg.Telemetry.CrashReports.LogEntries > 0 {
+		// Keep the recent logs to write them in the diagnostic bundles of the panics.
+		logs = crashreport.NewLogBuffer(cfg.Telemetry.CrashReports.LogEntries)
+		zapOptions = append(append([]zap.Option(nil), zapOptions...), zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return zapcore.NewTee(core, logs.Core(cfg.Telemetry.Logs.Level))
+		}))
+	}
+	srv.telemetry, err = telemetry.New(ctx, telemetry.Settings{BuildInfo: set.BuildInfo, ZapOptions: zapOptions}, cfg.Telemetry)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get logger: %w", err)
 	}
@@ -116,6 +143,18 @@ func New(ctx context.Context, set Settings, cfg Config) (*Service, error) {
 			// ignore other errors as they represent invalid state transitions and are considered benign.
 		}),
 	}
+	if cfg.Telemetry.CrashReports.Enabled {
+		if srv.telemetrySettings.Panics, err = crashreport.NewReporter(crashreport.Settings{
+			Logger:        logger,
+			MeterProvider: srv.telemetryInitializer.mp,
+			BuildInfo:     set.BuildInfo,
+			Directory:     cfg.Telemetry.CrashReports.Directory,
+			ConfigHash:    configHash(set.EffectiveConf),
+			Logs:          logs,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to create the panic reporter: %w", err)
+		}
+	}
 
 	// process the configuration and initialize the pipeline
 	if err = srv.initExtensionsAndPipeline(ctx, set, cfg); err != nil {
@@ -130,6 +169,24 @@ func New(ctx context.Context, set Settings, cfg Config) (*Service, error) {
 	return srv, nil
 }
 
+// configHash returns the hash of the effective configuration written in the diagnostic bundles of
+// the panics, so that they can be matched with a configuration without exposing it.
+func configHash(effectiveConf func() (*confmap.Conf, error)) string {
+	if effectiveConf == nil {
+		return ""
+	}
+	conf, err := effectiveConf()
+	if err != nil || conf == nil {
+		return ""
+	}
+	data, err := json.Marshal(conf.ToStringMap())
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
 // Start starts the extensions and pipelines. If Start fails Shutdown should be called to ensure a clean state.
 // Start does the following steps in order:
 // 1. Start all extensions.
@@ -141,6 +198,7 @@ func (srv *Service) Start(ctx context.Context) error {
 		zap.String("Version", srv.buildInfo.Version),
 		zap.Int("NumCPU", runtime.NumCPU()),
 	)
+	featuregatetelemetry.LogGates(srv.telemetrySettings.Logger, featuregate.GlobalRegistry())
 
 	// enable status reporting
 	srv.telemetrySettings.Status.Ready()
@@ -229,6 +287,12 @@ func (srv *Service) initExtensionsAndPipeline(ctx context.Context, set Settings,
 		return fmt.Errorf("failed to build pipelines: %w", err)
 	}
 
+	if cfg.Telemetry.Metrics.Level != configtelemetry.LevelNone {
+		if err = featuregatetelemetry.RegisterMetrics(srv.telemetryInitializer.mp, featuregate.GlobalRegistry()); err != nil {
+			return fmt.Errorf("failed to register feature gate metrics: %w", err)
+		}
+	}
+
 	if cfg.Telemetry.Metrics.Level != configtelemetry.LevelNone && cfg.Telemetry.Metrics.Address != "" {
 		// The process telemetry initialization requires the ballast size, which is available after the extensions are initialized.
 		if err = proctelemetry.RegisterProcessMetrics(srv.telemetryInitializer.mp, getBallastSize(srv.host)); err != nil {
@@ -496,6 +496,7 @@ func assertZPages(t *testing.T, zpagesAddr string) {
 		"/debug/pipelinez",
 		"/debug/servicez",
 		"/debug/extensionz",
+		"/debug/configz",
 	}
 
 	testZPagePathFn := func(t *testing.T, path string) {
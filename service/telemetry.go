@@ -6,7 +6,6 @@ package service // import "go.opentelemetry.io/collector/service"
 import (
 	"context"
 	"net"
-	"net/http"
 	"strconv"
 
 	ocmetric "go.opencensus.io/metric"
@@ -32,7 +31,7 @@ const (
 type telemetryInitializer struct {
 	ocRegistry *ocmetric.Registry
 	mp         metric.MeterProvider
-	servers    []*http.Server
+	servers    []*proctelemetry.PrometheusServer
 
 	disableHighCardinality bool
 	extendedConfig         bool
@@ -97,6 +96,11 @@ func (tel *telemetryInitializer) initMetrics(res *resource.Resource, logger *zap
 		// https://github.com/open-telemetry/opentelemetry-collector/issues/8045
 		r, server, err := proctelemetry.InitMetricReader(context.Background(), reader, asyncErrorChannel)
 		if err != nil {
+			// The servers of the previous readers are closed, so that their addresses can be bound again.
+			for _, s := range tel.servers {
+				err = multierr.Append(err, s.Close())
+			}
+			tel.servers = nil
 			return err
 		}
 		if server != nil {
@@ -18,6 +18,9 @@ type Config struct {
 	Metrics MetricsConfig `mapstructure:"metrics"`
 	Traces  TracesConfig  `mapstructure:"traces"`
 
+	// CrashReports configures the diagnostic bundles written when a panic of a component is recovered.
+	CrashReports CrashReportsConfig `mapstructure:"crash_reports"`
+
 	// Resource specifies user-defined attributes to include with all emitted telemetry.
 	// Note that some attributes are added automatically (e.g. service.version) even
 	// if they are not specified here. In order to suppress such attributes the
@@ -138,6 +141,27 @@ type TracesConfig struct {
 	Processors []SpanProcessor `mapstructure:"processors"`
 }
 
+// CrashReportsConfig defines the recovery of the panics of the components, and the diagnostic bundles written
+// when a panic is recovered. The panics are recovered when the component is started or shut down, and when it
+// is called to consume data.
+// Experimental: *NOTE* this structure is subject to change or removal in the future.
+type CrashReportsConfig struct {
+	// Enabled recovers the panics of the components instead of crashing the collector.
+	// (default = false)
+	Enabled bool `mapstructure:"enabled"`
+
+	// Directory is the directory where a diagnostic bundle is written for each panic, as a JSON file
+	// holding the component ID, the stack trace, the hash of the configuration and the recent logs.
+	// No bundle is written if empty.
+	// (default = "")
+	Directory string `mapstructure:"directory"`
+
+	// LogEntries is the number of recent internal log entries written in the bundles, with the values
+	// of their fields redacted.
+	// (default = 100)
+	LogEntries int `mapstructure:"log_entries"`
+}
+
 // Validate checks whether the current configuration is valid
 func (c *Config) Validate() error {
 	// Check when service telemetry metric level is not none, the metrics address should not be empty
@@ -145,5 +169,13 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("collector telemetry metric address or reader should exist when metric level is not none")
 	}
 
+	if c.CrashReports.LogEntries < 0 {
+		return fmt.Errorf("crash_reports::log_entries must not be negative")
+	}
+
+	if c.CrashReports.Directory != "" && !c.CrashReports.Enabled {
+		return fmt.Errorf("crash_reports::directory requires crash_reports::enabled")
+	}
+
 	return nil
 }
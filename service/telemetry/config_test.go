@@ -49,6 +49,32 @@ func TestLoadConfig(t *testing.T) {
 			},
 			success: true,
 		},
+		{
+			name: "invalid crash reports log entries",
+			cfg: &Config{
+				Metrics: MetricsConfig{
+					Level:   configtelemetry.LevelBasic,
+					Address: "127.0.0.1:3333",
+				},
+				CrashReports: CrashReportsConfig{
+					LogEntries: -1,
+				},
+			},
+			success: false,
+		},
+		{
+			name: "crash reports directory without recovery",
+			cfg: &Config{
+				Metrics: MetricsConfig{
+					Level:   configtelemetry.LevelBasic,
+					Address: "127.0.0.1:3333",
+				},
+				CrashReports: CrashReportsConfig{
+					Directory: "/var/lib/otelcol/crash-reports",
+				},
+			},
+			success: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -4,9 +4,13 @@
 package service // import "go.opentelemetry.io/collector/service"
 
 import (
+	"fmt"
 	"net/http"
+	"net/url"
 	"path"
 	"runtime"
+	"sort"
+	"strconv"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -20,6 +24,7 @@ const (
 	zPipelinePath  = "pipelinez"
 	zExtensionPath = "extensionz"
 	zFeaturePath   = "featurez"
+	zConfigPath    = "configz"
 )
 
 var (
@@ -41,7 +46,8 @@ func (host *serviceHost) RegisterZPages(mux *http.ServeMux, pathPrefix string) {
 	mux.HandleFunc(path.Join(pathPrefix, zServicePath), host.zPagesRequest)
 	mux.HandleFunc(path.Join(pathPrefix, zPipelinePath), host.pipelines.HandleZPages)
 	mux.HandleFunc(path.Join(pathPrefix, zExtensionPath), host.serviceExtensions.HandleZPages)
-	mux.HandleFunc(path.Join(pathPrefix, zFeaturePath), handleFeaturezRequest)
+	mux.HandleFunc(path.Join(pathPrefix, zFeaturePath), host.handleFeaturezRequest)
+	mux.HandleFunc(path.Join(pathPrefix, zConfigPath), host.handleConfigzRequest)
 }
 
 func (host *serviceHost) zPagesRequest(w http.ResponseWriter, _ *http.Request) {
@@ -64,19 +70,64 @@ func (host *serviceHost) zPagesRequest(w http.ResponseWriter, _ *http.Request) {
 		ComponentEndpoint: zFeaturePath,
 		Link:              true,
 	})
+	zpages.WriteHTMLComponentHeader(w, zpages.ComponentHeaderData{
+		Name:              "Configuration",
+		ComponentEndpoint: zConfigPath,
+		Link:              true,
+	})
 	zpages.WriteHTMLPageFooter(w)
 }
 
-func handleFeaturezRequest(w http.ResponseWriter, _ *http.Request) {
+func (host *serviceHost) handleFeaturezRequest(w http.ResponseWriter, r *http.Request) {
+	handleFeaturez(w, r, featuregate.GlobalRegistry(), host.runtimeFeatureGates)
+}
+
+// handleFeaturez writes the feature gates page. When toggle is true, the POST requests enable or disable
+// the runtime safe feature gate given by the "id" form value, according to the "enabled" form value,
+// before redirecting to the page. The POST requests sent from another origin are rejected, so that
+// another site can not toggle the gates through the browser of a user.
+func handleFeaturez(w http.ResponseWriter, r *http.Request, registry *featuregate.Registry, toggle bool) {
+	if r.Method == http.MethodPost {
+		if !toggle {
+			http.Error(w, "changing the feature gates at runtime is disabled, see service::runtime_feature_gates", http.StatusForbidden)
+			return
+		}
+		if !sameOrigin(r) {
+			http.Error(w, "cross-origin requests are not allowed", http.StatusForbidden)
+			return
+		}
+		enabled, err := strconv.ParseBool(r.FormValue("enabled"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid enabled value %q", r.FormValue("enabled")), http.StatusBadRequest)
+			return
+		}
+		if err = registry.SetAtRuntime(r.FormValue("id"), enabled); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Redirect(w, r, r.URL.Path, http.StatusSeeOther)
+		return
+	}
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	zpages.WriteHTMLPageHeader(w, zpages.HeaderData{Title: "Feature Gates"})
-	zpages.WriteHTMLFeaturesTable(w, getFeaturesTableData())
+	zpages.WriteHTMLFeaturesTable(w, getFeaturesTableData(registry, toggle))
 	zpages.WriteHTMLPageFooter(w)
 }
 
-func getFeaturesTableData() zpages.FeatureGateTableData {
-	data := zpages.FeatureGateTableData{}
-	featuregate.GlobalRegistry().VisitAll(func(gate *featuregate.Gate) {
+// sameOrigin returns true if the request was sent from the origin of the collector, or without origin
+// by a client other than a browser. The browsers send the Origin header with all the POST requests.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && u.Host == r.Host
+}
+
+func getFeaturesTableData(registry *featuregate.Registry, toggle bool) zpages.FeatureGateTableData {
+	data := zpages.FeatureGateTableData{Toggle: toggle}
+	registry.VisitAll(func(gate *featuregate.Gate) {
 		data.Rows = append(data.Rows, zpages.FeatureGateTableRowData{
 			ID:           gate.ID(),
 			Enabled:      gate.IsEnabled(),
@@ -85,11 +136,60 @@ func getFeaturesTableData() zpages.FeatureGateTableData {
 			FromVersion:  gate.FromVersion(),
 			ToVersion:    gate.ToVersion(),
 			ReferenceURL: gate.ReferenceURL(),
+			RuntimeSafe:  gate.IsRuntimeSafe(),
 		})
 	})
 	return data
 }
 
+func (host *serviceHost) handleConfigzRequest(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	zpages.WriteHTMLPageHeader(w, zpages.HeaderData{Title: "Configuration"})
+	zpages.WriteHTMLConfigTable(w, host.getConfigTableData())
+	zpages.WriteHTMLPropertiesTable(w, zpages.PropertiesTableData{Name: "Active Feature Gates", Properties: getActiveFeatureGatesProperties()})
+	zpages.WriteHTMLPageFooter(w)
+}
+
+// getConfigTableData returns the keys of the effective configuration, along with the URI they were retrieved from.
+// The keys that were not retrieved from any URI have their default value.
+func (host *serviceHost) getConfigTableData() zpages.ConfigTableData {
+	data := zpages.ConfigTableData{Name: "Effective Configuration"}
+	if host.effectiveConf == nil {
+		data.Error = "The effective configuration is not available."
+		return data
+	}
+	conf, err := host.effectiveConf()
+	if err != nil {
+		data.Error = "The effective configuration is not available: " + err.Error()
+		return data
+	}
+	keys := conf.AllKeys()
+	sort.Strings(keys)
+	for _, key := range keys {
+		row := zpages.ConfigTableRowData{Key: key}
+		if value := conf.Get(key); value != nil {
+			row.Value = fmt.Sprint(value)
+		}
+		if origin, ok := host.confOrigins[key]; ok {
+			row.Origin = origin
+		} else if host.confOrigins != nil {
+			row.Origin = "(default)"
+		}
+		data.Rows = append(data.Rows, row)
+	}
+	return data
+}
+
+func getActiveFeatureGatesProperties() [][2]string {
+	var properties [][2]string
+	featuregate.GlobalRegistry().VisitAll(func(gate *featuregate.Gate) {
+		if gate.IsEnabled() {
+			properties = append(properties, [2]string{gate.ID(), gate.Stage().String()})
+		}
+	})
+	return properties
+}
+
 func getBuildInfoProperties(buildInfo component.BuildInfo) [][2]string {
 	return [][2]string{
 		{"Command", buildInfo.Command},
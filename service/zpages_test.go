@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/service/internal/zpages"
+)
+
+func TestGetConfigTableData(t *testing.T) {
+	host := &serviceHost{
+		effectiveConf: func() (*confmap.Conf, error) {
+			return confmap.NewFromStringMap(map[string]any{
+				"receivers": map[string]any{"nop": map[string]any{"endpoint": "localhost:4317", "token": "[REDACTED]"}},
+			}), nil
+		},
+		confOrigins: map[string]string{"receivers::nop::token": "file:config.yaml"},
+	}
+	assert.Equal(t, zpages.ConfigTableData{
+		Name: "Effective Configuration",
+		Rows: []zpages.ConfigTableRowData{
+			{Key: "receivers::nop::endpoint", Value: "localhost:4317", Origin: "(default)"},
+			{Key: "receivers::nop::token", Value: "[REDACTED]", Origin: "file:config.yaml"},
+		},
+	}, host.getConfigTableData())
+
+	host.effectiveConf = func() (*confmap.Conf, error) { return nil, errors.New("invalid config") }
+	assert.Equal(t, zpages.ConfigTableData{
+		Name:  "Effective Configuration",
+		Error: "The effective configuration is not available: invalid config",
+	}, host.getConfigTableData())
+
+	host.effectiveConf = nil
+	assert.Equal(t, zpages.ConfigTableData{
+		Name:  "Effective Configuration",
+		Error: "The effective configuration is not available.",
+	}, host.getConfigTableData())
+}
+
+func TestHandleFeaturez(t *testing.T) {
+	registry := featuregate.NewRegistry()
+	runtimeSafe := registry.MustRegister("runtime.safe", featuregate.StageAlpha, featuregate.WithRegisterRuntimeSafe())
+	startup := registry.MustRegister("startup.only", featuregate.StageAlpha)
+	var changes []bool
+	runtimeSafe.Subscribe(func(enabled bool) { changes = append(changes, enabled) })
+
+	newPost := func(form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/debug/featurez", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+	post := func(form url.Values) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handleFeaturez(rec, newPost(form), registry, true)
+		return rec
+	}
+
+	// The gates can not be toggled without opt-in.
+	rec := httptest.NewRecorder()
+	handleFeaturez(rec, newPost(url.Values{"id": {"runtime.safe"}, "enabled": {"true"}}), registry, false)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.False(t, runtimeSafe.IsEnabled())
+
+	// The gates can not be toggled from another origin.
+	rec = httptest.NewRecorder()
+	req := newPost(url.Values{"id": {"runtime.safe"}, "enabled": {"true"}})
+	req.Header.Set("Origin", "https://attacker.example.com")
+	handleFeaturez(rec, req, registry, true)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.False(t, runtimeSafe.IsEnabled())
+
+	rec = httptest.NewRecorder()
+	req = newPost(url.Values{"id": {"runtime.safe"}, "enabled": {"true"}})
+	req.Header.Set("Origin", "http://"+req.Host)
+	handleFeaturez(rec, req, registry, true)
+	assert.Equal(t, http.StatusSeeOther, rec.Code)
+	require.NoError(t, registry.Set("runtime.safe", false))
+	changes = nil
+
+	rec = post(url.Values{"id": {"runtime.safe"}, "enabled": {"true"}})
+	assert.Equal(t, http.StatusSeeOther, rec.Code)
+	assert.Equal(t, "/debug/featurez", rec.Header().Get("Location"))
+	assert.True(t, runtimeSafe.IsEnabled())
+	assert.Equal(t, []bool{true}, changes)
+
+	rec = post(url.Values{"id": {"startup.only"}, "enabled": {"true"}})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), `feature gate "startup.only" is not runtime safe`)
+	assert.False(t, startup.IsEnabled())
+
+	rec = post(url.Values{"id": {"runtime.safe"}, "enabled": {"maybe"}})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.True(t, runtimeSafe.IsEnabled())
+
+	rec = httptest.NewRecorder()
+	handleFeaturez(rec, httptest.NewRequest(http.MethodGet, "/debug/featurez", nil), registry, true)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `<input type="hidden" name="id" value="runtime.safe">`)
+	assert.NotContains(t, rec.Body.String(), `value="startup.only"`)
+
+	rec = httptest.NewRecorder()
+	handleFeaturez(rec, httptest.NewRequest(http.MethodGet, "/debug/featurez", nil), registry, false)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "<form")
+}